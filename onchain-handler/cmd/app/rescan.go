@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/internal/listeners"
+	listenertypes "github.com/genefriendway/onchain-handler/internal/listeners/types"
+	"github.com/genefriendway/onchain-handler/internal/wire/instances"
+	pkglogger "github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+// NewTransferRescanner creates the transfer rescanner of a network from its configured RPC URLs and token contracts.
+func NewTransferRescanner(
+	ctx context.Context,
+	cacheRepository cachetypes.CacheRepository,
+	network constants.NetworkType,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
+) (listenertypes.TransferRescanner, error) {
+	rpcUrls, err := conf.GetRPCUrls(network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get RPC URLs: %w", err)
+	}
+	ethClient, err := instances.ETHClientInstance(network, rpcUrls)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenContractAddresses []string
+	for _, symbol := range []string{constants.USDT, constants.USDC} {
+		address, err := conf.GetTokenAddress(symbol, network.String())
+		if err != nil || address == "" {
+			continue
+		}
+		tokenContractAddresses = append(tokenContractAddresses, address)
+	}
+
+	return listeners.NewTokenTransferRescanner(
+		ctx,
+		cacheRepository,
+		ethClient,
+		paymentOrderUCase,
+		paymentEventHistoryUCase,
+		complianceUCase,
+		network,
+		tokenContractAddresses,
+	)
+}
+
+// initializeRescanners creates the rescanners served by the admin API. They are only created when the admin API
+// is enabled, a network whose rescanner cannot be created is left out.
+func initializeRescanners(
+	ctx context.Context,
+	config *conf.Configuration,
+	cacheRepository cachetypes.CacheRepository,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
+) map[string]listenertypes.TransferRescanner {
+	rescanners := make(map[string]listenertypes.TransferRescanner)
+	if config.AdminAPIKey == "" {
+		return rescanners
+	}
+
+	for _, network := range conf.GetNetworks() {
+		rescanner, err := NewTransferRescanner(
+			ctx,
+			cacheRepository,
+			network,
+			paymentOrderUCase,
+			paymentEventHistoryUCase,
+			complianceUCase,
+		)
+		if err != nil {
+			pkglogger.GetLogger().Errorf("Failed to initialize rescanner for network %s: %v", network.String(), err)
+			continue
+		}
+		rescanners[network.String()] = rescanner
+	}
+	return rescanners
+}
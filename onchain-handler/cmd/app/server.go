@@ -29,6 +29,19 @@ func RunServer(
 	tokenTransferUCase ucasetypes.TokenTransferUCase,
 	metadataUCase ucasetypes.MetadataUCase,
 	paymentStatisticsUCase ucasetypes.PaymentStatisticsUCase,
+	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
+	gaslessPaymentUCase ucasetypes.GaslessPaymentUCase,
+	tolerancePolicyUCase ucasetypes.TolerancePolicyUCase,
+	idempotencyUCase ucasetypes.IdempotencyUCase,
+	invoiceUCase ucasetypes.InvoiceUCase,
+	subscriptionUCase ucasetypes.SubscriptionUCase,
+	payoutUCase ucasetypes.PayoutUCase,
+	transferPolicyUCase ucasetypes.TransferPolicyUCase,
+	withdrawalRequestUCase ucasetypes.WithdrawalRequestUCase,
+	withdrawScheduleUCase ucasetypes.WithdrawScheduleUCase,
+	gasCostUCase ucasetypes.GasCostUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
+	adminUCase ucasetypes.AdminUCase,
 ) {
 	// Initialize Gin router with middleware
 	r := initializeRouter()
@@ -36,6 +49,16 @@ func RunServer(
 	// Initialize payment wallets
 	initializePaymentWallets(ctx, config, paymentWalletUCase)
 
+	// Initialize the rescanners of the admin API
+	rescanners := initializeRescanners(
+		ctx,
+		config,
+		cacheRepository,
+		paymentOrderUCase,
+		paymentEventHistoryUCase,
+		complianceUCase,
+	)
+
 	// Register routes
 	routev1.RegisterRoutes(
 		ctx,
@@ -47,6 +70,19 @@ func RunServer(
 		paymentWalletUCase,
 		metadataUCase,
 		paymentStatisticsUCase,
+		gaslessPaymentUCase,
+		tolerancePolicyUCase,
+		idempotencyUCase,
+		invoiceUCase,
+		subscriptionUCase,
+		payoutUCase,
+		transferPolicyUCase,
+		withdrawalRequestUCase,
+		withdrawScheduleUCase,
+		gasCostUCase,
+		complianceUCase,
+		adminUCase,
+		rescanners,
 	)
 
 	// Start server
@@ -77,6 +113,13 @@ func initializePaymentWallets(
 	if err != nil {
 		pkglogger.GetLogger().Fatalf("Init payment wallets error: %v", err)
 	}
+
+	// Wallets created before Tron was enabled have no Tron address yet
+	if conf.IsTronEnabled() {
+		if err := paymentWalletUCase.AssignMissingTronAddresses(ctx); err != nil {
+			pkglogger.GetLogger().Fatalf("Assign Tron addresses error: %v", err)
+		}
+	}
 }
 
 func startServer(
@@ -0,0 +1,251 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
+	settypes "github.com/genefriendway/onchain-handler/internal/adapters/orderset/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/ucases"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/internal/workers"
+	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
+	pkglogger "github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// networkRuntime holds everything needed to start the workers and listeners of one network
+type networkRuntime struct {
+	ethClient              clienttypes.Client
+	subscriber             clienttypes.Subscriber
+	network                constants.NetworkType
+	chainID                uint64
+	startBlockListener     uint64
+	tokenContractAddresses []string
+}
+
+// runningNetwork tracks the listeners started for the shards of one network
+type runningNetwork struct {
+	cancel    context.CancelFunc
+	shardKeys []string
+}
+
+// shardSupervisor starts and stops the per network workers and listeners following the shard assignments
+type shardSupervisor struct {
+	config                   *conf.Configuration
+	cacheRepository          cachetypes.CacheRepository
+	networks                 map[constants.NetworkType]networkRuntime
+	blockStateUCase          ucasetypes.BlockStateUCase
+	listenerShardUCase       ucasetypes.ListenerShardUCase
+	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase
+	paymentOrderUCase        ucasetypes.PaymentOrderUCase
+	tokenTransferUCase       ucasetypes.TokenTransferUCase
+	paymentWalletUCase       ucasetypes.PaymentWalletUCase
+	payoutUCase              ucasetypes.PayoutUCase
+	withdrawalRequestUCase   ucasetypes.WithdrawalRequestUCase
+	withdrawScheduleUCase    ucasetypes.WithdrawScheduleUCase
+	gasCostUCase             ucasetypes.GasCostUCase
+	complianceUCase          ucasetypes.ComplianceUCase
+	paymentOrderSet          settypes.Set[dto.PaymentOrderDTO]
+	running                  map[constants.NetworkType]*runningNetwork
+	mu                       sync.Mutex
+}
+
+// startShardedListeners joins the listener cluster and only runs the networks and address partitions
+// assigned to this instance. Assignments are rebalanced through the database when instances join or leave.
+func startShardedListeners(
+	ctx context.Context,
+	config *conf.Configuration,
+	cacheRepository cachetypes.CacheRepository,
+	networks []networkRuntime,
+	blockStateUCase ucasetypes.BlockStateUCase,
+	listenerShardUCase ucasetypes.ListenerShardUCase,
+	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+	tokenTransferUCase ucasetypes.TokenTransferUCase,
+	paymentWalletUCase ucasetypes.PaymentWalletUCase,
+	payoutUCase ucasetypes.PayoutUCase,
+	withdrawalRequestUCase ucasetypes.WithdrawalRequestUCase,
+	withdrawScheduleUCase ucasetypes.WithdrawScheduleUCase,
+	gasCostUCase ucasetypes.GasCostUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
+	paymentOrderSet settypes.Set[dto.PaymentOrderDTO],
+) {
+	supervisor := &shardSupervisor{
+		config:                   config,
+		cacheRepository:          cacheRepository,
+		networks:                 make(map[constants.NetworkType]networkRuntime),
+		blockStateUCase:          blockStateUCase,
+		listenerShardUCase:       listenerShardUCase,
+		paymentEventHistoryUCase: paymentEventHistoryUCase,
+		paymentOrderUCase:        paymentOrderUCase,
+		tokenTransferUCase:       tokenTransferUCase,
+		paymentWalletUCase:       paymentWalletUCase,
+		payoutUCase:              payoutUCase,
+		withdrawalRequestUCase:   withdrawalRequestUCase,
+		withdrawScheduleUCase:    withdrawScheduleUCase,
+		gasCostUCase:             gasCostUCase,
+		complianceUCase:          complianceUCase,
+		paymentOrderSet:          paymentOrderSet,
+		running:                  make(map[constants.NetworkType]*runningNetwork),
+	}
+
+	networkTypes := make([]constants.NetworkType, 0, len(networks))
+	for _, runtime := range networks {
+		supervisor.networks[runtime.network] = runtime
+		networkTypes = append(networkTypes, runtime.network)
+	}
+
+	// Hold no orders until the first assignment arrives
+	paymentOrderSet.SetFilter(func(dto.PaymentOrderDTO) bool { return false })
+
+	instanceID := conf.GetListenerInstanceID()
+	pkglogger.GetLogger().Infof("Listener sharding enabled, instance ID: %s", instanceID)
+
+	shardWorker := workers.NewListenerShardWorker(
+		listenerShardUCase,
+		instanceID,
+		networkTypes,
+		conf.GetListenerAddressPartitions(),
+		supervisor.applyAssignment,
+	)
+	go shardWorker.Start(ctx)
+
+	go supervisor.refillOrderSet(ctx)
+}
+
+// applyAssignment restricts the order set to the owned shards and restarts the listeners of the networks whose shards changed
+func (s *shardSupervisor) applyAssignment(ctx context.Context, shards []dto.ListenerShardDTO) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Step 1: Group the owned shards by network
+	shardsByNetwork := make(map[constants.NetworkType][]dto.ListenerShardDTO)
+	ownedPartitions := make(map[string]map[uint32]bool)
+	partitionCounts := make(map[string]uint32)
+	for _, shard := range shards {
+		network := constants.NetworkType(shard.Network)
+		shardsByNetwork[network] = append(shardsByNetwork[network], shard)
+
+		if ownedPartitions[shard.Network] == nil {
+			ownedPartitions[shard.Network] = make(map[uint32]bool)
+		}
+		ownedPartitions[shard.Network][shard.PartitionIndex] = true
+		partitionCounts[shard.Network] = shard.PartitionCount
+	}
+
+	// Step 2: Keep only the orders of the owned shards in the set
+	s.paymentOrderSet.SetFilter(func(order dto.PaymentOrderDTO) bool {
+		partitions, owned := ownedPartitions[order.Network]
+		if !owned {
+			return false
+		}
+		return partitions[utils.AddressPartition(order.PaymentAddress, partitionCounts[order.Network])]
+	})
+	if err := s.paymentOrderSet.Fill(s.paymentOrderUCase.GetActivePaymentOrders); err != nil {
+		pkglogger.GetLogger().Errorf("Failed to fill the order set after shard assignment: %v", err)
+	}
+
+	// Step 3: Stop the networks that were released or whose shards changed
+	for network, running := range s.running {
+		if slices.Equal(running.shardKeys, shardKeysOf(shardsByNetwork[network])) {
+			continue
+		}
+		pkglogger.GetLogger().Infof("Stopping listeners on network %s for shards %v", network.String(), running.shardKeys)
+		running.cancel()
+		delete(s.running, network)
+	}
+
+	// Step 4: Start the networks that were newly assigned
+	for network, networkShards := range shardsByNetwork {
+		if _, isRunning := s.running[network]; isRunning {
+			continue
+		}
+		runtime, supported := s.networks[network]
+		if !supported {
+			pkglogger.GetLogger().Warnf("Shard assigned for unsupported network %s, skipping", network.String())
+			continue
+		}
+
+		networkCtx, cancel := context.WithCancel(ctx)
+		s.running[network] = &runningNetwork{cancel: cancel, shardKeys: shardKeysOf(networkShards)}
+		s.startNetwork(networkCtx, runtime, networkShards)
+	}
+}
+
+// startNetwork starts the listeners of the owned shards of a network.
+// Network wide workers only run on the instance owning the first partition.
+func (s *shardSupervisor) startNetwork(ctx context.Context, runtime networkRuntime, shards []dto.ListenerShardDTO) {
+	pkglogger.GetLogger().Infof("Starting listeners on network %s for shards %v", runtime.network.String(), shardKeysOf(shards))
+
+	for _, shard := range shards {
+		if shard.PartitionIndex != 0 {
+			continue
+		}
+		startWorkers(
+			ctx,
+			s.config,
+			s.cacheRepository,
+			runtime.ethClient,
+			runtime.subscriber,
+			runtime.network,
+			runtime.chainID,
+			runtime.tokenContractAddresses,
+			s.blockStateUCase,
+			s.tokenTransferUCase,
+			s.paymentOrderUCase,
+			s.paymentWalletUCase,
+			s.paymentEventHistoryUCase,
+			s.payoutUCase,
+			s.withdrawalRequestUCase,
+			s.withdrawScheduleUCase,
+			s.gasCostUCase,
+			s.complianceUCase,
+		)
+	}
+
+	startEventListeners(
+		ctx,
+		runtime.ethClient,
+		runtime.subscriber,
+		runtime.network,
+		runtime.startBlockListener,
+		runtime.tokenContractAddresses,
+		s.cacheRepository,
+		ucases.NewShardBlockStateUCase(s.blockStateUCase, s.listenerShardUCase, shards),
+		s.paymentOrderUCase,
+		s.paymentEventHistoryUCase,
+		s.complianceUCase,
+		s.paymentOrderSet,
+	)
+}
+
+// refillOrderSet periodically loads the orders created through other instances into the shard set
+func (s *shardSupervisor) refillOrderSet(ctx context.Context) {
+	ticker := time.NewTicker(constants.ShardOrderSetRefillInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.paymentOrderSet.Fill(s.paymentOrderUCase.GetActivePaymentOrders); err != nil {
+				pkglogger.GetLogger().Errorf("Failed to refill the shard order set: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func shardKeysOf(shards []dto.ListenerShardDTO) []string {
+	keys := make([]string, 0, len(shards))
+	for _, shard := range shards {
+		keys = append(keys, shard.Key())
+	}
+	return keys
+}
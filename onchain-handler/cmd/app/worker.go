@@ -29,7 +29,14 @@ func RunWorkers(
 	paymentOrderUCase ucasetypes.PaymentOrderUCase,
 	tokenTransferUCase ucasetypes.TokenTransferUCase,
 	paymentWalletUCase ucasetypes.PaymentWalletUCase,
-	paymentStatisticsUCase ucasetypes.PaymentStatisticsUCase,
+	listenerShardUCase ucasetypes.ListenerShardUCase,
+	invoiceUCase ucasetypes.InvoiceUCase,
+	subscriptionUCase ucasetypes.SubscriptionUCase,
+	payoutUCase ucasetypes.PayoutUCase,
+	withdrawalRequestUCase ucasetypes.WithdrawalRequestUCase,
+	withdrawScheduleUCase ucasetypes.WithdrawScheduleUCase,
+	gasCostUCase ucasetypes.GasCostUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
 	paymentOrderSet settypes.Set[dto.PaymentOrderDTO],
 ) {
 	// Initialize AVAX C-Chain client
@@ -43,6 +50,13 @@ func RunWorkers(
 	}
 	defer ethClientAvax.Close()
 
+	// Initialize AVAX C-Chain websocket subscriber, nil when no websocket URLs are configured.
+	// Unlike the HTTP clients it is not closed here since the workers keep using it after RunWorkers returns
+	subscriberAvax, err := instances.ETHSubscriberInstance(constants.AvaxCChain, conf.GetWSUrls(constants.AvaxCChain))
+	if err != nil {
+		pkglogger.GetLogger().Fatalf("Failed to initialize AVAX C-Chain websocket subscriber: %v", err)
+	}
+
 	// Initialize BSC client
 	bscRPCUrls, err := conf.GetRPCUrls(constants.Bsc)
 	if err != nil {
@@ -54,6 +68,12 @@ func RunWorkers(
 	}
 	defer ethClientBsc.Close()
 
+	// Initialize BSC websocket subscriber, nil when no websocket URLs are configured
+	subscriberBsc, err := instances.ETHSubscriberInstance(constants.Bsc, conf.GetWSUrls(constants.Bsc))
+	if err != nil {
+		pkglogger.GetLogger().Fatalf("Failed to initialize BSC websocket subscriber: %v", err)
+	}
+
 	// Persist token decimals to cache
 	type tokenInfo struct {
 		Client          clienttypes.Client
@@ -74,6 +94,14 @@ func RunWorkers(
 	releaseWalletWorker := workers.NewOrderCleanWorker(paymentOrderUCase, paymentOrderSet)
 	go releaseWalletWorker.Start(ctx)
 
+	// Start invoice reconcile worker
+	invoiceReconcileWorker := workers.NewInvoiceReconcileWorker(invoiceUCase)
+	go invoiceReconcileWorker.Start(ctx)
+
+	// Start subscription billing worker
+	subscriptionBillingWorker := workers.NewSubscriptionBillingWorker(subscriptionUCase)
+	go subscriptionBillingWorker.Start(ctx)
+
 	// Token contract addresses for AVAX and BSC
 	tokenBSCContractAddresses := []string{
 		config.Blockchain.BscNetwork.BscUSDTContractAddress,
@@ -85,71 +113,120 @@ func RunWorkers(
 		config.Blockchain.AvaxNetwork.AvaxUSDCContractAddress,
 	}
 
-	// Start AVAX workers
-	startWorkers(
-		ctx,
-		config,
-		cacheRepository,
-		ethClientAvax,
-		constants.AvaxCChain,
-		uint64(config.Blockchain.AvaxNetwork.AvaxChainID),
-		tokenAVAXContractAddresses,
-		blockStateUCase,
-		tokenTransferUCase,
-		paymentOrderUCase,
-		paymentWalletUCase,
-		paymentStatisticsUCase,
-		paymentEventHistoryUCase,
-	)
+	runtimes := []networkRuntime{
+		{
+			ethClient:              ethClientAvax,
+			subscriber:             subscriberAvax,
+			network:                constants.AvaxCChain,
+			chainID:                uint64(config.Blockchain.AvaxNetwork.AvaxChainID),
+			startBlockListener:     config.Blockchain.AvaxNetwork.AvaxStartBlockListener,
+			tokenContractAddresses: tokenAVAXContractAddresses,
+		},
+		{
+			ethClient:              ethClientBsc,
+			subscriber:             subscriberBsc,
+			network:                constants.Bsc,
+			chainID:                uint64(config.Blockchain.BscNetwork.BscChainID),
+			startBlockListener:     config.Blockchain.BscNetwork.BscStartBlockListener,
+			tokenContractAddresses: tokenBSCContractAddresses,
+		},
+	}
 
-	// Start BSC workers
-	startWorkers(
-		ctx,
-		config,
-		cacheRepository,
-		ethClientBsc,
-		constants.Bsc,
-		uint64(config.Blockchain.BscNetwork.BscChainID),
-		tokenBSCContractAddresses,
-		blockStateUCase,
-		tokenTransferUCase,
-		paymentOrderUCase,
-		paymentWalletUCase,
-		paymentStatisticsUCase,
-		paymentEventHistoryUCase,
-	)
+	// Tron only supports USDT and has no websocket subscriber, its listeners poll
+	if conf.IsTronEnabled() {
+		tronRPCUrls, err := conf.GetRPCUrls(constants.Tron)
+		if err != nil {
+			pkglogger.GetLogger().Fatalf("Failed to get Tron RPC URLs: %v", err)
+		}
+		ethClientTron, err := instances.ETHClientInstance(constants.Tron, tronRPCUrls)
+		if err != nil {
+			pkglogger.GetLogger().Fatalf("Failed to initialize Tron client: %v", err)
+		}
+		defer ethClientTron.Close()
 
-	// Start AVAX event listeners
-	startEventListeners(
-		ctx,
-		ethClientAvax,
-		constants.AvaxCChain,
-		config.Blockchain.AvaxNetwork.AvaxStartBlockListener,
-		tokenAVAXContractAddresses,
-		cacheRepository,
-		blockStateUCase,
-		paymentOrderUCase,
-		paymentStatisticsUCase,
-		paymentEventHistoryUCase,
-		paymentWalletUCase,
-		paymentOrderSet,
-	)
+		tronUSDTContractAddress, err := conf.GetTokenAddress(constants.USDT, constants.Tron.String())
+		if err != nil {
+			pkglogger.GetLogger().Fatalf("Failed to get Tron USDT contract address: %v", err)
+		}
+		persistTokenDecimalsToCache(ctx, ethClientTron, tronUSDTContractAddress, constants.Tron, cacheRepository)
 
-	// Start BSC event listeners
-	startEventListeners(
-		ctx,
-		ethClientBsc,
-		constants.Bsc,
-		config.Blockchain.BscNetwork.BscStartBlockListener,
-		tokenBSCContractAddresses,
-		cacheRepository,
-		blockStateUCase,
-		paymentOrderUCase,
-		paymentStatisticsUCase,
-		paymentEventHistoryUCase,
-		paymentWalletUCase,
-		paymentOrderSet,
-	)
+		chainID, err := conf.GetChainID(constants.Tron)
+		if err != nil {
+			pkglogger.GetLogger().Fatalf("Failed to get Tron chain ID: %v", err)
+		}
+		runtimes = append(runtimes, networkRuntime{
+			ethClient:              ethClientTron,
+			network:                constants.Tron,
+			chainID:                chainID,
+			startBlockListener:     config.Blockchain.TronNetwork.TronStartBlockListener,
+			tokenContractAddresses: []string{tronUSDTContractAddress},
+		})
+	}
+
+	// Only run the assigned networks and address partitions when sharding is enabled
+	if conf.IsListenerShardingEnabled() {
+		startShardedListeners(
+			ctx,
+			config,
+			cacheRepository,
+			runtimes,
+			blockStateUCase,
+			listenerShardUCase,
+			paymentEventHistoryUCase,
+			paymentOrderUCase,
+			tokenTransferUCase,
+			paymentWalletUCase,
+			payoutUCase,
+			withdrawalRequestUCase,
+			withdrawScheduleUCase,
+			gasCostUCase,
+			complianceUCase,
+			paymentOrderSet,
+		)
+		return
+	}
+
+	// Start the workers of every network
+	for _, runtime := range runtimes {
+		startWorkers(
+			ctx,
+			config,
+			cacheRepository,
+			runtime.ethClient,
+			runtime.subscriber,
+			runtime.network,
+			runtime.chainID,
+			runtime.tokenContractAddresses,
+			blockStateUCase,
+			tokenTransferUCase,
+			paymentOrderUCase,
+			paymentWalletUCase,
+			paymentEventHistoryUCase,
+			payoutUCase,
+			withdrawalRequestUCase,
+			withdrawScheduleUCase,
+			gasCostUCase,
+			complianceUCase,
+		)
+	}
+
+	// Start the event listeners of every network
+	for _, runtime := range runtimes {
+		startEventListeners(
+			ctx,
+			runtime.ethClient,
+			runtime.subscriber,
+			runtime.network,
+			runtime.startBlockListener,
+			runtime.tokenContractAddresses,
+			cacheRepository,
+			blockStateUCase,
+			paymentOrderUCase,
+			paymentEventHistoryUCase,
+			complianceUCase,
+			paymentOrderSet,
+		)
+	}
 }
 
 // persistTokenDecimalsToCache fetches token decimals from the blockchain and persists them to the cache
@@ -178,6 +255,7 @@ func startWorkers(
 	config *conf.Configuration,
 	cacheRepository cachetypes.CacheRepository,
 	ethClient clienttypes.Client,
+	subscriber clienttypes.Subscriber,
 	network constants.NetworkType,
 	chainID uint64,
 	tokenContractAddresses []string,
@@ -185,17 +263,20 @@ func startWorkers(
 	tokenTransferUCase ucasetypes.TokenTransferUCase,
 	paymentOrderUCase ucasetypes.PaymentOrderUCase,
 	paymentWalletUCase ucasetypes.PaymentWalletUCase,
-	paymentStatisticsUCase ucasetypes.PaymentStatisticsUCase,
 	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
+	payoutUCase ucasetypes.PayoutUCase,
+	withdrawalRequestUCase ucasetypes.WithdrawalRequestUCase,
+	withdrawScheduleUCase ucasetypes.WithdrawScheduleUCase,
+	gasCostUCase ucasetypes.GasCostUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
 ) {
-	latestBlockWorker := workers.NewLatestBlockWorker(blockStateUCase, ethClient, network)
+	latestBlockWorker := workers.NewLatestBlockWorker(blockStateUCase, ethClient, subscriber, network)
 	go latestBlockWorker.Start(ctx)
 
 	expiredOrderCatchupWorker := workers.NewExpiredOrderCatchupWorker(
 		paymentOrderUCase,
 		paymentEventHistoryUCase,
-		paymentStatisticsUCase,
-		paymentWalletUCase,
+		complianceUCase,
 		blockStateUCase,
 		cacheRepository,
 		tokenContractAddresses,
@@ -205,6 +286,7 @@ func startWorkers(
 	go expiredOrderCatchupWorker.Start(ctx)
 
 	// Start payment wallet withdraw worker
+	receivingGasFloor, receivingGasTarget := conf.GetReceivingGasRefill(network)
 	paymentWalletWithdrawWorker := workers.NewPaymentWalletWithdrawWorker(
 		ctx,
 		ethClient,
@@ -213,34 +295,57 @@ func startWorkers(
 		cacheRepository,
 		tokenTransferUCase,
 		paymentWalletUCase,
+		withdrawalRequestUCase,
+		withdrawScheduleUCase,
+		gasCostUCase,
 		tokenContractAddresses,
-		config.PaymentGateway.MasterWalletAddress,
+		conf.GetMasterWalletAddress(network),
 		config.Wallet.Mnemonic,
 		config.Wallet.Passphrase,
 		config.Wallet.Salt,
 		conf.GetGasBufferMultiplier(),
-		config.PaymentGateway.WithdrawWorkerInterval,
+		conf.GetHotWalletTargets(network),
+		receivingGasFloor,
+		receivingGasTarget,
 	)
 	go paymentWalletWithdrawWorker.Start(ctx)
+
+	// Start payout worker, it runs on a single instance per network so that the payout wallet nonces do not collide.
+	// Payouts are only sent on the EVM networks
+	if conf.IsPayoutEnabled() && network != constants.Tron {
+		payoutWorker := workers.NewPayoutWorker(
+			ethClient,
+			network,
+			chainID,
+			cacheRepository,
+			payoutUCase,
+			tokenTransferUCase,
+			config.Wallet.Mnemonic,
+			config.Wallet.Passphrase,
+			config.Wallet.Salt,
+		)
+		go payoutWorker.Start(ctx)
+	}
 }
 
 // startEventListeners starts the event listeners for the given network
 func startEventListeners(
 	ctx context.Context,
 	ethClient clienttypes.Client,
+	subscriber clienttypes.Subscriber,
 	network constants.NetworkType,
 	startBlockListener uint64,
 	tokenContractAddresses []string,
 	cacheRepository cachetypes.CacheRepository,
 	blockstateUcase ucasetypes.BlockStateUCase,
 	paymentOrderUCase ucasetypes.PaymentOrderUCase,
-	paymentStatisticsUCase ucasetypes.PaymentStatisticsUCase,
 	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
-	paymentWalletUCase ucasetypes.PaymentWalletUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
 	paymentOrderSet settypes.Set[dto.PaymentOrderDTO],
 ) {
 	baseEventListener := listeners.NewBaseEventListener(
 		ethClient,
+		subscriber,
 		network,
 		blockstateUcase,
 		&startBlockListener,
@@ -252,10 +357,10 @@ func startEventListeners(
 		baseEventListener,
 		paymentOrderUCase,
 		paymentEventHistoryUCase,
-		paymentStatisticsUCase,
-		paymentWalletUCase,
+		complianceUCase,
 		network,
 		tokenContractAddresses,
+		conf.GetPaymentRouterAddress(network.String()),
 		paymentOrderSet,
 	)
 	if err != nil {
@@ -60,7 +60,14 @@ func main() {
 			ucases.PaymentOrderUCase,
 			ucases.TokenTransferUCase,
 			ucases.PaymentWalletUCase,
-			ucases.PaymentStatisticsUCase,
+			ucases.ListenerShardUCase,
+			ucases.InvoiceUCase,
+			ucases.SubscriptionUCase,
+			ucases.PayoutUCase,
+			ucases.WithdrawalRequestUCase,
+			ucases.WithdrawScheduleUCase,
+			ucases.GasCostUCase,
+			ucases.ComplianceUCase,
 			paymentOrderSet,
 		)
 	}
@@ -73,6 +80,19 @@ func main() {
 		ucases.TokenTransferUCase,
 		ucases.MetadataUCase,
 		ucases.PaymentStatisticsUCase,
+		ucases.PaymentEventHistoryUCase,
+		ucases.GaslessPaymentUCase,
+		ucases.TolerancePolicyUCase,
+		ucases.IdempotencyUCase,
+		ucases.InvoiceUCase,
+		ucases.SubscriptionUCase,
+		ucases.PayoutUCase,
+		ucases.TransferPolicyUCase,
+		ucases.WithdrawalRequestUCase,
+		ucases.WithdrawScheduleUCase,
+		ucases.GasCostUCase,
+		ucases.ComplianceUCase,
+		ucases.AdminUCase,
 	)
 
 	// Handle shutdown signals
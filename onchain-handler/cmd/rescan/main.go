@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	app "github.com/genefriendway/onchain-handler/cmd/app"
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/wire"
+	"github.com/genefriendway/onchain-handler/internal/wire/instances"
+)
+
+// Re-scans a block range of a network for missed payments, e.g.
+//
+//	go run ./cmd/rescan -network BSC -from 45000000 -to 45001000          # dry-run
+//	go run ./cmd/rescan -network BSC -from 45000000 -to 45001000 -apply   # credit the missed transfers
+func main() {
+	network := flag.String("network", "", "Network to re-scan (BSC or AVAX C-Chain)")
+	fromBlock := flag.Uint64("from", 0, "First block of the range")
+	toBlock := flag.Uint64("to", 0, "Last block of the range")
+	apply := flag.Bool("apply", false, "Credit the missed transfers instead of only reporting them")
+	flag.Parse()
+
+	if *network == "" || *fromBlock == 0 || *toBlock == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if !constants.ValidNetworks[constants.NetworkType(*network)] {
+		log.Fatalf("Unsupported network: %s", *network)
+	}
+
+	ctx := context.Background()
+
+	// Initialize database, cache and use cases
+	db := instances.DBInstance()
+	cacheRepository := instances.CacheRepositoryInstance(ctx)
+	ucases := wire.InitializeUseCases(db, cacheRepository, instances.PaymentOrderSetInstance(ctx))
+
+	rescanner, err := app.NewTransferRescanner(
+		ctx,
+		cacheRepository,
+		constants.NetworkType(*network),
+		ucases.PaymentOrderUCase,
+		ucases.PaymentEventHistoryUCase,
+		ucases.ComplianceUCase,
+	)
+	if err != nil {
+		log.Fatalf("Failed to initialize rescanner for network %s: %v", *network, err)
+	}
+
+	log.Printf("Re-scanning network %s from block %d to %d (apply: %t)", *network, *fromBlock, *toBlock, *apply)
+
+	result, err := rescanner.Rescan(ctx, *fromBlock, *toBlock, *apply)
+	if err != nil {
+		log.Fatalf("Failed to rescan: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		log.Fatalf("Failed to print rescan result: %v", err)
+	}
+
+	log.Printf("Rescan completed: %d logs scanned, %d matched, %d credited", result.ScannedLogs, result.MatchedLogs, result.CreditedLogs)
+}
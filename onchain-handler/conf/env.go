@@ -27,32 +27,97 @@ type DatabaseConfiguration struct {
 type PaymentGatewayConfiguration struct {
 	InitWalletCount        uint   `mapstructure:"INIT_WALLET_COUNT"`
 	ExpiredOrderTime       uint   `mapstructure:"EXPIRED_ORDER_TIME"`
+	MinExpiredOrderTime    uint   `mapstructure:"MIN_EXPIRED_ORDER_TIME"`
+	MaxExpiredOrderTime    uint   `mapstructure:"MAX_EXPIRED_ORDER_TIME"`
 	OrderCutoffTime        uint   `mapstructure:"ORDER_CUTOFF_TIME"`
 	PaymentCovering        string `mapstructure:"PAYMENT_COVERING"`
 	MasterWalletAddress    string `mapstructure:"MASTER_WALLET_ADDRESS"`
 	WithdrawWorkerInterval string `mapstructure:"WITHDRAW_WORKER_INTERVAL"`
+	GaslessPaymentEnabled  bool   `mapstructure:"GASLESS_PAYMENT_ENABLED"`
+	GaslessMinAmount       string `mapstructure:"GASLESS_MIN_AMOUNT"`
+	GaslessRateLimit       uint   `mapstructure:"GASLESS_RATE_LIMIT"`
+	PaymentPageEnabled     bool   `mapstructure:"PAYMENT_PAGE_ENABLED"`
+	WalletReleaseCooldown  uint   `mapstructure:"WALLET_RELEASE_COOLDOWN"`
+	IdempotencyKeyTTL      uint   `mapstructure:"IDEMPOTENCY_KEY_TTL"`
+	PayoutEnabled          bool   `mapstructure:"PAYOUT_ENABLED"`
+	SplitPaymentPolicy     string `mapstructure:"SPLIT_PAYMENT_POLICY"`
 }
 
 type BlockchainConfiguration struct {
 	AvaxNetwork         AvaxNetworkConfiguration `mapstructure:",squash"`
 	BscNetwork          BscNetworkConfiguration  `mapstructure:",squash"`
+	TronNetwork         TronNetworkConfiguration `mapstructure:",squash"`
 	GasBufferMultiplier string                   `mapstructure:"GAS_BUFFER_MULTIPLIER"`
 }
 
 type AvaxNetworkConfiguration struct {
 	AvaxRPCUrls             string `mapstructure:"AVAX_RPC_URLS"`
+	AvaxWSUrls              string `mapstructure:"AVAX_WS_URLS"`
 	AvaxChainID             uint32 `mapstructure:"AVAX_CHAIN_ID"`
 	AvaxStartBlockListener  uint64 `mapstructure:"AVAX_START_BLOCK_LISTENER"`
 	AvaxUSDTContractAddress string `mapstructure:"AVAX_USDT_CONTRACT_ADDRESS"`
 	AvaxUSDCContractAddress string `mapstructure:"AVAX_USDC_CONTRACT_ADDRESS"`
+	AvaxLogRangeMin         uint64 `mapstructure:"AVAX_LOG_RANGE_MIN"`
+	AvaxLogRangeMax         uint64 `mapstructure:"AVAX_LOG_RANGE_MAX"`
+	AvaxRPCLogRangeLimits   string `mapstructure:"AVAX_RPC_LOG_RANGE_LIMITS"`
+	AvaxPaymentRouter       string `mapstructure:"AVAX_PAYMENT_ROUTER_ADDRESS"`
+	AvaxHotWalletTargets    string `mapstructure:"AVAX_HOT_WALLET_TARGETS"`
+	AvaxReceivingGasFloor   string `mapstructure:"AVAX_RECEIVING_GAS_FLOOR"`
+	AvaxReceivingGasTarget  string `mapstructure:"AVAX_RECEIVING_GAS_TARGET"`
+	AvaxNativePriceFeed     string `mapstructure:"AVAX_NATIVE_PRICE_FEED"`
+	AvaxNativeUSDPrice      string `mapstructure:"AVAX_NATIVE_USD_PRICE"`
 }
 
 type BscNetworkConfiguration struct {
 	BscRPCUrls             string `mapstructure:"BSC_RPC_URLS"`
+	BscWSUrls              string `mapstructure:"BSC_WS_URLS"`
 	BscChainID             uint32 `mapstructure:"BSC_CHAIN_ID"`
 	BscStartBlockListener  uint64 `mapstructure:"BSC_START_BLOCK_LISTENER"`
 	BscUSDTContractAddress string `mapstructure:"BSC_USDT_CONTRACT_ADDRESS"`
 	BscUSDCContractAddress string `mapstructure:"BSC_USDC_CONTRACT_ADDRESS"`
+	BscLogRangeMin         uint64 `mapstructure:"BSC_LOG_RANGE_MIN"`
+	BscLogRangeMax         uint64 `mapstructure:"BSC_LOG_RANGE_MAX"`
+	BscRPCLogRangeLimits   string `mapstructure:"BSC_RPC_LOG_RANGE_LIMITS"`
+	BscPaymentRouter       string `mapstructure:"BSC_PAYMENT_ROUTER_ADDRESS"`
+	BscHotWalletTargets    string `mapstructure:"BSC_HOT_WALLET_TARGETS"`
+	BscReceivingGasFloor   string `mapstructure:"BSC_RECEIVING_GAS_FLOOR"`
+	BscReceivingGasTarget  string `mapstructure:"BSC_RECEIVING_GAS_TARGET"`
+	BscNativePriceFeed     string `mapstructure:"BSC_NATIVE_PRICE_FEED"`
+	BscNativeUSDPrice      string `mapstructure:"BSC_NATIVE_USD_PRICE"`
+}
+
+type TronNetworkConfiguration struct {
+	TronEnabled             bool   `mapstructure:"TRON_ENABLED"`
+	TronRPCUrls             string `mapstructure:"TRON_RPC_URLS"`
+	TronAPIUrl              string `mapstructure:"TRON_API_URL"`
+	TronAPIKey              string `mapstructure:"TRON_API_KEY"`
+	TronChainID             uint32 `mapstructure:"TRON_CHAIN_ID"`
+	TronStartBlockListener  uint64 `mapstructure:"TRON_START_BLOCK_LISTENER"`
+	TronUSDTContractAddress string `mapstructure:"TRON_USDT_CONTRACT_ADDRESS"`
+	TronLogRangeMin         uint64 `mapstructure:"TRON_LOG_RANGE_MIN"`
+	TronLogRangeMax         uint64 `mapstructure:"TRON_LOG_RANGE_MAX"`
+	TronRPCLogRangeLimits   string `mapstructure:"TRON_RPC_LOG_RANGE_LIMITS"`
+	TronFeeLimit            int64  `mapstructure:"TRON_FEE_LIMIT"`
+	TronMasterWalletAddress string `mapstructure:"TRON_MASTER_WALLET_ADDRESS"`
+	TronHotWalletTargets    string `mapstructure:"TRON_HOT_WALLET_TARGETS"`
+	TronReceivingGasFloor   string `mapstructure:"TRON_RECEIVING_GAS_FLOOR"`
+	TronReceivingGasTarget  string `mapstructure:"TRON_RECEIVING_GAS_TARGET"`
+	TronNativeUSDPrice      string `mapstructure:"TRON_NATIVE_USD_PRICE"`
+}
+
+type ShardingConfiguration struct {
+	ListenerShardingEnabled   bool   `mapstructure:"LISTENER_SHARDING_ENABLED"`
+	ListenerInstanceID        string `mapstructure:"LISTENER_INSTANCE_ID"`
+	ListenerAddressPartitions uint32 `mapstructure:"LISTENER_ADDRESS_PARTITIONS"`
+}
+
+type ComplianceConfiguration struct {
+	ScreeningProvider     string `mapstructure:"SCREENING_PROVIDER"`
+	ScreeningDenyListFile string `mapstructure:"SCREENING_DENY_LIST_FILE"`
+	ScreeningHTTPUrl      string `mapstructure:"SCREENING_HTTP_URL"`
+	ScreeningHTTPAPIKey   string `mapstructure:"SCREENING_HTTP_API_KEY"`
+	ScreeningFailClosed   bool   `mapstructure:"SCREENING_FAIL_CLOSED"`
+	ComplianceWebhookURL  string `mapstructure:"COMPLIANCE_WEBHOOK_URL"`
 }
 
 type WalletConfiguration struct {
@@ -67,12 +132,16 @@ type Configuration struct {
 	Blockchain     BlockchainConfiguration     `mapstructure:",squash"`
 	PaymentGateway PaymentGatewayConfiguration `mapstructure:",squash"`
 	Wallet         WalletConfiguration         `mapstructure:",squash"`
+	Sharding       ShardingConfiguration       `mapstructure:",squash"`
+	Compliance     ComplianceConfiguration     `mapstructure:",squash"`
 	AppName        string                      `mapstructure:"APP_NAME"`
 	AppPort        uint32                      `mapstructure:"APP_PORT"`
 	Env            string                      `mapstructure:"ENV"`
 	LogLevel       string                      `mapstructure:"LOG_LEVEL"`
 	CacheType      string                      `mapstructure:"CACHE_TYPE"`
 	WorkerEnabled  bool                        `mapstructure:"WORKER_ENABLED"`
+	AdminAPIKey    string                      `mapstructure:"ADMIN_API_KEY"`
+	ApproverKeys   string                      `mapstructure:"TRANSFER_APPROVER_KEYS"`
 }
 
 var configuration Configuration
@@ -103,11 +172,13 @@ var defaultConfigurations = map[string]any{
 	"WITHDRAW_WORKER_INTERVAL":   "hourly",
 	"MASTER_WALLET_ADDRESS":      "",
 	"AVAX_RPC_URLS":              "",
+	"AVAX_WS_URLS":               "",
 	"AVAX_CHAIN_ID":              0,
 	"AVAX_START_BLOCK_LISTENER":  0,
 	"AVAX_USDT_CONTRACT_ADDRESS": "",
 	"AVAX_USDC_CONTRACT_ADDRESS": "",
 	"BSC_RPC_URLS":               "",
+	"BSC_WS_URLS":                "",
 	"BSC_CHAIN_ID":               0,
 	"BSC_START_BLOCK_LISTENER":   0,
 	"BSC_USDT_CONTRACT_ADDRESS":  "",
@@ -115,6 +186,91 @@ var defaultConfigurations = map[string]any{
 	"MNEMONIC":                   "",
 	"PASSPHRASE":                 "",
 	"SALT":                       "",
+
+	// Listener sharding
+	"LISTENER_SHARDING_ENABLED":   false,
+	"LISTENER_INSTANCE_ID":        "",
+	"LISTENER_ADDRESS_PARTITIONS": 1,
+
+	// eth_getLogs block range
+	"AVAX_LOG_RANGE_MIN":        10,
+	"AVAX_LOG_RANGE_MAX":        2048,
+	"AVAX_RPC_LOG_RANGE_LIMITS": "",
+	"BSC_LOG_RANGE_MIN":         10,
+	"BSC_LOG_RANGE_MAX":         2048,
+	"BSC_RPC_LOG_RANGE_LIMITS":  "",
+
+	// Admin API
+	"ADMIN_API_KEY":          "",
+	"TRANSFER_APPROVER_KEYS": "", // Comma separated APPROVER:KEY entries, one key per approver of held transfers
+
+	// Payment router contract
+	"AVAX_PAYMENT_ROUTER_ADDRESS": "",
+	"BSC_PAYMENT_ROUTER_ADDRESS":  "",
+
+	// Gasless payments relayed by the relayer wallet
+	"GASLESS_PAYMENT_ENABLED": false,
+	"GASLESS_MIN_AMOUNT":      "1", // Token amount below which a gasless payment does not cover the gas of the relayer
+	"GASLESS_RATE_LIMIT":      10,  // Gasless payments accepted per minute from a client IP
+
+	// Hosted payment page
+	"PAYMENT_PAGE_ENABLED": false,
+
+	// Order cancellation
+	"WALLET_RELEASE_COOLDOWN": 60,
+
+	// Per order expiry bounds
+	"MIN_EXPIRED_ORDER_TIME": 1,
+	"MAX_EXPIRED_ORDER_TIME": 1440,
+
+	// Idempotency-Key retention
+	"IDEMPOTENCY_KEY_TTL": 1440,
+
+	// Payouts sent from the payout wallet
+	"PAYOUT_ENABLED": false,
+
+	// Payments of multi-chain orders made on several of their options
+	"SPLIT_PAYMENT_POLICY": "AGGREGATE",
+
+	// Treasury rebalancing of the receiving wallet
+	"AVAX_HOT_WALLET_TARGETS":   "",
+	"AVAX_RECEIVING_GAS_FLOOR":  "",
+	"AVAX_RECEIVING_GAS_TARGET": "",
+	"BSC_HOT_WALLET_TARGETS":    "",
+	"BSC_RECEIVING_GAS_FLOOR":   "",
+	"BSC_RECEIVING_GAS_TARGET":  "",
+
+	// USD price of the native token for the gas cost accounting
+	"AVAX_NATIVE_PRICE_FEED": "",
+	"AVAX_NATIVE_USD_PRICE":  "",
+	"BSC_NATIVE_PRICE_FEED":  "",
+	"BSC_NATIVE_USD_PRICE":   "",
+
+	// Screening of the payer addresses
+	"SCREENING_PROVIDER":       "",
+	"SCREENING_DENY_LIST_FILE": "",
+	"SCREENING_HTTP_URL":       "",
+	"SCREENING_HTTP_API_KEY":   "",
+	"SCREENING_FAIL_CLOSED":    false,
+	"COMPLIANCE_WEBHOOK_URL":   "",
+
+	// Tron network
+	"TRON_ENABLED":               false,
+	"TRON_RPC_URLS":              "",
+	"TRON_API_URL":               "",
+	"TRON_API_KEY":               "",
+	"TRON_CHAIN_ID":              728126428,
+	"TRON_START_BLOCK_LISTENER":  0,
+	"TRON_USDT_CONTRACT_ADDRESS": "",
+	"TRON_LOG_RANGE_MIN":         10,
+	"TRON_LOG_RANGE_MAX":         2048,
+	"TRON_RPC_LOG_RANGE_LIMITS":  "",
+	"TRON_FEE_LIMIT":             100000000,
+	"TRON_MASTER_WALLET_ADDRESS": "",
+	"TRON_HOT_WALLET_TARGETS":    "",
+	"TRON_RECEIVING_GAS_FLOOR":   "",
+	"TRON_RECEIVING_GAS_TARGET":  "",
+	"TRON_NATIVE_USD_PRICE":      "",
 }
 
 // loadDefaultConfigs sets default values for critical configurations
@@ -3,11 +3,14 @@ package conf
 import (
 	"fmt"
 	"log"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+	"github.com/genefriendway/onchain-handler/pkg/cron"
 )
 
 func GetRPCUrls(network constants.NetworkType) ([]string, error) {
@@ -18,6 +21,8 @@ func GetRPCUrls(network constants.NetworkType) ([]string, error) {
 		rpcUrls = configuration.Blockchain.BscNetwork.BscRPCUrls
 	case constants.AvaxCChain:
 		rpcUrls = configuration.Blockchain.AvaxNetwork.AvaxRPCUrls
+	case constants.Tron:
+		rpcUrls = configuration.Blockchain.TronNetwork.TronRPCUrls
 	default:
 		return nil, fmt.Errorf("unsupported network type: %s", network)
 	}
@@ -35,6 +40,97 @@ func GetRPCUrls(network constants.NetworkType) ([]string, error) {
 	return urls, nil
 }
 
+// GetChainID returns the configured chain ID of the network.
+func GetChainID(network constants.NetworkType) (uint64, error) {
+	var chainID uint32
+
+	switch network {
+	case constants.Bsc:
+		chainID = configuration.Blockchain.BscNetwork.BscChainID
+	case constants.AvaxCChain:
+		chainID = configuration.Blockchain.AvaxNetwork.AvaxChainID
+	case constants.Tron:
+		chainID = configuration.Blockchain.TronNetwork.TronChainID
+	default:
+		return 0, fmt.Errorf("unsupported network type: %s", network)
+	}
+
+	if chainID == 0 {
+		return 0, fmt.Errorf("no chain ID configured for network: %s", network)
+	}
+	return uint64(chainID), nil
+}
+
+// GetWSUrls returns the websocket endpoints of the network, or nil when none are configured.
+func GetWSUrls(network constants.NetworkType) []string {
+	var wsUrls string
+
+	switch network {
+	case constants.Bsc:
+		wsUrls = configuration.Blockchain.BscNetwork.BscWSUrls
+	case constants.AvaxCChain:
+		wsUrls = configuration.Blockchain.AvaxNetwork.AvaxWSUrls
+	}
+
+	var urls []string
+	for _, url := range strings.Split(wsUrls, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// GetLogRangeLimits returns the eth_getLogs block range bounds of the network. The per endpoint limits
+// are matched by position with the RPC URLs, an empty or invalid entry means the network maximum.
+func GetLogRangeLimits(network constants.NetworkType) (minRange, maxRange uint64, endpointMaxRanges []uint64) {
+	var endpointLimits string
+
+	switch network {
+	case constants.Bsc:
+		minRange = configuration.Blockchain.BscNetwork.BscLogRangeMin
+		maxRange = configuration.Blockchain.BscNetwork.BscLogRangeMax
+		endpointLimits = configuration.Blockchain.BscNetwork.BscRPCLogRangeLimits
+	case constants.AvaxCChain:
+		minRange = configuration.Blockchain.AvaxNetwork.AvaxLogRangeMin
+		maxRange = configuration.Blockchain.AvaxNetwork.AvaxLogRangeMax
+		endpointLimits = configuration.Blockchain.AvaxNetwork.AvaxRPCLogRangeLimits
+	case constants.Tron:
+		minRange = configuration.Blockchain.TronNetwork.TronLogRangeMin
+		maxRange = configuration.Blockchain.TronNetwork.TronLogRangeMax
+		endpointLimits = configuration.Blockchain.TronNetwork.TronRPCLogRangeLimits
+	}
+
+	if minRange == 0 {
+		minRange = constants.DefaultBlockOffset
+	}
+	if maxRange == 0 {
+		maxRange = constants.APIMaxBlocksPerRequest
+	}
+	if minRange > maxRange {
+		log.Printf("Log range min %d is above max %d on network %s. Using the max for both", minRange, maxRange, network)
+		minRange = maxRange
+	}
+
+	if endpointLimits == "" {
+		return minRange, maxRange, nil
+	}
+	for _, limit := range strings.Split(endpointLimits, ",") {
+		limit = strings.TrimSpace(limit)
+		if limit == "" {
+			endpointMaxRanges = append(endpointMaxRanges, 0)
+			continue
+		}
+		value, err := strconv.ParseUint(limit, 10, 64)
+		if err != nil {
+			log.Printf("Invalid RPC log range limit %q on network %s: %v. Using the network max", limit, network, err)
+			value = 0
+		}
+		endpointMaxRanges = append(endpointMaxRanges, value)
+	}
+	return minRange, maxRange, endpointMaxRanges
+}
+
 func GetConfiguration() *Configuration {
 	return &configuration
 }
@@ -51,6 +147,42 @@ func GetWalletConfiguration() *WalletConfiguration {
 	return &configuration.Wallet
 }
 
+func GetComplianceConfiguration() *ComplianceConfiguration {
+	return &configuration.Compliance
+}
+
+// GetScreeningProvider returns the provider screening the payer addresses, or an empty string when payments are
+// not screened. SCREENING_PROVIDER is deny_list or http, an invalid value falls back to the deny-list.
+func GetScreeningProvider() string {
+	provider := strings.TrimSpace(configuration.Compliance.ScreeningProvider)
+	switch provider {
+	case "", constants.ScreeningProviderDenyList:
+		return provider
+	case constants.ScreeningProviderHTTP:
+		if strings.TrimSpace(configuration.Compliance.ScreeningHTTPUrl) == "" {
+			log.Printf("SCREENING_HTTP_URL is not set. Using the deny-list screening provider")
+			return constants.ScreeningProviderDenyList
+		}
+		return provider
+	}
+
+	log.Printf("Invalid ScreeningProvider: %s. Using the deny-list screening provider", provider)
+	return constants.ScreeningProviderDenyList
+}
+
+// GetSplitPaymentPolicy returns how the payments made on different options of a multi-chain order add up,
+// AGGREGATE or SEPARATE. An invalid value falls back to AGGREGATE.
+func GetSplitPaymentPolicy() string {
+	policy := strings.ToUpper(strings.TrimSpace(configuration.PaymentGateway.SplitPaymentPolicy))
+	switch policy {
+	case constants.SplitPaymentAggregate, constants.SplitPaymentSeparate:
+		return policy
+	}
+
+	log.Printf("Invalid SplitPaymentPolicy: %s. Using %s", policy, constants.SplitPaymentAggregate)
+	return constants.SplitPaymentAggregate
+}
+
 func GetCacheType() string {
 	return configuration.CacheType
 }
@@ -59,15 +191,106 @@ func GetExpiredOrderTime() time.Duration {
 	return time.Duration(configuration.PaymentGateway.ExpiredOrderTime) * time.Minute
 }
 
+// GetExpiredOrderTimeBounds returns the bounds of the expiry time an order can be created or extended with.
+func GetExpiredOrderTimeBounds() (minTime, maxTime time.Duration) {
+	minTime = time.Duration(configuration.PaymentGateway.MinExpiredOrderTime) * time.Minute
+	maxTime = time.Duration(configuration.PaymentGateway.MaxExpiredOrderTime) * time.Minute
+	if maxTime < minTime {
+		log.Printf("MAX_EXPIRED_ORDER_TIME is below MIN_EXPIRED_ORDER_TIME. Using the min for both")
+		maxTime = minTime
+	}
+	return minTime, maxTime
+}
+
 func GetOrderCutoffTime() time.Duration {
 	return time.Duration(configuration.PaymentGateway.OrderCutoffTime) * time.Minute
 }
 
+// GetWalletReleaseCooldown returns how long the wallet of a cancelled order is kept from being claimed again.
+func GetWalletReleaseCooldown() time.Duration {
+	return time.Duration(configuration.PaymentGateway.WalletReleaseCooldown) * time.Minute
+}
+
+// GetIdempotencyKeyTTL returns how long the response of a request sent with an Idempotency-Key is replayed.
+func GetIdempotencyKeyTTL() time.Duration {
+	return time.Duration(configuration.PaymentGateway.IdempotencyKeyTTL) * time.Minute
+}
+
 func GetNetworks() []constants.NetworkType {
-	return []constants.NetworkType{
+	networks := []constants.NetworkType{
 		constants.Bsc,
 		constants.AvaxCChain,
 	}
+	if IsTronEnabled() {
+		networks = append(networks, constants.Tron)
+	}
+	return networks
+}
+
+func IsTronEnabled() bool {
+	return configuration.Blockchain.TronNetwork.TronEnabled
+}
+
+// GetTronAPI returns the base URL and the API key of the Tron HTTP API the transactions are sent through,
+// and the max TRX, in sun, a contract call may burn.
+func GetTronAPI() (apiURL, apiKey string, feeLimit int64) {
+	tron := configuration.Blockchain.TronNetwork
+	return strings.TrimSpace(tron.TronAPIUrl), strings.TrimSpace(tron.TronAPIKey), tron.TronFeeLimit
+}
+
+// GetMasterWalletAddress returns the wallet the payment wallets of the network are swept to.
+func GetMasterWalletAddress(network constants.NetworkType) string {
+	if network == constants.Tron {
+		return strings.TrimSpace(configuration.Blockchain.TronNetwork.TronMasterWalletAddress)
+	}
+	return configuration.PaymentGateway.MasterWalletAddress
+}
+
+func IsGaslessPaymentEnabled() bool {
+	return configuration.PaymentGateway.GaslessPaymentEnabled
+}
+
+// GetGaslessMinAmount returns the smallest token amount the relayer wallet relays a gasless payment for.
+func GetGaslessMinAmount() string {
+	return configuration.PaymentGateway.GaslessMinAmount
+}
+
+// GetGaslessRateLimit returns how many gasless payments a client IP can submit per minute.
+func GetGaslessRateLimit() uint {
+	return configuration.PaymentGateway.GaslessRateLimit
+}
+
+func IsPayoutEnabled() bool {
+	return configuration.PaymentGateway.PayoutEnabled
+}
+
+func IsPaymentPageEnabled() bool {
+	return configuration.PaymentGateway.PaymentPageEnabled
+}
+
+func IsListenerShardingEnabled() bool {
+	return configuration.Sharding.ListenerShardingEnabled
+}
+
+// GetListenerInstanceID returns the configured instance ID, falling back to the hostname.
+func GetListenerInstanceID() string {
+	if configuration.Sharding.ListenerInstanceID != "" {
+		return configuration.Sharding.ListenerInstanceID
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		log.Printf("Failed to resolve hostname for listener instance ID: %v. Using app name", err)
+		return configuration.AppName
+	}
+	return hostname
+}
+
+func GetListenerAddressPartitions() uint32 {
+	if configuration.Sharding.ListenerAddressPartitions == 0 {
+		return 1
+	}
+	return configuration.Sharding.ListenerAddressPartitions
 }
 
 func GetPaymentCovering() float64 {
@@ -92,6 +315,24 @@ func GetPaymentCovering() float64 {
 	return paymentCoveringFloat
 }
 
+// GetWithdrawSchedule returns the cron expression, evaluated in UTC, of the sweeps of the networks and tokens without
+// their own schedule. WITHDRAW_WORKER_INTERVAL is hourly, daily or a five field cron expression.
+func GetWithdrawSchedule() string {
+	interval := strings.TrimSpace(configuration.PaymentGateway.WithdrawWorkerInterval)
+	switch interval {
+	case constants.WithdrawIntervalHourly:
+		return "@hourly"
+	case constants.WithdrawIntervalDaily:
+		return "@daily"
+	}
+
+	if _, err := cron.Parse(interval); err != nil {
+		log.Printf("Invalid WithdrawWorkerInterval: %s. Using default value: hourly. Error: %v", interval, err)
+		return "@hourly"
+	}
+	return interval
+}
+
 func GetGasBufferMultiplier() float64 {
 	multiplierStr := configuration.Blockchain.GasBufferMultiplier
 	if multiplierStr == "" {
@@ -118,6 +359,9 @@ func GetTokenSymbol(tokenAddress string) (string, error) {
 		configuration.Blockchain.AvaxNetwork.AvaxUSDCContractAddress: constants.USDC,
 		configuration.Blockchain.BscNetwork.BscUSDCContractAddress:   constants.USDC,
 	}
+	if IsTronEnabled() {
+		tokenSymbols[tronUSDTContractAddress()] = constants.USDT
+	}
 
 	if symbol, exists := tokenSymbols[tokenAddress]; exists {
 		return symbol, nil
@@ -125,6 +369,103 @@ func GetTokenSymbol(tokenAddress string) (string, error) {
 	return "", fmt.Errorf("unknown token address: %s", tokenAddress)
 }
 
+// GetPaymentRouterAddress returns the payment router contract of the network, or an empty string when router payments are disabled.
+func GetPaymentRouterAddress(network string) string {
+	switch network {
+	case constants.Bsc.String():
+		return strings.TrimSpace(configuration.Blockchain.BscNetwork.BscPaymentRouter)
+	case constants.AvaxCChain.String():
+		return strings.TrimSpace(configuration.Blockchain.AvaxNetwork.AvaxPaymentRouter)
+	}
+	return ""
+}
+
+// GetHotWalletTargets returns the token balance, in token units by symbol, kept in the hot wallet of the network.
+// The targets are configured as comma separated SYMBOL:AMOUNT entries, invalid entries are ignored.
+func GetHotWalletTargets(network constants.NetworkType) map[string]string {
+	var hotWalletTargets string
+
+	switch network {
+	case constants.Bsc:
+		hotWalletTargets = configuration.Blockchain.BscNetwork.BscHotWalletTargets
+	case constants.AvaxCChain:
+		hotWalletTargets = configuration.Blockchain.AvaxNetwork.AvaxHotWalletTargets
+	case constants.Tron:
+		hotWalletTargets = configuration.Blockchain.TronNetwork.TronHotWalletTargets
+	}
+
+	targets := make(map[string]string)
+	for _, entry := range strings.Split(hotWalletTargets, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		symbol, amount, found := strings.Cut(entry, ":")
+		symbol, amount = strings.TrimSpace(symbol), strings.TrimSpace(amount)
+		if value, err := strconv.ParseFloat(amount, 64); !found || err != nil || value < 0 {
+			log.Printf("Invalid hot wallet target %q on network %s. Ignoring it", entry, network)
+			continue
+		}
+		targets[symbol] = amount
+	}
+	return targets
+}
+
+// GetReceivingGasRefill returns the native balance, in native token units, under which the receiving wallet of the network
+// is refilled and the balance it is refilled to. Both are empty when the receiving wallet is not refilled.
+func GetReceivingGasRefill(network constants.NetworkType) (floor, target string) {
+	switch network {
+	case constants.Bsc:
+		floor = configuration.Blockchain.BscNetwork.BscReceivingGasFloor
+		target = configuration.Blockchain.BscNetwork.BscReceivingGasTarget
+	case constants.AvaxCChain:
+		floor = configuration.Blockchain.AvaxNetwork.AvaxReceivingGasFloor
+		target = configuration.Blockchain.AvaxNetwork.AvaxReceivingGasTarget
+	case constants.Tron:
+		floor = configuration.Blockchain.TronNetwork.TronReceivingGasFloor
+		target = configuration.Blockchain.TronNetwork.TronReceivingGasTarget
+	}
+
+	floor, target = strings.TrimSpace(floor), strings.TrimSpace(target)
+	if floor == "" {
+		return "", ""
+	}
+	floorValue, err := strconv.ParseFloat(floor, 64)
+	if err != nil || floorValue <= 0 {
+		log.Printf("Invalid receiving gas floor %q on network %s. Not refilling the receiving wallet", floor, network)
+		return "", ""
+	}
+	if targetValue, err := strconv.ParseFloat(target, 64); err != nil || targetValue <= floorValue {
+		log.Printf("Receiving gas target %q on network %s is invalid or not above the floor. Not refilling the receiving wallet", target, network)
+		return "", ""
+	}
+	return floor, target
+}
+
+// GetNativePriceSource returns the Chainlink price feed of the USD price of the native token of the network, and the fixed
+// USD price used when the network has no feed. Both are empty when the gas costs of the network are not priced in USD.
+func GetNativePriceSource(network constants.NetworkType) (feedAddress, fixedPrice string) {
+	switch network {
+	case constants.Bsc:
+		feedAddress = configuration.Blockchain.BscNetwork.BscNativePriceFeed
+		fixedPrice = configuration.Blockchain.BscNetwork.BscNativeUSDPrice
+	case constants.AvaxCChain:
+		feedAddress = configuration.Blockchain.AvaxNetwork.AvaxNativePriceFeed
+		fixedPrice = configuration.Blockchain.AvaxNetwork.AvaxNativeUSDPrice
+	case constants.Tron:
+		fixedPrice = configuration.Blockchain.TronNetwork.TronNativeUSDPrice
+	}
+
+	feedAddress, fixedPrice = strings.TrimSpace(feedAddress), strings.TrimSpace(fixedPrice)
+	if fixedPrice != "" {
+		if value, err := strconv.ParseFloat(fixedPrice, 64); err != nil || value <= 0 {
+			log.Printf("Invalid native USD price %q on network %s. Ignoring it", fixedPrice, network)
+			fixedPrice = ""
+		}
+	}
+	return feedAddress, fixedPrice
+}
+
 func GetTokenAddress(symbol, network string) (string, error) {
 	tokenAddresses := map[string]map[string]string{
 		constants.AvaxCChain.String(): {
@@ -136,6 +477,9 @@ func GetTokenAddress(symbol, network string) (string, error) {
 			constants.USDC: configuration.Blockchain.BscNetwork.BscUSDCContractAddress,
 		},
 	}
+	if IsTronEnabled() {
+		tokenAddresses[constants.Tron.String()] = map[string]string{constants.USDT: tronUSDTContractAddress()}
+	}
 
 	if tokensForNetwork, exists := tokenAddresses[network]; exists {
 		if address, exists := tokensForNetwork[symbol]; exists {
@@ -145,3 +489,35 @@ func GetTokenAddress(symbol, network string) (string, error) {
 	}
 	return "", fmt.Errorf("unsupported network: %s", network)
 }
+
+// tronUSDTContractAddress returns the TRC-20 USDT contract, configured in base58, in the hex form of the logs.
+func tronUSDTContractAddress() string {
+	return chain.NormalizeAddress(strings.TrimSpace(configuration.Blockchain.TronNetwork.TronUSDTContractAddress))
+}
+
+// GetTransferApprovers returns the approvers of held transfers by their approver key. The approvers are configured
+// as comma separated APPROVER:KEY entries, invalid entries and keys shared by several approvers are ignored.
+func GetTransferApprovers() map[string]string {
+	approvers := make(map[string]string)
+	shared := make(map[string]bool)
+	for _, entry := range strings.Split(configuration.ApproverKeys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		approver, key, found := strings.Cut(entry, ":")
+		approver, key = strings.TrimSpace(approver), strings.TrimSpace(key)
+		if !found || approver == "" || key == "" || approver == constants.TransferPolicyActor {
+			log.Printf("Invalid transfer approver key entry for approver %q. Ignoring it", approver)
+			continue
+		}
+		if _, exists := approvers[key]; exists || shared[key] {
+			log.Printf("Transfer approver key of approver %q is shared with another approver. Ignoring it", approver)
+			delete(approvers, key)
+			shared[key] = true
+			continue
+		}
+		approvers[key] = approver
+	}
+	return approvers
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // ERC-20 transfer event ABI
@@ -11,9 +12,40 @@ const (
 	Erc20TransferEventABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
 )
 
+// ERC-20 approval and EIP-3009 authorization event ABIs
+const (
+	Erc20ApprovalEventABI            = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"},{"indexed":true,"name":"spender","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Approval","type":"event"}]`
+	Eip3009AuthorizationUsedEventABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"authorizer","type":"address"},{"indexed":true,"name":"nonce","type":"bytes32"}],"name":"AuthorizationUsed","type":"event"}]`
+)
+
+// EIP-3009 and EIP-2612 token methods used to relay gasless payments
+const (
+	GaslessTokenABI = `[{"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"validAfter","type":"uint256"},{"name":"validBefore","type":"uint256"},{"name":"nonce","type":"bytes32"},{"name":"v","type":"uint8"},{"name":"r","type":"bytes32"},{"name":"s","type":"bytes32"}],"name":"transferWithAuthorization","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"},{"name":"value","type":"uint256"},{"name":"deadline","type":"uint256"},{"name":"v","type":"uint8"},{"name":"r","type":"bytes32"},{"name":"s","type":"bytes32"}],"name":"permit","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"name":"owner","type":"address"}],"name":"nonces","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"DOMAIN_SEPARATOR","outputs":[{"name":"","type":"bytes32"}],"stateMutability":"view","type":"function"}]`
+)
+
+// Chainlink price feed methods used to price the gas costs in USD
+const (
+	PriceFeedABI             = `[{"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"latestRoundData","outputs":[{"name":"roundId","type":"uint80"},{"name":"answer","type":"int256"},{"name":"startedAt","type":"uint256"},{"name":"updatedAt","type":"uint256"},{"name":"answeredInRound","type":"uint80"}],"stateMutability":"view","type":"function"}]`
+	PriceFeedDecimalsMethod  = "decimals"
+	PriceFeedLatestRoundData = "latestRoundData"
+	PriceFeedMaxAge          = 24 * time.Hour // A price updated longer ago is not used
+)
+
+// Gasless token methods
+const (
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	PermitMethod                    = "permit"
+	TransferFromMethod              = "transferFrom"
+	NoncesMethod                    = "nonces"
+	DomainSeparatorMethod           = "DOMAIN_SEPARATOR"
+)
+
 // Event
 const (
-	TransferEventName = "Transfer"
+	TransferEventName          = "Transfer"
+	ApprovalEventName          = "Approval"
+	AuthorizationUsedEventName = "AuthorizationUsed"
+	PaymentReceivedEventName   = "PaymentReceived"
 )
 
 // Block confirmations
@@ -21,6 +53,7 @@ const (
 	DefaultConfirmationDepth = 15
 	ConfirmationDepthBSC     = 15
 	ConfirmationDepthAVAX    = 12
+	ConfirmationDepthTron    = 19 // A Tron block is solidified once confirmed by 19 of the 27 super representatives
 )
 
 // Method ID
@@ -38,12 +71,14 @@ func (t NetworkType) String() string {
 const (
 	Bsc        NetworkType = "BSC"
 	AvaxCChain NetworkType = "AVAX C-Chain"
+	Tron       NetworkType = "TRON"
 )
 
 // ValidNetworks contains the allowed values for NetworkType
 var ValidNetworks = map[NetworkType]bool{
 	Bsc:        true,
 	AvaxCChain: true,
+	Tron:       true,
 }
 
 // UnmarshalJSON ensures only valid network types are parsed from JSON.
@@ -73,6 +108,26 @@ func (t *NetworkType) UnmarshalJSON(data []byte) error {
 const (
 	NativeTokenDecimalsMultiplier = 1e18
 	NativeTokenDecimalPlaces      = 18 // for native token like ETH, BNB,... and AVAX
+	GweiDecimalPlaces             = 9  // wei in a gwei, for gas prices
+	TronSunDecimalPlaces          = 6  // sun in a TRX
+)
+
+// BIP-44 coin types the wallets are derived with
+const (
+	EVMCoinType  = 60
+	TronCoinType = 195
+)
+
+// Tron
+const (
+	TronDefaultChainID  = 728126428   // Chain ID returned by the Tron mainnet JSON-RPC
+	TronDefaultFeeLimit = 100_000_000 // Max TRX burnt by a contract call, in sun
+	TronBandwidthPrice  = 1000        // Sun burnt per byte of bandwidth once the free bandwidth is used
+	TronTransferSize    = 350         // Bytes of bandwidth used by a signed transfer
+	TronAPIKeyHeader    = "TRON-PRO-API-KEY"
+	TronReceiptTimeout  = 2 * time.Minute
+	TronReceiptInterval = 3 * time.Second
+	TronAPITimeout      = 10 * time.Second
 )
 
 // Token symbols
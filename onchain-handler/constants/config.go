@@ -16,12 +16,32 @@ const (
 	APIMaxBlocksPerRequest        = 2048 // Maximum number of blocks to query at once
 )
 
+// Log range config
+const (
+	LogRangeFastResponse = 2 * time.Second  // eth_getLogs responses faster than this let the block range grow
+	LogRangeSlowResponse = 10 * time.Second // eth_getLogs responses slower than this shrink the block range
+	LogRangeGrowMaxLogs  = 1000             // The block range only grows while responses hold fewer logs than this
+)
+
 // Retry config
 const (
 	MaxRetries = 3               // Maximum number of retries
 	RetryDelay = 3 * time.Second // Delay between retries
 )
 
+// Receipt config
+const (
+	ReceiptTimeout  = 2 * time.Minute // Maximum wait for a sent transaction to be mined
+	ReceiptInterval = 1 * time.Second // Interval between receipt polls
+)
+
+// Subscription config
+const (
+	SubscriptionRetryDelay    = 3 * time.Second // Initial delay before resubscribing after a dropped subscription
+	MaxSubscriptionRetryDelay = 1 * time.Minute // Upper bound of the exponential resubscribe backoff
+	DefaultSubscriptionBuffer = 128             // Buffer size for subscription channels
+)
+
 // Order set config
 const (
 	CleanSetInterval = 5 * time.Second // Interval to clean up the set
@@ -38,6 +58,17 @@ const (
 	LatestBlockFetchInterval    = 5 * time.Second
 	ExpiredOrderCatchupInterval = 1 * time.Minute
 	OrderCleanInterval          = 5 * time.Second
+	InvoiceReconcileInterval    = 10 * time.Second
+	SubscriptionBillingInterval = 1 * time.Minute
+	PayoutExecutionInterval     = 30 * time.Second
+	PayoutBatchSize             = 20 // Approved payouts sent per network in each payout worker run
+)
+
+// Listener sharding config
+const (
+	ListenerShardHeartbeatInterval = 10 * time.Second
+	ListenerInstanceTimeout        = 30 * time.Second // Instances without a heartbeat for this long lose their shards
+	ShardOrderSetRefillInterval    = 10 * time.Second // Interval to load orders created by other instances into the shard set
 )
 
 // Batch constants
@@ -52,12 +83,22 @@ const (
 	WebhookTimeout    = 5 * time.Second
 )
 
+// Screening constants
+const (
+	ScreeningTimeout = 5 * time.Second // Request timeout of the HTTP screening provider
+)
+
 // Withdraw interval
 const (
 	WithdrawIntervalDaily  = "daily"
 	WithdrawIntervalHourly = "hourly"
 )
 
+// Withdraw schedule check interval, due sweeps start within a minute of their scheduled time
+const (
+	WithdrawScheduleCheckInterval = time.Minute
+)
+
 // Eth client cooldown
 const (
 	EthClientCooldown = 15 * time.Second
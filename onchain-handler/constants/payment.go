@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 // Payment orders status
 const (
 	Pending    = "PENDING"
@@ -8,6 +10,118 @@ const (
 	Partial    = "PARTIAL"
 	Expired    = "EXPIRED"
 	Failed     = "FAILED"
+	Cancelled  = "CANCELLED"
+	Overpaid   = "OVERPAID" // Paid beyond the over tolerance of the order
+	OnHold     = "ON_HOLD"  // A payment to the order is held by the payer screening until it is reviewed
+)
+
+// IsPaidStatus reports whether the order status is final after a full payment.
+func IsPaidStatus(status string) bool {
+	return status == Success || status == Overpaid
+}
+
+// IsWalletReleasedStatus reports whether an order with the status no longer holds its wallet,
+// which may have been assigned to another order since.
+func IsWalletReleasedStatus(status string) bool {
+	return IsPaidStatus(status) || status == Failed || status == Cancelled
+}
+
+// Invoice status, derived from the payments of the orders of its line items
+const (
+	InvoicePending = "PENDING"
+	InvoicePartial = "PARTIAL"
+	InvoicePaid    = "PAID"
+	InvoiceFailed  = "FAILED" // Every order is closed and the total is not met
+)
+
+// Subscription status, moved by the outcome of the order of each billing cycle
+const (
+	SubscriptionActive    = "ACTIVE"
+	SubscriptionPastDue   = "PAST_DUE" // The order of the cycle expired unpaid, it can still be paid until it fails
+	SubscriptionCancelled = "CANCELLED"
+)
+
+// Subscription billing intervals
+const (
+	SubscriptionDaily   = "DAILY"
+	SubscriptionWeekly  = "WEEKLY"
+	SubscriptionMonthly = "MONTHLY"
+	SubscriptionYearly  = "YEARLY"
+)
+
+// Outbound transfer status of the payouts and withdrawal requests. A transfer held by its transfer policy
+// waits for approval before it is sent
+const (
+	OutboundPendingApproval = "PENDING_APPROVAL"
+	OutboundApproved        = "APPROVED"
+	OutboundProcessing      = "PROCESSING" // Claimed for sending, it stays here when the transfer outcome is unknown
+	OutboundSuccess         = "SUCCESS"
+	OutboundFailed          = "FAILED"
+	OutboundRejected        = "REJECTED"
+)
+
+// Outbound transfer kinds the transfer policies apply to
+const (
+	OutboundPayout     = "PAYOUT"
+	OutboundWithdrawal = "WITHDRAWAL"
+)
+
+// Transfer policy decisions recorded in the transfer decision audit
+const (
+	TransferDecisionAutoApproved = "AUTO_APPROVED" // Within the policy, sent without approval
+	TransferDecisionHeld         = "HELD"          // Above the approval threshold, waits for approvals
+	TransferDecisionBlocked      = "BLOCKED"       // Destination not on the allow-list, never sent
+	TransferDecisionApproved     = "APPROVED"      // Approval of one approver
+	TransferDecisionRejected     = "REJECTED"
+)
+
+// Actor of the decisions taken by the transfer policy rather than an approver
+const TransferPolicyActor = "policy"
+
+// Gin context key of the approver authenticated by its approver key
+const ApproverContextKey = "approver"
+
+// Scale of the payment amounts stored in the database, NUMERIC(30, 18)
+const PaymentAmountDecimalPlaces = 18
+
+// Payment modes, chosen when the order is created
+const (
+	PaymentModeAddress = "ADDRESS" // Paid to a payment wallet dedicated to the order
+	PaymentModeRouter  = "ROUTER"  // Paid through the payment router contract, tagged with the order ID
+)
+
+// Split payment policies, how the payments made on different options of a multi-chain order add up
+const (
+	SplitPaymentAggregate = "AGGREGATE" // Payments on every option count toward the order amount
+	SplitPaymentSeparate  = "SEPARATE"  // Each option is paid on its own, the order settles on the first option paid in full
+)
+
+// Gasless payment types, the payer signs and the relayer wallet pays the gas
+const (
+	GaslessTransferWithAuthorization = "TRANSFER_WITH_AUTHORIZATION" // EIP-3009 transferWithAuthorization to the payment address
+	GaslessPermit                    = "PERMIT"                      // EIP-2612 permit to the relayer followed by transferFrom to the payment address
+)
+
+// QR code formats of the payment URI
+const (
+	QRCodeFormatPNG = "png"
+	QRCodeFormatSVG = "svg"
+	QRCodeSize      = 256 // PNG width and height in pixels
+)
+
+// Hosted payment page config
+const (
+	PaymentPageRefreshInterval = 5 * time.Second
+)
+
+// Rescan actions
+const (
+	RescanAlreadyRecorded = "ALREADY_RECORDED" // The transfer is already in the payment event history
+	RescanWouldCredit     = "WOULD_CREDIT"     // Dry-run, the transfer would be credited to the order
+	RescanCredited        = "CREDITED"
+	RescanSettled         = "SETTLED" // With apply, the order of an already recorded transfer was updated from its payments
+	RescanFailed          = "FAILED"
+	RescanOrderCancelled  = "ORDER_CANCELLED" // The order owning the payment address was cancelled, nothing is credited
 )
 
 // Wallet type
@@ -21,6 +135,9 @@ const (
 	PaymentWallet   WalletType = "PaymentWallet"
 	UserWallet      WalletType = "UserWallet"
 	ReceivingWallet WalletType = "ReceivingWallet"
+	RelayerWallet   WalletType = "RelayerWallet"
+	PayoutWallet    WalletType = "PayoutWallet"
+	GasSourceWallet WalletType = "GasSourceWallet"
 )
 
 // Gas price multiplier
@@ -34,6 +151,50 @@ const (
 	Transfer         = "TRANSFER"
 	Withdraw         = "WITHDRAW"
 	Deposit          = "DEPOSIT"
+	Rebalance        = "REBALANCE"
 )
 
 const MinimumWithdrawThreshold = 10 // Minimum withdraw threshold in USD
+
+// Outcome of the last scheduled sweep of a network and token
+const (
+	WithdrawRunSuccess  = "SUCCESS"
+	WithdrawRunFailed   = "FAILED"
+	WithdrawRunSkipped  = "SKIPPED"  // The payment wallets hold less than the minimum batch value
+	WithdrawRunDeferred = "DEFERRED" // The gas price is above the ceiling, retried on the next check
+)
+
+// Payer screening providers
+const (
+	ScreeningProviderDenyList = "deny_list" // Deny-list table and file
+	ScreeningProviderHTTP     = "http"      // External screening service
+)
+
+// Compliance hold status of a payment flagged by the payer screening
+const (
+	ComplianceHoldHeld     = "HELD"     // Not credited to the order nor swept, waits for review
+	ComplianceHoldReleased = "RELEASED" // Credited to the order and swept as any payment
+	ComplianceHoldRejected = "REJECTED" // Never credited nor swept, the funds are handled out of band
+)
+
+// Kinds of actors of the payment order audit, see utils.AuditActor
+const (
+	AuditActorListener = "listener" // Named after the network
+	AuditActorRescan   = "rescan"   // Named after the network
+	AuditActorWorker   = "worker"   // Named after the worker
+	AuditActorVendor   = "vendor"   // Named after the Vendor-Id header
+	AuditActorAdmin    = "admin"    // Named after the operator or approver
+	AuditActorAPI      = "api"      // Requests without a vendor
+	AuditActorSystem   = "system"   // Changes made without a known actor
+)
+
+// Operations of the admin API recorded in the audit log
+const (
+	AdminActionViewBlockStates         = "VIEW_BLOCK_STATES"
+	AdminActionResetLastProcessedBlock = "RESET_LAST_PROCESSED_BLOCK"
+	AdminActionReleaseWallet           = "RELEASE_WALLET"
+	AdminActionLockWallet              = "LOCK_WALLET"
+	AdminActionResolveOrder            = "RESOLVE_ORDER" // Order manually marked SUCCESS or FAILED
+	AdminActionSyncBalances            = "SYNC_BALANCES"
+	AdminActionViewOrderSet            = "VIEW_ORDER_SET"
+)
@@ -0,0 +1,796 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package paymentrouter
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// PaymentrouterMetaData contains all meta data concerning the Paymentrouter contract.
+var PaymentrouterMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"initialTreasury\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"orderId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"payer\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"PaymentReceived\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousTreasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"name\":\"TreasuryUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"orderId\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"pay\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newTreasury\",\"type\":\"address\"}],\"name\":\"setTreasury\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"treasury\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// PaymentrouterABI is the input ABI used to generate the binding from.
+// Deprecated: Use PaymentrouterMetaData.ABI instead.
+var PaymentrouterABI = PaymentrouterMetaData.ABI
+
+// Paymentrouter is an auto generated Go binding around an Ethereum contract.
+type Paymentrouter struct {
+	PaymentrouterCaller     // Read-only binding to the contract
+	PaymentrouterTransactor // Write-only binding to the contract
+	PaymentrouterFilterer   // Log filterer for contract events
+}
+
+// PaymentrouterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PaymentrouterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PaymentrouterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PaymentrouterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PaymentrouterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PaymentrouterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PaymentrouterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PaymentrouterSession struct {
+	Contract     *Paymentrouter    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PaymentrouterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PaymentrouterCallerSession struct {
+	Contract *PaymentrouterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// PaymentrouterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PaymentrouterTransactorSession struct {
+	Contract     *PaymentrouterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// PaymentrouterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PaymentrouterRaw struct {
+	Contract *Paymentrouter // Generic contract binding to access the raw methods on
+}
+
+// PaymentrouterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PaymentrouterCallerRaw struct {
+	Contract *PaymentrouterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PaymentrouterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PaymentrouterTransactorRaw struct {
+	Contract *PaymentrouterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPaymentrouter creates a new instance of Paymentrouter, bound to a specific deployed contract.
+func NewPaymentrouter(address common.Address, backend bind.ContractBackend) (*Paymentrouter, error) {
+	contract, err := bindPaymentrouter(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Paymentrouter{PaymentrouterCaller: PaymentrouterCaller{contract: contract}, PaymentrouterTransactor: PaymentrouterTransactor{contract: contract}, PaymentrouterFilterer: PaymentrouterFilterer{contract: contract}}, nil
+}
+
+// NewPaymentrouterCaller creates a new read-only instance of Paymentrouter, bound to a specific deployed contract.
+func NewPaymentrouterCaller(address common.Address, caller bind.ContractCaller) (*PaymentrouterCaller, error) {
+	contract, err := bindPaymentrouter(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentrouterCaller{contract: contract}, nil
+}
+
+// NewPaymentrouterTransactor creates a new write-only instance of Paymentrouter, bound to a specific deployed contract.
+func NewPaymentrouterTransactor(address common.Address, transactor bind.ContractTransactor) (*PaymentrouterTransactor, error) {
+	contract, err := bindPaymentrouter(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentrouterTransactor{contract: contract}, nil
+}
+
+// NewPaymentrouterFilterer creates a new log filterer instance of Paymentrouter, bound to a specific deployed contract.
+func NewPaymentrouterFilterer(address common.Address, filterer bind.ContractFilterer) (*PaymentrouterFilterer, error) {
+	contract, err := bindPaymentrouter(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentrouterFilterer{contract: contract}, nil
+}
+
+// bindPaymentrouter binds a generic wrapper to an already deployed contract.
+func bindPaymentrouter(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PaymentrouterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Paymentrouter *PaymentrouterRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Paymentrouter.Contract.PaymentrouterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Paymentrouter *PaymentrouterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.PaymentrouterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Paymentrouter *PaymentrouterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.PaymentrouterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Paymentrouter *PaymentrouterCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Paymentrouter.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Paymentrouter *PaymentrouterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Paymentrouter *PaymentrouterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.contract.Transact(opts, method, params...)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Paymentrouter *PaymentrouterCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Paymentrouter.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Paymentrouter *PaymentrouterSession) Owner() (common.Address, error) {
+	return _Paymentrouter.Contract.Owner(&_Paymentrouter.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Paymentrouter *PaymentrouterCallerSession) Owner() (common.Address, error) {
+	return _Paymentrouter.Contract.Owner(&_Paymentrouter.CallOpts)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_Paymentrouter *PaymentrouterCaller) Treasury(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Paymentrouter.contract.Call(opts, &out, "treasury")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_Paymentrouter *PaymentrouterSession) Treasury() (common.Address, error) {
+	return _Paymentrouter.Contract.Treasury(&_Paymentrouter.CallOpts)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_Paymentrouter *PaymentrouterCallerSession) Treasury() (common.Address, error) {
+	return _Paymentrouter.Contract.Treasury(&_Paymentrouter.CallOpts)
+}
+
+// Pay is a paid mutator transaction binding the contract method 0x97d4df67.
+//
+// Solidity: function pay(bytes32 orderId, address token, uint256 amount) returns()
+func (_Paymentrouter *PaymentrouterTransactor) Pay(opts *bind.TransactOpts, orderId [32]byte, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Paymentrouter.contract.Transact(opts, "pay", orderId, token, amount)
+}
+
+// Pay is a paid mutator transaction binding the contract method 0x97d4df67.
+//
+// Solidity: function pay(bytes32 orderId, address token, uint256 amount) returns()
+func (_Paymentrouter *PaymentrouterSession) Pay(orderId [32]byte, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.Pay(&_Paymentrouter.TransactOpts, orderId, token, amount)
+}
+
+// Pay is a paid mutator transaction binding the contract method 0x97d4df67.
+//
+// Solidity: function pay(bytes32 orderId, address token, uint256 amount) returns()
+func (_Paymentrouter *PaymentrouterTransactorSession) Pay(orderId [32]byte, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.Pay(&_Paymentrouter.TransactOpts, orderId, token, amount)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Paymentrouter *PaymentrouterTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Paymentrouter.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Paymentrouter *PaymentrouterSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Paymentrouter.Contract.RenounceOwnership(&_Paymentrouter.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Paymentrouter *PaymentrouterTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Paymentrouter.Contract.RenounceOwnership(&_Paymentrouter.TransactOpts)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_Paymentrouter *PaymentrouterTransactor) SetTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error) {
+	return _Paymentrouter.contract.Transact(opts, "setTreasury", newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_Paymentrouter *PaymentrouterSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.SetTreasury(&_Paymentrouter.TransactOpts, newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_Paymentrouter *PaymentrouterTransactorSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.SetTreasury(&_Paymentrouter.TransactOpts, newTreasury)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Paymentrouter *PaymentrouterTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Paymentrouter.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Paymentrouter *PaymentrouterSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.TransferOwnership(&_Paymentrouter.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Paymentrouter *PaymentrouterTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Paymentrouter.Contract.TransferOwnership(&_Paymentrouter.TransactOpts, newOwner)
+}
+
+// PaymentrouterOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Paymentrouter contract.
+type PaymentrouterOwnershipTransferredIterator struct {
+	Event *PaymentrouterOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PaymentrouterOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PaymentrouterOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PaymentrouterOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PaymentrouterOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PaymentrouterOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PaymentrouterOwnershipTransferred represents a OwnershipTransferred event raised by the Paymentrouter contract.
+type PaymentrouterOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Paymentrouter *PaymentrouterFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*PaymentrouterOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Paymentrouter.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentrouterOwnershipTransferredIterator{contract: _Paymentrouter.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Paymentrouter *PaymentrouterFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *PaymentrouterOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Paymentrouter.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PaymentrouterOwnershipTransferred)
+				if err := _Paymentrouter.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Paymentrouter *PaymentrouterFilterer) ParseOwnershipTransferred(log types.Log) (*PaymentrouterOwnershipTransferred, error) {
+	event := new(PaymentrouterOwnershipTransferred)
+	if err := _Paymentrouter.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PaymentrouterPaymentReceivedIterator is returned from FilterPaymentReceived and is used to iterate over the raw logs and unpacked data for PaymentReceived events raised by the Paymentrouter contract.
+type PaymentrouterPaymentReceivedIterator struct {
+	Event *PaymentrouterPaymentReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PaymentrouterPaymentReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PaymentrouterPaymentReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PaymentrouterPaymentReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PaymentrouterPaymentReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PaymentrouterPaymentReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PaymentrouterPaymentReceived represents a PaymentReceived event raised by the Paymentrouter contract.
+type PaymentrouterPaymentReceived struct {
+	OrderId [32]byte
+	Payer   common.Address
+	Token   common.Address
+	Amount  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaymentReceived is a free log retrieval operation binding the contract event 0x1c517e85acdede9b6dbdaab4925d20d3551f2961e9a860e72658e1769f150322.
+//
+// Solidity: event PaymentReceived(bytes32 indexed orderId, address indexed payer, address indexed token, uint256 amount)
+func (_Paymentrouter *PaymentrouterFilterer) FilterPaymentReceived(opts *bind.FilterOpts, orderId [][32]byte, payer []common.Address, token []common.Address) (*PaymentrouterPaymentReceivedIterator, error) {
+
+	var orderIdRule []interface{}
+	for _, orderIdItem := range orderId {
+		orderIdRule = append(orderIdRule, orderIdItem)
+	}
+	var payerRule []interface{}
+	for _, payerItem := range payer {
+		payerRule = append(payerRule, payerItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _Paymentrouter.contract.FilterLogs(opts, "PaymentReceived", orderIdRule, payerRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentrouterPaymentReceivedIterator{contract: _Paymentrouter.contract, event: "PaymentReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchPaymentReceived is a free log subscription operation binding the contract event 0x1c517e85acdede9b6dbdaab4925d20d3551f2961e9a860e72658e1769f150322.
+//
+// Solidity: event PaymentReceived(bytes32 indexed orderId, address indexed payer, address indexed token, uint256 amount)
+func (_Paymentrouter *PaymentrouterFilterer) WatchPaymentReceived(opts *bind.WatchOpts, sink chan<- *PaymentrouterPaymentReceived, orderId [][32]byte, payer []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var orderIdRule []interface{}
+	for _, orderIdItem := range orderId {
+		orderIdRule = append(orderIdRule, orderIdItem)
+	}
+	var payerRule []interface{}
+	for _, payerItem := range payer {
+		payerRule = append(payerRule, payerItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _Paymentrouter.contract.WatchLogs(opts, "PaymentReceived", orderIdRule, payerRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PaymentrouterPaymentReceived)
+				if err := _Paymentrouter.contract.UnpackLog(event, "PaymentReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaymentReceived is a log parse operation binding the contract event 0x1c517e85acdede9b6dbdaab4925d20d3551f2961e9a860e72658e1769f150322.
+//
+// Solidity: event PaymentReceived(bytes32 indexed orderId, address indexed payer, address indexed token, uint256 amount)
+func (_Paymentrouter *PaymentrouterFilterer) ParsePaymentReceived(log types.Log) (*PaymentrouterPaymentReceived, error) {
+	event := new(PaymentrouterPaymentReceived)
+	if err := _Paymentrouter.contract.UnpackLog(event, "PaymentReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PaymentrouterTreasuryUpdatedIterator is returned from FilterTreasuryUpdated and is used to iterate over the raw logs and unpacked data for TreasuryUpdated events raised by the Paymentrouter contract.
+type PaymentrouterTreasuryUpdatedIterator struct {
+	Event *PaymentrouterTreasuryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PaymentrouterTreasuryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PaymentrouterTreasuryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PaymentrouterTreasuryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PaymentrouterTreasuryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PaymentrouterTreasuryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PaymentrouterTreasuryUpdated represents a TreasuryUpdated event raised by the Paymentrouter contract.
+type PaymentrouterTreasuryUpdated struct {
+	PreviousTreasury common.Address
+	NewTreasury      common.Address
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterTreasuryUpdated is a free log retrieval operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed previousTreasury, address indexed newTreasury)
+func (_Paymentrouter *PaymentrouterFilterer) FilterTreasuryUpdated(opts *bind.FilterOpts, previousTreasury []common.Address, newTreasury []common.Address) (*PaymentrouterTreasuryUpdatedIterator, error) {
+
+	var previousTreasuryRule []interface{}
+	for _, previousTreasuryItem := range previousTreasury {
+		previousTreasuryRule = append(previousTreasuryRule, previousTreasuryItem)
+	}
+	var newTreasuryRule []interface{}
+	for _, newTreasuryItem := range newTreasury {
+		newTreasuryRule = append(newTreasuryRule, newTreasuryItem)
+	}
+
+	logs, sub, err := _Paymentrouter.contract.FilterLogs(opts, "TreasuryUpdated", previousTreasuryRule, newTreasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymentrouterTreasuryUpdatedIterator{contract: _Paymentrouter.contract, event: "TreasuryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTreasuryUpdated is a free log subscription operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed previousTreasury, address indexed newTreasury)
+func (_Paymentrouter *PaymentrouterFilterer) WatchTreasuryUpdated(opts *bind.WatchOpts, sink chan<- *PaymentrouterTreasuryUpdated, previousTreasury []common.Address, newTreasury []common.Address) (event.Subscription, error) {
+
+	var previousTreasuryRule []interface{}
+	for _, previousTreasuryItem := range previousTreasury {
+		previousTreasuryRule = append(previousTreasuryRule, previousTreasuryItem)
+	}
+	var newTreasuryRule []interface{}
+	for _, newTreasuryItem := range newTreasury {
+		newTreasuryRule = append(newTreasuryRule, newTreasuryItem)
+	}
+
+	logs, sub, err := _Paymentrouter.contract.WatchLogs(opts, "TreasuryUpdated", previousTreasuryRule, newTreasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PaymentrouterTreasuryUpdated)
+				if err := _Paymentrouter.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTreasuryUpdated is a log parse operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed previousTreasury, address indexed newTreasury)
+func (_Paymentrouter *PaymentrouterFilterer) ParseTreasuryUpdated(log types.Log) (*PaymentrouterTreasuryUpdated, error) {
+	event := new(PaymentrouterTreasuryUpdated)
+	if err := _Paymentrouter.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
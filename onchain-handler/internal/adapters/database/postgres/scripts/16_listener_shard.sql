@@ -0,0 +1,42 @@
+-- Worker instances taking part in listener sharding
+CREATE TABLE IF NOT EXISTS listener_instance (
+    instance_id VARCHAR(100) PRIMARY KEY,
+    heartbeat_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Last time the instance reported as alive
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS listener_instance_heartbeat_at_idx ON listener_instance (heartbeat_at);
+
+-- Listener shards: one row per (network, payment address partition)
+CREATE TABLE IF NOT EXISTS listener_shard (
+    id SERIAL PRIMARY KEY,
+    network VARCHAR(20) NOT NULL,
+    partition_index INT NOT NULL, -- Hash partition of payment addresses handled by this shard
+    partition_count INT NOT NULL, -- Total number of partitions configured for the network
+    instance_id VARCHAR(100), -- Instance currently owning the shard, NULL when unassigned
+    last_processed_block BIGINT NOT NULL DEFAULT 0, -- Listener cursor of the shard
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (network, partition_index)
+);
+
+CREATE INDEX IF NOT EXISTS listener_shard_instance_id_idx ON listener_shard (instance_id);
+
+-- Add the updated_at trigger for the listener_shard table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_listener_shard_updated_at'
+          AND tgrelid = 'listener_shard'::regclass
+    ) THEN
+        DROP TRIGGER update_listener_shard_updated_at ON listener_shard;
+    END IF;
+
+    CREATE TRIGGER update_listener_shard_updated_at
+    BEFORE UPDATE ON listener_shard
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
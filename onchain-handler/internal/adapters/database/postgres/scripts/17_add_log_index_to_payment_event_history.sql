@@ -0,0 +1,18 @@
+-- Add `log_index` column to the `payment_event_history` table.
+-- Existing rows keep NULL since their log index is unknown.
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1
+        FROM information_schema.columns
+        WHERE table_name = 'payment_event_history' AND column_name = 'log_index'
+    ) THEN
+        ALTER TABLE payment_event_history
+        ADD COLUMN log_index INTEGER;
+    END IF;
+END;
+$$;
+
+-- Look up recorded events by transaction when re-scanning a block range
+CREATE INDEX IF NOT EXISTS payment_event_history_network_transaction_hash_idx
+ON payment_event_history (network, transaction_hash);
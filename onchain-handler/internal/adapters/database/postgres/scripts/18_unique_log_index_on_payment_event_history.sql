@@ -0,0 +1,12 @@
+-- Remove events recorded more than once for the same transfer log, keeping the first record.
+-- Rows created before the log index was tracked keep NULL and are not affected.
+DELETE FROM payment_event_history duplicate
+USING payment_event_history original
+WHERE duplicate.network = original.network
+  AND duplicate.transaction_hash = original.transaction_hash
+  AND duplicate.log_index = original.log_index
+  AND duplicate.id > original.id;
+
+-- A transfer log can only be recorded once, so processing it again is a no-op
+CREATE UNIQUE INDEX IF NOT EXISTS payment_event_history_network_transaction_hash_log_index_idx
+ON payment_event_history (network, transaction_hash, log_index);
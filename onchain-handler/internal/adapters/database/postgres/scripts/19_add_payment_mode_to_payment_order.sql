@@ -0,0 +1,17 @@
+-- Add `payment_mode` column to the `payment_order` table.
+-- ADDRESS orders are paid to a dedicated payment wallet, ROUTER orders through the payment router contract.
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1
+        FROM information_schema.columns
+        WHERE table_name = 'payment_order' AND column_name = 'payment_mode'
+    ) THEN
+        ALTER TABLE payment_order
+        ADD COLUMN payment_mode VARCHAR(10) NOT NULL DEFAULT 'ADDRESS';
+    END IF;
+END;
+$$;
+
+-- ROUTER orders have no payment wallet
+ALTER TABLE payment_order ALTER COLUMN wallet_id DROP NOT NULL;
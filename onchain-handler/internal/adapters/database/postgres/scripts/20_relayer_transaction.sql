@@ -0,0 +1,17 @@
+-- Transactions sent by the relayer wallet for gasless payments, the fee is paid in the native token of the network
+CREATE TABLE IF NOT EXISTS relayer_transaction (
+    id SERIAL PRIMARY KEY,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '', -- Vendor of the order the gas is spent for
+    payment_order_id INT NOT NULL REFERENCES payment_order(id) ON DELETE CASCADE,
+    network VARCHAR(20) NOT NULL,
+    transaction_hash VARCHAR(66) NOT NULL,
+    method VARCHAR(50) NOT NULL, -- Token method called, e.g. transferWithAuthorization, permit, transferFrom
+    gas_used BIGINT NOT NULL DEFAULT 0,
+    gas_price NUMERIC(78, 0) NOT NULL DEFAULT 0, -- In wei
+    fee NUMERIC(30, 18) NOT NULL DEFAULT 0, -- gas_used * gas_price in native token units
+    status BOOLEAN NOT NULL DEFAULT FALSE, -- Receipt status
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS relayer_transaction_vendor_id_network_idx ON relayer_transaction (vendor_id, network);
+CREATE INDEX IF NOT EXISTS relayer_transaction_payment_order_id_idx ON relayer_transaction (payment_order_id);
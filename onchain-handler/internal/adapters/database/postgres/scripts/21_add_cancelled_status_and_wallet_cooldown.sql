@@ -0,0 +1,6 @@
+-- PENDING orders can be cancelled by the vendor
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'CANCELLED';
+
+-- A wallet released by a cancelled order is not claimed again before `cooldown_until`,
+-- so that a late payment to it is not credited to the next order.
+ALTER TABLE payment_wallet ADD COLUMN IF NOT EXISTS cooldown_until TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,36 @@
+-- Orders paid beyond the over tolerance of their policy
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'OVERPAID';
+
+-- Payment tolerance of the orders of a vendor and token. An empty vendor_id or symbol matches any vendor or token,
+-- the most specific policy applies and PAYMENT_COVERING is used when no policy matches.
+CREATE TABLE IF NOT EXISTS tolerance_policy (
+    id SERIAL PRIMARY KEY,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    symbol VARCHAR(10) NOT NULL DEFAULT '',
+    under_absolute NUMERIC(30, 18), -- Token units an order may be underpaid by, NULL when not set
+    under_percentage NUMERIC(5, 2), -- Percentage of the order amount an order may be underpaid by, NULL when not set
+    over_absolute NUMERIC(30, 18), -- Token units an order may be overpaid by before it is OVERPAID, NULL when not set
+    over_percentage NUMERIC(5, 2), -- Percentage of the order amount an order may be overpaid by, NULL when not set
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (vendor_id, symbol)
+);
+
+-- Add the updated_at trigger for the tolerance_policy table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_tolerance_policy_updated_at'
+          AND tgrelid = 'tolerance_policy'::regclass
+    ) THEN
+        DROP TRIGGER update_tolerance_policy_updated_at ON tolerance_policy;
+    END IF;
+
+    CREATE TRIGGER update_tolerance_policy_updated_at
+    BEFORE UPDATE ON tolerance_policy
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
@@ -0,0 +1,35 @@
+-- Responses of the requests sent with an Idempotency-Key header, replayed when the vendor retries the same request.
+-- status_code is 0 while the first request is still being processed.
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    id SERIAL PRIMARY KEY,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    key VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL, -- SHA-256 of the request method, path, query and body
+    status_code INT NOT NULL DEFAULT 0,
+    response TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (vendor_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_key_expires_at_idx ON idempotency_key (expires_at);
+
+-- Add the updated_at trigger for the idempotency_key table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_idempotency_key_updated_at'
+          AND tgrelid = 'idempotency_key'::regclass
+    ) THEN
+        DROP TRIGGER update_idempotency_key_updated_at ON idempotency_key;
+    END IF;
+
+    CREATE TRIGGER update_idempotency_key_updated_at
+    BEFORE UPDATE ON idempotency_key
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
@@ -0,0 +1,55 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'invoice_status') THEN
+        CREATE TYPE invoice_status AS ENUM('PENDING', 'PARTIAL', 'PAID', 'FAILED');
+    END IF;
+END;
+$$;
+
+-- Invoices group the payment orders of their line items. The settled amount and status are derived from the
+-- transferred amounts of the orders, USDT and USDC are summed at face value.
+CREATE TABLE IF NOT EXISTS invoice (
+    id SERIAL PRIMARY KEY,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    request_id VARCHAR(255) NOT NULL,
+    total_amount NUMERIC(30, 18) NOT NULL,
+    settled_amount NUMERIC(30, 18) NOT NULL DEFAULT 0,
+    status invoice_status NOT NULL DEFAULT 'PENDING',
+    webhook_url VARCHAR(255) NOT NULL DEFAULT '',
+    paid_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (vendor_id, request_id)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_status_idx ON invoice (status);
+
+-- Line items of an invoice, each one paid by its own payment order
+CREATE TABLE IF NOT EXISTS invoice_item (
+    id SERIAL PRIMARY KEY,
+    invoice_id INT NOT NULL REFERENCES invoice(id) ON DELETE CASCADE,
+    payment_order_id INT NOT NULL UNIQUE REFERENCES payment_order(id) ON DELETE CASCADE,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS invoice_item_invoice_id_idx ON invoice_item (invoice_id);
+
+-- Add the updated_at trigger for the invoice table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_invoice_updated_at'
+          AND tgrelid = 'invoice'::regclass
+    ) THEN
+        DROP TRIGGER update_invoice_updated_at ON invoice;
+    END IF;
+
+    CREATE TRIGGER update_invoice_updated_at
+    BEFORE UPDATE ON invoice
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
@@ -0,0 +1,80 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'subscription_interval') THEN
+        CREATE TYPE subscription_interval AS ENUM('DAILY', 'WEEKLY', 'MONTHLY', 'YEARLY');
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'subscription_status') THEN
+        CREATE TYPE subscription_status AS ENUM('ACTIVE', 'PAST_DUE', 'CANCELLED');
+    END IF;
+END;
+$$;
+
+-- Recurring plans of a vendor, each cycle is billed with a payment order of the plan amount
+CREATE TABLE IF NOT EXISTS subscription_plan (
+    id SERIAL PRIMARY KEY,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    name VARCHAR(255) NOT NULL,
+    amount NUMERIC(30, 18) NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    network VARCHAR(20) NOT NULL,
+    billing_interval subscription_interval NOT NULL,
+    grace_period INT NOT NULL DEFAULT 0, -- Minutes the order of a cycle can be paid before the subscription is PAST_DUE, 0 for EXPIRED_ORDER_TIME
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS subscription_plan_vendor_id_idx ON subscription_plan (vendor_id);
+
+-- Subscriptions of the customers of a vendor to a plan. The cycle n is billed at billing_anchor plus n intervals,
+-- current_order_id is the order of the cycle being billed, NULL once it is paid.
+CREATE TABLE IF NOT EXISTS subscription (
+    id SERIAL PRIMARY KEY,
+    plan_id INT NOT NULL REFERENCES subscription_plan(id) ON DELETE CASCADE,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    customer_id VARCHAR(255) NOT NULL,
+    webhook_url VARCHAR(255) NOT NULL DEFAULT '',
+    status subscription_status NOT NULL DEFAULT 'ACTIVE',
+    billing_anchor TIMESTAMP WITH TIME ZONE NOT NULL,
+    cycle INT NOT NULL DEFAULT 0, -- Number of cycles billed
+    next_billing_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    current_order_id INT REFERENCES payment_order(id) ON DELETE SET NULL,
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS subscription_vendor_id_customer_id_idx ON subscription (vendor_id, customer_id);
+CREATE INDEX IF NOT EXISTS subscription_status_next_billing_at_idx ON subscription (status, next_billing_at);
+
+-- Add the updated_at triggers for the subscription_plan and subscription tables
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_subscription_plan_updated_at'
+          AND tgrelid = 'subscription_plan'::regclass
+    ) THEN
+        DROP TRIGGER update_subscription_plan_updated_at ON subscription_plan;
+    END IF;
+
+    CREATE TRIGGER update_subscription_plan_updated_at
+    BEFORE UPDATE ON subscription_plan
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_subscription_updated_at'
+          AND tgrelid = 'subscription'::regclass
+    ) THEN
+        DROP TRIGGER update_subscription_updated_at ON subscription;
+    END IF;
+
+    CREATE TRIGGER update_subscription_updated_at
+    BEFORE UPDATE ON subscription
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
@@ -0,0 +1,51 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'payout_status') THEN
+        CREATE TYPE payout_status AS ENUM('PENDING', 'APPROVED', 'PROCESSING', 'SUCCESS', 'FAILED', 'REJECTED');
+    END IF;
+END;
+$$;
+
+-- Outbound transfers of a vendor to user wallets, sent from the payout wallet once approved.
+-- user_id is set when the payout was requested for a registered user wallet, to_address is resolved at request time.
+CREATE TABLE IF NOT EXISTS payout (
+    id SERIAL PRIMARY KEY,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    request_id VARCHAR(255) NOT NULL,
+    user_id INT,
+    to_address VARCHAR(42) NOT NULL,
+    amount NUMERIC(30, 18) NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    network VARCHAR(20) NOT NULL,
+    status payout_status NOT NULL DEFAULT 'PENDING',
+    webhook_url VARCHAR(255) NOT NULL DEFAULT '',
+    transaction_hash VARCHAR(66) NOT NULL DEFAULT '',
+    fee NUMERIC(30, 18) NOT NULL DEFAULT 0,
+    error_message TEXT NOT NULL DEFAULT '',
+    approved_at TIMESTAMP WITH TIME ZONE,
+    executed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (vendor_id, request_id)
+);
+
+CREATE INDEX IF NOT EXISTS payout_status_network_idx ON payout (status, network);
+
+-- Add the updated_at trigger for the payout table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_payout_updated_at'
+          AND tgrelid = 'payout'::regclass
+    ) THEN
+        DROP TRIGGER update_payout_updated_at ON payout;
+    END IF;
+
+    CREATE TRIGGER update_payout_updated_at
+    BEFORE UPDATE ON payout
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
@@ -0,0 +1,121 @@
+-- Payouts held by their transfer policy wait in PENDING_APPROVAL
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_enum
+        WHERE enumlabel = 'PENDING'
+          AND enumtypid = (SELECT oid FROM pg_type WHERE typname = 'payout_status')
+    ) THEN
+        ALTER TYPE payout_status RENAME VALUE 'PENDING' TO 'PENDING_APPROVAL';
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'withdrawal_request_status') THEN
+        CREATE TYPE withdrawal_request_status AS ENUM('PENDING_APPROVAL', 'APPROVED', 'PROCESSING', 'SUCCESS', 'FAILED', 'REJECTED');
+    END IF;
+END;
+$$;
+
+ALTER TABLE payout ALTER COLUMN status SET DEFAULT 'PENDING_APPROVAL';
+ALTER TABLE payout ADD COLUMN IF NOT EXISTS required_approvals INT NOT NULL DEFAULT 1;
+ALTER TABLE payout ADD COLUMN IF NOT EXISTS approvals INT NOT NULL DEFAULT 0;
+
+-- Outbound transfer policy of a network and token. An empty network or symbol matches any network or token,
+-- the most specific policy applies.
+CREATE TABLE IF NOT EXISTS transfer_policy (
+    id SERIAL PRIMARY KEY,
+    network VARCHAR(20) NOT NULL DEFAULT '',
+    symbol VARCHAR(10) NOT NULL DEFAULT '',
+    approval_threshold NUMERIC(30, 18), -- Token units above which a transfer waits for approvals, NULL when none needs approval
+    required_approvals INT NOT NULL DEFAULT 1, -- Distinct approvers needed by a held transfer
+    daily_limit NUMERIC(30, 18), -- Token units sent per UTC day, NULL when not limited
+    allow_list_only BOOLEAN NOT NULL DEFAULT FALSE, -- Only transfers to transfer_allowed_address are sent
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (network, symbol)
+);
+
+-- Destination addresses allowed on a network by the allow-list only policies
+CREATE TABLE IF NOT EXISTS transfer_allowed_address (
+    id SERIAL PRIMARY KEY,
+    network VARCHAR(20) NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    label VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (network, address)
+);
+
+-- Withdrawals from the receiving wallet to the master wallet held by their transfer policy, one open per network and token
+CREATE TABLE IF NOT EXISTS withdrawal_request (
+    id SERIAL PRIMARY KEY,
+    network VARCHAR(20) NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    from_address VARCHAR(42) NOT NULL,
+    to_address VARCHAR(42) NOT NULL,
+    amount NUMERIC(30, 18) NOT NULL,
+    status withdrawal_request_status NOT NULL DEFAULT 'PENDING_APPROVAL',
+    required_approvals INT NOT NULL DEFAULT 1,
+    approvals INT NOT NULL DEFAULT 0,
+    transaction_hash VARCHAR(66) NOT NULL DEFAULT '',
+    fee NUMERIC(30, 18) NOT NULL DEFAULT 0,
+    error_message TEXT NOT NULL DEFAULT '',
+    approved_at TIMESTAMP WITH TIME ZONE,
+    executed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS withdrawal_request_open_idx ON withdrawal_request (network, symbol)
+    WHERE status IN ('PENDING_APPROVAL', 'APPROVED', 'PROCESSING');
+
+-- Audit of every policy decision and approval on an outbound transfer, rows are never updated.
+-- transfer_id is NULL for withdrawals sent without a withdrawal request.
+CREATE TABLE IF NOT EXISTS transfer_decision (
+    id SERIAL PRIMARY KEY,
+    transfer_kind VARCHAR(20) NOT NULL, -- PAYOUT or WITHDRAWAL
+    transfer_id INT,
+    network VARCHAR(20) NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    to_address VARCHAR(42) NOT NULL,
+    amount NUMERIC(30, 18) NOT NULL,
+    decision VARCHAR(20) NOT NULL, -- AUTO_APPROVED, HELD, BLOCKED, APPROVED or REJECTED
+    actor VARCHAR(255) NOT NULL, -- Approver, or policy for the decisions of the transfer policy
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS transfer_decision_transfer_idx ON transfer_decision (transfer_kind, transfer_id);
+CREATE UNIQUE INDEX IF NOT EXISTS transfer_decision_approver_idx ON transfer_decision (transfer_kind, transfer_id, actor)
+    WHERE decision = 'APPROVED';
+
+-- Add the updated_at triggers for the transfer_policy and withdrawal_request tables
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_transfer_policy_updated_at'
+          AND tgrelid = 'transfer_policy'::regclass
+    ) THEN
+        DROP TRIGGER update_transfer_policy_updated_at ON transfer_policy;
+    END IF;
+
+    CREATE TRIGGER update_transfer_policy_updated_at
+    BEFORE UPDATE ON transfer_policy
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_withdrawal_request_updated_at'
+          AND tgrelid = 'withdrawal_request'::regclass
+    ) THEN
+        DROP TRIGGER update_withdrawal_request_updated_at ON withdrawal_request;
+    END IF;
+
+    CREATE TRIGGER update_withdrawal_request_updated_at
+    BEFORE UPDATE ON withdrawal_request
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
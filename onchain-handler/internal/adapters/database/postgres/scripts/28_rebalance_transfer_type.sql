@@ -0,0 +1,13 @@
+-- Add new type 'REBALANCE' to the 'transfer_type' enum for the treasury rebalancing transfers
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1
+        FROM pg_enum
+        WHERE enumlabel = 'REBALANCE'
+          AND enumtypid = (SELECT oid FROM pg_type WHERE typname = 'transfer_type')
+    ) THEN
+        ALTER TYPE transfer_type ADD VALUE 'REBALANCE';
+    END IF;
+END;
+$$;
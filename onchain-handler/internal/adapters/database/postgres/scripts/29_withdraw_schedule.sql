@@ -0,0 +1,37 @@
+-- Sweep schedule of the payment wallets of a network and token, the withdraw worker creates the row of each of its tokens
+CREATE TABLE IF NOT EXISTS withdraw_schedule (
+    id SERIAL PRIMARY KEY,
+    network VARCHAR(20) NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    cron_expression VARCHAR(100) NOT NULL DEFAULT '', -- Evaluated in UTC, empty for the WITHDRAW_WORKER_INTERVAL schedule
+    min_batch_value NUMERIC(30, 18), -- Token units the payment wallets must hold together before a sweep, NULL when not set
+    max_gas_price_gwei NUMERIC(30, 9), -- Sweeps are deferred while the gas price is above it, NULL when not set
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    sweep_requested BOOLEAN NOT NULL DEFAULT FALSE, -- Sweep on the next check regardless of the schedule, batch value and gas price
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_status VARCHAR(20) NOT NULL DEFAULT '', -- SUCCESS, FAILED, SKIPPED or DEFERRED
+    last_message TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (network, symbol)
+);
+
+-- Add the updated_at trigger for the withdraw_schedule table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_withdraw_schedule_updated_at'
+          AND tgrelid = 'withdraw_schedule'::regclass
+    ) THEN
+        DROP TRIGGER update_withdraw_schedule_updated_at ON withdraw_schedule;
+    END IF;
+
+    CREATE TRIGGER update_withdraw_schedule_updated_at
+    BEFORE UPDATE ON withdraw_schedule
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
@@ -0,0 +1,22 @@
+-- Gas cost of the sweeps attributed to the orders whose funds they moved, proportionally to the order amounts in the batch.
+-- fee is in native token units, fee_usd is NULL when the native token price was not available.
+CREATE TABLE IF NOT EXISTS gas_cost_attribution (
+    id SERIAL PRIMARY KEY,
+    network VARCHAR(20) NOT NULL,
+    symbol VARCHAR(10) NOT NULL, -- Token swept
+    transaction_hash VARCHAR(66) NOT NULL, -- Sweep paying the gas
+    transfer_type transfer_type NOT NULL, -- INTERNAL_TRANSFER from a payment wallet or WITHDRAW to the master wallet
+    payment_order_id INT NOT NULL,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    amount NUMERIC(30, 18) NOT NULL, -- Token units of the order in the batch
+    fee NUMERIC(30, 18) NOT NULL,
+    native_price_usd NUMERIC(30, 18),
+    fee_usd NUMERIC(30, 18),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS gas_cost_attribution_vendor_idx ON gas_cost_attribution (vendor_id, created_at);
+CREATE INDEX IF NOT EXISTS gas_cost_attribution_batch_idx ON gas_cost_attribution (network, symbol, transfer_type, created_at);
+
+-- Network fees in USD attributed to the orders of the period
+ALTER TABLE payment_statistics ADD COLUMN IF NOT EXISTS total_network_fee NUMERIC(30, 18) NOT NULL DEFAULT 0;
@@ -0,0 +1,88 @@
+-- Orders with a payment held by the payer screening until it is reviewed
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'ON_HOLD';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'compliance_hold_status') THEN
+        CREATE TYPE compliance_hold_status AS ENUM('HELD', 'RELEASED', 'REJECTED');
+    END IF;
+END;
+$$;
+
+-- Payer addresses denied by the deny-list screening provider, an empty network matches any network.
+-- Addresses are stored in lower case.
+CREATE TABLE IF NOT EXISTS denied_address (
+    id SERIAL PRIMARY KEY,
+    address VARCHAR(42) NOT NULL,
+    network VARCHAR(20) NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (address, network)
+);
+
+-- Add the updated_at trigger for the denied_address table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_denied_address_updated_at'
+          AND tgrelid = 'denied_address'::regclass
+    ) THEN
+        DROP TRIGGER update_denied_address_updated_at ON denied_address;
+    END IF;
+
+    CREATE TRIGGER update_denied_address_updated_at
+    BEFORE UPDATE ON denied_address
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
+
+-- Audit of the payments flagged by the payer screening. A held payment is neither credited to its order nor added
+-- to the balance of its payment wallet, so the withdraw worker does not sweep it until it is released.
+CREATE TABLE IF NOT EXISTS compliance_hold (
+    id SERIAL PRIMARY KEY,
+    payment_order_id INT NOT NULL REFERENCES payment_order(id) ON DELETE CASCADE,
+    vendor_id VARCHAR(33) NOT NULL DEFAULT '',
+    wallet_id INT, -- Payment wallet holding the funds, NULL for router payments
+    network VARCHAR(20) NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    transaction_hash VARCHAR(66) NOT NULL,
+    log_index INT NOT NULL DEFAULT 0,
+    from_address VARCHAR(42) NOT NULL,
+    to_address VARCHAR(42) NOT NULL,
+    amount NUMERIC(30, 18) NOT NULL,
+    provider VARCHAR(20) NOT NULL DEFAULT '', -- Screening provider that flagged the payer, empty when the order was already on hold
+    reason TEXT NOT NULL DEFAULT '',
+    status compliance_hold_status NOT NULL DEFAULT 'HELD',
+    reviewed_by VARCHAR(255) NOT NULL DEFAULT '',
+    review_note TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (transaction_hash, log_index)
+);
+
+CREATE INDEX IF NOT EXISTS compliance_hold_payment_order_id_idx ON compliance_hold (payment_order_id, status);
+CREATE INDEX IF NOT EXISTS compliance_hold_status_idx ON compliance_hold (status);
+
+-- Add the updated_at trigger for the compliance_hold table
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'update_compliance_hold_updated_at'
+          AND tgrelid = 'compliance_hold'::regclass
+    ) THEN
+        DROP TRIGGER update_compliance_hold_updated_at ON compliance_hold;
+    END IF;
+
+    CREATE TRIGGER update_compliance_hold_updated_at
+    BEFORE UPDATE ON compliance_hold
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+END;
+$$;
@@ -0,0 +1,10 @@
+-- Address of the payment wallet on Tron, derived with the Tron coin type and written in base58
+ALTER TABLE payment_wallet ADD COLUMN IF NOT EXISTS tron_address VARCHAR(42) UNIQUE;
+
+DO $$
+BEGIN
+    INSERT INTO blockchain_network_metadata (alias, name, icon_base64)
+    VALUES ('TRON', 'Tron (TRC20)', NULL)
+    ON CONFLICT (alias) DO NOTHING;
+END;
+$$;
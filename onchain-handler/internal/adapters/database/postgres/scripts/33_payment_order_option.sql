@@ -0,0 +1,13 @@
+-- Networks and tokens a multi-chain order accepts, the first option is the network and token the order was created with.
+-- block_height is the block the option is listened from, the heights of different networks do not compare.
+CREATE TABLE IF NOT EXISTS payment_order_option (
+    id SERIAL PRIMARY KEY,
+    payment_order_id INT NOT NULL REFERENCES payment_order(id) ON DELETE CASCADE,
+    network VARCHAR(20) NOT NULL,
+    symbol VARCHAR(10) NOT NULL,
+    block_height BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (payment_order_id, network, symbol)
+);
+
+CREATE INDEX IF NOT EXISTS payment_order_option_network_idx ON payment_order_option (network, payment_order_id);
@@ -0,0 +1,44 @@
+-- Append-only trail of the changes of the payment orders: their creation, status and transferred amount changes.
+-- actor is what made the change, e.g. listener:BSC, worker:order_clean, vendor:<id> or admin:<operator>.
+-- block_number and tx_hash are the transfer behind the change, when there is one.
+CREATE TABLE IF NOT EXISTS payment_order_audit (
+    id BIGSERIAL PRIMARY KEY,
+    payment_order_id INT NOT NULL REFERENCES payment_order(id),
+    old_status VARCHAR(20) NOT NULL DEFAULT '',
+    new_status VARCHAR(20) NOT NULL,
+    old_transferred VARCHAR(255) NOT NULL DEFAULT '',
+    new_transferred VARCHAR(255) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    block_number BIGINT,
+    tx_hash VARCHAR(100) NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS payment_order_audit_order_idx ON payment_order_audit (payment_order_id, id);
+
+-- Reject any change to the recorded trail
+CREATE OR REPLACE FUNCTION reject_payment_order_audit_change()
+RETURNS TRIGGER AS $$
+BEGIN
+   RAISE EXCEPTION 'payment_order_audit is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_trigger
+        WHERE tgname = 'reject_payment_order_audit_change'
+          AND tgrelid = 'payment_order_audit'::regclass
+    ) THEN
+        DROP TRIGGER reject_payment_order_audit_change ON payment_order_audit;
+    END IF;
+
+    CREATE TRIGGER reject_payment_order_audit_change
+    BEFORE UPDATE OR DELETE ON payment_order_audit
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_payment_order_audit_change();
+END;
+$$;
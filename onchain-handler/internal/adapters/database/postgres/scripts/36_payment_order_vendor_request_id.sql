@@ -0,0 +1,7 @@
+-- Request IDs are unique per vendor, as for invoices and payouts, instead of across all vendors
+ALTER TABLE payment_order DROP CONSTRAINT IF EXISTS payment_order_request_id_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS payment_order_vendor_id_request_id_idx ON payment_order (vendor_id, request_id);
+
+-- Keep the lookups by request ID alone indexed
+CREATE INDEX IF NOT EXISTS payment_order_request_id_idx ON payment_order (request_id);
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
@@ -16,6 +17,9 @@ type set[T comparable] struct {
 	cacheRepo cachetypes.CacheRepository
 	keyFunc   func(T) string
 	ttl       time.Duration
+	prefix    string
+	filter    func(T) bool // Optional, only items accepted by the filter belong to the set
+	filterMu  sync.RWMutex
 }
 
 // NewSet creates a new set.
@@ -24,20 +28,69 @@ func NewSet[T comparable](
 	keyFunc func(T) string,
 	cacheRepo cachetypes.CacheRepository,
 ) (types.Set[T], error) {
+	return NewNamespacedSet(ctx, "", keyFunc, cacheRepo)
+}
+
+// NewNamespacedSet creates a new set whose keys do not collide with sets of other namespaces sharing the same cache.
+func NewNamespacedSet[T comparable](
+	ctx context.Context,
+	namespace string,
+	keyFunc func(T) string,
+	cacheRepo cachetypes.CacheRepository,
+) (types.Set[T], error) {
+	prefix := "orderset_"
+	if namespace != "" {
+		prefix = fmt.Sprintf("orderset_%s_", namespace)
+	}
 	return &set[T]{
 		ctx:       ctx,
 		cacheRepo: cacheRepo,
 		keyFunc:   keyFunc,
 		ttl:       -1, // Default no expiration
+		prefix:    prefix,
 	}, nil
 }
 
 func (s *set[T]) prefixKeyOnly() fmt.Stringer {
-	return &cachetypes.Keyer{Raw: "orderset_"}
+	return &cachetypes.Keyer{Raw: s.prefix}
 }
 
 func (s *set[T]) fullKey(key string) string {
-	return fmt.Sprintf("orderset_%s", key)
+	return s.prefix + key
+}
+
+// accepts reports whether the item passes the current filter.
+func (s *set[T]) accepts(item T) bool {
+	s.filterMu.RLock()
+	defer s.filterMu.RUnlock()
+	return s.filter == nil || s.filter(item)
+}
+
+// SetFilter restricts the set to the items accepted by filter and evicts the items that no longer match.
+// A nil filter accepts every item.
+func (s *set[T]) SetFilter(filter func(T) bool) {
+	s.filterMu.Lock()
+	s.filter = filter
+	s.filterMu.Unlock()
+
+	items, err := s.cacheRepo.GetAllMatching(s.prefixKeyOnly(), func() any {
+		var t T
+		return &t
+	})
+	if err != nil {
+		logger.GetLogger().Errorf("SetFilter: failed to get all items: %v", err)
+		return
+	}
+
+	for _, raw := range items {
+		item := *(raw.(*T))
+		if s.accepts(item) {
+			continue
+		}
+		if err := s.cacheRepo.RemoveItem(&cachetypes.Keyer{Raw: s.fullKey(s.keyFunc(item))}); err != nil {
+			logger.GetLogger().Errorf("SetFilter: failed to evict item %v: %v", item, err)
+		}
+	}
 }
 
 // GetAll returns a copy of all items.
@@ -56,7 +109,9 @@ func (s *set[T]) GetAll() []T {
 	results := make([]T, 0, len(itemsAny))
 	for _, item := range itemsAny {
 		if v, ok := item.(*T); ok {
-			results = append(results, *v)
+			if s.accepts(*v) {
+				results = append(results, *v)
+			}
 		} else {
 			logger.GetLogger().Warnf("GetAll: failed to cast item %v to type %T", item, *new(T))
 		}
@@ -66,23 +121,26 @@ func (s *set[T]) GetAll() []T {
 
 // Contains checks if an item exists in the set.
 func (s *set[T]) Contains(key string) bool {
-	var dummy T
-	err := s.cacheRepo.RetrieveItem(&cachetypes.Keyer{Raw: s.fullKey(key)}, &dummy)
-	return err == nil
+	_, exists := s.GetItem(key)
+	return exists
 }
 
 // GetItem retrieves an item by its key.
 func (s *set[T]) GetItem(key string) (T, bool) {
 	var item T
 	err := s.cacheRepo.RetrieveItem(&cachetypes.Keyer{Raw: s.fullKey(key)}, &item)
-	if err != nil {
+	if err != nil || !s.accepts(item) {
 		return item, false
 	}
 	return item, true
 }
 
-// Add inserts an item into the set.
+// Add inserts an item into the set. Items rejected by the filter are silently skipped.
 func (s *set[T]) Add(item T) error {
+	if !s.accepts(item) {
+		return nil
+	}
+
 	key := s.fullKey(s.keyFunc(item))
 
 	var existing T
@@ -152,6 +210,10 @@ func (s *set[T]) Fill(loader func(ctx context.Context) ([]T, error)) error {
 	logger.GetLogger().Debugf("Loaded %d new items", len(newItems))
 
 	for _, item := range newItems {
+		if !s.accepts(item) {
+			continue
+		}
+
 		key := s.fullKey(s.keyFunc(item))
 
 		var existing T
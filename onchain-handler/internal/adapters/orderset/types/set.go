@@ -10,4 +10,5 @@ type Set[T comparable] interface {
 	Remove(condition func(T) bool) bool                       // Removes an item from the set based on a condition
 	UpdateItem(key string, newItem T) error                   // Updates an item by key
 	Fill(loader func(ctx context.Context) ([]T, error)) error // Ensures the set is populated
+	SetFilter(filter func(T) bool)                            // Restricts the set to items accepted by the filter
 }
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type adminAuditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAdminAuditLogRepository(db *gorm.DB) repotypes.AdminAuditLogRepository {
+	return &adminAuditLogRepository{
+		db: db,
+	}
+}
+
+// CreateAuditLog appends the record to the audit
+func (r *adminAuditLogRepository) CreateAuditLog(ctx context.Context, auditLog *entities.AdminAuditLog) error {
+	if err := r.db.WithContext(ctx).Create(auditLog).Error; err != nil {
+		return fmt.Errorf("failed to create admin audit log: %w", err)
+	}
+	return nil
+}
+
+// GetAuditLogs retrieves the audit records, newest first, of one action when it is given
+func (r *adminAuditLogRepository) GetAuditLogs(
+	ctx context.Context,
+	limit, offset int,
+	action *string,
+) ([]entities.AdminAuditLog, error) {
+	var auditLogs []entities.AdminAuditLog
+	query := r.db.WithContext(ctx)
+	if action != nil {
+		query = query.Where("action = ?", *action)
+	}
+	if err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&auditLogs).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch admin audit logs: %w", err)
+	}
+	return auditLogs, nil
+}
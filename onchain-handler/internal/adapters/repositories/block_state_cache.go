@@ -6,6 +6,7 @@ import (
 
 	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
 	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
 )
 
@@ -96,3 +97,8 @@ func (c *blockStateCache) UpdateLastProcessedBlock(ctx context.Context, blockNum
 
 	return nil
 }
+
+// GetBlockStates always reads the database, it backs the admin API rather than the listeners
+func (c *blockStateCache) GetBlockStates(ctx context.Context) ([]entities.BlockState, error) {
+	return c.blockStateRepository.GetBlockStates(ctx)
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"gorm.io/gorm"
 
@@ -97,3 +98,12 @@ func (r *blockstateRepository) UpdateLastProcessedBlock(ctx context.Context, blo
 
 	return nil
 }
+
+// GetBlockStates retrieves the block state of every network
+func (r *blockstateRepository) GetBlockStates(ctx context.Context) ([]entities.BlockState, error) {
+	var blockStates []entities.BlockState
+	if err := r.db.WithContext(ctx).Order("network").Find(&blockStates).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch block states: %w", err)
+	}
+	return blockStates, nil
+}
@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type complianceRepository struct {
+	db *gorm.DB
+}
+
+// NewComplianceRepository creates a new ComplianceRepository
+func NewComplianceRepository(db *gorm.DB) repotypes.ComplianceRepository {
+	return &complianceRepository{
+		db: db,
+	}
+}
+
+// GetDeniedAddresses retrieves all denied addresses
+func (r *complianceRepository) GetDeniedAddresses(ctx context.Context) ([]entities.DeniedAddress, error) {
+	var deniedAddresses []entities.DeniedAddress
+	if err := r.db.WithContext(ctx).Order("address, network").Find(&deniedAddresses).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch denied addresses: %w", err)
+	}
+	return deniedAddresses, nil
+}
+
+// GetDeniedAddress retrieves the entry denying the address on the network, the entry of the network
+// is preferred over the one of any network
+func (r *complianceRepository) GetDeniedAddress(ctx context.Context, network, address string) (*entities.DeniedAddress, error) {
+	var deniedAddress entities.DeniedAddress
+	if err := r.db.WithContext(ctx).
+		Where("address = ? AND network IN ?", strings.ToLower(address), []string{network, ""}).
+		Order("network DESC").
+		First(&deniedAddress).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("address %s is not denied: %w", address, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve denied address: %w", err)
+	}
+	return &deniedAddress, nil
+}
+
+// UpsertDeniedAddress denies the address on its network or replaces the reason of the existing entry
+func (r *complianceRepository) UpsertDeniedAddress(ctx context.Context, deniedAddress *entities.DeniedAddress) error {
+	deniedAddress.Address = strings.ToLower(deniedAddress.Address)
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "address"}, {Name: "network"}},
+			DoUpdates: clause.AssignmentColumns([]string{"reason"}),
+		}).
+		Create(deniedAddress).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert denied address: %w", err)
+	}
+	return nil
+}
+
+// DeleteDeniedAddress deletes the entry of the address and network, it returns false when there is none
+func (r *complianceRepository) DeleteDeniedAddress(ctx context.Context, address, network string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("address = ? AND network = ?", strings.ToLower(address), network).
+		Delete(&entities.DeniedAddress{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete denied address: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateComplianceHold records the hold of a payment, it returns false when the payment is already held
+func (r *complianceRepository) CreateComplianceHold(ctx context.Context, hold *entities.ComplianceHold) (bool, error) {
+	return r.CreateComplianceHoldInTx(r.db.WithContext(ctx), hold)
+}
+
+// CreateComplianceHoldInTx records the hold of a payment within the transaction of the caller,
+// it returns false when the payment is already held
+func (r *complianceRepository) CreateComplianceHoldInTx(tx *gorm.DB, hold *entities.ComplianceHold) (bool, error) {
+	result := tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "transaction_hash"}, {Name: "log_index"}},
+			DoNothing: true,
+		}).
+		Create(hold)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create compliance hold: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetComplianceHoldByID retrieves a compliance hold by its ID
+func (r *complianceRepository) GetComplianceHoldByID(ctx context.Context, id uint64) (*entities.ComplianceHold, error) {
+	var hold entities.ComplianceHold
+	if err := r.db.WithContext(ctx).First(&hold, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("compliance hold %d not found: %w", id, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve compliance hold: %w", err)
+	}
+	return &hold, nil
+}
+
+// GetComplianceHolds retrieves the compliance holds with the status, or all of them when the status is empty
+func (r *complianceRepository) GetComplianceHolds(ctx context.Context, status string) ([]entities.ComplianceHold, error) {
+	var holds []entities.ComplianceHold
+	query := r.db.WithContext(ctx).Order("id")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Find(&holds).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve compliance holds: %w", err)
+	}
+	return holds, nil
+}
+
+// GetComplianceHoldsByOrderID retrieves the compliance holds of the payments of an order
+func (r *complianceRepository) GetComplianceHoldsByOrderID(ctx context.Context, orderID uint64) ([]entities.ComplianceHold, error) {
+	var holds []entities.ComplianceHold
+	if err := r.db.WithContext(ctx).Where("payment_order_id = ?", orderID).Order("id").Find(&holds).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve compliance holds of order %d: %w", orderID, err)
+	}
+	return holds, nil
+}
+
+// GetHeldWalletAmount returns the total amount of the token held in the payment wallet on the network
+func (r *complianceRepository) GetHeldWalletAmount(ctx context.Context, walletID uint64, network, symbol string) (string, error) {
+	var amount string
+	if err := r.db.WithContext(ctx).
+		Model(&entities.ComplianceHold{}).
+		Select("COALESCE(SUM(amount), 0)::TEXT").
+		Where("wallet_id = ? AND network = ? AND symbol = ? AND status = ?", walletID, network, symbol, constants.ComplianceHoldHeld).
+		Scan(&amount).Error; err != nil {
+		return "", fmt.Errorf("failed to get held amount of wallet %d: %w", walletID, err)
+	}
+	return amount, nil
+}
+
+// UpdateComplianceHoldStatus applies the updates if the hold still has the current status,
+// it returns false when another update came first. onUpdated, when not nil, records what follows from the updated hold
+// in the same transaction. The hold is not updated when it fails.
+func (r *complianceRepository) UpdateComplianceHoldStatus(
+	ctx context.Context,
+	id uint64,
+	currentStatus string,
+	updates map[string]any,
+	onUpdated func(tx *gorm.DB, hold entities.ComplianceHold) error,
+) (bool, error) {
+	var updated bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&entities.ComplianceHold{}).
+			Where("id = ? AND status = ?", id, currentStatus).
+			Updates(updates)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update compliance hold %d: %w", id, result.Error)
+		}
+		if result.RowsAffected == 0 || onUpdated == nil {
+			updated = result.RowsAffected > 0
+			return nil
+		}
+
+		var hold entities.ComplianceHold
+		if err := tx.First(&hold, id).Error; err != nil {
+			return fmt.Errorf("failed to retrieve updated compliance hold %d: %w", id, err)
+		}
+		if err := onUpdated(tx, hold); err != nil {
+			return err
+		}
+		updated = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return updated, nil
+}
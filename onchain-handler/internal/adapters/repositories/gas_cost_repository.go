@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type gasCostRepository struct {
+	db *gorm.DB
+}
+
+// NewGasCostRepository creates a new GasCostRepository
+func NewGasCostRepository(db *gorm.DB) repotypes.GasCostRepository {
+	return &gasCostRepository{
+		db: db,
+	}
+}
+
+// CreateGasCostAttributions inserts the gas cost shares of a sweep
+func (r *gasCostRepository) CreateGasCostAttributions(ctx context.Context, attributions []entities.GasCostAttribution) error {
+	if len(attributions) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(&attributions).Error; err != nil {
+		return fmt.Errorf("failed to create gas cost attributions: %w", err)
+	}
+	return nil
+}
+
+// GetPaidOrderAmounts sums, by order, the payments of the network and symbol received by the wallet after since,
+// or ever when since is nil, leaving out the payments held by the payer screening
+func (r *gasCostRepository) GetPaidOrderAmounts(
+	ctx context.Context,
+	network, symbol, walletAddress string,
+	since *time.Time,
+) ([]entities.OrderAmount, error) {
+	var amounts []entities.OrderAmount
+	query := r.db.WithContext(ctx).
+		Table("payment_event_history").
+		Joins("JOIN payment_order ON payment_order.id = payment_event_history.payment_order_id").
+		Where("payment_event_history.network = ? AND payment_event_history.token_symbol = ?", network, symbol).
+		Where("LOWER(payment_event_history.to_address) = LOWER(?)", walletAddress).
+		// Payments held or rejected by the payer screening are not swept
+		Where(`NOT EXISTS (SELECT 1 FROM compliance_hold WHERE compliance_hold.transaction_hash = payment_event_history.transaction_hash
+			AND compliance_hold.log_index = payment_event_history.log_index AND compliance_hold.status IN ?)`,
+			[]string{constants.ComplianceHoldHeld, constants.ComplianceHoldRejected})
+	if since != nil {
+		query = query.Where("payment_event_history.created_at > ?", *since)
+	}
+	if err := query.
+		Select("payment_event_history.payment_order_id, payment_order.vendor_id, SUM(payment_event_history.amount)::TEXT AS amount").
+		Group("payment_event_history.payment_order_id, payment_order.vendor_id").
+		Order("payment_event_history.payment_order_id").
+		Scan(&amounts).Error; err != nil {
+		return nil, fmt.Errorf("failed to get paid order amounts: %w", err)
+	}
+	return amounts, nil
+}
+
+// GetSweptOrderAmounts sums, by order, the amounts swept from the payment wallets of the network and symbol
+// into the receiving wallet after since, or ever when since is nil
+func (r *gasCostRepository) GetSweptOrderAmounts(
+	ctx context.Context,
+	network, symbol string,
+	since *time.Time,
+) ([]entities.OrderAmount, error) {
+	var amounts []entities.OrderAmount
+	query := r.db.WithContext(ctx).
+		Model(&entities.GasCostAttribution{}).
+		Where("network = ? AND symbol = ? AND transfer_type = ?", network, symbol, constants.InternalTransfer)
+	if since != nil {
+		query = query.Where("created_at > ?", *since)
+	}
+	if err := query.
+		Select("payment_order_id, vendor_id, SUM(amount)::TEXT AS amount").
+		Group("payment_order_id, vendor_id").
+		Order("payment_order_id").
+		Scan(&amounts).Error; err != nil {
+		return nil, fmt.Errorf("failed to get swept order amounts: %w", err)
+	}
+	return amounts, nil
+}
+
+// GetNetworkFeeTotals sums the gas cost attributed to the orders of the vendor between the times by network and symbol
+func (r *gasCostRepository) GetNetworkFeeTotals(
+	ctx context.Context,
+	vendorID string,
+	startTime, endTime time.Time,
+	symbols []string,
+) ([]entities.NetworkFeeTotal, error) {
+	var totals []entities.NetworkFeeTotal
+	query := r.db.WithContext(ctx).
+		Model(&entities.GasCostAttribution{}).
+		Where("vendor_id = ? AND created_at >= ? AND created_at < ?", vendorID, startTime.UTC(), endTime.UTC())
+	if len(symbols) > 0 {
+		query = query.Where("symbol IN ?", symbols)
+	}
+	if err := query.
+		Select([]string{
+			"network",
+			"symbol",
+			"COUNT(DISTINCT payment_order_id) AS total_orders",
+			"SUM(fee)::TEXT AS total_fee",
+			"COALESCE(SUM(fee_usd), 0)::TEXT AS total_fee_usd",
+			"COALESCE(SUM(fee) FILTER (WHERE fee_usd IS NULL), 0)::TEXT AS unpriced_fee",
+		}).
+		Group("network, symbol").
+		Order("network, symbol").
+		Scan(&totals).Error; err != nil {
+		return nil, fmt.Errorf("failed to get network fee totals: %w", err)
+	}
+	return totals, nil
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type idempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyRepository(db *gorm.DB) repotypes.IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// CreateIdempotencyKey inserts the key, it returns false when the vendor already uses the key
+func (r *idempotencyKeyRepository) CreateIdempotencyKey(ctx context.Context, model *entities.IdempotencyKey) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "vendor_id"}, {Name: "key"}},
+			DoNothing: true,
+		}).
+		Create(model)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create idempotency key: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetIdempotencyKey returns the key of the vendor, or nil when there is none
+func (r *idempotencyKeyRepository) GetIdempotencyKey(ctx context.Context, vendorID, key string) (*entities.IdempotencyKey, error) {
+	var model entities.IdempotencyKey
+	err := r.db.WithContext(ctx).
+		Where("vendor_id = ? AND key = ?", vendorID, key).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	return &model, nil
+}
+
+// CompleteIdempotencyKey stores the response of the request sent with the key
+func (r *idempotencyKeyRepository) CompleteIdempotencyKey(
+	ctx context.Context, vendorID, key string, statusCode int, response string,
+) error {
+	err := r.db.WithContext(ctx).
+		Model(&entities.IdempotencyKey{}).
+		Where("vendor_id = ? AND key = ?", vendorID, key).
+		Updates(map[string]any{
+			"status_code": statusCode,
+			"response":    response,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteIdempotencyKey frees the key so the vendor can send a request with it again
+func (r *idempotencyKeyRepository) DeleteIdempotencyKey(ctx context.Context, vendorID, key string) error {
+	err := r.db.WithContext(ctx).
+		Where("vendor_id = ? AND key = ?", vendorID, key).
+		Delete(&entities.IdempotencyKey{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type invoiceRepository struct {
+	db *gorm.DB
+}
+
+// NewInvoiceRepository creates a new InvoiceRepository
+func NewInvoiceRepository(db *gorm.DB) repotypes.InvoiceRepository {
+	return &invoiceRepository{
+		db: db,
+	}
+}
+
+// CreateInvoice inserts the invoice together with its line items
+func (r *invoiceRepository) CreateInvoice(ctx context.Context, invoice *entities.Invoice) error {
+	if err := r.db.WithContext(ctx).Create(invoice).Error; err != nil {
+		return fmt.Errorf("failed to create invoice: %w", err)
+	}
+	return nil
+}
+
+// GetInvoiceByRequestID retrieves the invoice of the vendor with its line items
+func (r *invoiceRepository) GetInvoiceByRequestID(ctx context.Context, vendorID, requestID string) (*entities.Invoice, error) {
+	var invoice entities.Invoice
+	if err := r.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		First(&invoice, "vendor_id = ? AND request_id = ?", vendorID, requestID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("invoice with request ID %s not found: %w", requestID, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve invoice: %w", err)
+	}
+	return &invoice, nil
+}
+
+// GetOpenInvoices retrieves the PENDING and PARTIAL invoices with their line items
+func (r *invoiceRepository) GetOpenInvoices(ctx context.Context) ([]entities.Invoice, error) {
+	var invoices []entities.Invoice
+	if err := r.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Where("status IN ?", []string{constants.InvoicePending, constants.InvoicePartial}).
+		Order("id").
+		Find(&invoices).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve open invoices: %w", err)
+	}
+	return invoices, nil
+}
+
+// UpdateInvoiceState sets the settled amount and status of the invoice if it is still in the current status,
+// it returns false when another update changed the status first
+func (r *invoiceRepository) UpdateInvoiceState(
+	ctx context.Context,
+	id uint64,
+	currentStatus, status, settledAmount string,
+	paidAt *time.Time,
+) (bool, error) {
+	updates := map[string]any{
+		"status":         status,
+		"settled_amount": settledAmount,
+	}
+	if paidAt != nil {
+		updates["paid_at"] = *paidAt
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entities.Invoice{}).
+		Where("id = ? AND status = ?", id, currentStatus).
+		Updates(updates)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update invoice %d: %w", id, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,154 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+// listenerShardLockKey is the postgres advisory lock key serializing shard rebalancing across instances.
+const listenerShardLockKey = 260126
+
+type listenerShardRepository struct {
+	db *gorm.DB
+}
+
+// NewListenerShardRepository creates a new ListenerShardRepository
+func NewListenerShardRepository(db *gorm.DB) repotypes.ListenerShardRepository {
+	return &listenerShardRepository{
+		db: db,
+	}
+}
+
+// UpsertInstanceHeartbeat registers the instance or refreshes its heartbeat
+func (r *listenerShardRepository) UpsertInstanceHeartbeat(ctx context.Context, instanceID string) error {
+	instance := entities.ListenerInstance{
+		InstanceID:  instanceID,
+		HeartbeatAt: time.Now().UTC(),
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "instance_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"heartbeat_at"}),
+		}).
+		Create(&instance).Error
+}
+
+// DeleteInstance removes the instance and releases all shards it owns
+func (r *listenerShardRepository) DeleteInstance(ctx context.Context, instanceID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entities.ListenerShard{}).
+			Where("instance_id = ?", instanceID).
+			Update("instance_id", nil).Error; err != nil {
+			return fmt.Errorf("failed to release shards of instance %s: %w", instanceID, err)
+		}
+		if err := tx.Where("instance_id = ?", instanceID).Delete(&entities.ListenerInstance{}).Error; err != nil {
+			return fmt.Errorf("failed to delete instance %s: %w", instanceID, err)
+		}
+		return nil
+	})
+}
+
+// LockShardsForRebalance takes a transaction scoped advisory lock so only one instance rebalances at a time
+func (r *listenerShardRepository) LockShardsForRebalance(tx *gorm.DB, ctx context.Context) error {
+	return tx.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(?)", listenerShardLockKey).Error
+}
+
+// DeleteStaleInstances removes instances that have not sent a heartbeat since heartbeatBefore
+func (r *listenerShardRepository) DeleteStaleInstances(tx *gorm.DB, ctx context.Context, heartbeatBefore time.Time) error {
+	return tx.WithContext(ctx).
+		Where("heartbeat_at < ?", heartbeatBefore).
+		Delete(&entities.ListenerInstance{}).Error
+}
+
+// GetInstanceIDs returns the IDs of all registered instances
+func (r *listenerShardRepository) GetInstanceIDs(tx *gorm.DB, ctx context.Context) ([]string, error) {
+	var instanceIDs []string
+	err := tx.WithContext(ctx).
+		Model(&entities.ListenerInstance{}).
+		Order("instance_id").
+		Pluck("instance_id", &instanceIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return instanceIDs, nil
+}
+
+// EnsureShards creates the missing partitions of a network and drops the ones beyond partitionCount
+func (r *listenerShardRepository) EnsureShards(tx *gorm.DB, ctx context.Context, network string, partitionCount uint32) error {
+	shards := make([]entities.ListenerShard, 0, partitionCount)
+	for index := range partitionCount {
+		shards = append(shards, entities.ListenerShard{
+			Network:        network,
+			PartitionIndex: index,
+			PartitionCount: partitionCount,
+		})
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "network"}, {Name: "partition_index"}},
+			DoUpdates: clause.AssignmentColumns([]string{"partition_count"}),
+		}).
+		Create(&shards).Error; err != nil {
+		return fmt.Errorf("failed to create shards for network %s: %w", network, err)
+	}
+
+	if err := tx.WithContext(ctx).
+		Where("network = ? AND partition_index >= ?", network, partitionCount).
+		Delete(&entities.ListenerShard{}).Error; err != nil {
+		return fmt.Errorf("failed to delete surplus shards for network %s: %w", network, err)
+	}
+
+	return nil
+}
+
+// GetShards returns all shards
+func (r *listenerShardRepository) GetShards(tx *gorm.DB, ctx context.Context) ([]entities.ListenerShard, error) {
+	var shards []entities.ListenerShard
+	if err := tx.WithContext(ctx).Order("network, partition_index").Find(&shards).Error; err != nil {
+		return nil, err
+	}
+	return shards, nil
+}
+
+// UpdateShardOwner assigns the shard to the given instance, nil leaves it unassigned
+func (r *listenerShardRepository) UpdateShardOwner(tx *gorm.DB, ctx context.Context, shardID uint64, instanceID *string) error {
+	return tx.WithContext(ctx).
+		Model(&entities.ListenerShard{}).
+		Where("id = ?", shardID).
+		Update("instance_id", instanceID).Error
+}
+
+// GetShardsByInstanceID returns the shards owned by the instance
+func (r *listenerShardRepository) GetShardsByInstanceID(ctx context.Context, instanceID string) ([]entities.ListenerShard, error) {
+	var shards []entities.ListenerShard
+	err := r.db.WithContext(ctx).
+		Where("instance_id = ?", instanceID).
+		Order("network, partition_index").
+		Find(&shards).Error
+	if err != nil {
+		return nil, err
+	}
+	return shards, nil
+}
+
+// UpdateShardLastProcessedBlock moves the shard cursor, only while the shard is still owned by the instance
+func (r *listenerShardRepository) UpdateShardLastProcessedBlock(
+	ctx context.Context,
+	network string,
+	partitionIndex uint32,
+	instanceID string,
+	blockNumber uint64,
+) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.ListenerShard{}).
+		Where("network = ? AND partition_index = ? AND instance_id = ?", network, partitionIndex, instanceID).
+		Update("last_processed_block", blockNumber).Error
+}
@@ -0,0 +1,383 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adapters/repositories/types/payment_order.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/adapters/repositories/types/payment_order.go -destination=internal/adapters/repositories/mocks/mock_payment_order.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	constants "github.com/genefriendway/onchain-handler/constants"
+	entities "github.com/genefriendway/onchain-handler/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockPaymentOrderRepository is a mock of PaymentOrderRepository interface.
+type MockPaymentOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentOrderRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentOrderRepositoryMockRecorder is the mock recorder for MockPaymentOrderRepository.
+type MockPaymentOrderRepositoryMockRecorder struct {
+	mock *MockPaymentOrderRepository
+}
+
+// NewMockPaymentOrderRepository creates a new mock instance.
+func NewMockPaymentOrderRepository(ctrl *gomock.Controller) *MockPaymentOrderRepository {
+	mock := &MockPaymentOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentOrderRepository) EXPECT() *MockPaymentOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BatchUpdateOrderBlockHeights mocks base method.
+func (m *MockPaymentOrderRepository) BatchUpdateOrderBlockHeights(ctx context.Context, orderIDs, blockHeights []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateOrderBlockHeights", ctx, orderIDs, blockHeights)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpdateOrderBlockHeights indicates an expected call of BatchUpdateOrderBlockHeights.
+func (mr *MockPaymentOrderRepositoryMockRecorder) BatchUpdateOrderBlockHeights(ctx, orderIDs, blockHeights any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateOrderBlockHeights", reflect.TypeOf((*MockPaymentOrderRepository)(nil).BatchUpdateOrderBlockHeights), ctx, orderIDs, blockHeights)
+}
+
+// BatchUpdateOrdersToExpired mocks base method.
+func (m *MockPaymentOrderRepository) BatchUpdateOrdersToExpired(ctx context.Context, orderIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateOrdersToExpired", ctx, orderIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpdateOrdersToExpired indicates an expected call of BatchUpdateOrdersToExpired.
+func (mr *MockPaymentOrderRepositoryMockRecorder) BatchUpdateOrdersToExpired(ctx, orderIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateOrdersToExpired", reflect.TypeOf((*MockPaymentOrderRepository)(nil).BatchUpdateOrdersToExpired), ctx, orderIDs)
+}
+
+// CancelOrderAndReleaseWallet mocks base method.
+func (m *MockPaymentOrderRepository) CancelOrderAndReleaseWallet(ctx context.Context, orderID uint64, walletCooldownUntil time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderAndReleaseWallet", ctx, orderID, walletCooldownUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrderAndReleaseWallet indicates an expected call of CancelOrderAndReleaseWallet.
+func (mr *MockPaymentOrderRepositoryMockRecorder) CancelOrderAndReleaseWallet(ctx, orderID, walletCooldownUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderAndReleaseWallet", reflect.TypeOf((*MockPaymentOrderRepository)(nil).CancelOrderAndReleaseWallet), ctx, orderID, walletCooldownUntil)
+}
+
+// CreatePaymentOrders mocks base method.
+func (m *MockPaymentOrderRepository) CreatePaymentOrders(tx *gorm.DB, ctx context.Context, orders []entities.PaymentOrder, vendorID string) ([]entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentOrders", tx, ctx, orders, vendorID)
+	ret0, _ := ret[0].([]entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentOrders indicates an expected call of CreatePaymentOrders.
+func (mr *MockPaymentOrderRepositoryMockRecorder) CreatePaymentOrders(tx, ctx, orders, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentOrders", reflect.TypeOf((*MockPaymentOrderRepository)(nil).CreatePaymentOrders), tx, ctx, orders, vendorID)
+}
+
+// ExtendOrderExpiry mocks base method.
+func (m *MockPaymentOrderRepository) ExtendOrderExpiry(ctx context.Context, orderID uint64, expiredTime time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendOrderExpiry", ctx, orderID, expiredTime)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendOrderExpiry indicates an expected call of ExtendOrderExpiry.
+func (mr *MockPaymentOrderRepositoryMockRecorder) ExtendOrderExpiry(ctx, orderID, expiredTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendOrderExpiry", reflect.TypeOf((*MockPaymentOrderRepository)(nil).ExtendOrderExpiry), ctx, orderID, expiredTime)
+}
+
+// GetActivePaymentOrders mocks base method.
+func (m *MockPaymentOrderRepository) GetActivePaymentOrders(ctx context.Context, network *string) ([]entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivePaymentOrders", ctx, network)
+	ret0, _ := ret[0].([]entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivePaymentOrders indicates an expected call of GetActivePaymentOrders.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetActivePaymentOrders(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivePaymentOrders", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetActivePaymentOrders), ctx, network)
+}
+
+// GetExistingRequestIDs mocks base method.
+func (m *MockPaymentOrderRepository) GetExistingRequestIDs(ctx context.Context, vendorID string, requestIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingRequestIDs", ctx, vendorID, requestIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingRequestIDs indicates an expected call of GetExistingRequestIDs.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetExistingRequestIDs(ctx, vendorID, requestIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingRequestIDs", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetExistingRequestIDs), ctx, vendorID, requestIDs)
+}
+
+// GetExpiredPaymentOrders mocks base method.
+func (m *MockPaymentOrderRepository) GetExpiredPaymentOrders(ctx context.Context, network string) ([]entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredPaymentOrders", ctx, network)
+	ret0, _ := ret[0].([]entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredPaymentOrders indicates an expected call of GetExpiredPaymentOrders.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetExpiredPaymentOrders(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredPaymentOrders", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetExpiredPaymentOrders), ctx, network)
+}
+
+// GetPaymentOrderByID mocks base method.
+func (m *MockPaymentOrderRepository) GetPaymentOrderByID(ctx context.Context, id uint64) (*entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrderByID", ctx, id)
+	ret0, _ := ret[0].(*entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrderByID indicates an expected call of GetPaymentOrderByID.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetPaymentOrderByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderByID", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetPaymentOrderByID), ctx, id)
+}
+
+// GetPaymentOrderByRequestID mocks base method.
+func (m *MockPaymentOrderRepository) GetPaymentOrderByRequestID(ctx context.Context, vendorID, requestID string) (*entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrderByRequestID", ctx, vendorID, requestID)
+	ret0, _ := ret[0].(*entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrderByRequestID indicates an expected call of GetPaymentOrderByRequestID.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetPaymentOrderByRequestID(ctx, vendorID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderByRequestID", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetPaymentOrderByRequestID), ctx, vendorID, requestID)
+}
+
+// GetPaymentOrderIDByRequestID mocks base method.
+func (m *MockPaymentOrderRepository) GetPaymentOrderIDByRequestID(ctx context.Context, vendorID, requestID string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrderIDByRequestID", ctx, vendorID, requestID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrderIDByRequestID indicates an expected call of GetPaymentOrderIDByRequestID.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetPaymentOrderIDByRequestID(ctx, vendorID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderIDByRequestID", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetPaymentOrderIDByRequestID), ctx, vendorID, requestID)
+}
+
+// GetPaymentOrders mocks base method.
+func (m *MockPaymentOrderRepository) GetPaymentOrders(ctx context.Context, limit, offset int, vendorID string, requestIDs []string, status, orderBy, fromAddress, network *string, orderDirection constants.OrderDirection, startTime, endTime *time.Time, timeFilterField *string) ([]entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrders", ctx, limit, offset, vendorID, requestIDs, status, orderBy, fromAddress, network, orderDirection, startTime, endTime, timeFilterField)
+	ret0, _ := ret[0].([]entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrders indicates an expected call of GetPaymentOrders.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetPaymentOrders(ctx, limit, offset, vendorID, requestIDs, status, orderBy, fromAddress, network, orderDirection, startTime, endTime, timeFilterField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrders", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetPaymentOrders), ctx, limit, offset, vendorID, requestIDs, status, orderBy, fromAddress, network, orderDirection, startTime, endTime, timeFilterField)
+}
+
+// GetPaymentOrdersByIDs mocks base method.
+func (m *MockPaymentOrderRepository) GetPaymentOrdersByIDs(ctx context.Context, ids []uint64) ([]entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrdersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrdersByIDs indicates an expected call of GetPaymentOrdersByIDs.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetPaymentOrdersByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrdersByIDs", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetPaymentOrdersByIDs), ctx, ids)
+}
+
+// GetPaymentOrdersOpenBetween mocks base method.
+func (m *MockPaymentOrderRepository) GetPaymentOrdersOpenBetween(ctx context.Context, network string, startTime, endTime time.Time) ([]entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrdersOpenBetween", ctx, network, startTime, endTime)
+	ret0, _ := ret[0].([]entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrdersOpenBetween indicates an expected call of GetPaymentOrdersOpenBetween.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetPaymentOrdersOpenBetween(ctx, network, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrdersOpenBetween", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetPaymentOrdersOpenBetween), ctx, network, startTime, endTime)
+}
+
+// GetProcessingOrdersExpired mocks base method.
+func (m *MockPaymentOrderRepository) GetProcessingOrdersExpired(ctx context.Context, network string) ([]entities.PaymentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessingOrdersExpired", ctx, network)
+	ret0, _ := ret[0].([]entities.PaymentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessingOrdersExpired indicates an expected call of GetProcessingOrdersExpired.
+func (mr *MockPaymentOrderRepositoryMockRecorder) GetProcessingOrdersExpired(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessingOrdersExpired", reflect.TypeOf((*MockPaymentOrderRepository)(nil).GetProcessingOrdersExpired), ctx, network)
+}
+
+// ReleaseWalletsForSuccessfulOrders mocks base method.
+func (m *MockPaymentOrderRepository) ReleaseWalletsForSuccessfulOrders(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWalletsForSuccessfulOrders", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseWalletsForSuccessfulOrders indicates an expected call of ReleaseWalletsForSuccessfulOrders.
+func (mr *MockPaymentOrderRepositoryMockRecorder) ReleaseWalletsForSuccessfulOrders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWalletsForSuccessfulOrders", reflect.TypeOf((*MockPaymentOrderRepository)(nil).ReleaseWalletsForSuccessfulOrders), ctx)
+}
+
+// UpdateActiveOrdersToExpired mocks base method.
+func (m *MockPaymentOrderRepository) UpdateActiveOrdersToExpired(ctx context.Context) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActiveOrdersToExpired", ctx)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActiveOrdersToExpired indicates an expected call of UpdateActiveOrdersToExpired.
+func (mr *MockPaymentOrderRepositoryMockRecorder) UpdateActiveOrdersToExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActiveOrdersToExpired", reflect.TypeOf((*MockPaymentOrderRepository)(nil).UpdateActiveOrdersToExpired), ctx)
+}
+
+// UpdateExpiredOrdersToFailed mocks base method.
+func (m *MockPaymentOrderRepository) UpdateExpiredOrdersToFailed(ctx context.Context) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExpiredOrdersToFailed", ctx)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExpiredOrdersToFailed indicates an expected call of UpdateExpiredOrdersToFailed.
+func (mr *MockPaymentOrderRepositoryMockRecorder) UpdateExpiredOrdersToFailed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExpiredOrdersToFailed", reflect.TypeOf((*MockPaymentOrderRepository)(nil).UpdateExpiredOrdersToFailed), ctx)
+}
+
+// UpdateOptionBlockHeight mocks base method.
+func (m *MockPaymentOrderRepository) UpdateOptionBlockHeight(ctx context.Context, orderID uint64, network, symbol string, blockHeight uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOptionBlockHeight", ctx, orderID, network, symbol, blockHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOptionBlockHeight indicates an expected call of UpdateOptionBlockHeight.
+func (mr *MockPaymentOrderRepositoryMockRecorder) UpdateOptionBlockHeight(ctx, orderID, network, symbol, blockHeight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOptionBlockHeight", reflect.TypeOf((*MockPaymentOrderRepository)(nil).UpdateOptionBlockHeight), ctx, orderID, network, symbol, blockHeight)
+}
+
+// UpdateOrderFieldsByRequestIDAndStatus mocks base method.
+func (m *MockPaymentOrderRepository) UpdateOrderFieldsByRequestIDAndStatus(ctx context.Context, vendorID, requestID, status string, updates map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderFieldsByRequestIDAndStatus", ctx, vendorID, requestID, status, updates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderFieldsByRequestIDAndStatus indicates an expected call of UpdateOrderFieldsByRequestIDAndStatus.
+func (mr *MockPaymentOrderRepositoryMockRecorder) UpdateOrderFieldsByRequestIDAndStatus(ctx, vendorID, requestID, status, updates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderFieldsByRequestIDAndStatus", reflect.TypeOf((*MockPaymentOrderRepository)(nil).UpdateOrderFieldsByRequestIDAndStatus), ctx, vendorID, requestID, status, updates)
+}
+
+// UpdateOrderNetwork mocks base method.
+func (m *MockPaymentOrderRepository) UpdateOrderNetwork(ctx context.Context, vendorID, requestID, network string, blockHeight uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderNetwork", ctx, vendorID, requestID, network, blockHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderNetwork indicates an expected call of UpdateOrderNetwork.
+func (mr *MockPaymentOrderRepositoryMockRecorder) UpdateOrderNetwork(ctx, vendorID, requestID, network, blockHeight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderNetwork", reflect.TypeOf((*MockPaymentOrderRepository)(nil).UpdateOrderNetwork), ctx, vendorID, requestID, network, blockHeight)
+}
+
+// UpdateOrderToSuccessAndReleaseWallet mocks base method.
+func (m *MockPaymentOrderRepository) UpdateOrderToSuccessAndReleaseWallet(ctx context.Context, orderID uint64, status string, succeededAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderToSuccessAndReleaseWallet", ctx, orderID, status, succeededAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderToSuccessAndReleaseWallet indicates an expected call of UpdateOrderToSuccessAndReleaseWallet.
+func (mr *MockPaymentOrderRepositoryMockRecorder) UpdateOrderToSuccessAndReleaseWallet(ctx, orderID, status, succeededAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderToSuccessAndReleaseWallet", reflect.TypeOf((*MockPaymentOrderRepository)(nil).UpdateOrderToSuccessAndReleaseWallet), ctx, orderID, status, succeededAt)
+}
+
+// UpdatePaymentOrder mocks base method.
+func (m *MockPaymentOrderRepository) UpdatePaymentOrder(ctx context.Context, orderID uint64, updateFunc func(*entities.PaymentOrder) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentOrder", ctx, orderID, updateFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentOrder indicates an expected call of UpdatePaymentOrder.
+func (mr *MockPaymentOrderRepositoryMockRecorder) UpdatePaymentOrder(ctx, orderID, updateFunc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentOrder", reflect.TypeOf((*MockPaymentOrderRepository)(nil).UpdatePaymentOrder), ctx, orderID, updateFunc)
+}
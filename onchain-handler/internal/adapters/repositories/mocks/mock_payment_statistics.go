@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adapters/repositories/types/payment_statistics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/adapters/repositories/types/payment_statistics.go -destination=internal/adapters/repositories/mocks/mock_payment_statistics.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/genefriendway/onchain-handler/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockPaymentStatisticsRepository is a mock of PaymentStatisticsRepository interface.
+type MockPaymentStatisticsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentStatisticsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentStatisticsRepositoryMockRecorder is the mock recorder for MockPaymentStatisticsRepository.
+type MockPaymentStatisticsRepositoryMockRecorder struct {
+	mock *MockPaymentStatisticsRepository
+}
+
+// NewMockPaymentStatisticsRepository creates a new mock instance.
+func NewMockPaymentStatisticsRepository(ctrl *gomock.Controller) *MockPaymentStatisticsRepository {
+	mock := &MockPaymentStatisticsRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentStatisticsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentStatisticsRepository) EXPECT() *MockPaymentStatisticsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DecrementStatistics mocks base method.
+func (m *MockPaymentStatisticsRepository) DecrementStatistics(ctx context.Context, granularity string, periodStart time.Time, amount *string, symbol, vendorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementStatistics", ctx, granularity, periodStart, amount, symbol, vendorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecrementStatistics indicates an expected call of DecrementStatistics.
+func (mr *MockPaymentStatisticsRepositoryMockRecorder) DecrementStatistics(ctx, granularity, periodStart, amount, symbol, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementStatistics", reflect.TypeOf((*MockPaymentStatisticsRepository)(nil).DecrementStatistics), ctx, granularity, periodStart, amount, symbol, vendorID)
+}
+
+// GetStatisticsByTimeRangeAndGranularity mocks base method.
+func (m *MockPaymentStatisticsRepository) GetStatisticsByTimeRangeAndGranularity(ctx context.Context, granularity string, startTime, endTime time.Time, vendorID string, symbols []string) ([]entities.PaymentStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatisticsByTimeRangeAndGranularity", ctx, granularity, startTime, endTime, vendorID, symbols)
+	ret0, _ := ret[0].([]entities.PaymentStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatisticsByTimeRangeAndGranularity indicates an expected call of GetStatisticsByTimeRangeAndGranularity.
+func (mr *MockPaymentStatisticsRepositoryMockRecorder) GetStatisticsByTimeRangeAndGranularity(ctx, granularity, startTime, endTime, vendorID, symbols any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatisticsByTimeRangeAndGranularity", reflect.TypeOf((*MockPaymentStatisticsRepository)(nil).GetStatisticsByTimeRangeAndGranularity), ctx, granularity, startTime, endTime, vendorID, symbols)
+}
+
+// IncrementNetworkFee mocks base method.
+func (m *MockPaymentStatisticsRepository) IncrementNetworkFee(ctx context.Context, granularity string, periodStart time.Time, fee, symbol, vendorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementNetworkFee", ctx, granularity, periodStart, fee, symbol, vendorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementNetworkFee indicates an expected call of IncrementNetworkFee.
+func (mr *MockPaymentStatisticsRepositoryMockRecorder) IncrementNetworkFee(ctx, granularity, periodStart, fee, symbol, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementNetworkFee", reflect.TypeOf((*MockPaymentStatisticsRepository)(nil).IncrementNetworkFee), ctx, granularity, periodStart, fee, symbol, vendorID)
+}
+
+// IncrementStatistics mocks base method.
+func (m *MockPaymentStatisticsRepository) IncrementStatistics(ctx context.Context, granularity string, periodStart time.Time, amount, transferred *string, symbol, vendorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementStatistics", ctx, granularity, periodStart, amount, transferred, symbol, vendorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementStatistics indicates an expected call of IncrementStatistics.
+func (mr *MockPaymentStatisticsRepositoryMockRecorder) IncrementStatistics(ctx, granularity, periodStart, amount, transferred, symbol, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementStatistics", reflect.TypeOf((*MockPaymentStatisticsRepository)(nil).IncrementStatistics), ctx, granularity, periodStart, amount, transferred, symbol, vendorID)
+}
+
+// IncrementStatisticsInTx mocks base method.
+func (m *MockPaymentStatisticsRepository) IncrementStatisticsInTx(tx *gorm.DB, granularity string, periodStart time.Time, amount, transferred *string, symbol, vendorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementStatisticsInTx", tx, granularity, periodStart, amount, transferred, symbol, vendorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementStatisticsInTx indicates an expected call of IncrementStatisticsInTx.
+func (mr *MockPaymentStatisticsRepositoryMockRecorder) IncrementStatisticsInTx(tx, granularity, periodStart, amount, transferred, symbol, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementStatisticsInTx", reflect.TypeOf((*MockPaymentStatisticsRepository)(nil).IncrementStatisticsInTx), tx, granularity, periodStart, amount, transferred, symbol, vendorID)
+}
+
+// RevertAndIncrementStatistics mocks base method.
+func (m *MockPaymentStatisticsRepository) RevertAndIncrementStatistics(ctx context.Context, granularity string, periodStart time.Time, amount *string, oldSymbol, newSymbol, vendorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertAndIncrementStatistics", ctx, granularity, periodStart, amount, oldSymbol, newSymbol, vendorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevertAndIncrementStatistics indicates an expected call of RevertAndIncrementStatistics.
+func (mr *MockPaymentStatisticsRepositoryMockRecorder) RevertAndIncrementStatistics(ctx, granularity, periodStart, amount, oldSymbol, newSymbol, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertAndIncrementStatistics", reflect.TypeOf((*MockPaymentStatisticsRepository)(nil).RevertAndIncrementStatistics), ctx, granularity, periodStart, amount, oldSymbol, newSymbol, vendorID)
+}
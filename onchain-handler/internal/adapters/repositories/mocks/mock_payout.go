@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adapters/repositories/types/payout.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/adapters/repositories/types/payout.go -destination=internal/adapters/repositories/mocks/mock_payout.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/genefriendway/onchain-handler/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPayoutRepository is a mock of PayoutRepository interface.
+type MockPayoutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayoutRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPayoutRepositoryMockRecorder is the mock recorder for MockPayoutRepository.
+type MockPayoutRepositoryMockRecorder struct {
+	mock *MockPayoutRepository
+}
+
+// NewMockPayoutRepository creates a new mock instance.
+func NewMockPayoutRepository(ctrl *gomock.Controller) *MockPayoutRepository {
+	mock := &MockPayoutRepository{ctrl: ctrl}
+	mock.recorder = &MockPayoutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayoutRepository) EXPECT() *MockPayoutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePayouts mocks base method.
+func (m *MockPayoutRepository) CreatePayouts(ctx context.Context, payouts []entities.Payout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayouts", ctx, payouts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePayouts indicates an expected call of CreatePayouts.
+func (mr *MockPayoutRepositoryMockRecorder) CreatePayouts(ctx, payouts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayouts", reflect.TypeOf((*MockPayoutRepository)(nil).CreatePayouts), ctx, payouts)
+}
+
+// GetApprovedPayouts mocks base method.
+func (m *MockPayoutRepository) GetApprovedPayouts(ctx context.Context, network string, limit int) ([]entities.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovedPayouts", ctx, network, limit)
+	ret0, _ := ret[0].([]entities.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovedPayouts indicates an expected call of GetApprovedPayouts.
+func (mr *MockPayoutRepositoryMockRecorder) GetApprovedPayouts(ctx, network, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovedPayouts", reflect.TypeOf((*MockPayoutRepository)(nil).GetApprovedPayouts), ctx, network, limit)
+}
+
+// GetPayoutByID mocks base method.
+func (m *MockPayoutRepository) GetPayoutByID(ctx context.Context, id uint64) (*entities.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutByID indicates an expected call of GetPayoutByID.
+func (mr *MockPayoutRepositoryMockRecorder) GetPayoutByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutByID", reflect.TypeOf((*MockPayoutRepository)(nil).GetPayoutByID), ctx, id)
+}
+
+// GetPayoutByRequestID mocks base method.
+func (m *MockPayoutRepository) GetPayoutByRequestID(ctx context.Context, vendorID, requestID string) (*entities.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutByRequestID", ctx, vendorID, requestID)
+	ret0, _ := ret[0].(*entities.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutByRequestID indicates an expected call of GetPayoutByRequestID.
+func (mr *MockPayoutRepositoryMockRecorder) GetPayoutByRequestID(ctx, vendorID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutByRequestID", reflect.TypeOf((*MockPayoutRepository)(nil).GetPayoutByRequestID), ctx, vendorID, requestID)
+}
+
+// GetPayouts mocks base method.
+func (m *MockPayoutRepository) GetPayouts(ctx context.Context, status string) ([]entities.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayouts", ctx, status)
+	ret0, _ := ret[0].([]entities.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayouts indicates an expected call of GetPayouts.
+func (mr *MockPayoutRepositoryMockRecorder) GetPayouts(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayouts", reflect.TypeOf((*MockPayoutRepository)(nil).GetPayouts), ctx, status)
+}
+
+// UpdatePayoutStatus mocks base method.
+func (m *MockPayoutRepository) UpdatePayoutStatus(ctx context.Context, id uint64, currentStatus string, updates map[string]any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayoutStatus", ctx, id, currentStatus, updates)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePayoutStatus indicates an expected call of UpdatePayoutStatus.
+func (mr *MockPayoutRepositoryMockRecorder) UpdatePayoutStatus(ctx, id, currentStatus, updates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayoutStatus", reflect.TypeOf((*MockPayoutRepository)(nil).UpdatePayoutStatus), ctx, id, currentStatus, updates)
+}
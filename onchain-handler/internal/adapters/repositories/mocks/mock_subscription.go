@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adapters/repositories/types/subscription.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/adapters/repositories/types/subscription.go -destination=internal/adapters/repositories/mocks/mock_subscription.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/genefriendway/onchain-handler/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSubscriptionRepository is a mock of SubscriptionRepository interface.
+type MockSubscriptionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionRepositoryMockRecorder is the mock recorder for MockSubscriptionRepository.
+type MockSubscriptionRepositoryMockRecorder struct {
+	mock *MockSubscriptionRepository
+}
+
+// NewMockSubscriptionRepository creates a new mock instance.
+func NewMockSubscriptionRepository(ctrl *gomock.Controller) *MockSubscriptionRepository {
+	mock := &MockSubscriptionRepository{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionRepository) EXPECT() *MockSubscriptionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelSubscription mocks base method.
+func (m *MockSubscriptionRepository) CancelSubscription(ctx context.Context, id uint64, cancelledAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSubscription", ctx, id, cancelledAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSubscription indicates an expected call of CancelSubscription.
+func (mr *MockSubscriptionRepositoryMockRecorder) CancelSubscription(ctx, id, cancelledAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSubscription", reflect.TypeOf((*MockSubscriptionRepository)(nil).CancelSubscription), ctx, id, cancelledAt)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockSubscriptionRepository) CreateSubscription(ctx context.Context, subscription *entities.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockSubscriptionRepositoryMockRecorder) CreateSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockSubscriptionRepository)(nil).CreateSubscription), ctx, subscription)
+}
+
+// CreateSubscriptionPlan mocks base method.
+func (m *MockSubscriptionRepository) CreateSubscriptionPlan(ctx context.Context, plan *entities.SubscriptionPlan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscriptionPlan", ctx, plan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscriptionPlan indicates an expected call of CreateSubscriptionPlan.
+func (mr *MockSubscriptionRepositoryMockRecorder) CreateSubscriptionPlan(ctx, plan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscriptionPlan", reflect.TypeOf((*MockSubscriptionRepository)(nil).CreateSubscriptionPlan), ctx, plan)
+}
+
+// GetBillingSubscriptions mocks base method.
+func (m *MockSubscriptionRepository) GetBillingSubscriptions(ctx context.Context) ([]entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillingSubscriptions", ctx)
+	ret0, _ := ret[0].([]entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBillingSubscriptions indicates an expected call of GetBillingSubscriptions.
+func (mr *MockSubscriptionRepositoryMockRecorder) GetBillingSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillingSubscriptions", reflect.TypeOf((*MockSubscriptionRepository)(nil).GetBillingSubscriptions), ctx)
+}
+
+// GetDueSubscriptions mocks base method.
+func (m *MockSubscriptionRepository) GetDueSubscriptions(ctx context.Context, now time.Time) ([]entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueSubscriptions", ctx, now)
+	ret0, _ := ret[0].([]entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueSubscriptions indicates an expected call of GetDueSubscriptions.
+func (mr *MockSubscriptionRepositoryMockRecorder) GetDueSubscriptions(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueSubscriptions", reflect.TypeOf((*MockSubscriptionRepository)(nil).GetDueSubscriptions), ctx, now)
+}
+
+// GetSubscriptionByID mocks base method.
+func (m *MockSubscriptionRepository) GetSubscriptionByID(ctx context.Context, vendorID string, id uint64) (*entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionByID", ctx, vendorID, id)
+	ret0, _ := ret[0].(*entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionByID indicates an expected call of GetSubscriptionByID.
+func (mr *MockSubscriptionRepositoryMockRecorder) GetSubscriptionByID(ctx, vendorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionByID", reflect.TypeOf((*MockSubscriptionRepository)(nil).GetSubscriptionByID), ctx, vendorID, id)
+}
+
+// GetSubscriptionPlanByID mocks base method.
+func (m *MockSubscriptionRepository) GetSubscriptionPlanByID(ctx context.Context, vendorID string, id uint64) (*entities.SubscriptionPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionPlanByID", ctx, vendorID, id)
+	ret0, _ := ret[0].(*entities.SubscriptionPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionPlanByID indicates an expected call of GetSubscriptionPlanByID.
+func (mr *MockSubscriptionRepositoryMockRecorder) GetSubscriptionPlanByID(ctx, vendorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionPlanByID", reflect.TypeOf((*MockSubscriptionRepository)(nil).GetSubscriptionPlanByID), ctx, vendorID, id)
+}
+
+// GetSubscriptionPlans mocks base method.
+func (m *MockSubscriptionRepository) GetSubscriptionPlans(ctx context.Context, vendorID string) ([]entities.SubscriptionPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionPlans", ctx, vendorID)
+	ret0, _ := ret[0].([]entities.SubscriptionPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionPlans indicates an expected call of GetSubscriptionPlans.
+func (mr *MockSubscriptionRepositoryMockRecorder) GetSubscriptionPlans(ctx, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionPlans", reflect.TypeOf((*MockSubscriptionRepository)(nil).GetSubscriptionPlans), ctx, vendorID)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockSubscriptionRepository) UpdateSubscription(ctx context.Context, id uint64, currentOrderID *uint64, updates map[string]any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, id, currentOrderID, updates)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockSubscriptionRepositoryMockRecorder) UpdateSubscription(ctx, id, currentOrderID, updates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockSubscriptionRepository)(nil).UpdateSubscription), ctx, id, currentOrderID, updates)
+}
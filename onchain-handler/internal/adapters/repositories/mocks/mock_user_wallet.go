@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adapters/repositories/types/user_wallet.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/adapters/repositories/types/user_wallet.go -destination=internal/adapters/repositories/mocks/mock_user_wallet.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/genefriendway/onchain-handler/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserWalletRepository is a mock of UserWalletRepository interface.
+type MockUserWalletRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserWalletRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserWalletRepositoryMockRecorder is the mock recorder for MockUserWalletRepository.
+type MockUserWalletRepositoryMockRecorder struct {
+	mock *MockUserWalletRepository
+}
+
+// NewMockUserWalletRepository creates a new mock instance.
+func NewMockUserWalletRepository(ctrl *gomock.Controller) *MockUserWalletRepository {
+	mock := &MockUserWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockUserWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserWalletRepository) EXPECT() *MockUserWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetUserWalletByUserID mocks base method.
+func (m *MockUserWalletRepository) GetUserWalletByUserID(ctx context.Context, userID uint64) (*entities.UserWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWalletByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entities.UserWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWalletByUserID indicates an expected call of GetUserWalletByUserID.
+func (mr *MockUserWalletRepositoryMockRecorder) GetUserWalletByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWalletByUserID", reflect.TypeOf((*MockUserWalletRepository)(nil).GetUserWalletByUserID), ctx, userID)
+}
+
+// GetUserWalletsByUserIDs mocks base method.
+func (m *MockUserWalletRepository) GetUserWalletsByUserIDs(ctx context.Context, userIDs []uint64) ([]entities.UserWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWalletsByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]entities.UserWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWalletsByUserIDs indicates an expected call of GetUserWalletsByUserIDs.
+func (mr *MockUserWalletRepositoryMockRecorder) GetUserWalletsByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWalletsByUserIDs", reflect.TypeOf((*MockUserWalletRepository)(nil).GetUserWalletsByUserIDs), ctx, userIDs)
+}
+
+// UpsertUserWallet mocks base method.
+func (m *MockUserWalletRepository) UpsertUserWallet(ctx context.Context, wallet *entities.UserWallet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserWallet", ctx, wallet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUserWallet indicates an expected call of UpsertUserWallet.
+func (mr *MockUserWalletRepositoryMockRecorder) UpsertUserWallet(ctx, wallet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserWallet", reflect.TypeOf((*MockUserWalletRepository)(nil).UpsertUserWallet), ctx, wallet)
+}
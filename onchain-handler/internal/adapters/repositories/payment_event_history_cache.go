@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"gorm.io/gorm"
+
 	"github.com/genefriendway/onchain-handler/conf"
 	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
 	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
@@ -30,9 +32,10 @@ func NewPaymentEventHistoryCacheRepository(
 func (c *paymentEventHistoryCache) CreatePaymentEventHistory(
 	ctx context.Context,
 	paymentEvents []entities.PaymentEventHistory,
+	onCreated func(tx *gorm.DB, paymentEvent entities.PaymentEventHistory) error,
 ) ([]entities.PaymentEventHistory, error) {
-	// Create payment event history records in the repository
-	createdEvents, err := c.paymentEventHistoryRepository.CreatePaymentEventHistory(ctx, paymentEvents)
+	// Create payment event history records in the repository, the cache is updated once they are committed
+	createdEvents, err := c.paymentEventHistoryRepository.CreatePaymentEventHistory(ctx, paymentEvents, onCreated)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create payment event history in repository: %w", err)
 	}
@@ -70,3 +73,12 @@ func (c *paymentEventHistoryCache) CreatePaymentEventHistory(
 	// Return the created events with updated fields
 	return createdEvents, nil
 }
+
+func (c *paymentEventHistoryCache) IsPaymentEventRecorded(
+	ctx context.Context,
+	network, transactionHash string,
+	logIndex uint,
+	toAddress, contractAddress string,
+) (bool, error) {
+	return c.paymentEventHistoryRepository.IsPaymentEventRecorded(ctx, network, transactionHash, logIndex, toAddress, contractAddress)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
 	"github.com/genefriendway/onchain-handler/internal/domain/entities"
@@ -21,14 +22,48 @@ func NewPaymentEventHistoryRepository(db *gorm.DB) repotypes.PaymentEventHistory
 }
 
 // CreatePaymentEventHistory inserts multiple payment event history records in a single transaction
-// and returns the created records.
+// and returns the created records. Events whose transfer log is already recorded are skipped and not returned,
+// so processing the same log twice is a no-op. onCreated, when not nil, records what follows from each created event
+// in the same transaction. None of the events is recorded when it fails.
 func (r *paymentEventHistoryRepository) CreatePaymentEventHistory(
 	ctx context.Context,
 	paymentEvents []entities.PaymentEventHistory,
+	onCreated func(tx *gorm.DB, paymentEvent entities.PaymentEventHistory) error,
 ) ([]entities.PaymentEventHistory, error) {
+	var createdEvents []entities.PaymentEventHistory
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Create(&paymentEvents).Error; err != nil {
-			return fmt.Errorf("failed to create payment event history records: %w", err)
+		for _, paymentEvent := range paymentEvents {
+			// Step 1: Skip logs recorded before the log index was tracked
+			if paymentEvent.LogIndex != nil {
+				var legacyCount int64
+				if err := tx.Model(&entities.PaymentEventHistory{}).
+					Where("network = ? AND transaction_hash = ? AND log_index IS NULL", paymentEvent.Network, paymentEvent.TransactionHash).
+					Where("LOWER(to_address) = LOWER(?) AND LOWER(contract_address) = LOWER(?)", paymentEvent.ToAddress, paymentEvent.ContractAddress).
+					Count(&legacyCount).Error; err != nil {
+					return fmt.Errorf("failed to check legacy payment event history for transaction %s: %w", paymentEvent.TransactionHash, err)
+				}
+				if legacyCount > 0 {
+					continue
+				}
+			}
+
+			// Step 2: Insert unless the same log is already recorded
+			result := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "network"}, {Name: "transaction_hash"}, {Name: "log_index"}},
+				DoNothing: true,
+			}).Create(&paymentEvent)
+			if result.Error != nil {
+				return fmt.Errorf("failed to create payment event history records: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			if onCreated != nil {
+				if err := onCreated(tx, paymentEvent); err != nil {
+					return err
+				}
+			}
+			createdEvents = append(createdEvents, paymentEvent)
 		}
 		return nil
 	})
@@ -37,5 +72,28 @@ func (r *paymentEventHistoryRepository) CreatePaymentEventHistory(
 	}
 
 	// Return the created models with updated fields (e.g., IDs, timestamps)
-	return paymentEvents, nil
+	return createdEvents, nil
+}
+
+// IsPaymentEventRecorded checks whether a transfer log is already stored. Records created before the log index
+// was tracked are matched by transaction hash, recipient and token contract instead.
+func (r *paymentEventHistoryRepository) IsPaymentEventRecorded(
+	ctx context.Context,
+	network, transactionHash string,
+	logIndex uint,
+	toAddress, contractAddress string,
+) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.PaymentEventHistory{}).
+		Where("network = ? AND transaction_hash = ?", network, transactionHash).
+		Where(
+			"log_index = ? OR (log_index IS NULL AND LOWER(to_address) = LOWER(?) AND LOWER(contract_address) = LOWER(?))",
+			logIndex, toAddress, contractAddress,
+		).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to check payment event history for transaction %s: %w", transactionHash, err)
+	}
+	return count > 0, nil
 }
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type paymentOrderAuditRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentOrderAuditRepository(db *gorm.DB) repotypes.PaymentOrderAuditRepository {
+	return &paymentOrderAuditRepository{
+		db: db,
+	}
+}
+
+// GetPaymentOrderAudits retrieves the changes of an order, oldest first
+func (r *paymentOrderAuditRepository) GetPaymentOrderAudits(ctx context.Context, orderID uint64) ([]entities.PaymentOrderAudit, error) {
+	var audits []entities.PaymentOrderAudit
+	if err := r.db.WithContext(ctx).
+		Where("payment_order_id = ?", orderID).
+		Order("id").
+		Find(&audits).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch payment order audits: %w", err)
+	}
+	return audits, nil
+}
+
+// newOrderAudit records the change of the order, as it was before the change, to the new status and transferred amount
+// under the audit source of the context.
+func newOrderAudit(ctx context.Context, order entities.PaymentOrder, newStatus, newTransferred string) entities.PaymentOrderAudit {
+	source, _ := utils.AuditSourceOf(ctx)
+	audit := entities.PaymentOrderAudit{
+		PaymentOrderID: order.ID,
+		OldStatus:      order.Status,
+		NewStatus:      newStatus,
+		OldTransferred: order.Transferred,
+		NewTransferred: newTransferred,
+		Actor:          source.Actor,
+		TxHash:         source.TxHash,
+		Note:           source.Note,
+	}
+	if source.BlockNumber != 0 {
+		audit.BlockNumber = &source.BlockNumber
+	}
+	return audit
+}
+
+// newStatusAudits records the move of the orders, as they were before the update, to the status
+func newStatusAudits(ctx context.Context, orders []entities.PaymentOrder, status string) []entities.PaymentOrderAudit {
+	audits := make([]entities.PaymentOrderAudit, 0, len(orders))
+	for _, order := range orders {
+		audits = append(audits, newOrderAudit(ctx, order, status, order.Transferred))
+	}
+	return audits
+}
+
+// createOrderAudits appends the audits in the transaction of the changes they record
+func createOrderAudits(tx *gorm.DB, audits []entities.PaymentOrderAudit) error {
+	if len(audits) == 0 {
+		return nil
+	}
+	if err := tx.Create(&audits).Error; err != nil {
+		return fmt.Errorf("failed to create payment order audits: %w", err)
+	}
+	return nil
+}
+
+// lockOrderStates locks the orders and reads the status and transferred amount they have before being updated
+func lockOrderStates(tx *gorm.DB, orderIDs []uint64) ([]entities.PaymentOrder, error) {
+	var orders []entities.PaymentOrder
+	if len(orderIDs) == 0 {
+		return orders, nil
+	}
+	if err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Select("id", "status", "transferred").
+		Where("id IN ?", orderIDs).
+		Order("id").
+		Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to lock payment orders: %w", err)
+	}
+	return orders, nil
+}
+
+// newMoveAudit records the move of the order to another network or token, its status and transferred amount are unchanged
+func newMoveAudit(ctx context.Context, order entities.PaymentOrder, network, symbol string) entities.PaymentOrderAudit {
+	audit := newOrderAudit(ctx, order, order.Status, order.Transferred)
+	if note := describeOrderMove(order, network, symbol); note != "" {
+		audit.Note = note
+	}
+	return audit
+}
+
+// describeOrderMove describes the move of the order to the network and token, empty when it stays on them
+func describeOrderMove(order entities.PaymentOrder, network, symbol string) string {
+	var changes []string
+	if order.Network != network {
+		changes = append(changes, fmt.Sprintf("network %s -> %s", order.Network, network))
+	}
+	if order.Symbol != symbol {
+		changes = append(changes, fmt.Sprintf("symbol %s -> %s", order.Symbol, symbol))
+	}
+	return strings.Join(changes, ", ")
+}
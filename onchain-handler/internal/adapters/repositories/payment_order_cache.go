@@ -157,7 +157,7 @@ func (c *paymentOrderCache) UpdatePaymentOrder(
 func mergePaymentOrderFields(dst, src *entities.PaymentOrder) {
 	if src.Status != "" {
 		dst.Status = src.Status
-		if src.Status == constants.Success {
+		if constants.IsPaidStatus(src.Status) {
 			dst.SucceededAt = src.SucceededAt
 		}
 	}
@@ -167,6 +167,9 @@ func mergePaymentOrderFields(dst, src *entities.PaymentOrder) {
 	if src.Network != "" {
 		dst.Network = src.Network
 	}
+	if src.Symbol != "" {
+		dst.Symbol = src.Symbol
+	}
 	if src.BlockHeight != 0 {
 		dst.BlockHeight = src.BlockHeight
 	}
@@ -177,15 +180,15 @@ func mergePaymentOrderFields(dst, src *entities.PaymentOrder) {
 
 // UpdateOrderNetwork updates the network and block height of a payment order
 func (c *paymentOrderCache) UpdateOrderNetwork(
-	ctx context.Context, requestID, network string, blockHeight uint64,
+	ctx context.Context, vendorID, requestID, network string, blockHeight uint64,
 ) error {
 	// Update the database (source of truth) first
-	if err := c.paymentOrderRepository.UpdateOrderNetwork(ctx, requestID, network, blockHeight); err != nil {
+	if err := c.paymentOrderRepository.UpdateOrderNetwork(ctx, vendorID, requestID, network, blockHeight); err != nil {
 		return fmt.Errorf("failed to update payment order network in repository: %w", err)
 	}
 
 	// Retrieve the order ID by request ID
-	orderID, err := c.paymentOrderRepository.GetPaymentOrderIDByRequestID(ctx, requestID)
+	orderID, err := c.paymentOrderRepository.GetPaymentOrderIDByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return fmt.Errorf("failed to get payment order ID by request ID: %w", err)
 	}
@@ -213,25 +216,26 @@ func (c *paymentOrderCache) UpdateOrderNetwork(
 	return nil
 }
 
-// UpdateOrderToSuccessAndReleaseWallet updates the order status to SUCCESS, releases the associated wallet, and syncs cache
+// UpdateOrderToSuccessAndReleaseWallet updates the order status to SUCCESS or OVERPAID, releases the associated wallet, and syncs cache
 func (c *paymentOrderCache) UpdateOrderToSuccessAndReleaseWallet(
 	ctx context.Context,
 	orderID uint64,
+	status string,
 	succeededAt time.Time,
-) error {
+) (bool, error) {
 	cacheKey := &cachetypes.Keyer{Raw: keyPrefixPaymentOrder + strconv.FormatUint(orderID, 10)}
 
 	// First, update in the repository
-	err := c.paymentOrderRepository.UpdateOrderToSuccessAndReleaseWallet(ctx, orderID, succeededAt)
+	updated, err := c.paymentOrderRepository.UpdateOrderToSuccessAndReleaseWallet(ctx, orderID, status, succeededAt)
 	if err != nil {
-		return fmt.Errorf("failed to update order to SUCCESS and release wallet: %w", err)
+		return false, fmt.Errorf("failed to update order to %s and release wallet: %w", status, err)
 	}
 
 	// Attempt to retrieve the payment order from the cache
 	var cachedOrder entities.PaymentOrder
-	if cacheErr := c.cache.RetrieveItem(cacheKey, &cachedOrder); cacheErr == nil {
+	if cacheErr := c.cache.RetrieveItem(cacheKey, &cachedOrder); cacheErr == nil && updated {
 		// Cache hit: update status and succeededAt fields
-		cachedOrder.Status = constants.Success
+		cachedOrder.Status = status
 		cachedOrder.SucceededAt = succeededAt
 
 		// Save updated order back into cache
@@ -239,11 +243,11 @@ func (c *paymentOrderCache) UpdateOrderToSuccessAndReleaseWallet(
 			logger.GetLogger().Warnf("Failed to update cache for payment order ID %d: %v", orderID, saveErr)
 		}
 	} else {
-		// Cache miss: retrieve the fresh updated order from DB to ensure accuracy
+		// Cache miss or already successful: retrieve the fresh updated order from DB to ensure accuracy
 		updatedOrder, dbErr := c.paymentOrderRepository.GetPaymentOrderByID(ctx, orderID)
 		if dbErr != nil {
 			logger.GetLogger().Warnf("Failed to retrieve updated order ID %d after updating status: %v", orderID, dbErr)
-			return nil // No critical error; DB already updated
+			return updated, nil // No critical error; DB already updated
 		}
 
 		// Cache the fresh order
@@ -252,7 +256,7 @@ func (c *paymentOrderCache) UpdateOrderToSuccessAndReleaseWallet(
 		}
 	}
 
-	return nil
+	return updated, nil
 }
 
 func (c *paymentOrderCache) BatchUpdateOrdersToExpired(
@@ -268,8 +272,12 @@ func (c *paymentOrderCache) BatchUpdateOrdersToExpired(
 		var cachedOrder entities.PaymentOrder
 		cacheErr := c.cache.RetrieveItem(cacheKey, &cachedOrder)
 
-		// If found in cache, update it
+		// If found in cache, update it unless its expiry was extended
 		if cacheErr == nil {
+			if cachedOrder.ExpiredTime.After(time.Now().UTC()) || cachedOrder.Status == constants.OnHold {
+				continue
+			}
+
 			// Update status in the cached order
 			cachedOrder.Status = constants.Expired
 
@@ -329,6 +337,30 @@ func (c *paymentOrderCache) BatchUpdateOrderBlockHeights(ctx context.Context, or
 	return nil
 }
 
+// UpdateOptionBlockHeight updates the block height of the option of a multi-chain order in the DB and the cached order.
+func (c *paymentOrderCache) UpdateOptionBlockHeight(
+	ctx context.Context, orderID uint64, network, symbol string, blockHeight uint64,
+) error {
+	if err := c.paymentOrderRepository.UpdateOptionBlockHeight(ctx, orderID, network, symbol, blockHeight); err != nil {
+		return err
+	}
+
+	cacheKey := &cachetypes.Keyer{Raw: keyPrefixPaymentOrder + strconv.FormatUint(orderID, 10)}
+	var cachedOrder entities.PaymentOrder
+	if cacheErr := c.cache.RetrieveItem(cacheKey, &cachedOrder); cacheErr != nil {
+		return nil
+	}
+	for index, option := range cachedOrder.Options {
+		if option.Network == network && option.Symbol == symbol {
+			cachedOrder.Options[index].BlockHeight = blockHeight
+		}
+	}
+	if saveErr := c.cache.SaveItem(cacheKey, cachedOrder, conf.GetExpiredOrderTime()); saveErr != nil {
+		logger.GetLogger().Warnf("Failed to update cache for payment order ID %d: %v", orderID, saveErr)
+	}
+	return nil
+}
+
 func (c *paymentOrderCache) GetExpiredPaymentOrders(ctx context.Context, network string) ([]entities.PaymentOrder, error) {
 	// Generate a consistent cache key
 	key := &cachetypes.Keyer{Raw: fmt.Sprintf("%sGetExpiredPaymentOrders_network:%s", keyPrefixPaymentOrder, network)}
@@ -354,6 +386,14 @@ func (c *paymentOrderCache) GetExpiredPaymentOrders(ctx context.Context, network
 	return paymentOrders, nil
 }
 
+func (c *paymentOrderCache) GetPaymentOrdersOpenBetween(
+	ctx context.Context,
+	network string,
+	startTime, endTime time.Time,
+) ([]entities.PaymentOrder, error) {
+	return c.paymentOrderRepository.GetPaymentOrdersOpenBetween(ctx, network, startTime, endTime)
+}
+
 func (c *paymentOrderCache) UpdateExpiredOrdersToFailed(ctx context.Context) ([]uint64, error) {
 	// Call the repository to update expired orders to "Failed" and get the updated IDs
 	updatedIDs, err := c.paymentOrderRepository.UpdateExpiredOrdersToFailed(ctx)
@@ -512,9 +552,12 @@ func (c *paymentOrderCache) GetPaymentOrdersByIDs(ctx context.Context, ids []uin
 	return orders, nil
 }
 
-func (c *paymentOrderCache) GetPaymentOrderByRequestID(ctx context.Context, requestID string) (*entities.PaymentOrder, error) {
+func (c *paymentOrderCache) GetPaymentOrderByRequestID(
+	ctx context.Context,
+	vendorID, requestID string,
+) (*entities.PaymentOrder, error) {
 	// Fetch the order ID using the request ID
-	orderID, err := c.GetPaymentOrderIDByRequestID(ctx, requestID)
+	orderID, err := c.GetPaymentOrderIDByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return nil, err
 	}
@@ -533,7 +576,7 @@ func (c *paymentOrderCache) GetPaymentOrderByRequestID(ctx context.Context, requ
 	}
 
 	// Cache miss: fetch the payment order from the repository (DB)
-	order, err := c.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, requestID)
+	order, err := c.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch payment order with Request ID %s from repository: %w", requestID, err)
 	}
@@ -546,9 +589,9 @@ func (c *paymentOrderCache) GetPaymentOrderByRequestID(ctx context.Context, requ
 	return order, nil
 }
 
-func (c *paymentOrderCache) GetPaymentOrderIDByRequestID(ctx context.Context, requestID string) (uint64, error) {
-	// Construct the cache key using the request ID
-	cacheKey := &cachetypes.Keyer{Raw: keyPrefixPaymentOrder + requestID}
+func (c *paymentOrderCache) GetPaymentOrderIDByRequestID(ctx context.Context, vendorID, requestID string) (uint64, error) {
+	// Construct the cache key using the vendor and request ID, request IDs are unique per vendor
+	cacheKey := &cachetypes.Keyer{Raw: keyPrefixPaymentOrder + vendorID + "_" + requestID}
 
 	// Attempt to retrieve the payment order ID from the cache
 	var cachedOrderID uint64
@@ -561,7 +604,7 @@ func (c *paymentOrderCache) GetPaymentOrderIDByRequestID(ctx context.Context, re
 	}
 
 	// Cache miss: fetch the payment order ID from the repository (DB)
-	orderID, err := c.paymentOrderRepository.GetPaymentOrderIDByRequestID(ctx, requestID)
+	orderID, err := c.paymentOrderRepository.GetPaymentOrderIDByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch payment order ID with Request ID %s from repository: %w", requestID, err)
 	}
@@ -574,6 +617,59 @@ func (c *paymentOrderCache) GetPaymentOrderIDByRequestID(ctx context.Context, re
 	return orderID, nil
 }
 
+// ExtendOrderExpiry extends the order expiry and refreshes the cached order
+func (c *paymentOrderCache) ExtendOrderExpiry(ctx context.Context, orderID uint64, expiredTime time.Time) (bool, error) {
+	extended, err := c.paymentOrderRepository.ExtendOrderExpiry(ctx, orderID, expiredTime)
+	if err != nil || !extended {
+		return extended, err
+	}
+
+	cacheKey := &cachetypes.Keyer{Raw: keyPrefixPaymentOrder + strconv.FormatUint(orderID, 10)}
+	var cachedOrder entities.PaymentOrder
+	if cacheErr := c.cache.RetrieveItem(cacheKey, &cachedOrder); cacheErr == nil {
+		cachedOrder.ExpiredTime = expiredTime.UTC()
+		if saveErr := c.cache.SaveItem(cacheKey, cachedOrder, conf.GetExpiredOrderTime()); saveErr != nil {
+			logger.GetLogger().Warnf("Failed to update cache for payment order ID %d: %v", orderID, saveErr)
+		}
+	}
+	return true, nil
+}
+
+// CancelOrderAndReleaseWallet cancels the order, releases its wallet and refreshes the cached order
+func (c *paymentOrderCache) CancelOrderAndReleaseWallet(
+	ctx context.Context,
+	orderID uint64,
+	walletCooldownUntil time.Time,
+) (bool, error) {
+	cancelled, err := c.paymentOrderRepository.CancelOrderAndReleaseWallet(ctx, orderID, walletCooldownUntil)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel order and release wallet: %w", err)
+	}
+	if !cancelled {
+		return false, nil
+	}
+
+	// Cache the fresh order
+	cacheKey := &cachetypes.Keyer{Raw: keyPrefixPaymentOrder + strconv.FormatUint(orderID, 10)}
+	updatedOrder, err := c.paymentOrderRepository.GetPaymentOrderByID(ctx, orderID)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to retrieve cancelled order ID %d: %v", orderID, err)
+		if delErr := c.cache.RemoveItem(cacheKey); delErr != nil {
+			logger.GetLogger().Warnf("Failed to remove cache for payment order ID %d: %v", orderID, delErr)
+		}
+		return true, nil // No critical error; DB already updated
+	}
+	if saveErr := c.cache.SaveItem(cacheKey, updatedOrder, conf.GetExpiredOrderTime()); saveErr != nil {
+		logger.GetLogger().Warnf("Failed to update cache for payment order ID %d: %v", orderID, saveErr)
+	}
+	return true, nil
+}
+
+func (c *paymentOrderCache) GetExistingRequestIDs(ctx context.Context, vendorID string, requestIDs []string) ([]string, error) {
+	// Always read the DB, a stale cache would let duplicates through
+	return c.paymentOrderRepository.GetExistingRequestIDs(ctx, vendorID, requestIDs)
+}
+
 func (c *paymentOrderCache) ReleaseWalletsForSuccessfulOrders(ctx context.Context) error {
 	return c.paymentOrderRepository.ReleaseWalletsForSuccessfulOrders(ctx)
 }
@@ -605,17 +701,17 @@ func (c *paymentOrderCache) GetProcessingOrdersExpired(ctx context.Context, netw
 
 func (c *paymentOrderCache) UpdateOrderFieldsByRequestIDAndStatus(
 	ctx context.Context,
-	requestID string,
+	vendorID, requestID string,
 	status string,
 	updates map[string]any,
 ) error {
 	// Step 1: Update DB
-	if err := c.paymentOrderRepository.UpdateOrderFieldsByRequestIDAndStatus(ctx, requestID, status, updates); err != nil {
+	if err := c.paymentOrderRepository.UpdateOrderFieldsByRequestIDAndStatus(ctx, vendorID, requestID, status, updates); err != nil {
 		return fmt.Errorf("failed to update payment order in repository: %w", err)
 	}
 
 	// Step 2: Get order ID for cache key
-	orderID, err := c.paymentOrderRepository.GetPaymentOrderIDByRequestID(ctx, requestID)
+	orderID, err := c.paymentOrderRepository.GetPaymentOrderIDByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return fmt.Errorf("failed to get payment order ID for cache update: %w", err)
 	}
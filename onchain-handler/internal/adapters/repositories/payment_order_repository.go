@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"gorm.io/gorm"
@@ -45,6 +46,15 @@ func (r *paymentOrderRepository) CreatePaymentOrders(
 		return nil, fmt.Errorf("failed to create payment orders: %w", err)
 	}
 
+	// Record the creation of the orders, they have no status before it
+	audits := make([]entities.PaymentOrderAudit, 0, len(orders))
+	for _, order := range orders {
+		audits = append(audits, newOrderAudit(ctx, entities.PaymentOrder{ID: order.ID}, order.Status, order.Transferred))
+	}
+	if err := createOrderAudits(tx.WithContext(ctx), audits); err != nil {
+		return nil, err
+	}
+
 	// Log the success
 	logger.GetLogger().Infof("Successfully created %d payment orders for vendor: %s", len(orders), vendorID)
 	return orders, nil
@@ -56,8 +66,9 @@ func (r *paymentOrderRepository) GetActivePaymentOrders(ctx context.Context, net
 	currentTime := time.Now().UTC() // Get the current UTC time
 
 	query := r.db.WithContext(ctx).
-		Joins("JOIN payment_wallet ON payment_wallet.id = payment_order.wallet_id").                          // Join PaymentWallet with PaymentOrder.
+		Joins("LEFT JOIN payment_wallet ON payment_wallet.id = payment_order.wallet_id").                     // Router orders have no wallet.
 		Preload("Wallet").                                                                                    // Preload the associated Wallet
+		Preload("Options", orderedOptions).                                                                   // Preload the options of multi-chain orders.
 		Where("(payment_order.status IN (?) AND payment_order.expired_time > ?) OR payment_order.status = ?", // Differentiate logic for `Processing`.
 			[]string{constants.Pending, constants.Partial}, // Non-expired statuses.
 			currentTime,
@@ -88,6 +99,7 @@ func (r *paymentOrderRepository) UpdatePaymentOrder(
 		// Retrieve the order with row-level locking
 		if err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
 			Preload("Wallet").
+			Preload("Options", orderedOptions).
 			Preload("PaymentEventHistories").
 			First(&order, "id = ?", orderID).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -97,6 +109,13 @@ func (r *paymentOrderRepository) UpdatePaymentOrder(
 		}
 
 		// Allow caller to update fields safely within transaction
+		previous := entities.PaymentOrder{
+			ID:          order.ID,
+			Status:      order.Status,
+			Transferred: order.Transferred,
+			Network:     order.Network,
+			Symbol:      order.Symbol,
+		}
 		if err := updateFunc(&order); err != nil {
 			return err
 		}
@@ -106,6 +125,8 @@ func (r *paymentOrderRepository) UpdatePaymentOrder(
 			"status":       order.Status,
 			"transferred":  order.Transferred,
 			"succeeded_at": order.SucceededAt,
+			"network":      order.Network, // A multi-chain order moves to the option paid
+			"symbol":       order.Symbol,
 		}
 		if order.BlockHeight != 0 {
 			updates["block_height"] = order.BlockHeight
@@ -119,8 +140,25 @@ func (r *paymentOrderRepository) UpdatePaymentOrder(
 			return fmt.Errorf("failed to update payment order: %w", err)
 		}
 
+		// Record the change, block height updates alone are not audited
+		note := describeOrderMove(previous, order.Network, order.Symbol)
+		if previous.Status != order.Status || previous.Transferred != order.Transferred || note != "" {
+			audit := newOrderAudit(ctx, previous, order.Status, order.Transferred)
+			if note != "" {
+				audit.Note = note
+			}
+			if err := createOrderAudits(tx, []entities.PaymentOrderAudit{audit}); err != nil {
+				return err
+			}
+		}
+
 		// Determine wallet `in_use` status based on updated order status
-		walletInUse := !(order.Status == constants.Success || order.Status == constants.Failed)
+		walletInUse := !(constants.IsPaidStatus(order.Status) || order.Status == constants.Failed)
+
+		// Router orders have no wallet to update, and the wallet of a closed order may hold another order by now
+		if order.WalletID == nil || constants.IsWalletReleasedStatus(previous.Status) {
+			return nil
+		}
 
 		// Update associated wallet's `in_use` status
 		if err := tx.Model(&entities.PaymentWallet{}).
@@ -133,37 +171,63 @@ func (r *paymentOrderRepository) UpdatePaymentOrder(
 	})
 }
 
-// UpdateOrderToSuccessAndReleaseWallet updates the status of a payment order to "Success" and releases the associated wallet.
+// UpdateOrderToSuccessAndReleaseWallet updates the status of a payment order to a paid status, SUCCESS or OVERPAID,
+// and releases the associated wallet. It returns false without changes when the order is already paid.
 func (r *paymentOrderRepository) UpdateOrderToSuccessAndReleaseWallet(
 	ctx context.Context,
 	orderID uint64,
+	status string,
 	succeededAt time.Time,
-) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Step 1: Update order status
+) (bool, error) {
+	updated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		previous, err := lockOrderStates(tx, []uint64{orderID})
+		if err != nil {
+			return err
+		}
+
+		// Step 1: Update order status unless it is already paid
 		result := tx.Model(&entities.PaymentOrder{}).
-			Where("id = ?", orderID).
+			Where("id = ? AND status NOT IN (?)", orderID, []string{constants.Success, constants.Overpaid}).
 			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
 			Updates(map[string]any{
-				"status":       constants.Success,
+				"status":       status,
 				"succeeded_at": succeededAt,
 			})
 		if result.Error != nil {
-			return fmt.Errorf("failed to set order status SUCCESS: %w", result.Error)
+			return fmt.Errorf("failed to set order status %s: %w", status, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Model(&entities.PaymentOrder{}).Where("id = ?", orderID).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check payment_order ID %d: %w", orderID, err)
+			}
+			if count == 0 {
+				return fmt.Errorf("payment_order ID %d not found", orderID)
+			}
+			return nil
 		}
 		if result.RowsAffected != 1 {
 			return fmt.Errorf("unexpected number of rows affected updating payment_order ID %d: %d", orderID, result.RowsAffected)
 		}
+		if err := createOrderAudits(tx, newStatusAudits(ctx, previous, status)); err != nil {
+			return err
+		}
 
 		// Step 2: Get wallet ID
-		var walletID uint64
-		err := tx.Model(&entities.PaymentOrder{}).
+		var walletID *uint64
+		err = tx.Model(&entities.PaymentOrder{}).
 			Select("wallet_id").
 			Where("id = ?", orderID).
 			Scan(&walletID).Error
 		if err != nil {
 			return fmt.Errorf("failed to get wallet_id for order ID %d: %w", orderID, err)
 		}
+		if walletID == nil {
+			// Router orders have no wallet to release
+			updated = true
+			return nil
+		}
 
 		// Step 3: Release wallet
 		resultWallet := tx.Model(&entities.PaymentWallet{}).
@@ -173,16 +237,21 @@ func (r *paymentOrderRepository) UpdateOrderToSuccessAndReleaseWallet(
 			return fmt.Errorf("failed to release wallet: %w", resultWallet.Error)
 		}
 		if resultWallet.RowsAffected != 1 {
-			return fmt.Errorf("unexpected number of rows affected releasing wallet ID %d: %d", walletID, resultWallet.RowsAffected)
+			return fmt.Errorf("unexpected number of rows affected releasing wallet ID %d: %d", *walletID, resultWallet.RowsAffected)
 		}
 
+		updated = true
 		return nil
 	})
+	if err != nil {
+		return false, err
+	}
+	return updated, nil
 }
 
-// UpdateOrderNetwork updates the network and block height of a payment order by its request ID.
+// UpdateOrderNetwork updates the network and block height of the payment order of the vendor by its request ID.
 func (r *paymentOrderRepository) UpdateOrderNetwork(
-	ctx context.Context, requestID, network string, blockHeight uint64,
+	ctx context.Context, vendorID, requestID, network string, blockHeight uint64,
 ) error {
 	// Prepare the update map
 	updates := map[string]any{
@@ -190,26 +259,37 @@ func (r *paymentOrderRepository) UpdateOrderNetwork(
 		"block_height": blockHeight,
 	}
 
-	// Execute the update
-	result := r.db.WithContext(ctx).
-		Model(&entities.PaymentOrder{}).
-		Where("request_id = ?", requestID).
-		Updates(updates)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous entities.PaymentOrder
+		if err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Select("id", "status", "transferred", "network", "symbol").
+			Where("vendor_id = ? AND request_id = ?", vendorID, requestID).
+			Limit(1).
+			Find(&previous).Error; err != nil {
+			return fmt.Errorf("failed to lock payment order: %w", err)
+		}
 
-	// Handle errors from the update query
-	if result.Error != nil {
-		return fmt.Errorf("failed to update order network and block height: %w", result.Error)
-	}
+		// Execute the update
+		result := tx.Model(&entities.PaymentOrder{}).
+			Where("vendor_id = ? AND request_id = ?", vendorID, requestID).
+			Updates(updates)
 
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("no order found with the provided ID")
-	}
+		// Handle errors from the update query
+		if result.Error != nil {
+			return fmt.Errorf("failed to update order network and block height: %w", result.Error)
+		}
 
-	return nil
+		// Check if any rows were affected
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("no order found with the provided ID")
+		}
+
+		return createOrderAudits(tx, []entities.PaymentOrderAudit{newMoveAudit(ctx, previous, network, previous.Symbol)})
+	})
 }
 
 // BatchUpdateOrdersToExpired updates the status of multiple payment orders to "Expired" by their OrderIDs.
+// Orders whose expiry was extended in the meantime are left untouched.
 func (r *paymentOrderRepository) BatchUpdateOrdersToExpired(ctx context.Context, orderIDs []uint64) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Explicit row locking
@@ -227,13 +307,32 @@ func (r *paymentOrderRepository) BatchUpdateOrdersToExpired(ctx context.Context,
 		// Perform the update
 		result := tx.Model(&entities.PaymentOrder{}).
 			Where("id IN ?", orderIDs).
+			Where("expired_time <= ?", time.Now().UTC()).
+			Where("status <> ?", constants.OnHold). // Orders on hold expire once reviewed
+			Where("status NOT IN ?", []string{constants.Success, constants.Overpaid}).
 			Update("status", constants.Expired)
 
 		if result.Error != nil {
 			return fmt.Errorf("failed to update orders: %w", result.Error)
 		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
 
-		return nil
+		// Record the orders moved to EXPIRED by the update
+		var expiredIDs []uint64
+		if err := tx.Model(&entities.PaymentOrder{}).
+			Where("id IN ? AND status = ?", orderIDs, constants.Expired).
+			Pluck("id", &expiredIDs).Error; err != nil {
+			return fmt.Errorf("failed to fetch expired orders: %w", err)
+		}
+		var expiredOrders []entities.PaymentOrder
+		for _, order := range orders {
+			if order.Status != constants.Expired && slices.Contains(expiredIDs, order.ID) {
+				expiredOrders = append(expiredOrders, order)
+			}
+		}
+		return createOrderAudits(tx, newStatusAudits(ctx, expiredOrders, constants.Expired))
 	})
 }
 
@@ -268,6 +367,29 @@ func (r *paymentOrderRepository) BatchUpdateOrderBlockHeights(ctx context.Contex
 	return nil
 }
 
+// UpdateOptionBlockHeight updates the block height the option of a multi-chain order is listened from.
+func (r *paymentOrderRepository) UpdateOptionBlockHeight(
+	ctx context.Context, orderID uint64, network, symbol string, blockHeight uint64,
+) error {
+	if err := r.db.WithContext(ctx).
+		Model(&entities.PaymentOrderOption{}).
+		Where("payment_order_id = ? AND network = ? AND symbol = ?", orderID, network, symbol).
+		Update("block_height", blockHeight).Error; err != nil {
+		return fmt.Errorf("failed to update block height of order ID %d on %s: %w", orderID, network, err)
+	}
+	return nil
+}
+
+// optionOrderIDs returns the subquery of the multi-chain orders with an option on the network.
+func (r *paymentOrderRepository) optionOrderIDs(network string) *gorm.DB {
+	return r.db.Model(&entities.PaymentOrderOption{}).Select("payment_order_id").Where("network = ?", network)
+}
+
+// orderedOptions preloads the options of multi-chain orders in the order they were given, the primary option first.
+func orderedOptions(db *gorm.DB) *gorm.DB {
+	return db.Order("payment_order_option.id ASC")
+}
+
 // GetExpiredPaymentOrders retrieves orders for a specific network that are expired within a day.
 func (r *paymentOrderRepository) GetExpiredPaymentOrders(ctx context.Context, network string) ([]entities.PaymentOrder, error) {
 	var orders []entities.PaymentOrder
@@ -282,8 +404,9 @@ func (r *paymentOrderRepository) GetExpiredPaymentOrders(ctx context.Context, ne
 	if err := r.db.WithContext(ctx).
 		Joins("JOIN payment_wallet ON payment_wallet.id = payment_order.wallet_id"). // Join PaymentWallet with PaymentOrder.
 		Preload("Wallet").                                                           // Preload the associated Wallet.
-		Where("payment_order.network = ? AND payment_order.status NOT IN (?) AND payment_order.expired_time <= ? AND payment_order.expired_time > ?",
-			network, []string{constants.Success, constants.Failed}, now, cutoffTime).
+		Preload("Options", orderedOptions).                                          // Preload the options of multi-chain orders.
+		Where("(payment_order.network = ? OR payment_order.id IN (?)) AND payment_order.status NOT IN (?) AND payment_order.expired_time <= ? AND payment_order.expired_time > ?",
+			network, r.optionOrderIDs(network), []string{constants.Success, constants.Overpaid, constants.Failed, constants.Cancelled}, now, cutoffTime).
 		Order("payment_order.block_height ASC").
 		Find(&orders).Error; err != nil {
 		return nil, fmt.Errorf("failed to retrieve expired orders: %w", err)
@@ -292,6 +415,103 @@ func (r *paymentOrderRepository) GetExpiredPaymentOrders(ctx context.Context, ne
 	return orders, nil
 }
 
+// GetPaymentOrdersOpenBetween retrieves the orders of a network that could receive payments between startTime and endTime,
+// that is orders created before endTime whose expiry plus the order cutoff time is after startTime.
+func (r *paymentOrderRepository) GetPaymentOrdersOpenBetween(
+	ctx context.Context,
+	network string,
+	startTime, endTime time.Time,
+) ([]entities.PaymentOrder, error) {
+	var orders []entities.PaymentOrder
+
+	orderCutoffTime := conf.GetOrderCutoffTime()
+
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN payment_wallet ON payment_wallet.id = payment_order.wallet_id"). // Join PaymentWallet with PaymentOrder.
+		Preload("Wallet").                                                           // Preload the associated Wallet.
+		Preload("Options", orderedOptions).                                          // Preload the options of multi-chain orders.
+		Where("(payment_order.network = ? OR payment_order.id IN (?)) AND payment_order.created_at <= ? AND payment_order.expired_time > ?",
+			network, r.optionOrderIDs(network), endTime.UTC(), startTime.UTC().Add(-orderCutoffTime)).
+		Order("payment_order.created_at ASC").
+		Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve payment orders open between %s and %s: %w", startTime, endTime, err)
+	}
+
+	return orders, nil
+}
+
+// ExtendOrderExpiry sets the expiry of a PENDING or PARTIAL order. It returns false when the order is in another status.
+func (r *paymentOrderRepository) ExtendOrderExpiry(ctx context.Context, orderID uint64, expiredTime time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.PaymentOrder{}).
+		Where("id = ? AND status IN ?", orderID, []string{constants.Pending, constants.Partial}).
+		Update("expired_time", expiredTime.UTC())
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to extend order expiry: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CancelOrderAndReleaseWallet moves a PENDING order to CANCELLED and releases its wallet, which cannot be claimed
+// again before walletCooldownUntil. It returns false when the order is no longer PENDING.
+func (r *paymentOrderRepository) CancelOrderAndReleaseWallet(
+	ctx context.Context,
+	orderID uint64,
+	walletCooldownUntil time.Time,
+) (bool, error) {
+	cancelled := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		previous, err := lockOrderStates(tx, []uint64{orderID})
+		if err != nil {
+			return err
+		}
+
+		// Step 1: Cancel the order only if it is still pending
+		result := tx.Model(&entities.PaymentOrder{}).
+			Where("id = ? AND status = ?", orderID, constants.Pending).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Update("status", constants.Cancelled)
+		if result.Error != nil {
+			return fmt.Errorf("failed to set order status CANCELLED: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		cancelled = true
+		if err := createOrderAudits(tx, newStatusAudits(ctx, previous, constants.Cancelled)); err != nil {
+			return err
+		}
+
+		// Step 2: Get wallet ID
+		var walletID *uint64
+		if err := tx.Model(&entities.PaymentOrder{}).
+			Select("wallet_id").
+			Where("id = ?", orderID).
+			Scan(&walletID).Error; err != nil {
+			return fmt.Errorf("failed to get wallet_id for order ID %d: %w", orderID, err)
+		}
+		if walletID == nil {
+			// Router orders have no wallet to release
+			return nil
+		}
+
+		// Step 3: Release the wallet with a cooldown
+		if err := tx.Model(&entities.PaymentWallet{}).
+			Where("id = ?", *walletID).
+			Updates(map[string]any{
+				"in_use":         false,
+				"cooldown_until": walletCooldownUntil.UTC(),
+			}).Error; err != nil {
+			return fmt.Errorf("failed to release wallet ID %d: %w", *walletID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return cancelled, nil
+}
+
 // UpdateExpiredOrdersToFailed updates all expired orders to "Failed" and sets their associated wallets' "in_use" status to false.
 // It returns the IDs of the updated orders.
 func (r *paymentOrderRepository) UpdateExpiredOrdersToFailed(ctx context.Context) ([]uint64, error) {
@@ -304,22 +524,29 @@ func (r *paymentOrderRepository) UpdateExpiredOrdersToFailed(ctx context.Context
 		offset := 0
 
 		for {
-			var orderIDs []uint64
+			var orders []entities.PaymentOrder
 
 			// Step 1: Select a batch of expired orders with row-level locks
 			if err := tx.Model(&entities.PaymentOrder{}).
 				Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
-				Where("status NOT IN (?)", []string{constants.Success, constants.Failed, constants.Processing}).
+				Select("id", "status", "transferred").
+				Where("status NOT IN (?)", []string{
+					constants.Success, constants.Overpaid, constants.Failed, constants.Processing, constants.Cancelled, constants.OnHold,
+				}).
 				Where("expired_time <= ?", cutoffTime).
 				Limit(constants.BatchSize).
 				Offset(offset).
-				Pluck("id", &orderIDs).Error; err != nil {
+				Find(&orders).Error; err != nil {
 				return fmt.Errorf("failed to fetch expired order IDs: %w", err)
 			}
 
-			if len(orderIDs) == 0 {
+			if len(orders) == 0 {
 				break // No more expired orders to process
 			}
+			orderIDs := make([]uint64, 0, len(orders))
+			for _, order := range orders {
+				orderIDs = append(orderIDs, order.ID)
+			}
 
 			// Step 2: Update their status to "Failed"
 			if err := tx.Model(&entities.PaymentOrder{}).
@@ -329,6 +556,9 @@ func (r *paymentOrderRepository) UpdateExpiredOrdersToFailed(ctx context.Context
 				}).Error; err != nil {
 				return fmt.Errorf("failed to update expired orders: %w", err)
 			}
+			if err := createOrderAudits(tx, newStatusAudits(ctx, orders, constants.Failed)); err != nil {
+				return err
+			}
 
 			// Step 3: Update associated wallets to "in_use = false"
 			if err := tx.Model(&entities.PaymentWallet{}).
@@ -365,19 +595,24 @@ func (r *paymentOrderRepository) UpdateActiveOrdersToExpired(ctx context.Context
 
 	// Use GORM transaction
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Step 1: Fetch the IDs of orders to be updated
+		// Step 1: Fetch the orders to be updated
+		var orders []entities.PaymentOrder
 		if err := tx.Model(&entities.PaymentOrder{}).
-			Select("id").
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Select("id", "status", "transferred").
 			Where("status IN (?)", []string{constants.Pending, constants.Partial}).
 			Where("expired_time > ? AND expired_time <= ?", cutoffTime, currentTime).
-			Scan(&updatedIDs).Error; err != nil {
+			Find(&orders).Error; err != nil {
 			return fmt.Errorf("failed to fetch IDs of active orders to be updated: %w", err)
 		}
 
-		if len(updatedIDs) == 0 {
+		if len(orders) == 0 {
 			// No orders to update, return early
 			return nil
 		}
+		for _, order := range orders {
+			updatedIDs = append(updatedIDs, order.ID)
+		}
 
 		// Step 2: Update the status of the fetched orders
 		if err := tx.Model(&entities.PaymentOrder{}).
@@ -386,7 +621,7 @@ func (r *paymentOrderRepository) UpdateActiveOrdersToExpired(ctx context.Context
 			return fmt.Errorf("failed to update active orders: %w", err)
 		}
 
-		return nil
+		return createOrderAudits(tx, newStatusAudits(ctx, orders, constants.Expired))
 	})
 	if err != nil {
 		return nil, err
@@ -418,6 +653,7 @@ func (r *paymentOrderRepository) GetPaymentOrders(
 	}
 
 	query := r.db.WithContext(ctx).
+		Preload("Options", orderedOptions).
 		Where("vendor_id = ?", vendorID). // Always filter by vendorID
 		Limit(limit).
 		Offset(offset).
@@ -468,6 +704,7 @@ func (r *paymentOrderRepository) GetPaymentOrderByID(ctx context.Context, id uin
 	// Execute query to find the payment order by ID with preloaded PaymentEventHistories
 	if err := r.db.WithContext(ctx).
 		Preload("Wallet").
+		Preload("Options", orderedOptions).
 		Preload("PaymentEventHistories").
 		First(&order, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -490,6 +727,7 @@ func (r *paymentOrderRepository) GetPaymentOrdersByIDs(ctx context.Context, ids
 	// Execute query to find the payment orders by IDs with preloaded PaymentEventHistories
 	if err := r.db.WithContext(ctx).
 		Preload("Wallet").
+		Preload("Options", orderedOptions).
 		Preload("PaymentEventHistories").
 		Where("id IN ?", ids).
 		Find(&orders).Error; err != nil {
@@ -499,17 +737,22 @@ func (r *paymentOrderRepository) GetPaymentOrdersByIDs(ctx context.Context, ids
 	return orders, nil
 }
 
-// GetPaymentOrderByRequestID retrieves a single payment order by its request ID.
-func (r *paymentOrderRepository) GetPaymentOrderByRequestID(ctx context.Context, requestID string) (*entities.PaymentOrder, error) {
+// GetPaymentOrderByRequestID retrieves the payment order of the vendor by its request ID,
+// request IDs are unique per vendor only.
+func (r *paymentOrderRepository) GetPaymentOrderByRequestID(
+	ctx context.Context,
+	vendorID, requestID string,
+) (*entities.PaymentOrder, error) {
 	var order entities.PaymentOrder
 
 	// Execute query to find the payment order by request ID with preloaded PaymentEventHistories
 	if err := r.db.WithContext(ctx).
 		Preload("Wallet").
+		Preload("Options", orderedOptions).
 		Preload("PaymentEventHistories").
-		First(&order, "request_id = ?", requestID).Error; err != nil {
+		First(&order, "vendor_id = ? AND request_id = ?", vendorID, requestID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("payment order with request ID %s not found: %w", requestID, err)
+			return nil, fmt.Errorf("payment order with request ID %s not found for vendor %s: %w", requestID, vendorID, err)
 		}
 		return nil, fmt.Errorf("failed to retrieve payment order: %w", err)
 	}
@@ -517,18 +760,18 @@ func (r *paymentOrderRepository) GetPaymentOrderByRequestID(ctx context.Context,
 	return &order, nil
 }
 
-// GetPaymentOrderIDByRequestID retrieves the ID of a payment order by its request ID.
-func (r *paymentOrderRepository) GetPaymentOrderIDByRequestID(ctx context.Context, requestID string) (uint64, error) {
+// GetPaymentOrderIDByRequestID retrieves the ID of the payment order of the vendor by its request ID.
+func (r *paymentOrderRepository) GetPaymentOrderIDByRequestID(ctx context.Context, vendorID, requestID string) (uint64, error) {
 	var orderID uint64
 
 	// Query to fetch only the ID
 	if err := r.db.WithContext(ctx).
 		Model(&entities.PaymentOrder{}).
 		Select("id").
-		Where("request_id = ?", requestID).
+		Where("vendor_id = ? AND request_id = ?", vendorID, requestID).
 		Scan(&orderID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return 0, fmt.Errorf("payment order with request ID %s not found: %w", requestID, err)
+			return 0, fmt.Errorf("payment order with request ID %s not found for vendor %s: %w", requestID, vendorID, err)
 		}
 		return 0, fmt.Errorf("failed to retrieve payment order ID: %w", err)
 	}
@@ -536,6 +779,24 @@ func (r *paymentOrderRepository) GetPaymentOrderIDByRequestID(ctx context.Contex
 	return orderID, nil
 }
 
+// GetExistingRequestIDs returns the given request IDs that are already used by a payment order of the vendor.
+func (r *paymentOrderRepository) GetExistingRequestIDs(ctx context.Context, vendorID string, requestIDs []string) ([]string, error) {
+	var existingRequestIDs []string
+
+	if len(requestIDs) == 0 {
+		return nil, nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Model(&entities.PaymentOrder{}).
+		Where("vendor_id = ? AND request_id IN ?", vendorID, requestIDs).
+		Pluck("request_id", &existingRequestIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve existing request IDs: %w", err)
+	}
+
+	return existingRequestIDs, nil
+}
+
 // ReleaseWalletsForSuccessfulOrders releases wallets that are still marked as in_use for successful orders.
 func (r *paymentOrderRepository) ReleaseWalletsForSuccessfulOrders(ctx context.Context) error {
 	return r.db.WithContext(ctx).Model(&entities.PaymentWallet{}).Where("in_use = true").
@@ -548,6 +809,7 @@ func (r *paymentOrderRepository) ReleaseWalletsForSuccessfulOrders(ctx context.C
 					constants.Pending,
 					constants.Partial,
 					constants.Expired,
+					constants.OnHold, // Held funds stay in the wallet until reviewed
 				}),
 		).
 		Update("in_use", false).Error
@@ -561,8 +823,9 @@ func (r *paymentOrderRepository) GetProcessingOrdersExpired(ctx context.Context,
 	expiredTime := time.Now().UTC().Add(-orderExpiredTime)
 
 	err := r.db.WithContext(ctx).
-		Joins("JOIN payment_wallet ON payment_wallet.id = payment_order.wallet_id").
+		Joins("LEFT JOIN payment_wallet ON payment_wallet.id = payment_order.wallet_id"). // Router orders have no wallet.
 		Preload("Wallet").
+		Preload("Options", orderedOptions).
 		Preload("PaymentEventHistories").
 		Where("payment_order.network = ? AND payment_order.status = ? AND payment_order.expired_time <= ?",
 			network, constants.Processing, expiredTime).
@@ -574,24 +837,43 @@ func (r *paymentOrderRepository) GetProcessingOrdersExpired(ctx context.Context,
 	return orders, nil
 }
 
+// UpdateOrderFieldsByRequestIDAndStatus updates the payment order of the vendor by its request ID
+// while it still has the status.
 func (r *paymentOrderRepository) UpdateOrderFieldsByRequestIDAndStatus(
 	ctx context.Context,
-	requestID string,
+	vendorID, requestID string,
 	status string,
 	updates map[string]any,
 ) error {
-	result := r.db.WithContext(ctx).
-		Model(&entities.PaymentOrder{}).
-		Where("request_id = ? AND status = ?", requestID, status).
-		Updates(updates)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous entities.PaymentOrder
+		if err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Select("id", "status", "transferred", "network", "symbol").
+			Where("vendor_id = ? AND request_id = ? AND status = ?", vendorID, requestID, status).
+			Limit(1).
+			Find(&previous).Error; err != nil {
+			return fmt.Errorf("failed to lock payment order: %w", err)
+		}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to update: %w", result.Error)
-	}
+		result := tx.Model(&entities.PaymentOrder{}).
+			Where("vendor_id = ? AND request_id = ? AND status = ?", vendorID, requestID, status).
+			Updates(updates)
 
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("%w: request ID %s and status %s", repotypes.ErrPaymentOrderNotFound, requestID, status)
-	}
+		if result.Error != nil {
+			return fmt.Errorf("failed to update: %w", result.Error)
+		}
 
-	return nil
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: request ID %s and status %s", repotypes.ErrPaymentOrderNotFound, requestID, status)
+		}
+
+		network, symbol := previous.Network, previous.Symbol
+		if value, ok := updates["network"].(string); ok {
+			network = value
+		}
+		if value, ok := updates["symbol"].(string); ok {
+			symbol = value
+		}
+		return createOrderAudits(tx, []entities.PaymentOrderAudit{newMoveAudit(ctx, previous, network, symbol)})
+	})
 }
@@ -34,51 +34,93 @@ func (r *paymentStatisticsRepository) IncrementStatistics(
 	symbol, vendorID string,
 ) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		updates := map[string]any{}
+		return r.IncrementStatisticsInTx(tx, granularity, periodStart, amount, transferred, symbol, vendorID)
+	})
+}
+
+// IncrementStatisticsInTx increments or initializes the statistics within the transaction of the caller.
+func (r *paymentStatisticsRepository) IncrementStatisticsInTx(
+	tx *gorm.DB,
+	granularity string,
+	periodStart time.Time,
+	amount, transferred *string,
+	symbol, vendorID string,
+) error {
+	updates := map[string]any{}
+	if amount != nil {
+		updates["total_orders"] = gorm.Expr("total_orders + 1")
+		updates["total_amount"] = gorm.Expr("total_amount::numeric + ?", *amount)
+	}
+	if transferred != nil {
+		updates["total_transferred"] = gorm.Expr("total_transferred::numeric + ?", *transferred)
+	}
+
+	// Attempt to update with row-level locking
+	result := tx.Model(&entities.PaymentStatistics{}).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where(paymentStatsWhereClause, granularity, periodStart.UTC(), vendorID, symbol).
+		Updates(updates)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update payment statistics: %w", result.Error)
+	}
+
+	// If no rows were updated, insert a new record
+	if result.RowsAffected == 0 {
+		newStatistic := entities.PaymentStatistics{
+			Granularity:      granularity,
+			PeriodStart:      periodStart.UTC(),
+			TotalOrders:      0,
+			TotalAmount:      "0",
+			TotalTransferred: "0",
+			Symbol:           symbol,
+			VendorID:         vendorID,
+		}
+
 		if amount != nil {
-			updates["total_orders"] = gorm.Expr("total_orders + 1")
-			updates["total_amount"] = gorm.Expr("total_amount::numeric + ?", *amount)
+			newStatistic.TotalOrders = 1
+			newStatistic.TotalAmount = *amount
 		}
 		if transferred != nil {
-			updates["total_transferred"] = gorm.Expr("total_transferred::numeric + ?", *transferred)
+			newStatistic.TotalTransferred = *transferred
 		}
 
-		// Attempt to update with row-level locking
-		result := tx.Model(&entities.PaymentStatistics{}).
-			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
-			Where(paymentStatsWhereClause, granularity, periodStart.UTC(), vendorID, symbol).
-			Updates(updates)
-
-		if result.Error != nil {
-			return fmt.Errorf("failed to update payment statistics: %w", result.Error)
+		if err := tx.Create(&newStatistic).Error; err != nil {
+			return fmt.Errorf("failed to insert new payment statistics: %w", err)
 		}
+	}
+	return nil
+}
 
-		// If no rows were updated, insert a new record
-		if result.RowsAffected == 0 {
-			newStatistic := entities.PaymentStatistics{
-				Granularity:      granularity,
-				PeriodStart:      periodStart.UTC(),
-				TotalOrders:      0,
-				TotalAmount:      "0",
-				TotalTransferred: "0",
-				Symbol:           symbol,
-				VendorID:         vendorID,
-			}
-
-			if amount != nil {
-				newStatistic.TotalOrders = 1
-				newStatistic.TotalAmount = *amount
-			}
-			if transferred != nil {
-				newStatistic.TotalTransferred = *transferred
-			}
-
-			if err := tx.Create(&newStatistic).Error; err != nil {
-				return fmt.Errorf("failed to insert new payment statistics: %w", err)
-			}
-		}
-		return nil
-	})
+// IncrementNetworkFee adds the network fee, in USD, attributed to the orders of the vendor to the statistics of the period.
+func (r *paymentStatisticsRepository) IncrementNetworkFee(
+	ctx context.Context,
+	granularity string,
+	periodStart time.Time,
+	fee string,
+	symbol, vendorID string,
+) error {
+	statistic := entities.PaymentStatistics{
+		Granularity:      granularity,
+		PeriodStart:      periodStart.UTC(),
+		TotalAmount:      "0",
+		TotalTransferred: "0",
+		TotalNetworkFee:  fee,
+		Symbol:           symbol,
+		VendorID:         vendorID,
+	}
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "granularity"}, {Name: "period_start"}, {Name: "symbol"}, {Name: "vendor_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"total_network_fee": gorm.Expr("payment_statistics.total_network_fee + EXCLUDED.total_network_fee"),
+			}),
+		}).
+		Create(&statistic).Error
+	if err != nil {
+		return fmt.Errorf("failed to increment network fee statistics: %w", err)
+	}
+	return nil
 }
 
 func (r *paymentStatisticsRepository) RevertAndIncrementStatistics(
@@ -128,6 +170,22 @@ func (r *paymentStatisticsRepository) RevertAndIncrementStatistics(
 	})
 }
 
+// DecrementStatistics removes an order and its amount from the statistics of the period, e.g. when it is cancelled.
+func (r *paymentStatisticsRepository) DecrementStatistics(
+	ctx context.Context,
+	granularity string,
+	periodStart time.Time,
+	amount *string,
+	symbol, vendorID string,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := r.updateStatsInTx(tx, granularity, periodStart, vendorID, symbol, amount, -1); err != nil {
+			return fmt.Errorf("failed to decrement payment statistics: %w", err)
+		}
+		return nil
+	})
+}
+
 // Helper to apply +/- update to stats
 func (r *paymentStatisticsRepository) updateStatsInTx(
 	tx *gorm.DB,
@@ -200,6 +258,7 @@ func (r *paymentStatisticsRepository) GetStatisticsByTimeRangeAndGranularity(
 			"SUM(total_orders) AS total_orders",
 			"SUM(total_amount::numeric) AS total_amount",
 			"SUM(total_transferred::numeric) AS total_transferred",
+			"SUM(total_network_fee::numeric) AS total_network_fee",
 			"symbol",
 			"vendor_id",
 		}).
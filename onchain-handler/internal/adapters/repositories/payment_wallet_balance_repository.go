@@ -31,66 +31,77 @@ func (r *paymentWalletBalanceRepository) AddPaymentWalletBalance(
 	symbol string,
 ) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Lock the existing balance row, default balance to "0"
-		existingBalance := "0"
-		err := tx.Raw(`
-			SELECT COALESCE(balance, '0') 
-			FROM payment_wallet_balance 
-			WHERE wallet_id = ? AND network = ? AND symbol = ? 
-			FOR UPDATE
-		`, walletID, network, symbol).Scan(&existingBalance).Error
+		return r.AddPaymentWalletBalanceInTx(tx, walletID, amountToAdd, network, symbol)
+	})
+}
 
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("failed to lock balance row: %w", err)
-		}
+// AddPaymentWalletBalanceInTx adds the amount to the balance of the wallet within the transaction of the caller.
+func (r *paymentWalletBalanceRepository) AddPaymentWalletBalanceInTx(
+	tx *gorm.DB,
+	walletID uint64,
+	amountToAdd string,
+	network string,
+	symbol string,
+) error {
+	// Lock the existing balance row, default balance to "0"
+	existingBalance := "0"
+	err := tx.Raw(`
+		SELECT COALESCE(balance, '0') 
+		FROM payment_wallet_balance 
+		WHERE wallet_id = ? AND network = ? AND symbol = ? 
+		FOR UPDATE
+	`, walletID, network, symbol).Scan(&existingBalance).Error
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to lock balance row: %w", err)
+	}
 
-		// Safely initialize big.Float values
-		existingBalanceFloat := new(big.Float)
-		if existingBalance != "" {
-			if _, ok := existingBalanceFloat.SetString(existingBalance); !ok {
-				return fmt.Errorf("invalid existing balance format: %s", existingBalance)
-			}
+	// Safely initialize big.Float values
+	existingBalanceFloat := new(big.Float)
+	if existingBalance != "" {
+		if _, ok := existingBalanceFloat.SetString(existingBalance); !ok {
+			return fmt.Errorf("invalid existing balance format: %s", existingBalance)
 		}
+	}
 
-		newBalanceFloat := new(big.Float)
-		if amountToAdd != "" {
-			if _, ok := newBalanceFloat.SetString(amountToAdd); !ok {
-				return fmt.Errorf("invalid amountToAdd format: %s", amountToAdd)
-			}
+	newBalanceFloat := new(big.Float)
+	if amountToAdd != "" {
+		if _, ok := newBalanceFloat.SetString(amountToAdd); !ok {
+			return fmt.Errorf("invalid amountToAdd format: %s", amountToAdd)
 		}
+	}
 
-		// Calculate updated balance
-		updatedBalance := new(big.Float).Add(existingBalanceFloat, newBalanceFloat)
+	// Calculate updated balance
+	updatedBalance := new(big.Float).Add(existingBalanceFloat, newBalanceFloat)
+
+	// Check if the balance row exists
+	var count int64
+	err = tx.Model(&entities.PaymentWalletBalance{}).
+		Where("wallet_id = ? AND network = ? AND symbol = ?", walletID, network, symbol).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to check existing balance: %w", err)
+	}
 
-		// Check if the balance row exists
-		var count int64
+	// If record exists, update it; otherwise, insert a new one
+	if count > 0 {
 		err = tx.Model(&entities.PaymentWalletBalance{}).
 			Where("wallet_id = ? AND network = ? AND symbol = ?", walletID, network, symbol).
-			Count(&count).Error
-		if err != nil {
-			return fmt.Errorf("failed to check existing balance: %w", err)
-		}
-
-		// If record exists, update it; otherwise, insert a new one
-		if count > 0 {
-			err = tx.Model(&entities.PaymentWalletBalance{}).
-				Where("wallet_id = ? AND network = ? AND symbol = ?", walletID, network, symbol).
-				Update("balance", updatedBalance.String()).Error
-		} else {
-			err = tx.Create(&entities.PaymentWalletBalance{
-				WalletID: walletID,
-				Network:  network,
-				Symbol:   symbol,
-				Balance:  updatedBalance.String(),
-			}).Error
-		}
+			Update("balance", updatedBalance.String()).Error
+	} else {
+		err = tx.Create(&entities.PaymentWalletBalance{
+			WalletID: walletID,
+			Network:  network,
+			Symbol:   symbol,
+			Balance:  updatedBalance.String(),
+		}).Error
+	}
 
-		if err != nil {
-			return fmt.Errorf("failed to update wallet balance: %w", err)
-		}
+	if err != nil {
+		return fmt.Errorf("failed to update wallet balance: %w", err)
+	}
 
-		return nil
-	})
+	return nil
 }
 
 func (r *paymentWalletBalanceRepository) SubtractPaymentWalletBalance(
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -14,6 +15,7 @@ import (
 	"github.com/genefriendway/onchain-handler/constants"
 	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
 	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	"github.com/genefriendway/onchain-handler/pkg/crypto"
 	"github.com/genefriendway/onchain-handler/pkg/payment"
 )
@@ -53,6 +55,7 @@ func (r *paymentWalletRepository) ClaimFirstAvailableWallet(tx *gorm.DB, ctx con
 	err := tx.WithContext(ctx).
 		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}). // Lock row to prevent race conditions
 		Where("in_use = ?", false).
+		Where("cooldown_until IS NULL OR cooldown_until <= ?", time.Now().UTC()).
 		Order("id").
 		First(&wallet).Error
 	if err != nil {
@@ -119,18 +122,65 @@ func (r *paymentWalletRepository) CreateNewWallet(tx *gorm.DB, inUse bool) (*ent
 		return nil, fmt.Errorf("failed to generate new wallet: %w", genErr)
 	}
 
-	// Step 3: Update the wallet record with the real address
-	if err := tx.Model(&placeholderWallet).Update("address", account.Address.Hex()).Error; err != nil {
+	// Step 3: Generate the account of the wallet on Tron, derived with its own coin type
+	tronAddress, genErr := generateTronAddress(placeholderWallet.ID)
+	if genErr != nil {
+		return nil, genErr
+	}
+
+	// Step 4: Update the wallet record with the real addresses
+	if err := tx.Model(&placeholderWallet).Updates(map[string]any{
+		"address":      account.Address.Hex(),
+		"tron_address": tronAddress,
+	}).Error; err != nil {
 		return nil, fmt.Errorf("failed to update wallet address: %w", err)
 	}
 
 	placeholderWallet.Address = account.Address.Hex()
+	placeholderWallet.TronAddress = tronAddress
 	return &placeholderWallet, nil
 }
 
+// AssignMissingTronAddresses derives the Tron address of the wallets created before Tron was supported
+func (r *paymentWalletRepository) AssignMissingTronAddresses(ctx context.Context) (int, error) {
+	var wallets []entities.PaymentWallet
+	if err := r.db.WithContext(ctx).Where("tron_address IS NULL").Order("id").Find(&wallets).Error; err != nil {
+		return 0, fmt.Errorf("failed to fetch wallets without a Tron address: %w", err)
+	}
+
+	for _, wallet := range wallets {
+		tronAddress, err := generateTronAddress(wallet.ID)
+		if err != nil {
+			return 0, err
+		}
+		if err := r.db.WithContext(ctx).Model(&wallet).Update("tron_address", tronAddress).Error; err != nil {
+			return 0, fmt.Errorf("failed to update Tron address of wallet %d: %w", wallet.ID, err)
+		}
+	}
+	return len(wallets), nil
+}
+
+func generateTronAddress(walletID uint64) (string, error) {
+	walletConfig := conf.GetWalletConfiguration()
+	account, _, err := crypto.GenerateAccountForCoinType(
+		chain.Tron.CoinType(),
+		walletConfig.Mnemonic,
+		walletConfig.Passphrase,
+		walletConfig.Salt,
+		constants.PaymentWallet,
+		walletID,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate Tron wallet: %w", err)
+	}
+	return chain.Tron.FormatAddress(account.Address), nil
+}
+
 func (r *paymentWalletRepository) GetPaymentWalletByAddress(ctx context.Context, address string) (*entities.PaymentWallet, error) {
 	var wallet entities.PaymentWallet
-	if err := r.db.WithContext(ctx).Where("address = ?", address).First(&wallet).Error; err != nil {
+	if err := r.db.WithContext(ctx).
+		Where("LOWER(address) = ? OR tron_address = ?", strings.ToLower(address), address).
+		First(&wallet).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -207,7 +257,7 @@ func (r *paymentWalletRepository) GetPaymentWalletWithBalancesByAddress(
 
 	// Apply required address filtering (should not be nil)
 	if address != nil {
-		query = query.Where("payment_wallet.address = ?", *address)
+		query = query.Where("payment_wallet.address = ? OR payment_wallet.tron_address = ?", *address, *address)
 	} else {
 		return entities.PaymentWallet{}, fmt.Errorf("address is required")
 	}
@@ -281,12 +331,37 @@ func (r *paymentWalletRepository) ReleaseWalletsByIDs(tx *gorm.DB, walletIDs []u
 	return nil
 }
 
+// UpdateWalletInUse locks or releases a wallet regardless of its orders, a released wallet can be claimed right away.
+// It returns gorm.ErrRecordNotFound when the wallet does not exist.
+func (r *paymentWalletRepository) UpdateWalletInUse(ctx context.Context, walletID uint64, inUse bool) (*entities.PaymentWallet, error) {
+	updates := map[string]any{"in_use": inUse}
+	if !inUse {
+		updates["cooldown_until"] = nil
+	}
+
+	var wallet entities.PaymentWallet
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.PaymentWallet{}).Where("id = ?", walletID).Updates(updates)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update wallet ID %d in_use status: %w", walletID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.First(&wallet, "id = ?", walletID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wallet, nil
+}
+
 func (r *paymentWalletRepository) GetWalletIDByAddress(ctx context.Context, address string) (uint64, error) {
 	var walletID uint64
 	err := r.db.WithContext(ctx).
 		Model(&entities.PaymentWallet{}).
 		Select("id").
-		Where("LOWER(address) = ?", strings.ToLower(address)).
+		Where("LOWER(address) = ? OR tron_address = ?", strings.ToLower(address), address).
 		Limit(1).
 		Scan(&walletID).
 		Error
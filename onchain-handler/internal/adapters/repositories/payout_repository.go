@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type payoutRepository struct {
+	db *gorm.DB
+}
+
+// NewPayoutRepository creates a new PayoutRepository
+func NewPayoutRepository(db *gorm.DB) repotypes.PayoutRepository {
+	return &payoutRepository{
+		db: db,
+	}
+}
+
+// CreatePayouts inserts the payouts, none of them is created when one fails
+func (r *payoutRepository) CreatePayouts(ctx context.Context, payouts []entities.Payout) error {
+	if err := r.db.WithContext(ctx).Create(&payouts).Error; err != nil {
+		return fmt.Errorf("failed to create payouts: %w", err)
+	}
+	return nil
+}
+
+// GetPayoutByID retrieves a payout by its ID
+func (r *payoutRepository) GetPayoutByID(ctx context.Context, id uint64) (*entities.Payout, error) {
+	var payout entities.Payout
+	if err := r.db.WithContext(ctx).First(&payout, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("payout %d not found: %w", id, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve payout: %w", err)
+	}
+	return &payout, nil
+}
+
+// GetPayoutByRequestID retrieves a payout of the vendor by its request ID
+func (r *payoutRepository) GetPayoutByRequestID(ctx context.Context, vendorID, requestID string) (*entities.Payout, error) {
+	var payout entities.Payout
+	if err := r.db.WithContext(ctx).First(&payout, "vendor_id = ? AND request_id = ?", vendorID, requestID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("payout %s not found: %w", requestID, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve payout: %w", err)
+	}
+	return &payout, nil
+}
+
+// GetPayouts retrieves the payouts with the status, or all of them when the status is empty
+func (r *payoutRepository) GetPayouts(ctx context.Context, status string) ([]entities.Payout, error) {
+	var payouts []entities.Payout
+	query := r.db.WithContext(ctx).Order("id")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Find(&payouts).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve payouts: %w", err)
+	}
+	return payouts, nil
+}
+
+// GetApprovedPayouts retrieves the oldest approved payouts of the network
+func (r *payoutRepository) GetApprovedPayouts(ctx context.Context, network string, limit int) ([]entities.Payout, error) {
+	var payouts []entities.Payout
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND network = ?", constants.OutboundApproved, network).
+		Order("approved_at").
+		Limit(limit).
+		Find(&payouts).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve approved payouts: %w", err)
+	}
+	return payouts, nil
+}
+
+// UpdatePayoutStatus applies the updates if the payout still has the current status,
+// it returns false when another update came first
+func (r *payoutRepository) UpdatePayoutStatus(
+	ctx context.Context,
+	id uint64,
+	currentStatus string,
+	updates map[string]any,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Payout{}).
+		Where("id = ? AND status = ?", id, currentStatus).
+		Updates(updates)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update payout %d: %w", id, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type relayerTransactionRepository struct {
+	db *gorm.DB
+}
+
+func NewRelayerTransactionRepository(db *gorm.DB) repotypes.RelayerTransactionRepository {
+	return &relayerTransactionRepository{
+		db: db,
+	}
+}
+
+func (r *relayerTransactionRepository) CreateRelayerTransaction(ctx context.Context, model entities.RelayerTransaction) error {
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return fmt.Errorf("failed to create relayer transaction: %w", err)
+	}
+	return nil
+}
+
+// GetRelayerFeesByVendorID sums the gas fees paid by the relayer wallet for the vendor, per network.
+func (r *relayerTransactionRepository) GetRelayerFeesByVendorID(ctx context.Context, vendorID string) ([]entities.RelayerFee, error) {
+	var fees []entities.RelayerFee
+	if err := r.db.WithContext(ctx).
+		Model(&entities.RelayerTransaction{}).
+		Select("network, COUNT(*) AS transaction_count, COALESCE(SUM(fee), 0)::TEXT AS total_fee").
+		Where("vendor_id = ?", vendorID).
+		Group("network").
+		Order("network ASC").
+		Scan(&fees).Error; err != nil {
+		return nil, fmt.Errorf("failed to get relayer fees for vendor %s: %w", vendorID, err)
+	}
+	return fees, nil
+}
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type subscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewSubscriptionRepository creates a new SubscriptionRepository
+func NewSubscriptionRepository(db *gorm.DB) repotypes.SubscriptionRepository {
+	return &subscriptionRepository{
+		db: db,
+	}
+}
+
+// CreateSubscriptionPlan inserts a new subscription plan
+func (r *subscriptionRepository) CreateSubscriptionPlan(ctx context.Context, plan *entities.SubscriptionPlan) error {
+	if err := r.db.WithContext(ctx).Create(plan).Error; err != nil {
+		return fmt.Errorf("failed to create subscription plan: %w", err)
+	}
+	return nil
+}
+
+// GetSubscriptionPlans retrieves the subscription plans of the vendor
+func (r *subscriptionRepository) GetSubscriptionPlans(ctx context.Context, vendorID string) ([]entities.SubscriptionPlan, error) {
+	var plans []entities.SubscriptionPlan
+	if err := r.db.WithContext(ctx).Where("vendor_id = ?", vendorID).Order("id").Find(&plans).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve subscription plans: %w", err)
+	}
+	return plans, nil
+}
+
+// GetSubscriptionPlanByID retrieves a subscription plan of the vendor
+func (r *subscriptionRepository) GetSubscriptionPlanByID(ctx context.Context, vendorID string, id uint64) (*entities.SubscriptionPlan, error) {
+	var plan entities.SubscriptionPlan
+	if err := r.db.WithContext(ctx).First(&plan, "vendor_id = ? AND id = ?", vendorID, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("subscription plan %d not found: %w", id, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve subscription plan: %w", err)
+	}
+	return &plan, nil
+}
+
+// CreateSubscription inserts a new subscription
+func (r *subscriptionRepository) CreateSubscription(ctx context.Context, subscription *entities.Subscription) error {
+	if err := r.db.WithContext(ctx).Omit("Plan").Create(subscription).Error; err != nil {
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+	return nil
+}
+
+// GetSubscriptionByID retrieves a subscription of the vendor with its plan
+func (r *subscriptionRepository) GetSubscriptionByID(ctx context.Context, vendorID string, id uint64) (*entities.Subscription, error) {
+	var subscription entities.Subscription
+	if err := r.db.WithContext(ctx).
+		Preload("Plan").
+		First(&subscription, "vendor_id = ? AND id = ?", vendorID, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("subscription %d not found: %w", id, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve subscription: %w", err)
+	}
+	return &subscription, nil
+}
+
+// GetDueSubscriptions retrieves the ACTIVE subscriptions whose next cycle is due and not billed yet
+func (r *subscriptionRepository) GetDueSubscriptions(ctx context.Context, now time.Time) ([]entities.Subscription, error) {
+	var subscriptions []entities.Subscription
+	if err := r.db.WithContext(ctx).
+		Preload("Plan").
+		Where("status = ? AND current_order_id IS NULL AND next_billing_at <= ?", constants.SubscriptionActive, now).
+		Order("next_billing_at").
+		Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve due subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// GetBillingSubscriptions retrieves the subscriptions waiting for the payment of the order of their cycle
+func (r *subscriptionRepository) GetBillingSubscriptions(ctx context.Context) ([]entities.Subscription, error) {
+	var subscriptions []entities.Subscription
+	if err := r.db.WithContext(ctx).
+		Preload("Plan").
+		Where("status IN ? AND current_order_id IS NOT NULL", []string{constants.SubscriptionActive, constants.SubscriptionPastDue}).
+		Order("id").
+		Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve billing subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscription applies the updates if the subscription is not cancelled and still bills the given order
+// (nil for none), it returns false when another update came first
+func (r *subscriptionRepository) UpdateSubscription(
+	ctx context.Context,
+	id uint64,
+	currentOrderID *uint64,
+	updates map[string]any,
+) (bool, error) {
+	query := r.db.WithContext(ctx).
+		Model(&entities.Subscription{}).
+		Where("id = ? AND status <> ?", id, constants.SubscriptionCancelled)
+	if currentOrderID == nil {
+		query = query.Where("current_order_id IS NULL")
+	} else {
+		query = query.Where("current_order_id = ?", *currentOrderID)
+	}
+
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update subscription %d: %w", id, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CancelSubscription cancels the subscription, it returns false when it is already cancelled
+func (r *subscriptionRepository) CancelSubscription(ctx context.Context, id uint64, cancelledAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Subscription{}).
+		Where("id = ? AND status <> ?", id, constants.SubscriptionCancelled).
+		Updates(map[string]any{
+			"status":       constants.SubscriptionCancelled,
+			"cancelled_at": cancelledAt,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to cancel subscription %d: %w", id, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
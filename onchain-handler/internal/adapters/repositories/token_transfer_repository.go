@@ -118,3 +118,40 @@ func (r *tokenTransferRepository) GetTotalTokenAmount(
 
 	return totalTokenAmount, nil
 }
+
+// GetOutboundTokenAmount sums the token amount of the successful payouts and withdrawals of the network and symbol since the time
+func (r *tokenTransferRepository) GetOutboundTokenAmount(
+	ctx context.Context,
+	network, symbol string,
+	since time.Time,
+) (string, error) {
+	var total string
+	if err := r.db.WithContext(ctx).
+		Table("onchain_token_transfer").
+		Where("network = ? AND symbol = ? AND status = ?", network, symbol, true).
+		Where("type IN ?", []string{constants.Transfer, constants.Withdraw}).
+		Where("created_at >= ?", since).
+		Select("COALESCE(SUM(token_amount), 0)::TEXT").
+		Scan(&total).Error; err != nil {
+		return "", fmt.Errorf("failed to calculate outbound token amount: %w", err)
+	}
+	return total, nil
+}
+
+// GetLastTransferTime returns when the last successful transfer of the type, network and symbol was sent
+// from the address, other than the excluded transaction. It returns nil when there is none.
+func (r *tokenTransferRepository) GetLastTransferTime(
+	ctx context.Context,
+	network, symbol, transferType, fromAddress, excludedTransactionHash string,
+) (*time.Time, error) {
+	var lastTransferTime *time.Time
+	if err := r.db.WithContext(ctx).
+		Table("onchain_token_transfer").
+		Where("network = ? AND symbol = ? AND type = ? AND status = ?", network, symbol, transferType, true).
+		Where("LOWER(from_address) = LOWER(?) AND transaction_hash <> ?", fromAddress, excludedTransactionHash).
+		Select("MAX(created_at)").
+		Scan(&lastTransferTime).Error; err != nil {
+		return nil, fmt.Errorf("failed to get last transfer time: %w", err)
+	}
+	return lastTransferTime, nil
+}
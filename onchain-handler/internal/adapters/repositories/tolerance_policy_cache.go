@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+
+	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+var keyPrefixTolerancePolicy = "tolerance_policy_"
+
+type tolerancePolicyCache struct {
+	tolerancePolicyRepository repotypes.TolerancePolicyRepository
+	cache                     cachetypes.CacheRepository
+}
+
+func NewTolerancePolicyCacheRepository(
+	repo repotypes.TolerancePolicyRepository,
+	cache cachetypes.CacheRepository,
+) repotypes.TolerancePolicyRepository {
+	return &tolerancePolicyCache{
+		tolerancePolicyRepository: repo,
+		cache:                     cache,
+	}
+}
+
+func (c *tolerancePolicyCache) GetTolerancePolicies(ctx context.Context) ([]entities.TolerancePolicy, error) {
+	key := &cachetypes.Keyer{Raw: keyPrefixTolerancePolicy + "GetTolerancePolicies"}
+	var policies []entities.TolerancePolicy
+
+	// Try to retrieve data from cache
+	err := c.cache.RetrieveItem(key, &policies)
+	if err == nil {
+		// Cache hit
+		return policies, nil
+	}
+
+	// Cache miss, fetch from repository
+	policies, err = c.tolerancePolicyRepository.GetTolerancePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to cache with no expiration, it is invalidated on every change
+	if cacheErr := c.cache.SaveItem(key, policies, -1); cacheErr != nil {
+		logger.GetLogger().Warnf("Failed to save tolerance policies to cache: %v", cacheErr)
+	}
+
+	return policies, nil
+}
+
+func (c *tolerancePolicyCache) UpsertTolerancePolicy(ctx context.Context, policy *entities.TolerancePolicy) error {
+	if err := c.tolerancePolicyRepository.UpsertTolerancePolicy(ctx, policy); err != nil {
+		return err
+	}
+	c.invalidate()
+	return nil
+}
+
+func (c *tolerancePolicyCache) DeleteTolerancePolicy(ctx context.Context, vendorID, symbol string) (bool, error) {
+	deleted, err := c.tolerancePolicyRepository.DeleteTolerancePolicy(ctx, vendorID, symbol)
+	if err != nil {
+		return false, err
+	}
+	if deleted {
+		c.invalidate()
+	}
+	return deleted, nil
+}
+
+// invalidate removes the cached policies so the next read loads them from the database
+func (c *tolerancePolicyCache) invalidate() {
+	key := &cachetypes.Keyer{Raw: keyPrefixTolerancePolicy + "GetTolerancePolicies"}
+	if err := c.cache.RemoveItem(key); err != nil {
+		logger.GetLogger().Warnf("Failed to remove tolerance policies from cache: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type tolerancePolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewTolerancePolicyRepository creates a new TolerancePolicyRepository
+func NewTolerancePolicyRepository(db *gorm.DB) repotypes.TolerancePolicyRepository {
+	return &tolerancePolicyRepository{
+		db: db,
+	}
+}
+
+// GetTolerancePolicies retrieves all tolerance policies
+func (r *tolerancePolicyRepository) GetTolerancePolicies(ctx context.Context) ([]entities.TolerancePolicy, error) {
+	var policies []entities.TolerancePolicy
+	if err := r.db.WithContext(ctx).Order("vendor_id, symbol").Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch tolerance policies: %w", err)
+	}
+	return policies, nil
+}
+
+// UpsertTolerancePolicy creates the policy of its vendor and symbol or replaces the limits of the existing one
+func (r *tolerancePolicyRepository) UpsertTolerancePolicy(ctx context.Context, policy *entities.TolerancePolicy) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "vendor_id"}, {Name: "symbol"}},
+			DoUpdates: clause.AssignmentColumns([]string{"under_absolute", "under_percentage", "over_absolute", "over_percentage"}),
+		}).
+		Create(policy).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert tolerance policy: %w", err)
+	}
+	return nil
+}
+
+// DeleteTolerancePolicy deletes the policy of the vendor and symbol, it returns false when there is none
+func (r *tolerancePolicyRepository) DeleteTolerancePolicy(ctx context.Context, vendorID, symbol string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("vendor_id = ? AND symbol = ?", vendorID, symbol).
+		Delete(&entities.TolerancePolicy{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete tolerance policy: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type transferPolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewTransferPolicyRepository creates a new TransferPolicyRepository
+func NewTransferPolicyRepository(db *gorm.DB) repotypes.TransferPolicyRepository {
+	return &transferPolicyRepository{
+		db: db,
+	}
+}
+
+// GetTransferPolicies retrieves all transfer policies
+func (r *transferPolicyRepository) GetTransferPolicies(ctx context.Context) ([]entities.TransferPolicy, error) {
+	var policies []entities.TransferPolicy
+	if err := r.db.WithContext(ctx).Order("network, symbol").Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch transfer policies: %w", err)
+	}
+	return policies, nil
+}
+
+// UpsertTransferPolicy creates the policy of its network and symbol or replaces the rules of the existing one
+func (r *transferPolicyRepository) UpsertTransferPolicy(ctx context.Context, policy *entities.TransferPolicy) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "network"}, {Name: "symbol"}},
+			DoUpdates: clause.AssignmentColumns(
+				[]string{"approval_threshold", "required_approvals", "daily_limit", "allow_list_only"},
+			),
+		}).
+		Create(policy).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert transfer policy: %w", err)
+	}
+	return nil
+}
+
+// DeleteTransferPolicy deletes the policy of the network and symbol, it returns false when there is none
+func (r *transferPolicyRepository) DeleteTransferPolicy(ctx context.Context, network, symbol string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("network = ? AND symbol = ?", network, symbol).
+		Delete(&entities.TransferPolicy{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete transfer policy: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetAllowedAddresses retrieves the allow-list of every network
+func (r *transferPolicyRepository) GetAllowedAddresses(ctx context.Context) ([]entities.TransferAllowedAddress, error) {
+	var addresses []entities.TransferAllowedAddress
+	if err := r.db.WithContext(ctx).Order("network, address").Find(&addresses).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch allowed addresses: %w", err)
+	}
+	return addresses, nil
+}
+
+// IsAddressAllowed checks whether the address is on the allow-list of the network
+func (r *transferPolicyRepository) IsAddressAllowed(ctx context.Context, network, address string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&entities.TransferAllowedAddress{}).
+		Where("network = ? AND address = ?", network, address).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check allowed address: %w", err)
+	}
+	return count > 0, nil
+}
+
+// UpsertAllowedAddress adds the address to the allow-list of the network or replaces its label
+func (r *transferPolicyRepository) UpsertAllowedAddress(ctx context.Context, allowedAddress *entities.TransferAllowedAddress) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "network"}, {Name: "address"}},
+			DoUpdates: clause.AssignmentColumns([]string{"label"}),
+		}).
+		Create(allowedAddress).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert allowed address: %w", err)
+	}
+	return nil
+}
+
+// DeleteAllowedAddress removes the address from the allow-list of the network, it returns false when it is not on it
+func (r *transferPolicyRepository) DeleteAllowedAddress(ctx context.Context, network, address string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("network = ? AND address = ?", network, address).
+		Delete(&entities.TransferAllowedAddress{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete allowed address: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateTransferDecisions appends the decisions to the audit
+func (r *transferPolicyRepository) CreateTransferDecisions(ctx context.Context, decisions []entities.TransferDecision) error {
+	if len(decisions) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(&decisions).Error; err != nil {
+		return fmt.Errorf("failed to create transfer decisions: %w", err)
+	}
+	return nil
+}
+
+// GetTransferDecisions retrieves the audited decisions of a transfer kind, or of one transfer when its ID is given
+func (r *transferPolicyRepository) GetTransferDecisions(
+	ctx context.Context,
+	transferKind string,
+	transferID *uint64,
+) ([]entities.TransferDecision, error) {
+	var decisions []entities.TransferDecision
+	query := r.db.WithContext(ctx).Where("transfer_kind = ?", transferKind)
+	if transferID != nil {
+		query = query.Where("transfer_id = ?", *transferID)
+	}
+	if err := query.Order("id").Find(&decisions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch transfer decisions: %w", err)
+	}
+	return decisions, nil
+}
+
+// CountApprovals counts the distinct approvers of a transfer
+func (r *transferPolicyRepository) CountApprovals(ctx context.Context, transferKind string, transferID uint64) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&entities.TransferDecision{}).
+		Where("transfer_kind = ? AND transfer_id = ? AND decision = ?", transferKind, transferID, constants.TransferDecisionApproved).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count approvals: %w", err)
+	}
+	return count, nil
+}
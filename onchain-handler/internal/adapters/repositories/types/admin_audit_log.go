@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type AdminAuditLogRepository interface {
+	CreateAuditLog(ctx context.Context, auditLog *entities.AdminAuditLog) error
+	GetAuditLogs(ctx context.Context, limit, offset int, action *string) ([]entities.AdminAuditLog, error)
+}
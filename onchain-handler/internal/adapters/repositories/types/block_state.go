@@ -2,6 +2,8 @@ package types
 
 import (
 	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
 )
 
 type BlockStateRepository interface {
@@ -9,4 +11,5 @@ type BlockStateRepository interface {
 	UpdateLastProcessedBlock(ctx context.Context, blockNumber uint64, network string) error
 	GetLatestBlock(ctx context.Context, network string) (uint64, error)
 	UpdateLatestBlock(ctx context.Context, blockNumber uint64, network string) error
+	GetBlockStates(ctx context.Context) ([]entities.BlockState, error)
 }
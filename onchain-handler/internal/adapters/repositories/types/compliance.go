@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type ComplianceRepository interface {
+	GetDeniedAddresses(ctx context.Context) ([]entities.DeniedAddress, error)
+	GetDeniedAddress(ctx context.Context, network, address string) (*entities.DeniedAddress, error)
+	UpsertDeniedAddress(ctx context.Context, deniedAddress *entities.DeniedAddress) error
+	DeleteDeniedAddress(ctx context.Context, address, network string) (bool, error)
+	CreateComplianceHold(ctx context.Context, hold *entities.ComplianceHold) (bool, error)
+	CreateComplianceHoldInTx(tx *gorm.DB, hold *entities.ComplianceHold) (bool, error)
+	GetComplianceHoldByID(ctx context.Context, id uint64) (*entities.ComplianceHold, error)
+	GetComplianceHolds(ctx context.Context, status string) ([]entities.ComplianceHold, error)
+	GetComplianceHoldsByOrderID(ctx context.Context, orderID uint64) ([]entities.ComplianceHold, error)
+	GetHeldWalletAmount(ctx context.Context, walletID uint64, network, symbol string) (string, error)
+	UpdateComplianceHoldStatus(
+		ctx context.Context,
+		id uint64,
+		currentStatus string,
+		updates map[string]any,
+		onUpdated func(tx *gorm.DB, hold entities.ComplianceHold) error,
+	) (bool, error)
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type GasCostRepository interface {
+	CreateGasCostAttributions(ctx context.Context, attributions []entities.GasCostAttribution) error
+	GetPaidOrderAmounts(
+		ctx context.Context,
+		network, symbol, walletAddress string,
+		since *time.Time,
+	) ([]entities.OrderAmount, error)
+	GetSweptOrderAmounts(ctx context.Context, network, symbol string, since *time.Time) ([]entities.OrderAmount, error)
+	GetNetworkFeeTotals(
+		ctx context.Context,
+		vendorID string,
+		startTime, endTime time.Time,
+		symbols []string,
+	) ([]entities.NetworkFeeTotal, error)
+}
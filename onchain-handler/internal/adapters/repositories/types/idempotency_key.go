@@ -0,0 +1,14 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type IdempotencyKeyRepository interface {
+	CreateIdempotencyKey(ctx context.Context, model *entities.IdempotencyKey) (bool, error)
+	GetIdempotencyKey(ctx context.Context, vendorID, key string) (*entities.IdempotencyKey, error)
+	CompleteIdempotencyKey(ctx context.Context, vendorID, key string, statusCode int, response string) error
+	DeleteIdempotencyKey(ctx context.Context, vendorID, key string) error
+}
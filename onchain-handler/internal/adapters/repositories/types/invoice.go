@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type InvoiceRepository interface {
+	CreateInvoice(ctx context.Context, invoice *entities.Invoice) error
+	GetInvoiceByRequestID(ctx context.Context, vendorID, requestID string) (*entities.Invoice, error)
+	GetOpenInvoices(ctx context.Context) ([]entities.Invoice, error)
+	UpdateInvoiceState(
+		ctx context.Context,
+		id uint64,
+		currentStatus, status, settledAmount string,
+		paidAt *time.Time,
+	) (bool, error)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type ListenerShardRepository interface {
+	UpsertInstanceHeartbeat(ctx context.Context, instanceID string) error
+	DeleteInstance(ctx context.Context, instanceID string) error
+	LockShardsForRebalance(tx *gorm.DB, ctx context.Context) error
+	DeleteStaleInstances(tx *gorm.DB, ctx context.Context, heartbeatBefore time.Time) error
+	GetInstanceIDs(tx *gorm.DB, ctx context.Context) ([]string, error)
+	EnsureShards(tx *gorm.DB, ctx context.Context, network string, partitionCount uint32) error
+	GetShards(tx *gorm.DB, ctx context.Context) ([]entities.ListenerShard, error)
+	UpdateShardOwner(tx *gorm.DB, ctx context.Context, shardID uint64, instanceID *string) error
+	GetShardsByInstanceID(ctx context.Context, instanceID string) ([]entities.ListenerShard, error)
+	UpdateShardLastProcessedBlock(
+		ctx context.Context,
+		network string,
+		partitionIndex uint32,
+		instanceID string,
+		blockNumber uint64,
+	) error
+}
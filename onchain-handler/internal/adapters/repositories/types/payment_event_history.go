@@ -3,6 +3,8 @@ package types
 import (
 	"context"
 
+	"gorm.io/gorm"
+
 	"github.com/genefriendway/onchain-handler/internal/domain/entities"
 )
 
@@ -10,5 +12,12 @@ type PaymentEventHistoryRepository interface {
 	CreatePaymentEventHistory(
 		ctx context.Context,
 		paymentEvents []entities.PaymentEventHistory,
+		onCreated func(tx *gorm.DB, paymentEvent entities.PaymentEventHistory) error,
 	) ([]entities.PaymentEventHistory, error)
+	IsPaymentEventRecorded(
+		ctx context.Context,
+		network, transactionHash string,
+		logIndex uint,
+		toAddress, contractAddress string,
+	) (bool, error)
 }
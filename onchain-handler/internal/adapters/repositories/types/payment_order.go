@@ -25,15 +25,24 @@ type PaymentOrderRepository interface {
 		orderID uint64,
 		updateFunc func(order *entities.PaymentOrder) error,
 	) error
-	UpdateOrderNetwork(ctx context.Context, requestID, network string, blockHeight uint64) error
+	UpdateOrderNetwork(ctx context.Context, vendorID, requestID, network string, blockHeight uint64) error
 	BatchUpdateOrdersToExpired(ctx context.Context, orderIDs []uint64) error
 	BatchUpdateOrderBlockHeights(ctx context.Context, orderIDs, blockHeights []uint64) error
+	UpdateOptionBlockHeight(ctx context.Context, orderID uint64, network, symbol string, blockHeight uint64) error
 	GetExpiredPaymentOrders(ctx context.Context, network string) ([]entities.PaymentOrder, error)
+	GetPaymentOrdersOpenBetween(ctx context.Context, network string, startTime, endTime time.Time) ([]entities.PaymentOrder, error)
 	UpdateOrderToSuccessAndReleaseWallet(
 		ctx context.Context,
 		orderID uint64,
+		status string,
 		succeededAt time.Time,
-	) error
+	) (bool, error)
+	ExtendOrderExpiry(ctx context.Context, orderID uint64, expiredTime time.Time) (bool, error)
+	CancelOrderAndReleaseWallet(
+		ctx context.Context,
+		orderID uint64,
+		walletCooldownUntil time.Time,
+	) (bool, error)
 	UpdateExpiredOrdersToFailed(ctx context.Context) ([]uint64, error)
 	UpdateActiveOrdersToExpired(ctx context.Context) ([]uint64, error)
 	GetPaymentOrders(
@@ -48,13 +57,14 @@ type PaymentOrderRepository interface {
 	) ([]entities.PaymentOrder, error)
 	GetPaymentOrderByID(ctx context.Context, id uint64) (*entities.PaymentOrder, error)
 	GetPaymentOrdersByIDs(ctx context.Context, ids []uint64) ([]entities.PaymentOrder, error)
-	GetPaymentOrderByRequestID(ctx context.Context, requestID string) (*entities.PaymentOrder, error)
-	GetPaymentOrderIDByRequestID(ctx context.Context, requestID string) (uint64, error)
+	GetPaymentOrderByRequestID(ctx context.Context, vendorID, requestID string) (*entities.PaymentOrder, error)
+	GetPaymentOrderIDByRequestID(ctx context.Context, vendorID, requestID string) (uint64, error)
+	GetExistingRequestIDs(ctx context.Context, vendorID string, requestIDs []string) ([]string, error)
 	ReleaseWalletsForSuccessfulOrders(ctx context.Context) error
 	GetProcessingOrdersExpired(ctx context.Context, network string) ([]entities.PaymentOrder, error)
 	UpdateOrderFieldsByRequestIDAndStatus(
 		ctx context.Context,
-		requestID string,
+		vendorID, requestID string,
 		status string,
 		updates map[string]any,
 	) error
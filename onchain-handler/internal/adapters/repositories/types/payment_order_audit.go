@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+// PaymentOrderAuditRepository reads the payment order audit, the payment order repository records it.
+type PaymentOrderAuditRepository interface {
+	GetPaymentOrderAudits(ctx context.Context, orderID uint64) ([]entities.PaymentOrderAudit, error)
+}
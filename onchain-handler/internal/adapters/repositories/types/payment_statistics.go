@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/genefriendway/onchain-handler/internal/domain/entities"
 )
 
@@ -15,6 +17,13 @@ type PaymentStatisticsRepository interface {
 		amount, transferred *string,
 		symbol, vendorID string,
 	) error
+	IncrementStatisticsInTx(
+		tx *gorm.DB,
+		granularity string,
+		periodStart time.Time,
+		amount, transferred *string,
+		symbol, vendorID string,
+	) error
 	RevertAndIncrementStatistics(
 		ctx context.Context,
 		granularity string,
@@ -22,6 +31,20 @@ type PaymentStatisticsRepository interface {
 		amount *string,
 		oldSymbol, newSymbol, vendorID string,
 	) error
+	DecrementStatistics(
+		ctx context.Context,
+		granularity string,
+		periodStart time.Time,
+		amount *string,
+		symbol, vendorID string,
+	) error
+	IncrementNetworkFee(
+		ctx context.Context,
+		granularity string,
+		periodStart time.Time,
+		fee string,
+		symbol, vendorID string,
+	) error
 	GetStatisticsByTimeRangeAndGranularity(
 		ctx context.Context,
 		granularity string,
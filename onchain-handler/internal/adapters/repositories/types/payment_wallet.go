@@ -29,5 +29,7 @@ type PaymentWalletRepository interface {
 		symbols []string,
 	) (map[string]map[string]string, error)
 	ReleaseWalletsByIDs(tx *gorm.DB, walletIDs []uint64) error
+	UpdateWalletInUse(ctx context.Context, walletID uint64, inUse bool) (*entities.PaymentWallet, error)
 	GetWalletIDByAddress(ctx context.Context, address string) (uint64, error)
+	AssignMissingTronAddresses(ctx context.Context) (int, error)
 }
@@ -1,6 +1,10 @@
 package types
 
-import "context"
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
 
 type PaymentWalletBalanceRepository interface {
 	AddPaymentWalletBalance(
@@ -10,6 +14,13 @@ type PaymentWalletBalanceRepository interface {
 		network string,
 		symbol string,
 	) error
+	AddPaymentWalletBalanceInTx(
+		tx *gorm.DB,
+		walletID uint64,
+		amountToAdd string,
+		network string,
+		symbol string,
+	) error
 	SubtractPaymentWalletBalance(
 		ctx context.Context,
 		walletID uint64,
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type PayoutRepository interface {
+	CreatePayouts(ctx context.Context, payouts []entities.Payout) error
+	GetPayoutByID(ctx context.Context, id uint64) (*entities.Payout, error)
+	GetPayoutByRequestID(ctx context.Context, vendorID, requestID string) (*entities.Payout, error)
+	GetPayouts(ctx context.Context, status string) ([]entities.Payout, error)
+	GetApprovedPayouts(ctx context.Context, network string, limit int) ([]entities.Payout, error)
+	UpdatePayoutStatus(
+		ctx context.Context,
+		id uint64,
+		currentStatus string,
+		updates map[string]any,
+	) (bool, error)
+}
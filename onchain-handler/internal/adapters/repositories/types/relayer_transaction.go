@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type RelayerTransactionRepository interface {
+	CreateRelayerTransaction(ctx context.Context, model entities.RelayerTransaction) error
+	GetRelayerFeesByVendorID(ctx context.Context, vendorID string) ([]entities.RelayerFee, error)
+}
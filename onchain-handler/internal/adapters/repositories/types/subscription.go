@@ -0,0 +1,25 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type SubscriptionRepository interface {
+	CreateSubscriptionPlan(ctx context.Context, plan *entities.SubscriptionPlan) error
+	GetSubscriptionPlans(ctx context.Context, vendorID string) ([]entities.SubscriptionPlan, error)
+	GetSubscriptionPlanByID(ctx context.Context, vendorID string, id uint64) (*entities.SubscriptionPlan, error)
+	CreateSubscription(ctx context.Context, subscription *entities.Subscription) error
+	GetSubscriptionByID(ctx context.Context, vendorID string, id uint64) (*entities.Subscription, error)
+	GetDueSubscriptions(ctx context.Context, now time.Time) ([]entities.Subscription, error)
+	GetBillingSubscriptions(ctx context.Context) ([]entities.Subscription, error)
+	UpdateSubscription(
+		ctx context.Context,
+		id uint64,
+		currentOrderID *uint64,
+		updates map[string]any,
+	) (bool, error)
+	CancelSubscription(ctx context.Context, id uint64, cancelledAt time.Time) (bool, error)
+}
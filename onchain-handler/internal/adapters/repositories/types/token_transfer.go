@@ -23,4 +23,9 @@ type TokenTransferRepository interface {
 		startTime, endTime *time.Time,
 		fromAddress, toAddress *string,
 	) (float64, error)
+	GetOutboundTokenAmount(ctx context.Context, network, symbol string, since time.Time) (string, error)
+	GetLastTransferTime(
+		ctx context.Context,
+		network, symbol, transferType, fromAddress, excludedTransactionHash string,
+	) (*time.Time, error)
 }
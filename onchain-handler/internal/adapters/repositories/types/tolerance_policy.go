@@ -0,0 +1,13 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type TolerancePolicyRepository interface {
+	GetTolerancePolicies(ctx context.Context) ([]entities.TolerancePolicy, error)
+	UpsertTolerancePolicy(ctx context.Context, policy *entities.TolerancePolicy) error
+	DeleteTolerancePolicy(ctx context.Context, vendorID, symbol string) (bool, error)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type TransferPolicyRepository interface {
+	GetTransferPolicies(ctx context.Context) ([]entities.TransferPolicy, error)
+	UpsertTransferPolicy(ctx context.Context, policy *entities.TransferPolicy) error
+	DeleteTransferPolicy(ctx context.Context, network, symbol string) (bool, error)
+	GetAllowedAddresses(ctx context.Context) ([]entities.TransferAllowedAddress, error)
+	IsAddressAllowed(ctx context.Context, network, address string) (bool, error)
+	UpsertAllowedAddress(ctx context.Context, allowedAddress *entities.TransferAllowedAddress) error
+	DeleteAllowedAddress(ctx context.Context, network, address string) (bool, error)
+	CreateTransferDecisions(ctx context.Context, decisions []entities.TransferDecision) error
+	GetTransferDecisions(ctx context.Context, transferKind string, transferID *uint64) ([]entities.TransferDecision, error)
+	CountApprovals(ctx context.Context, transferKind string, transferID uint64) (int64, error)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type UserWalletRepository interface {
+	UpsertUserWallet(ctx context.Context, wallet *entities.UserWallet) error
+	GetUserWalletByUserID(ctx context.Context, userID uint64) (*entities.UserWallet, error)
+	GetUserWalletsByUserIDs(ctx context.Context, userIDs []uint64) ([]entities.UserWallet, error)
+}
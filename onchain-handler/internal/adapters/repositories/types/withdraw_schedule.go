@@ -0,0 +1,16 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type WithdrawScheduleRepository interface {
+	CreateWithdrawSchedules(ctx context.Context, schedules []entities.WithdrawSchedule) error
+	UpsertWithdrawSchedule(ctx context.Context, schedule *entities.WithdrawSchedule) error
+	GetWithdrawSchedule(ctx context.Context, network, symbol string) (*entities.WithdrawSchedule, error)
+	GetWithdrawSchedules(ctx context.Context, network, symbol string) ([]entities.WithdrawSchedule, error)
+	UpdateWithdrawSchedule(ctx context.Context, id uint64, updates map[string]any) error
+	UpdateWithdrawSchedules(ctx context.Context, network, symbol string, paused *bool, updates map[string]any) (int64, error)
+}
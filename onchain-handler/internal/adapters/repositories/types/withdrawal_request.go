@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type WithdrawalRequestRepository interface {
+	CreateWithdrawalRequest(ctx context.Context, request *entities.WithdrawalRequest) error
+	GetOpenWithdrawalRequest(ctx context.Context, network, symbol string) (*entities.WithdrawalRequest, error)
+	GetWithdrawalRequestByID(ctx context.Context, id uint64) (*entities.WithdrawalRequest, error)
+	GetWithdrawalRequests(ctx context.Context, status string) ([]entities.WithdrawalRequest, error)
+	UpdateWithdrawalRequestStatus(
+		ctx context.Context,
+		id uint64,
+		currentStatus string,
+		updates map[string]any,
+	) (bool, error)
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type userWalletRepository struct {
+	db *gorm.DB
+}
+
+// NewUserWalletRepository creates a new UserWalletRepository
+func NewUserWalletRepository(db *gorm.DB) repotypes.UserWalletRepository {
+	return &userWalletRepository{
+		db: db,
+	}
+}
+
+// UpsertUserWallet registers the wallet of the user, replacing the address registered before
+func (r *userWalletRepository) UpsertUserWallet(ctx context.Context, wallet *entities.UserWallet) error {
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"address"}),
+		}).
+		Create(wallet).Error; err != nil {
+		return fmt.Errorf("failed to register wallet of user %d: %w", wallet.UserID, err)
+	}
+	return nil
+}
+
+// GetUserWalletByUserID retrieves the wallet registered for the user
+func (r *userWalletRepository) GetUserWalletByUserID(ctx context.Context, userID uint64) (*entities.UserWallet, error) {
+	var wallet entities.UserWallet
+	if err := r.db.WithContext(ctx).First(&wallet, "user_id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("wallet of user %d not found: %w", userID, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve wallet of user %d: %w", userID, err)
+	}
+	return &wallet, nil
+}
+
+// GetUserWalletsByUserIDs retrieves the wallets registered for the users, users without a wallet are left out
+func (r *userWalletRepository) GetUserWalletsByUserIDs(ctx context.Context, userIDs []uint64) ([]entities.UserWallet, error) {
+	var wallets []entities.UserWallet
+	if err := r.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&wallets).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve user wallets: %w", err)
+	}
+	return wallets, nil
+}
@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type withdrawScheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewWithdrawScheduleRepository creates a new WithdrawScheduleRepository
+func NewWithdrawScheduleRepository(db *gorm.DB) repotypes.WithdrawScheduleRepository {
+	return &withdrawScheduleRepository{
+		db: db,
+	}
+}
+
+// CreateWithdrawSchedules inserts the schedules, the schedules of a network and symbol that already exist are kept
+func (r *withdrawScheduleRepository) CreateWithdrawSchedules(ctx context.Context, schedules []entities.WithdrawSchedule) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "network"}, {Name: "symbol"}},
+			DoNothing: true,
+		}).
+		Create(&schedules).Error
+	if err != nil {
+		return fmt.Errorf("failed to create withdraw schedules: %w", err)
+	}
+	return nil
+}
+
+// UpsertWithdrawSchedule creates the schedule of its network and symbol or replaces the settings of the existing one,
+// the paused state and the last run are kept
+func (r *withdrawScheduleRepository) UpsertWithdrawSchedule(ctx context.Context, schedule *entities.WithdrawSchedule) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "network"}, {Name: "symbol"}},
+			DoUpdates: clause.AssignmentColumns(
+				[]string{"cron_expression", "min_batch_value", "max_gas_price_gwei", "next_run_at"},
+			),
+		}).
+		Create(schedule).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert withdraw schedule: %w", err)
+	}
+	return nil
+}
+
+// GetWithdrawSchedule retrieves the schedule of the network and symbol
+func (r *withdrawScheduleRepository) GetWithdrawSchedule(
+	ctx context.Context,
+	network, symbol string,
+) (*entities.WithdrawSchedule, error) {
+	var schedule entities.WithdrawSchedule
+	if err := r.db.WithContext(ctx).First(&schedule, "network = ? AND symbol = ?", network, symbol).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("withdraw schedule of %s on network %s not found: %w", symbol, network, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve withdraw schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+// GetWithdrawSchedules retrieves the schedules of the network and symbol, an empty network or symbol matches any
+func (r *withdrawScheduleRepository) GetWithdrawSchedules(
+	ctx context.Context,
+	network, symbol string,
+) ([]entities.WithdrawSchedule, error) {
+	var schedules []entities.WithdrawSchedule
+	if err := r.filter(r.db.WithContext(ctx), network, symbol).Order("network, symbol").Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve withdraw schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// UpdateWithdrawSchedule applies the updates to the schedule
+func (r *withdrawScheduleRepository) UpdateWithdrawSchedule(ctx context.Context, id uint64, updates map[string]any) error {
+	if err := r.db.WithContext(ctx).Model(&entities.WithdrawSchedule{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update withdraw schedule %d: %w", id, err)
+	}
+	return nil
+}
+
+// UpdateWithdrawSchedules applies the updates to the schedules of the network and symbol, an empty network or symbol
+// matches any. When paused is set only the schedules in that state are updated. It returns the number of updated schedules.
+func (r *withdrawScheduleRepository) UpdateWithdrawSchedules(
+	ctx context.Context,
+	network, symbol string,
+	paused *bool,
+	updates map[string]any,
+) (int64, error) {
+	query := r.filter(r.db.WithContext(ctx).Model(&entities.WithdrawSchedule{}), network, symbol)
+	if paused != nil {
+		query = query.Where("paused = ?", *paused)
+	} else {
+		// Updates needs a condition to update more than one row
+		query = query.Where("1 = 1")
+	}
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to update withdraw schedules: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *withdrawScheduleRepository) filter(query *gorm.DB, network, symbol string) *gorm.DB {
+	if network != "" {
+		query = query.Where("network = ?", network)
+	}
+	if symbol != "" {
+		query = query.Where("symbol = ?", symbol)
+	}
+	return query
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+)
+
+type withdrawalRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewWithdrawalRequestRepository creates a new WithdrawalRequestRepository
+func NewWithdrawalRequestRepository(db *gorm.DB) repotypes.WithdrawalRequestRepository {
+	return &withdrawalRequestRepository{
+		db: db,
+	}
+}
+
+// CreateWithdrawalRequest inserts a new withdrawal request
+func (r *withdrawalRequestRepository) CreateWithdrawalRequest(ctx context.Context, request *entities.WithdrawalRequest) error {
+	if err := r.db.WithContext(ctx).Create(request).Error; err != nil {
+		return fmt.Errorf("failed to create withdrawal request: %w", err)
+	}
+	return nil
+}
+
+// GetOpenWithdrawalRequest retrieves the withdrawal request of the network and symbol that is not closed yet
+func (r *withdrawalRequestRepository) GetOpenWithdrawalRequest(
+	ctx context.Context,
+	network, symbol string,
+) (*entities.WithdrawalRequest, error) {
+	var request entities.WithdrawalRequest
+	if err := r.db.WithContext(ctx).First(
+		&request,
+		"network = ? AND symbol = ? AND status IN ?",
+		network,
+		symbol,
+		[]string{constants.OutboundPendingApproval, constants.OutboundApproved, constants.OutboundProcessing},
+	).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("no open withdrawal request of %s on network %s: %w", symbol, network, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve open withdrawal request: %w", err)
+	}
+	return &request, nil
+}
+
+// GetWithdrawalRequestByID retrieves a withdrawal request by its ID
+func (r *withdrawalRequestRepository) GetWithdrawalRequestByID(ctx context.Context, id uint64) (*entities.WithdrawalRequest, error) {
+	var request entities.WithdrawalRequest
+	if err := r.db.WithContext(ctx).First(&request, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("withdrawal request %d not found: %w", id, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve withdrawal request: %w", err)
+	}
+	return &request, nil
+}
+
+// GetWithdrawalRequests retrieves the withdrawal requests with the status, or all of them when the status is empty
+func (r *withdrawalRequestRepository) GetWithdrawalRequests(ctx context.Context, status string) ([]entities.WithdrawalRequest, error) {
+	var requests []entities.WithdrawalRequest
+	query := r.db.WithContext(ctx).Order("id")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to retrieve withdrawal requests: %w", err)
+	}
+	return requests, nil
+}
+
+// UpdateWithdrawalRequestStatus applies the updates if the request still has the current status,
+// it returns false when another update came first
+func (r *withdrawalRequestRepository) UpdateWithdrawalRequestStatus(
+	ctx context.Context,
+	id uint64,
+	currentStatus string,
+	updates map[string]any,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.WithdrawalRequest{}).
+		Where("id = ? AND status = ?", id, currentStatus).
+		Updates(updates)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update withdrawal request %d: %w", id, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
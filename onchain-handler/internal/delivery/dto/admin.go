@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+type BlockStateDTO struct {
+	Network            string `json:"network"`
+	LatestBlock        uint64 `json:"latest_block"`
+	LastProcessedBlock uint64 `json:"last_processed_block"`
+}
+
+// ResetLastProcessedBlockPayloadDTO moves the listener cursor of a network, the blocks after it are listened again.
+type ResetLastProcessedBlockPayloadDTO struct {
+	LastProcessedBlock uint64 `json:"last_processed_block" binding:"required"`
+	Reason             string `json:"reason" binding:"required"`
+}
+
+// AdminReasonPayloadDTO carries the reason of an admin operation, recorded in the audit log.
+type AdminReasonPayloadDTO struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// ResolvePaymentOrderPayloadDTO manually marks an order SUCCESS or FAILED.
+type ResolvePaymentOrderPayloadDTO struct {
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+}
+
+// AdminSyncBalancesPayloadDTO re-syncs the balances of one payment wallet, or of every payment wallet of the network
+// when no address is given.
+type AdminSyncBalancesPayloadDTO struct {
+	Network       constants.NetworkType `json:"network" binding:"required"`
+	WalletAddress string                `json:"wallet_address"`
+	Reason        string                `json:"reason" binding:"required"`
+}
+
+type AdminAuditLogDTO struct {
+	ID        uint64    `json:"id"`
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	Reason    string    `json:"reason,omitempty"`
+	Details   string    `json:"details,omitempty"`
+	Succeeded bool      `json:"succeeded"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+// DeniedAddressPayloadDTO adds an address to the deny-list. An empty network denies the address on any network.
+type DeniedAddressPayloadDTO struct {
+	Address string `json:"address" binding:"required"`
+	Network string `json:"network"`
+	Reason  string `json:"reason"`
+}
+
+type DeniedAddressDTO struct {
+	ID        uint64    `json:"id"`
+	Address   string    `json:"address"`
+	Network   string    `json:"network"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ComplianceHoldPayloadDTO is a payment to screen before it is credited to its order.
+type ComplianceHoldPayloadDTO struct {
+	PaymentOrderID  uint64
+	VendorID        string
+	WalletID        *uint64 // Payment wallet holding the funds, nil for router payments
+	Network         string
+	Symbol          string
+	TransactionHash string
+	LogIndex        uint
+	FromAddress     string
+	ToAddress       string
+	Amount          string
+}
+
+type ComplianceHoldDTO struct {
+	ID              uint64     `json:"id"`
+	PaymentOrderID  uint64     `json:"payment_order_id"`
+	VendorID        string     `json:"vendor_id"`
+	WalletID        *uint64    `json:"wallet_id,omitempty"`
+	Network         string     `json:"network"`
+	Symbol          string     `json:"symbol"`
+	TransactionHash string     `json:"transaction_hash"`
+	LogIndex        uint       `json:"log_index"`
+	FromAddress     string     `json:"from_address"`
+	ToAddress       string     `json:"to_address"`
+	Amount          string     `json:"amount"`
+	Provider        string     `json:"provider,omitempty"` // Empty when the payment was held because its order was already on hold
+	Reason          string     `json:"reason"`
+	Status          string     `json:"status"`
+	ReviewedBy      string     `json:"reviewed_by,omitempty"`
+	ReviewNote      string     `json:"review_note,omitempty"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
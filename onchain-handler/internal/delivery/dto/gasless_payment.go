@@ -0,0 +1,35 @@
+package dto
+
+type GaslessPaymentPayloadDTO struct {
+	RequestID   string `json:"request_id" binding:"required"`
+	Type        string `json:"type" binding:"required"`      // TRANSFER_WITH_AUTHORIZATION or PERMIT
+	From        string `json:"from" binding:"required"`      // Payer, the authorizer or permit owner
+	Value       string `json:"value" binding:"required"`     // Amount in the smallest token unit
+	ValidAfter  string `json:"valid_after,omitempty"`        // TRANSFER_WITH_AUTHORIZATION only, unix seconds
+	ValidBefore string `json:"valid_before,omitempty"`       // TRANSFER_WITH_AUTHORIZATION only, unix seconds
+	Nonce       string `json:"nonce,omitempty"`              // TRANSFER_WITH_AUTHORIZATION only, bytes32 hex
+	Deadline    string `json:"deadline,omitempty"`           // PERMIT only, unix seconds
+	Signature   string `json:"signature" binding:"required"` // 65 bytes hex EIP-712 signature
+}
+
+type RelayerTransactionDTO struct {
+	TransactionHash string `json:"transaction_hash"`
+	Method          string `json:"method"`
+	GasUsed         uint64 `json:"gas_used"`
+	Fee             string `json:"fee"`
+	Status          bool   `json:"status"`
+}
+
+type GaslessPaymentDTO struct {
+	RequestID      string                  `json:"request_id"`
+	Network        string                  `json:"network"`
+	PaymentAddress string                  `json:"payment_address"`
+	Transactions   []RelayerTransactionDTO `json:"transactions"`
+}
+
+type RelayerFeeDTO struct {
+	Network          string `json:"network"`
+	NativeSymbol     string `json:"native_symbol"`
+	TransactionCount uint64 `json:"transaction_count"`
+	TotalFee         string `json:"total_fee"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// IdempotentResponseDTO is the stored response replayed for a retry with the same Idempotency-Key.
+type IdempotentResponseDTO struct {
+	StatusCode int    `json:"status_code"`
+	Body       string `json:"body"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+// InvoicePayloadDTO creates an invoice, each line item is created as a payment order.
+type InvoicePayloadDTO struct {
+	RequestID  string                  `json:"request_id"`
+	WebhookURL string                  `json:"webhook_url"` // Receives the invoice when its status changes
+	Items      []InvoiceItemPayloadDTO `json:"items"`
+}
+
+type InvoiceItemPayloadDTO struct {
+	PaymentOrderPayloadDTO
+	Description string `json:"description"`
+}
+
+type CreatedInvoiceDTO struct {
+	ID          uint64                   `json:"id"`
+	RequestID   string                   `json:"request_id"`
+	TotalAmount string                   `json:"total_amount"`
+	Status      string                   `json:"status"`
+	Orders      []CreatedPaymentOrderDTO `json:"orders"`
+}
+
+type InvoiceDTO struct {
+	ID            uint64           `json:"id"`
+	RequestID     string           `json:"request_id"`
+	VendorID      string           `json:"vendor_id"`
+	TotalAmount   string           `json:"total_amount"`
+	SettledAmount string           `json:"settled_amount"` // Sum of the payments of the orders, a paid order counts for at least its amount
+	Status        string           `json:"status"`
+	WebhookURL    string           `json:"webhook_url"`
+	PaidAt        *time.Time       `json:"paid_at,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+	Items         []InvoiceItemDTO `json:"items"`
+}
+
+type InvoiceItemDTO struct {
+	Description string                  `json:"description"`
+	Order       PaymentOrderDTOResponse `json:"order"`
+}
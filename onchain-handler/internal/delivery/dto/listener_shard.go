@@ -0,0 +1,17 @@
+package dto
+
+import "fmt"
+
+type ListenerShardDTO struct {
+	ID                 uint64 `json:"id"`
+	Network            string `json:"network"`
+	PartitionIndex     uint32 `json:"partition_index"`
+	PartitionCount     uint32 `json:"partition_count"`
+	InstanceID         string `json:"instance_id"`
+	LastProcessedBlock uint64 `json:"last_processed_block"`
+}
+
+// Key returns the unique identifier of the shard.
+func (s ListenerShardDTO) Key() string {
+	return fmt.Sprintf("%s_%d", s.Network, s.PartitionIndex)
+}
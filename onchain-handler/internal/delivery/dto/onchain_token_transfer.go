@@ -3,6 +3,7 @@ package dto
 import "time"
 
 type TokenTransferHistoryDTO struct {
+	RequestID       string    `json:"request_id,omitempty"` // Request ID of the payout sent by the transfer
 	Network         string    `json:"network"`
 	TransactionHash string    `json:"transaction_hash"`
 	FromAddress     string    `json:"from_address"`
@@ -12,11 +12,16 @@ type TokenTransferPayloadDTO struct {
 }
 
 type PaymentOrderPayloadDTO struct {
-	RequestID  string `json:"request_id"`
-	Amount     string `json:"amount"`
-	Symbol     string `json:"symbol"`
-	Network    string `json:"network"`
-	WebhookURL string `json:"webhook_url"`
+	RequestID        string `json:"request_id"`
+	Amount           string `json:"amount"`
+	Symbol           string `json:"symbol"`
+	Network          string `json:"network"`
+	WebhookURL       string `json:"webhook_url"`
+	PaymentMode      string `json:"payment_mode,omitempty"`       // ADDRESS (default) or ROUTER
+	ExpiredOrderTime uint   `json:"expired_order_time,omitempty"` // Minutes before the order expires, defaults to EXPIRED_ORDER_TIME
+
+	// Other networks and tokens the payer may pay the order with, the order settles on the first one paid in full
+	Options []PaymentOptionDTO `json:"options,omitempty"`
 }
 
 type PaymentOrderNetworkPayloadDTO struct {
@@ -33,6 +38,7 @@ type PaymentEventPayloadDTO struct {
 	PaymentOrderID  uint64 `json:"payment_order_id"`
 	Network         string `json:"network"`
 	TransactionHash string `json:"transaction_hash"`
+	LogIndex        uint   `json:"log_index"`
 	FromAddress     string `json:"from_address"`
 	ToAddress       string `json:"to_address"`
 	ContractAddress string `json:"contract_address"`
@@ -56,6 +62,10 @@ type SyncWalletBalancePayloadDTO struct {
 	Network       constants.NetworkType `json:"network" binding:"required"`
 }
 
+type ExtendPaymentOrderPayloadDTO struct {
+	ExtendTime uint `json:"extend_time" binding:"required"` // Minutes added to the order expiry
+}
+
 type UpdatePaymentOrderPayloadDTO struct {
 	Network string `json:"network,omitempty"`
 	Symbol  string `json:"symbol,omitempty"`
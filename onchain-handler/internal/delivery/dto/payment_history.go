@@ -1,9 +1,13 @@
 package dto
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type PaymentHistoryDTO struct {
 	TransactionHash string    `json:"transaction_hash"`
+	LogIndex        *uint     `json:"log_index,omitempty"`
 	FromAddress     string    `json:"from_address"`
 	ToAddress       string    `json:"to_address"`
 	Amount          string    `json:"amount"`
@@ -11,3 +15,8 @@ type PaymentHistoryDTO struct {
 	Network         string    `json:"network"`
 	CreatedAt       time.Time `json:"created_at"`
 }
+
+// IsLog reports whether the event was recorded for the transfer log with the given transaction hash and log index.
+func (h PaymentHistoryDTO) IsLog(transactionHash string, logIndex uint) bool {
+	return h.LogIndex != nil && *h.LogIndex == logIndex && strings.EqualFold(h.TransactionHash, transactionHash)
+}
@@ -1,6 +1,13 @@
 package dto
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+)
 
 type PaymentOrderDTO struct {
 	ID                  uint64           `json:"id"`
@@ -14,10 +21,13 @@ type PaymentOrderDTO struct {
 	Transferred         string           `json:"transferred"`
 	Symbol              string           `json:"symbol"`
 	Network             string           `json:"network"`
+	PaymentMode         string           `json:"payment_mode"`
 	Status              string           `json:"status"`
 	WebhookURL          string           `json:"webhook_url"`
 	SucceededAt         time.Time        `json:"succeeded_at,omitempty"`
 	ExpiredTime         time.Time        `json:"expired_time"`
+	CreatedAt           time.Time        `json:"created_at"`
+	Options             string           `json:"options,omitempty"` // Options of a multi-chain order, see EncodePaymentOptions
 }
 
 type CreatedPaymentOrderDTO struct {
@@ -28,4 +38,119 @@ type CreatedPaymentOrderDTO struct {
 	Symbol         string `json:"symbol"`
 	Network        string `json:"network"`
 	Expired        uint64 `json:"expired"`
+	PaymentMode    string `json:"payment_mode"`
+	RouterAddress  string `json:"router_address,omitempty"`  // Contract to call with pay(router_order_id, token, amount)
+	RouterOrderID  string `json:"router_order_id,omitempty"` // bytes32 order ID expected by the payment router
+	PaymentURI     string `json:"payment_uri,omitempty"`     // EIP-681 transfer URI of the order
+	QRCode         string `json:"qr_code,omitempty"`         // QR code of the payment URI as a data URI, when requested
+
+	Options []PaymentOptionDTO `json:"options,omitempty"` // Networks and tokens a multi-chain order accepts
+}
+
+// PaymentOptionDTO is a network and token a multi-chain order can be paid with.
+type PaymentOptionDTO struct {
+	Network string `json:"network"`
+	Symbol  string `json:"symbol"`
+}
+
+// EncodePaymentOptions encodes the options of a multi-chain order as NETWORK:SYMBOL pairs separated by commas,
+// the order set only holds comparable items.
+func EncodePaymentOptions(options []PaymentOptionDTO) string {
+	pairs := make([]string, 0, len(options))
+	for _, option := range options {
+		pairs = append(pairs, option.Network+":"+option.Symbol)
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ParsePaymentOptions decodes options encoded by EncodePaymentOptions.
+func ParsePaymentOptions(encoded string) []PaymentOptionDTO {
+	if encoded == "" {
+		return nil
+	}
+	var options []PaymentOptionDTO
+	for _, pair := range strings.Split(encoded, ",") {
+		network, symbol, _ := strings.Cut(pair, ":")
+		options = append(options, PaymentOptionDTO{Network: network, Symbol: symbol})
+	}
+	return options
+}
+
+// SetKey returns the key of the order in the payment order set.
+// A multi-chain order has an item per option, keyed by its network too.
+func (o PaymentOrderDTO) SetKey() string {
+	key := OrderSetKey(o.PaymentMode, o.PaymentAddress, o.ID, o.Symbol)
+	if o.IsMultiChain() {
+		return OptionSetKey(key, o.Network)
+	}
+	return key
+}
+
+// SetKeys returns the keys of all the items of the order in the payment order set.
+func (o PaymentOrderDTO) SetKeys() []string {
+	return orderSetKeys(o.PaymentMode, o.PaymentAddress, o.ID, o.Symbol, ParsePaymentOptions(o.Options))
+}
+
+// IsMultiChain reports whether the order accepts several networks and tokens.
+func (o PaymentOrderDTO) IsMultiChain() bool {
+	return o.Options != ""
+}
+
+// IsPrimaryOption reports whether the item is the one of the network and token the order was created with.
+// Single chain orders only have this one.
+func (o PaymentOrderDTO) IsPrimaryOption() bool {
+	options := ParsePaymentOptions(o.Options)
+	return len(options) == 0 || (options[0].Network == o.Network && options[0].Symbol == o.Symbol)
+}
+
+// RouterOrderID returns the order ID tagged on the payments made through the payment router, as a bytes32 hex string.
+func RouterOrderID(orderID uint64) string {
+	return fmt.Sprintf("0x%064x", orderID)
+}
+
+// OrderSetKey returns the key of an order in the payment order set: its payment address for ADDRESS orders
+// and its router order ID for ROUTER orders, followed by the token symbol.
+// Tron payment addresses are keyed in hex, the form of the addresses in the logs.
+func OrderSetKey(paymentMode, paymentAddress string, orderID uint64, symbol string) string {
+	if paymentMode == constants.PaymentModeRouter {
+		return RouterOrderID(orderID) + "_" + symbol
+	}
+	return chain.NormalizeAddress(paymentAddress) + "_" + symbol
+}
+
+// OptionSetKey returns the key of an option of a multi-chain order in the payment order set from the order key,
+// the options on different networks share the payment address and may share the token.
+func OptionSetKey(orderKey, network string) string {
+	return orderKey + "_" + network
+}
+
+// orderSetKeys returns the keys of the items of an order in the payment order set, one per option of a multi-chain order.
+func orderSetKeys(paymentMode, paymentAddress string, orderID uint64, symbol string, options []PaymentOptionDTO) []string {
+	if len(options) == 0 {
+		return []string{OrderSetKey(paymentMode, paymentAddress, orderID, symbol)}
+	}
+	keys := make([]string, 0, len(options))
+	for _, option := range options {
+		keys = append(keys, OptionSetKey(OrderSetKey(paymentMode, paymentAddress, orderID, option.Symbol), option.Network))
+	}
+	return keys
+}
+
+// IsRouterPayment reports whether the order is paid through the payment router contract.
+func (o PaymentOrderDTO) IsRouterPayment() bool {
+	return o.PaymentMode == constants.PaymentModeRouter
+}
+
+// PaymentOrderAuditDTO is a change of a payment order, its creation has no old status.
+type PaymentOrderAuditDTO struct {
+	ID             uint64    `json:"id"`
+	OldStatus      string    `json:"old_status,omitempty"`
+	NewStatus      string    `json:"new_status"`
+	OldTransferred string    `json:"old_transferred,omitempty"`
+	NewTransferred string    `json:"new_transferred"`
+	Actor          string    `json:"actor"`
+	BlockNumber    *uint64   `json:"block_number,omitempty"`
+	TxHash         string    `json:"tx_hash,omitempty"`
+	Note           string    `json:"note,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
 }
@@ -5,6 +5,18 @@ type TokenStats struct {
 	TotalOrders      uint64 `json:"total_orders"`
 	TotalAmount      string `json:"total_amount"`
 	TotalTransferred string `json:"total_transferred"`
+	TotalNetworkFee  string `json:"total_network_fee"` // Network fees in USD attributed to the orders
+}
+
+// NetworkFeeStats is the gas cost of the sweeps attributed to the orders of a vendor on a network and token.
+type NetworkFeeStats struct {
+	Network      string `json:"network"`
+	Symbol       string `json:"symbol"`
+	NativeSymbol string `json:"native_symbol"`
+	TotalOrders  uint64 `json:"total_orders"`
+	TotalFee     string `json:"total_fee"`     // Native token units
+	TotalFeeUSD  string `json:"total_fee_usd"` // USD value of the priced part of total_fee
+	UnpricedFee  string `json:"unpriced_fee"`  // Native token units without a USD price when attributed
 }
 
 type PeriodStatistics struct {
@@ -1,7 +1,8 @@
 package dto
 
 type PaymentWalletDTO struct {
-	ID      uint64 `json:"id"`
-	Address string `json:"address"`
-	InUse   bool   `json:"in_use"`
+	ID          uint64 `json:"id"`
+	Address     string `json:"address"`
+	TronAddress string `json:"tron_address,omitempty"`
+	InUse       bool   `json:"in_use"`
 }
@@ -1,5 +1,7 @@
 package dto
 
+import "github.com/genefriendway/onchain-handler/constants"
+
 type TokenBalanceDTO struct {
 	Symbol string `json:"symbol"`
 	Amount string `json:"amount"`
@@ -13,5 +15,14 @@ type NetworkBalanceDTO struct {
 type PaymentWalletBalanceDTO struct {
 	ID              uint64              `json:"id"`
 	Address         string              `json:"address"`
+	TronAddress     string              `json:"tron_address,omitempty"`
 	NetworkBalances []NetworkBalanceDTO `json:"network_balances"`
 }
+
+// AddressOn returns the address the wallet receives payments at on the network
+func (w PaymentWalletBalanceDTO) AddressOn(network string) string {
+	if network == constants.Tron.String() {
+		return w.TronAddress
+	}
+	return w.Address
+}
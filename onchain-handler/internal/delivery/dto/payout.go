@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// PayoutPayloadDTO requests a payout to the wallet registered for user_id or to to_address, exactly one of them is set.
+type PayoutPayloadDTO struct {
+	RequestID  string  `json:"request_id" binding:"required"`
+	UserID     *uint64 `json:"user_id,omitempty"`
+	ToAddress  string  `json:"to_address,omitempty"`
+	Amount     string  `json:"amount" binding:"required"`
+	Symbol     string  `json:"symbol" binding:"required"`
+	Network    string  `json:"network" binding:"required"`
+	WebhookURL string  `json:"webhook_url"` // Receives the payout once it is sent, failed or rejected
+}
+
+type PayoutDTO struct {
+	ID                uint64     `json:"id"`
+	RequestID         string     `json:"request_id"`
+	UserID            *uint64    `json:"user_id,omitempty"`
+	ToAddress         string     `json:"to_address"`
+	Amount            string     `json:"amount"`
+	Symbol            string     `json:"symbol"`
+	Network           string     `json:"network"`
+	Status            string     `json:"status"`
+	RequiredApprovals uint       `json:"required_approvals"` // Distinct approvals needed while PENDING_APPROVAL
+	Approvals         uint       `json:"approvals"`
+	WebhookURL        string     `json:"webhook_url"`
+	TransactionHash   string     `json:"transaction_hash,omitempty"`
+	Fee               string     `json:"fee,omitempty"` // Gas paid by the payout wallet in the native token
+	ErrorMessage      string     `json:"error_message,omitempty"`
+	ApprovedAt        *time.Time `json:"approved_at,omitempty"`
+	ExecutedAt        *time.Time `json:"executed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+type RescanPayloadDTO struct {
+	Network   string `json:"network" binding:"required"`
+	FromBlock uint64 `json:"from_block" binding:"required"`
+	ToBlock   uint64 `json:"to_block" binding:"required"`
+	Apply     bool   `json:"apply"` // Dry-run when false
+}
+
+type RescanEventDTO struct {
+	TransactionHash string `json:"transaction_hash"`
+	LogIndex        uint   `json:"log_index"`
+	BlockNumber     uint64 `json:"block_number"`
+	FromAddress     string `json:"from_address"`
+	ToAddress       string `json:"to_address"`
+	TokenSymbol     string `json:"token_symbol"`
+	Amount          string `json:"amount"`
+	PaymentOrderID  uint64 `json:"payment_order_id"`
+	RequestID       string `json:"request_id"`
+	PreviousStatus  string `json:"previous_status"`
+	Status          string `json:"status"` // Projected status in dry-run, resulting status when applied
+	Action          string `json:"action"`
+	Error           string `json:"error,omitempty"`
+}
+
+type RescanResultDTO struct {
+	Network      string           `json:"network"`
+	FromBlock    uint64           `json:"from_block"`
+	ToBlock      uint64           `json:"to_block"`
+	Apply        bool             `json:"apply"`
+	ScannedLogs  int              `json:"scanned_logs"`
+	MatchedLogs  int              `json:"matched_logs"`
+	CreditedLogs int              `json:"credited_logs"`
+	Events       []RescanEventDTO `json:"events"`
+}
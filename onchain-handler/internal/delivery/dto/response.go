@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
 
 type PaginationDTOResponse struct {
 	NextPage               int                          `json:"next_page"`
@@ -21,6 +25,7 @@ type TokenTransferResultDTOResponse struct {
 type PaymentOrderDTOResponse struct {
 	ID                  uint64              `json:"id"`
 	RequestID           string              `json:"request_id"`
+	VendorID            string              `json:"vendor_id,omitempty"`
 	Network             string              `json:"network"`
 	Amount              string              `json:"amount"`
 	Transferred         string              `json:"transferred"`
@@ -30,8 +35,41 @@ type PaymentOrderDTOResponse struct {
 	BlockHeight         uint64              `json:"block_height"`
 	UpcomingBlockHeight uint64              `json:"upcoming_block_height,omitempty"`
 	PaymentAddress      string              `json:"payment_address,omitempty"`
+	PaymentMode         string              `json:"payment_mode,omitempty"`
+	PaymentURI          string              `json:"payment_uri,omitempty"`
 	SucceededAt         *time.Time          `json:"succeeded_at,omitempty"`
 	CreatedAt           time.Time           `json:"created_at"`
 	Expired             uint64              `json:"expired,omitempty"`
 	EventHistories      []PaymentHistoryDTO `json:"event_histories,omitempty"`
+	Options             []PaymentOptionDTO  `json:"options,omitempty"` // Networks and tokens a multi-chain order accepts
+}
+
+// SetKey returns the key of the order in the payment order set, for a multi-chain order the key of the option
+// of its current network and token.
+func (o PaymentOrderDTOResponse) SetKey() string {
+	key := OrderSetKey(o.PaymentMode, o.PaymentAddress, o.ID, o.Symbol)
+	if len(o.Options) > 0 {
+		return OptionSetKey(key, o.Network)
+	}
+	return key
+}
+
+// SetKeys returns the keys of all the items of the order in the payment order set.
+func (o PaymentOrderDTOResponse) SetKeys() []string {
+	return orderSetKeys(o.PaymentMode, o.PaymentAddress, o.ID, o.Symbol, o.Options)
+}
+
+// CountedEventHistories returns the payments counting toward the order once paid on the network and token.
+// Under the SEPARATE split payment policy, a multi-chain order only counts the payments made on that option.
+func (o PaymentOrderDTOResponse) CountedEventHistories(network, symbol, splitPaymentPolicy string) []PaymentHistoryDTO {
+	if len(o.Options) == 0 || splitPaymentPolicy != constants.SplitPaymentSeparate {
+		return o.EventHistories
+	}
+	var events []PaymentHistoryDTO
+	for _, event := range o.EventHistories {
+		if event.Network == network && event.TokenSymbol == symbol {
+			events = append(events, event)
+		}
+	}
+	return events
 }
@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+type SubscriptionPlanPayloadDTO struct {
+	Name            string `json:"name" binding:"required"`
+	Amount          string `json:"amount" binding:"required"`
+	Symbol          string `json:"symbol" binding:"required"`
+	Network         string `json:"network" binding:"required"`
+	BillingInterval string `json:"billing_interval" binding:"required"` // DAILY, WEEKLY, MONTHLY or YEARLY
+	GracePeriod     uint   `json:"grace_period,omitempty"`              // Minutes the order of a cycle can be paid, defaults to EXPIRED_ORDER_TIME
+}
+
+type SubscriptionPlanDTO struct {
+	ID              uint64    `json:"id"`
+	Name            string    `json:"name"`
+	Amount          string    `json:"amount"`
+	Symbol          string    `json:"symbol"`
+	Network         string    `json:"network"`
+	BillingInterval string    `json:"billing_interval"`
+	GracePeriod     uint      `json:"grace_period"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type SubscriptionPayloadDTO struct {
+	PlanID     uint64 `json:"plan_id" binding:"required"`
+	CustomerID string `json:"customer_id" binding:"required"`
+	WebhookURL string `json:"webhook_url"` // Receives the subscription when a cycle is billed and when its status changes
+}
+
+type SubscriptionDTO struct {
+	ID            uint64                   `json:"id"`
+	CustomerID    string                   `json:"customer_id"`
+	Status        string                   `json:"status"`
+	Cycle         uint                     `json:"cycle"` // Number of cycles billed
+	NextBillingAt time.Time                `json:"next_billing_at"`
+	WebhookURL    string                   `json:"webhook_url"`
+	CancelledAt   *time.Time               `json:"cancelled_at,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+	Plan          SubscriptionPlanDTO      `json:"plan"`
+	CurrentOrder  *PaymentOrderDTOResponse `json:"current_order,omitempty"` // Order of the cycle being billed, with its payment address
+}
@@ -0,0 +1,22 @@
+package dto
+
+// TolerancePolicyPayloadDTO sets the tolerance policy of a vendor and token. An empty vendor ID or symbol
+// matches any vendor or token. Absolute limits are in token units, percentage limits are of the order amount.
+type TolerancePolicyPayloadDTO struct {
+	VendorID        string   `json:"vendor_id"`
+	Symbol          string   `json:"symbol"`
+	UnderAbsolute   *float64 `json:"under_absolute"`
+	UnderPercentage *float64 `json:"under_percentage"`
+	OverAbsolute    *float64 `json:"over_absolute"`
+	OverPercentage  *float64 `json:"over_percentage"`
+}
+
+type TolerancePolicyDTO struct {
+	ID              uint64   `json:"id"`
+	VendorID        string   `json:"vendor_id"`
+	Symbol          string   `json:"symbol"`
+	UnderAbsolute   *float64 `json:"under_absolute"`
+	UnderPercentage *float64 `json:"under_percentage"`
+	OverAbsolute    *float64 `json:"over_absolute"`
+	OverPercentage  *float64 `json:"over_percentage"`
+}
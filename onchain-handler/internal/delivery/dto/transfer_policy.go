@@ -0,0 +1,63 @@
+package dto
+
+import "time"
+
+// TransferPolicyPayloadDTO sets the outbound transfer policy of a network and token. An empty network or symbol
+// matches any network or token. Limits are in token units, a null limit is not set.
+type TransferPolicyPayloadDTO struct {
+	Network           string   `json:"network"`
+	Symbol            string   `json:"symbol"`
+	ApprovalThreshold *float64 `json:"approval_threshold"` // Transfers above it wait for approvals, 0 holds every transfer
+	RequiredApprovals uint     `json:"required_approvals"` // Distinct approvers needed by a held transfer, defaults to 1
+	DailyLimit        *float64 `json:"daily_limit"`        // Total sent per UTC day
+	AllowListOnly     bool     `json:"allow_list_only"`    // Only transfers to an allow-listed address are sent
+}
+
+type TransferPolicyDTO struct {
+	ID                uint64   `json:"id"`
+	Network           string   `json:"network"`
+	Symbol            string   `json:"symbol"`
+	ApprovalThreshold *float64 `json:"approval_threshold"`
+	RequiredApprovals uint     `json:"required_approvals"`
+	DailyLimit        *float64 `json:"daily_limit"`
+	AllowListOnly     bool     `json:"allow_list_only"`
+}
+
+type TransferAllowedAddressPayloadDTO struct {
+	Network string `json:"network" binding:"required"`
+	Address string `json:"address" binding:"required"`
+	Label   string `json:"label"`
+}
+
+type TransferAllowedAddressDTO struct {
+	Network   string    `json:"network"`
+	Address   string    `json:"address"`
+	Label     string    `json:"label"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransferEvaluationDTO is the decision of the transfer policy on an outbound transfer.
+type TransferEvaluationDTO struct {
+	Decision          string // AUTO_APPROVED, HELD or BLOCKED
+	Reason            string
+	RequiredApprovals uint // Approvals needed when HELD
+}
+
+type TransferDecisionDTO struct {
+	ID           uint64    `json:"id"`
+	TransferKind string    `json:"transfer_kind"`
+	TransferID   *uint64   `json:"transfer_id,omitempty"`
+	Network      string    `json:"network"`
+	Symbol       string    `json:"symbol"`
+	ToAddress    string    `json:"to_address"`
+	Amount       string    `json:"amount"`
+	Decision     string    `json:"decision"`
+	Actor        string    `json:"actor"`
+	Reason       string    `json:"reason,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TransferReviewPayloadDTO approves or rejects a held transfer, the reason is recorded in the decision audit.
+type TransferReviewPayloadDTO struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+// WithdrawSchedulePayloadDTO sets the sweep schedule of the payment wallets of a network and token.
+// A null limit is not set.
+type WithdrawSchedulePayloadDTO struct {
+	Network         string   `json:"network" binding:"required"`
+	Symbol          string   `json:"symbol" binding:"required"`
+	CronExpression  string   `json:"cron_expression"`    // Five field cron expression in UTC, empty for WITHDRAW_WORKER_INTERVAL
+	MinBatchValue   *float64 `json:"min_batch_value"`    // Token units the payment wallets must hold together before a sweep
+	MaxGasPriceGwei *float64 `json:"max_gas_price_gwei"` // Sweeps are deferred while the gas price is above it
+}
+
+type WithdrawScheduleDTO struct {
+	ID              uint64     `json:"id"`
+	Network         string     `json:"network"`
+	Symbol          string     `json:"symbol"`
+	CronExpression  string     `json:"cron_expression"`
+	Schedule        string     `json:"schedule"` // Cron expression in use, WITHDRAW_WORKER_INTERVAL when cron_expression is empty
+	MinBatchValue   *float64   `json:"min_batch_value"`
+	MaxGasPriceGwei *float64   `json:"max_gas_price_gwei"`
+	Paused          bool       `json:"paused"`
+	SweepRequested  bool       `json:"sweep_requested"`
+	NextRunAt       time.Time  `json:"next_run_at"`
+	LastRunAt       *time.Time `json:"last_run_at,omitempty"`
+	LastStatus      string     `json:"last_status,omitempty"`
+	LastMessage     string     `json:"last_message,omitempty"`
+}
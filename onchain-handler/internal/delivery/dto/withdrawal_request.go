@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type WithdrawalRequestDTO struct {
+	ID                uint64     `json:"id"`
+	Network           string     `json:"network"`
+	Symbol            string     `json:"symbol"`
+	FromAddress       string     `json:"from_address"`
+	ToAddress         string     `json:"to_address"`
+	Amount            string     `json:"amount"`
+	Status            string     `json:"status"`
+	RequiredApprovals uint       `json:"required_approvals"`
+	Approvals         uint       `json:"approvals"`
+	TransactionHash   string     `json:"transaction_hash,omitempty"`
+	Fee               string     `json:"fee,omitempty"`
+	ErrorMessage      string     `json:"error_message,omitempty"`
+	ApprovedAt        *time.Time `json:"approved_at,omitempty"`
+	ExecutedAt        *time.Time `json:"executed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// WithdrawalAuthorizationDTO tells the withdraw worker whether, and how much, it can withdraw now.
+type WithdrawalAuthorizationDTO struct {
+	Authorized bool
+	Amount     string  // Token units to withdraw when authorized
+	RequestID  *uint64 // Approved withdrawal request being sent, nil when the policy lets the withdrawal through
+}
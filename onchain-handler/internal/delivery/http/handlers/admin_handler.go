@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"gorm.io/gorm"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type adminHandler struct {
+	ucase ucasetypes.AdminUCase
+}
+
+func NewAdminHandler(ucase ucasetypes.AdminUCase) *adminHandler {
+	return &adminHandler{
+		ucase: ucase,
+	}
+}
+
+// GetBlockStates lists the listener cursors.
+// @Summary List block states
+// @Description Lists the latest block and the last processed block of every network.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator recorded in the audit log"
+// @Success 200 {array} dto.BlockStateDTO
+// @Failure 400 {object} http.GeneralError "Missing operator"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/block-states [get]
+func (h *adminHandler) GetBlockStates(ctx *gin.Context) {
+	blockStates, err := h.ucase.GetBlockStates(ctx, ctx.GetHeader("X-Operator"))
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get block states: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get block states", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, blockStates)
+}
+
+// ResetLastProcessedBlock moves the listener cursor of a network.
+// @Summary Reset the last processed block
+// @Description Sets the last processed block of a network, its confirmed events listener resumes from the block after it:
+// @Description a lower block listens to the blocks again, a higher one skips them. With listener sharding enabled the running shards keep their own cursors.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator recorded in the audit log"
+// @Param network path string true "Network"
+// @Param payload body dto.ResetLastProcessedBlockPayloadDTO true "Last processed block and reason"
+// @Success 200 {object} dto.BlockStateDTO
+// @Failure 400 {object} http.GeneralError "Invalid network, payload or block after the latest block"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/block-states/{network} [put]
+func (h *adminHandler) ResetLastProcessedBlock(ctx *gin.Context) {
+	network := ctx.Param("network")
+	if err := utils.ValidateNetworkType(network); err != nil {
+		logger.GetLogger().Errorf(errLogUnsupportedNetwork, network)
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf(errLogUnsupportedNetwork, network), err)
+		return
+	}
+
+	var req dto.ResetLastProcessedBlockPayloadDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to reset last processed block, invalid payload", err)
+		return
+	}
+
+	blockState, err := h.ucase.ResetLastProcessedBlock(
+		ctx, ctx.GetHeader("X-Operator"), constants.NetworkType(network), req.LastProcessedBlock, req.Reason,
+	)
+	if err != nil {
+		if errors.Is(err, ucasetypes.ErrBlockAfterLatest) {
+			httpresponse.Error(ctx, http.StatusBadRequest, "Block is after the latest block of the network", err)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to reset last processed block of network %s: %v", network, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to reset last processed block", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, blockState)
+}
+
+// ReleasePaymentWallet force-releases a payment wallet.
+// @Summary Release a payment wallet
+// @Description Marks a payment wallet not in use without a cooldown, even when an open order still uses it, so new orders can claim it.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator recorded in the audit log"
+// @Param id path int true "Payment wallet ID"
+// @Param payload body dto.AdminReasonPayloadDTO true "Reason"
+// @Success 200 {object} dto.PaymentWalletDTO
+// @Failure 400 {object} http.GeneralError "Invalid wallet ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Payment wallet not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/payment-wallets/{id}/release [post]
+func (h *adminHandler) ReleasePaymentWallet(ctx *gin.Context) {
+	h.updateWalletInUse(ctx, false)
+}
+
+// LockPaymentWallet locks a payment wallet.
+// @Summary Lock a payment wallet
+// @Description Marks a payment wallet in use so new orders do not claim it, until it is released.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator recorded in the audit log"
+// @Param id path int true "Payment wallet ID"
+// @Param payload body dto.AdminReasonPayloadDTO true "Reason"
+// @Success 200 {object} dto.PaymentWalletDTO
+// @Failure 400 {object} http.GeneralError "Invalid wallet ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Payment wallet not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/payment-wallets/{id}/lock [post]
+func (h *adminHandler) LockPaymentWallet(ctx *gin.Context) {
+	h.updateWalletInUse(ctx, true)
+}
+
+func (h *adminHandler) updateWalletInUse(ctx *gin.Context, inUse bool) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid payment wallet ID", err)
+		return
+	}
+
+	var req dto.AdminReasonPayloadDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to update payment wallet, invalid payload", err)
+		return
+	}
+
+	operator := ctx.GetHeader("X-Operator")
+	var wallet dto.PaymentWalletDTO
+	if inUse {
+		wallet, err = h.ucase.LockPaymentWallet(ctx, operator, id, req.Reason)
+	} else {
+		wallet, err = h.ucase.ReleasePaymentWallet(ctx, operator, id, req.Reason)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			httpresponse.Error(ctx, http.StatusNotFound, "Payment wallet not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to update payment wallet %d: %v", id, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to update payment wallet", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, wallet)
+}
+
+// SyncWalletBalances re-syncs payment wallet balances from the chain.
+// @Summary Re-sync payment wallet balances
+// @Description Fetches the USDT and USDC balances of a payment wallet on a network and stores them, or of every payment wallet
+// @Description with an address on the network when wallet_address is empty. The balances are returned by wallet address.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator recorded in the audit log"
+// @Param payload body dto.AdminSyncBalancesPayloadDTO true "Network, optional wallet address and reason"
+// @Success 200 {object} map[string]map[string]string
+// @Failure 400 {object} http.GeneralError "Invalid network, wallet address or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/payment-wallets/balances/sync [post]
+func (h *adminHandler) SyncWalletBalances(ctx *gin.Context) {
+	var req dto.AdminSyncBalancesPayloadDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to sync wallet balances, invalid payload", err)
+		return
+	}
+	if err := utils.ValidateNetworkType(req.Network.String()); err != nil {
+		logger.GetLogger().Errorf(errLogUnsupportedNetwork, req.Network)
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf(errLogUnsupportedNetwork, req.Network), err)
+		return
+	}
+	if req.WalletAddress != "" && !utils.IsValidAddress(req.Network.String(), req.WalletAddress) {
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid address: %s", req.WalletAddress), nil)
+		return
+	}
+
+	tokenSymbols := []string{constants.USDC, constants.USDT}
+	balances, err := h.ucase.SyncWalletBalances(ctx, ctx.GetHeader("X-Operator"), req, tokenSymbols)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to sync wallet balances on network %s: %v", req.Network, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to sync wallet balances", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, balances)
+}
+
+// ResolvePaymentOrder manually marks a payment order SUCCESS or FAILED.
+// @Summary Resolve a payment order
+// @Description Marks a payment order SUCCESS or FAILED whatever its payments, releases its payment wallet, stops listening for it
+// @Description and sends its webhook again. The outstanding amount of an order marked SUCCESS is counted as transferred.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator recorded in the audit log"
+// @Param Vendor-Id header string true "Vendor ID of the order"
+// @Param request_id path string true "Payment order request ID"
+// @Param payload body dto.ResolvePaymentOrderPayloadDTO true "Status and reason"
+// @Success 200 {object} dto.PaymentOrderDTOResponse
+// @Failure 400 {object} http.GeneralError "Invalid status, payload or headers"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Payment order not found"
+// @Failure 409 {object} http.GeneralError "Payment order is already resolved"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/payment-orders/{request_id}/resolve [post]
+func (h *adminHandler) ResolvePaymentOrder(ctx *gin.Context) {
+	requestID := ctx.Param("request_id")
+
+	var req dto.ResolvePaymentOrderPayloadDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to resolve payment order, invalid payload", err)
+		return
+	}
+	if req.Status != constants.Success && req.Status != constants.Failed {
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid status: %s", req.Status), nil)
+		return
+	}
+
+	order, err := h.ucase.ResolvePaymentOrder(
+		ctx, ctx.GetHeader("X-Operator"), ctx.GetHeader("Vendor-Id"), requestID, req.Status, req.Reason,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			logger.GetLogger().Warnf("Payment order not found for request ID %s", requestID)
+			httpresponse.Error(ctx, http.StatusNotFound, "Payment order not found", nil)
+		case errors.Is(err, ucasetypes.ErrPaymentOrderNotResolvable):
+			httpresponse.Error(ctx, http.StatusConflict, "Payment order is already resolved", err)
+		default:
+			logger.GetLogger().Errorf("Failed to resolve payment order for request ID %s: %v", requestID, err)
+			httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to resolve payment order", err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, order)
+}
+
+// GetOrderSet lists the orders the listeners currently watch.
+// @Summary View the order set
+// @Description Lists the in-memory order set of this instance, one item per option of a multi-chain order.
+// @Description It is only filled on instances running the workers.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator recorded in the audit log"
+// @Success 200 {array} dto.PaymentOrderDTO
+// @Failure 400 {object} http.GeneralError "Missing operator"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Router /admin/v1/order-set [get]
+func (h *adminHandler) GetOrderSet(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, h.ucase.GetOrderSet(ctx, ctx.GetHeader("X-Operator")))
+}
+
+// GetAuditLogs lists the audit log of the admin operations.
+// @Summary List the admin audit log
+// @Description Lists the admin operations, newest first, failed attempts included.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator"
+// @Param action query string false "Action, empty for every action"
+// @Param page query int false "Page number, default is 1"
+// @Param size query int false "Page size, default is 10"
+// @Success 200 {object} dto.PaginationDTOResponse
+// @Failure 400 {object} http.GeneralError "Invalid pagination parameters"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/audit-logs [get]
+func (h *adminHandler) GetAuditLogs(ctx *gin.Context) {
+	page, size, err := utils.ParsePaginationParams(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Invalid pagination parameters: %v", err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid pagination parameters", err)
+		return
+	}
+
+	var action *string
+	if actionStr := ctx.Query("action"); actionStr != "" {
+		action = &actionStr
+	}
+
+	auditLogs, err := h.ucase.GetAuditLogs(ctx, action, page, size)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get admin audit logs: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get admin audit logs", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, auditLogs)
+}
+
+// GetPaymentOrderAudits lists the audit trail of a payment order.
+// @Summary List the audit trail of a payment order
+// @Description Lists the changes of the status and transferred amount of a payment order, oldest first, with the listener,
+// @Description worker, vendor or operator that made them and the block and transaction of the payment.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Operator header string true "Operator"
+// @Param Vendor-Id header string true "Vendor ID of the order"
+// @Param request_id path string true "Payment order request ID"
+// @Success 200 {array} dto.PaymentOrderAuditDTO
+// @Failure 400 {object} http.GeneralError "Invalid headers"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Payment order not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /admin/v1/payment-orders/{request_id}/audit [get]
+func (h *adminHandler) GetPaymentOrderAudits(ctx *gin.Context) {
+	requestID := ctx.Param("request_id")
+
+	audits, err := h.ucase.GetPaymentOrderAudits(ctx, ctx.GetHeader("Vendor-Id"), requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.GetLogger().Warnf("Payment order not found for request ID %s", requestID)
+			httpresponse.Error(ctx, http.StatusNotFound, "Payment order not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to get audit trail of payment order %s: %v", requestID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get payment order audit trail", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, audits)
+}
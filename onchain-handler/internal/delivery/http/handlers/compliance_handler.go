@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type complianceHandler struct {
+	ucase ucasetypes.ComplianceUCase
+}
+
+func NewComplianceHandler(ucase ucasetypes.ComplianceUCase) *complianceHandler {
+	return &complianceHandler{
+		ucase: ucase,
+	}
+}
+
+// GetDeniedAddresses lists the deny-list of the payer screening.
+// @Summary List denied addresses
+// @Description Lists the payer addresses denied by the deny_list screening provider, besides the ones of the deny-list file.
+// @Description An empty network denies the address on any network.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Success 200 {array} dto.DeniedAddressDTO
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/compliance/denied-addresses [get]
+func (h *complianceHandler) GetDeniedAddresses(ctx *gin.Context) {
+	addresses, err := h.ucase.GetDeniedAddresses(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get denied addresses: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get denied addresses", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, addresses)
+}
+
+// UpsertDeniedAddress adds a payer address to the deny-list.
+// @Summary Deny an address
+// @Description Adds a payer address to the deny-list of a network, or of any network when it is empty, or replaces its reason.
+// @Description The payments from the address are held for review from then on.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param payload body dto.DeniedAddressPayloadDTO true "Address, network and reason"
+// @Success 200 {object} dto.DeniedAddressDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/compliance/denied-addresses [put]
+func (h *complianceHandler) UpsertDeniedAddress(ctx *gin.Context) {
+	var req dto.DeniedAddressPayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to deny address, invalid payload", err)
+		return
+	}
+	if req.Network != "" {
+		if err := utils.ValidateNetworkType(req.Network); err != nil {
+			logger.GetLogger().Errorf(errLogUnsupportedNetwork, req.Network)
+			httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf(errLogUnsupportedNetwork, req.Network), err)
+			return
+		}
+	}
+	if !utils.IsValidAddress(req.Network, req.Address) {
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid address: %s", req.Address), nil)
+		return
+	}
+
+	deniedAddress, err := h.ucase.UpsertDeniedAddress(ctx, req)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to deny address %s on network %q: %v", req.Address, req.Network, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to deny address", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deniedAddress)
+}
+
+// DeleteDeniedAddress removes a payer address from the deny-list.
+// @Summary Remove a denied address
+// @Description Removes a payer address from the deny-list of a network, or of any network when it is empty.
+// @Description The payments already held stay held until they are reviewed.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param address query string true "Address"
+// @Param network query string false "Network, empty for any network"
+// @Success 200 {object} map[string]bool "Success response: {\"success\": true}"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Address is not on the deny-list"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/compliance/denied-addresses [delete]
+func (h *complianceHandler) DeleteDeniedAddress(ctx *gin.Context) {
+	address := ctx.Query("address")
+	network := ctx.Query("network")
+
+	if err := h.ucase.DeleteDeniedAddress(ctx, address, network); err != nil {
+		if errors.Is(err, ucasetypes.ErrDeniedAddressNotFound) {
+			logger.GetLogger().Warnf("Denied address not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Address is not on the deny-list", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to remove denied address %s on network %q: %v", address, network, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to remove denied address", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GetComplianceHolds lists the payments held by the payer screening.
+// @Summary List compliance holds
+// @Description Lists the payments held by the payer screening, oldest first.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param status query string false "HELD, RELEASED or REJECTED, empty for every status"
+// @Success 200 {array} dto.ComplianceHoldDTO
+// @Failure 400 {object} http.GeneralError "Invalid status"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/compliance/holds [get]
+func (h *complianceHandler) GetComplianceHolds(ctx *gin.Context) {
+	status := ctx.Query("status")
+	switch status {
+	case "", constants.ComplianceHoldHeld, constants.ComplianceHoldReleased, constants.ComplianceHoldRejected:
+	default:
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid status: %s", status), nil)
+		return
+	}
+
+	holds, err := h.ucase.GetComplianceHolds(ctx, status)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get compliance holds: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get compliance holds", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, holds)
+}
+
+// ReleaseComplianceHold releases a held payment.
+// @Summary Release a held payment
+// @Description Credits a HELD payment to its order and payment wallet. Once the order has no held payment left
+// @Description it leaves ON_HOLD for the status of its payments and its webhook is sent.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Approver-Key header string true "Key of the reviewer recorded on the hold"
+// @Param id path int true "Compliance hold ID"
+// @Param payload body dto.TransferReviewPayloadDTO false "Review note"
+// @Success 200 {object} dto.ComplianceHoldDTO
+// @Failure 400 {object} http.GeneralError "Invalid compliance hold ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin or approver key"
+// @Failure 403 {object} http.GeneralError "Transfer reviews are disabled"
+// @Failure 404 {object} http.GeneralError "Compliance hold not found"
+// @Failure 409 {object} http.GeneralError "Compliance hold is not held"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/compliance/holds/{id}/release [post]
+func (h *complianceHandler) ReleaseComplianceHold(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid compliance hold ID", err)
+		return
+	}
+	reviewer, req, ok := bindTransferReview(ctx)
+	if !ok {
+		return
+	}
+
+	hold, err := h.ucase.ReleaseComplianceHold(auditContext(ctx), id, reviewer, req.Reason)
+	if err != nil {
+		h.reviewError(ctx, id, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, hold)
+}
+
+// RejectComplianceHold rejects a held payment.
+// @Summary Reject a held payment
+// @Description Rejects a HELD payment, it is never credited to its order and stays in the payment wallet
+// @Description until it is moved out by hand. Once the order has no held payment left it leaves ON_HOLD.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Approver-Key header string true "Key of the reviewer recorded on the hold"
+// @Param id path int true "Compliance hold ID"
+// @Param payload body dto.TransferReviewPayloadDTO false "Review note"
+// @Success 200 {object} dto.ComplianceHoldDTO
+// @Failure 400 {object} http.GeneralError "Invalid compliance hold ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin or approver key"
+// @Failure 403 {object} http.GeneralError "Transfer reviews are disabled"
+// @Failure 404 {object} http.GeneralError "Compliance hold not found"
+// @Failure 409 {object} http.GeneralError "Compliance hold is not held"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/compliance/holds/{id}/reject [post]
+func (h *complianceHandler) RejectComplianceHold(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid compliance hold ID", err)
+		return
+	}
+	reviewer, req, ok := bindTransferReview(ctx)
+	if !ok {
+		return
+	}
+
+	hold, err := h.ucase.RejectComplianceHold(auditContext(ctx), id, reviewer, req.Reason)
+	if err != nil {
+		h.reviewError(ctx, id, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, hold)
+}
+
+func (h *complianceHandler) reviewError(ctx *gin.Context, id uint64, err error) {
+	switch {
+	case errors.Is(err, ucasetypes.ErrComplianceHoldNotFound):
+		logger.GetLogger().Warnf("Compliance hold not found: %v", err)
+		httpresponse.Error(ctx, http.StatusNotFound, "Compliance hold not found", nil)
+	case errors.Is(err, ucasetypes.ErrComplianceHoldNotReviewable):
+		logger.GetLogger().Warnf("Compliance hold %d is not reviewable: %v", id, err)
+		httpresponse.Error(ctx, http.StatusConflict, "Compliance hold is not held", err)
+	default:
+		logger.GetLogger().Errorf("Failed to review compliance hold %d: %v", id, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to review compliance hold", err)
+	}
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+type gaslessPaymentHandler struct {
+	ucase ucasetypes.GaslessPaymentUCase
+}
+
+func NewGaslessPaymentHandler(ucase ucasetypes.GaslessPaymentUCase) *gaslessPaymentHandler {
+	return &gaslessPaymentHandler{
+		ucase: ucase,
+	}
+}
+
+// SubmitGaslessPayment relays a signed gasless payment for a payment order.
+// @Summary Submit a gasless payment
+// @Description This endpoint validates a signed EIP-3009 transferWithAuthorization or EIP-2612 permit paying the order and relays it from the relayer wallet.
+// @Tags gasless-payment
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID of the order"
+// @Param payload body dto.GaslessPaymentPayloadDTO true "Signed gasless payment"
+// @Success 200 {object} dto.GaslessPaymentDTO "Gasless payment relayed successfully"
+// @Failure 400 {object} http.GeneralError "Invalid payload, headers or signature"
+// @Failure 404 {object} http.GeneralError "Payment order not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/gasless-payments [post]
+func (h *gaslessPaymentHandler) SubmitGaslessPayment(ctx *gin.Context) {
+	var req dto.GaslessPaymentPayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to submit gasless payment, invalid payload", err)
+		return
+	}
+
+	response, err := h.ucase.SubmitGaslessPayment(ctx, ctx.GetHeader("Vendor-Id"), req)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to submit gasless payment for order %s: %v", req.RequestID, err)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			httpresponse.Error(ctx, http.StatusNotFound, "Payment order not found", err)
+		case errors.Is(err, ucasetypes.ErrInvalidGaslessPayment):
+			httpresponse.Error(ctx, http.StatusBadRequest, "Invalid gasless payment", err)
+		default:
+			httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to submit gasless payment", err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetRelayerAddress returns the relayer wallet address.
+// @Summary Get relayer address
+// @Description This endpoint returns the relayer wallet address, the spender to sign EIP-2612 permits for.
+// @Tags gasless-payment
+// @Produce json
+// @Success 200 {object} map[string]string "Relayer address"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/gasless-payments/relayer-address [get]
+func (h *gaslessPaymentHandler) GetRelayerAddress(ctx *gin.Context) {
+	address, err := h.ucase.GetRelayerAddress()
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get relayer address: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get relayer address", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"address": address})
+}
+
+// GetRelayerFees returns the gas paid by the relayer for the vendor's gasless payments.
+// @Summary Get relayer fees
+// @Description This endpoint returns the native token fees paid by the relayer for the vendor's gasless payments, per network.
+// @Tags gasless-payment
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Success 200 {object} []dto.RelayerFeeDTO "Relayer fees retrieved successfully"
+// @Failure 400 {object} http.GeneralError "Invalid vendor ID"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/gasless-payments/relayer-fees [get]
+func (h *gaslessPaymentHandler) GetRelayerFees(ctx *gin.Context) {
+	// Get the Vendor-Id from the header
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	fees, err := h.ucase.GetRelayerFees(ctx, vendorID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to retrieve relayer fees: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve relayer fees", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, fees)
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/database/postgresql"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+type invoiceHandler struct {
+	ucase ucasetypes.InvoiceUCase
+}
+
+func NewInvoiceHandler(ucase ucasetypes.InvoiceUCase) *invoiceHandler {
+	return &invoiceHandler{
+		ucase: ucase,
+	}
+}
+
+// CreateInvoice creates an invoice and a payment order for each of its line items.
+// @Summary Create an invoice
+// @Description Creates an invoice grouping several line items, each one paid by its own payment order on any network and token.
+// @Description The total is the sum of the item amounts, USDT and USDC are counted at face value. The invoice is PAID once the payments of its orders meet the total.
+// @Tags invoice
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param payload body dto.InvoicePayloadDTO true "Invoice request id, webhook url and line items. Each item is a payment order with a description."
+// @Success 201 {object} map[string]interface{} "Success created: {\"success\": true, \"data\": dto.CreatedInvoiceDTO}"
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 412 {object} http.GeneralError "Request ID already used"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/invoices [post]
+func (h *invoiceHandler) CreateInvoice(ctx *gin.Context) {
+	var req dto.InvoicePayloadDTO
+
+	// Get the Vendor-Id from the header
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to create invoice, invalid payload", err)
+		return
+	}
+	if err := validateInvoice(req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to create invoice, invalid payload", err)
+		return
+	}
+
+	response, err := h.ucase.CreateInvoice(auditContext(ctx), req, vendorID, conf.GetExpiredOrderTime())
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to create invoice %s: %v", req.RequestID, err)
+		if errors.Is(err, ucasetypes.ErrDuplicateRequestID) || postgresql.IsUniqueViolation(err) {
+			httpresponse.Error(ctx, http.StatusPreconditionFailed, "Failed to create invoice, request id already used", err)
+			return
+		}
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to create invoice", err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// GetInvoice retrieves an invoice of the vendor with the orders of its line items.
+// @Summary Retrieve an invoice
+// @Description Retrieves an invoice with its settled amount, status and the orders and payment events of its line items.
+// @Tags invoice
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param request_id path string true "Invoice request ID"
+// @Success 200 {object} dto.InvoiceDTO
+// @Failure 404 {object} http.GeneralError "Invoice not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/invoice/{request_id} [get]
+func (h *invoiceHandler) GetInvoice(ctx *gin.Context) {
+	vendorID := ctx.GetHeader("Vendor-Id")
+	requestID := ctx.Param("request_id")
+
+	invoice, err := h.ucase.GetInvoice(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, ucasetypes.ErrInvoiceNotFound) {
+			logger.GetLogger().Warnf("Invoice not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Invoice not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to retrieve invoice %s: %v", requestID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve invoice", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, invoice)
+}
+
+func validateInvoice(req dto.InvoicePayloadDTO) error {
+	if req.RequestID == "" {
+		return fmt.Errorf("request_id is required")
+	}
+	if len(req.Items) == 0 {
+		return fmt.Errorf("items must not be empty")
+	}
+
+	requestIDs := make(map[string]struct{}, len(req.Items))
+	for _, item := range req.Items {
+		if err := validatePaymentOrder(item.PaymentOrderPayloadDTO); err != nil {
+			return fmt.Errorf("item %s: %w", item.RequestID, err)
+		}
+		if _, exists := requestIDs[item.RequestID]; exists {
+			return fmt.Errorf("duplicate item request id: %s", item.RequestID)
+		}
+		requestIDs[item.RequestID] = struct{}{}
+	}
+	return nil
+}
@@ -1,11 +1,13 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -16,6 +18,7 @@ import (
 	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	"github.com/genefriendway/onchain-handler/pkg/database/postgresql"
 	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
@@ -46,10 +49,13 @@ func NewPaymentOrderHandler(
 // @Accept json
 // @Produce json
 // @Param Vendor-Id header string true "Vendor ID for authentication"
-// @Param payload body []dto.PaymentOrderPayloadDTO true "List of payment orders. Each order must include request id, amount, symbol (USDT or USDC) and network (AVAX C-Chain or BSC)."
+// @Param Idempotency-Key header string false "Replays the first response when the same request is retried with this key"
+// @Param payload body []dto.PaymentOrderPayloadDTO true "List of payment orders. Each order must include request id, amount, symbol (USDT or USDC) and network (AVAX C-Chain, BSC or TRON, which only supports USDT), and may set payment_mode (ADDRESS or ROUTER) and options, other networks and tokens the order can be paid with on the same payment address."
+// @Param qr_format query string false "Adds a QR code of each payment URI to the response (png or svg)"
 // @Success 201 {object} map[string]interface{} "Success created: {\"success\": true, \"data\": []dto.CreatedPaymentOrderDTO}"
 // @Failure 400 {object} http.GeneralError "Invalid payload"
-// @Failure 412 {object} http.GeneralError "Duplicate key value"
+// @Failure 409 {object} http.GeneralError "Idempotency-Key reused with a different request or still in progress"
+// @Failure 412 {object} http.GeneralError "Request ID already used"
 // @Failure 500 {object} http.GeneralError "Internal server error"
 // @Router /api/v1/payment-orders [post]
 func (h *paymentOrderHandler) CreateOrders(ctx *gin.Context) {
@@ -58,6 +64,13 @@ func (h *paymentOrderHandler) CreateOrders(ctx *gin.Context) {
 	// Get the Vendor-Id from the header
 	vendorID := ctx.GetHeader("Vendor-Id")
 
+	// Validate the optional QR code format
+	qrFormat := strings.ToLower(ctx.Query("qr_format"))
+	if qrFormat != "" && qrFormat != constants.QRCodeFormatPNG && qrFormat != constants.QRCodeFormatSVG {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid qr_format. Valid options are: png, svg", nil)
+		return
+	}
+
 	// Parse and validate the request payload
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		logger.GetLogger().Errorf(errLogInvalidPayload, err)
@@ -66,20 +79,28 @@ func (h *paymentOrderHandler) CreateOrders(ctx *gin.Context) {
 	}
 
 	// Validate each payment order
+	requestIDs := make(map[string]struct{}, len(req))
 	for _, order := range req {
 		if err := validatePaymentOrder(order); err != nil {
 			logger.GetLogger().Errorf("Validation failed for request id %s: %v", order.RequestID, err)
 			httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Failed to create payment orders, validation failed for request id: %s", order.RequestID), err)
 			return
 		}
+		if _, exists := requestIDs[order.RequestID]; exists {
+			logger.GetLogger().Errorf("Duplicate request id in payload: %s", order.RequestID)
+			httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Failed to create payment orders, duplicate request id in payload: %s", order.RequestID), nil)
+			return
+		}
+		requestIDs[order.RequestID] = struct{}{}
 	}
 
 	// Call the use case to create the payment orders
-	response, err := h.ucase.CreatePaymentOrders(ctx, req, vendorID, conf.GetExpiredOrderTime())
+	response, err := h.ucase.CreatePaymentOrders(auditContext(ctx), req, vendorID, conf.GetExpiredOrderTime())
 	if err != nil {
 		logger.GetLogger().Errorf("Failed to create payment orders: %v", err)
-		if postgresql.IsUniqueViolation(err) {
-			httpresponse.Error(ctx, http.StatusPreconditionFailed, "Failed to create payment orders, duplicate key value violates unique constraint", err)
+		// The unique violation covers a concurrent request creating an order with the same request id
+		if errors.Is(err, ucasetypes.ErrDuplicateRequestID) || postgresql.IsUniqueViolation(err) {
+			httpresponse.Error(ctx, http.StatusPreconditionFailed, "Failed to create payment orders, request id already used", err)
 			return
 		} else {
 			httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to create payment orders", err)
@@ -87,6 +108,21 @@ func (h *paymentOrderHandler) CreateOrders(ctx *gin.Context) {
 		}
 	}
 
+	// Render the QR code of each payment URI when requested
+	if qrFormat != "" {
+		for index := range response {
+			if response[index].PaymentURI == "" {
+				continue
+			}
+			qrCode, err := utils.GenerateQRCodeDataURI(response[index].PaymentURI, qrFormat)
+			if err != nil {
+				logger.GetLogger().Errorf("Failed to generate QR code for request id %s: %v", response[index].RequestID, err)
+				continue
+			}
+			response[index].QRCode = qrCode
+		}
+	}
+
 	// Respond with success and response data
 	ctx.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -106,7 +142,7 @@ func (h *paymentOrderHandler) CreateOrders(ctx *gin.Context) {
 // @Param request_ids query []string false "List of request IDs to filter (maximum 50)"
 // @Param from_address query string false "Filter by sender's address (from_address)"
 // @Param network query string false "Filter by network (e.g., BSC, AVAX C-Chain)"
-// @Param status query string false "Status filter (e.g., PENDING, PROCESSING, SUCCESS, PARTIAL, EXPIRED, FAILED)"
+// @Param status query string false "Status filter (e.g., PENDING, PROCESSING, SUCCESS, PARTIAL, EXPIRED, FAILED, CANCELLED, OVERPAID)"
 // @Param sort query string false "Sorting parameter in the format `field_direction` (e.g., id_asc, created_at_desc, succeeded_at_desc)"
 // @Param start_time query int false "Start time in UNIX timestamp format to filter (e.g., 1704067200)"
 // @Param end_time query int false "End time in UNIX timestamp format to filter (e.g., 1706745600)"
@@ -228,13 +264,14 @@ func (h *paymentOrderHandler) GetPaymentOrders(ctx *gin.Context) {
 
 // GetPaymentOrderByRequestID retrieves a payment order by its request ID.
 // @Summary Retrieve payment order by request ID
-// @Description This endpoint retrieves a payment order by its request ID, which can contain special characters.
+// @Description This endpoint retrieves a payment order of the vendor by its request ID, which can contain special characters.
 // @Tags payment-order
 // @Accept json
 // @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
 // @Param request_id path string true "Payment order request ID"
 // @Success 200 {object} dto.PaymentOrderDTOResponse "Successful retrieval of payment order"
-// @Failure 400 {object} http.GeneralError "Invalid request ID"
+// @Failure 400 {object} http.GeneralError "Invalid request ID or headers"
 // @Failure 404 {object} http.GeneralError "Payment order not found"
 // @Failure 500 {object} http.GeneralError "Internal server error"
 // @Router /api/v1/payment-order/{request_id} [get]
@@ -248,7 +285,7 @@ func (h *paymentOrderHandler) GetPaymentOrderByRequestID(ctx *gin.Context) {
 	}
 
 	// Delegate to the use case layer
-	response, err := h.ucase.GetPaymentOrderByRequestID(ctx, requestID)
+	response, err := h.ucase.GetPaymentOrderByRequestID(ctx, ctx.GetHeader("Vendor-Id"), requestID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			logger.GetLogger().Warnf("Payment order not found for request ID %s", requestID)
@@ -260,11 +297,107 @@ func (h *paymentOrderHandler) GetPaymentOrderByRequestID(ctx *gin.Context) {
 		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve payment order", err)
 		return
 	}
+	response.PaymentURI = h.ucase.GetPaymentURI(ctx, response)
 
 	// Return the payment order in JSON format
 	ctx.JSON(http.StatusOK, response)
 }
 
+// CancelPaymentOrder cancels a pending payment order by its request ID.
+// @Summary Cancel payment order
+// @Description Cancels a payment order in `PENDING` status. The order moves to `CANCELLED`, stops being listened for, its payment wallet is released after the wallet release cooldown, its statistics are reverted and its webhook is sent.
+// @Description Orders in any other status, such as `PROCESSING` or `PARTIAL`, are rejected. Only the vendor of the order can cancel it.
+// @Tags payment-order
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param request_id path string true "Payment order request ID"
+// @Success 200 {object} dto.PaymentOrderDTOResponse "Cancelled payment order"
+// @Failure 400 {object} http.GeneralError "Invalid request ID or headers"
+// @Failure 404 {object} http.GeneralError "Payment order not found"
+// @Failure 409 {object} http.GeneralError "Payment order is not pending"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/payment-order/{request_id}/cancel [post]
+func (h *paymentOrderHandler) CancelPaymentOrder(ctx *gin.Context) {
+	requestID := ctx.Param("request_id")
+	if requestID == "" {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to cancel payment order, request ID cannot be empty", nil)
+		return
+	}
+
+	// Get the Vendor-Id from the header
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	response, err := h.ucase.CancelPaymentOrder(auditContext(ctx), vendorID, requestID)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			logger.GetLogger().Warnf("Payment order not found for request ID %s", requestID)
+			httpresponse.Error(ctx, http.StatusNotFound, "Payment order not found", nil)
+		case errors.Is(err, ucasetypes.ErrPaymentOrderNotCancellable):
+			logger.GetLogger().Warnf("Rejected cancellation of payment order %s: %v", requestID, err)
+			httpresponse.Error(ctx, http.StatusConflict, "Only PENDING payment orders can be cancelled", err)
+		default:
+			logger.GetLogger().Errorf("Failed to cancel payment order for request ID %s: %v", requestID, err)
+			httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to cancel payment order", err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ExtendPaymentOrder extends the expiry of a payment order by its request ID.
+// @Summary Extend payment order expiry
+// @Description Pushes out the expiry of a payment order in `PENDING` or `PARTIAL` status by `extend_time` minutes, counted from now when the order is already past its expiry.
+// @Description The order cannot expire later than `MAX_EXPIRED_ORDER_TIME` minutes from now. Only the vendor of the order can extend it.
+// @Tags payment-order
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param request_id path string true "Payment order request ID"
+// @Param payload body dto.ExtendPaymentOrderPayloadDTO true "Minutes to extend the expiry by"
+// @Success 200 {object} dto.PaymentOrderDTOResponse "Extended payment order"
+// @Failure 400 {object} http.GeneralError "Invalid payload or headers"
+// @Failure 404 {object} http.GeneralError "Payment order not found"
+// @Failure 409 {object} http.GeneralError "Payment order cannot be extended"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/payment-order/{request_id}/extend [post]
+func (h *paymentOrderHandler) ExtendPaymentOrder(ctx *gin.Context) {
+	requestID := ctx.Param("request_id")
+	if requestID == "" {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to extend payment order, request ID cannot be empty", nil)
+		return
+	}
+
+	var req dto.ExtendPaymentOrderPayloadDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to extend payment order, invalid payload", err)
+		return
+	}
+
+	// Get the Vendor-Id from the header
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	response, err := h.ucase.ExtendPaymentOrder(auditContext(ctx), vendorID, requestID, time.Duration(req.ExtendTime)*time.Minute)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			logger.GetLogger().Warnf("Payment order not found for request ID %s", requestID)
+			httpresponse.Error(ctx, http.StatusNotFound, "Payment order not found", nil)
+		case errors.Is(err, ucasetypes.ErrPaymentOrderNotExtendable):
+			logger.GetLogger().Warnf("Rejected extension of payment order %s: %v", requestID, err)
+			httpresponse.Error(ctx, http.StatusConflict, "Payment order cannot be extended", err)
+		default:
+			logger.GetLogger().Errorf("Failed to extend payment order for request ID %s: %v", requestID, err)
+			httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to extend payment order", err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // UpdatePaymentOrderByRequestID updates network and/or symbol of a payment order by request ID.
 // @Summary Update payment order fields
 // @Description Updates one or both of the following fields of a payment order:
@@ -278,10 +411,11 @@ func (h *paymentOrderHandler) GetPaymentOrderByRequestID(ctx *gin.Context) {
 // @Tags payment-order
 // @Accept json
 // @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
 // @Param request_id path string true "Payment order request ID"
 // @Param payload body dto.UpdatePaymentOrderPayloadDTO true "Fields to update (must include at least 'network' or 'symbol')"
 // @Success 200 {object} map[string]interface{} "Success response: {\"success\": true}"
-// @Failure 400 {object} http.GeneralError "Missing fields, invalid values, or non-PENDING order"
+// @Failure 400 {object} http.GeneralError "Missing fields, invalid values or headers, or non-PENDING order"
 // @Failure 404 {object} http.GeneralError "Payment order not found"
 // @Failure 500 {object} http.GeneralError "Internal server error"
 // @Router /api/v1/payment-order/{request_id} [put]
@@ -328,11 +462,15 @@ func (h *paymentOrderHandler) UpdatePaymentOrderByRequestID(ctx *gin.Context) {
 		Symbol:  req.Symbol,
 	}
 
-	if err := h.ucase.UpdateOrderMetaByRequestID(ctx, requestID, payload); err != nil {
+	if err := h.ucase.UpdateOrderMetaByRequestID(auditContext(ctx), ctx.GetHeader("Vendor-Id"), requestID, payload); err != nil {
 		if errors.Is(err, repotypes.ErrPaymentOrderNotFound) {
 			httpresponse.Error(ctx, http.StatusNotFound, "Pending payment order not found", nil)
 			return
 		}
+		if errors.Is(err, ucasetypes.ErrUnsupportedOrderChange) {
+			httpresponse.Error(ctx, http.StatusBadRequest, "Failed to update payment order, unsupported change", err)
+			return
+		}
 		logger.GetLogger().Errorf("Failed to update payment order: %v", err)
 		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to update payment order", err)
 		return
@@ -347,9 +485,10 @@ func (h *paymentOrderHandler) UpdatePaymentOrderByRequestID(ctx *gin.Context) {
 // @Tags payment-order
 // @Accept json
 // @Produce json
-// @Param payload body dto.PaymentOrderNetworkPayloadDTO true "Payment order ID and network (AVAX C-Chain or BSC)."
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param payload body dto.PaymentOrderNetworkPayloadDTO true "Payment order ID and network (AVAX C-Chain, BSC or TRON)."
 // @Success 200 {object} map[string]interface{} "Success response: {\"success\": true}"
-// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 400 {object} http.GeneralError "Invalid payload or headers"
 // @Failure 400 {object} http.GeneralError "Unsupported network"
 // @Failure 500 {object} http.GeneralError "Internal server error"
 // @Router /api/v1/payment-order/network [put]
@@ -369,16 +508,12 @@ func (h *paymentOrderHandler) UpdatePaymentOrderNetwork(ctx *gin.Context) {
 		return
 	}
 
-	// Determine the network type
-	var network constants.NetworkType
-	if req.Network == constants.AvaxCChain.String() {
-		network = constants.AvaxCChain
-	} else {
-		network = constants.Bsc
-	}
-
 	// Call the use case to update the payment order network
-	if err := h.ucase.UpdateOrderNetwork(ctx, req.RequestID, network); err != nil {
+	if err := h.ucase.UpdateOrderNetwork(auditContext(ctx), ctx.GetHeader("Vendor-Id"), req.RequestID, constants.NetworkType(req.Network)); err != nil {
+		if errors.Is(err, ucasetypes.ErrUnsupportedOrderChange) {
+			httpresponse.Error(ctx, http.StatusBadRequest, "Failed to update payment order network, unsupported change", err)
+			return
+		}
 		logger.GetLogger().Errorf("Failed to update payment order network: %v", err)
 		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to update payment order network", err)
 		return
@@ -390,6 +525,20 @@ func (h *paymentOrderHandler) UpdatePaymentOrderNetwork(ctx *gin.Context) {
 	})
 }
 
+// auditContext returns the context of the request whose payment order changes are audited under the operator
+// or approver of admin requests, the vendor of vendor requests, or as made through the API.
+func auditContext(ctx *gin.Context) context.Context {
+	actor := constants.AuditActorAPI
+	if operator := ctx.GetHeader("X-Operator"); operator != "" {
+		actor = utils.AuditActor(constants.AuditActorAdmin, operator)
+	} else if approver := ctx.GetString(constants.ApproverContextKey); approver != "" {
+		actor = utils.AuditActor(constants.AuditActorAdmin, approver)
+	} else if vendorID := ctx.GetHeader("Vendor-Id"); vendorID != "" {
+		actor = utils.AuditActor(constants.AuditActorVendor, vendorID)
+	}
+	return utils.WithAuditActor(ctx, actor)
+}
+
 // validatePaymentOrder performs validation checks on the payment order.
 func validatePaymentOrder(order dto.PaymentOrderPayloadDTO) error {
 	// Validate amount is a valid float
@@ -407,5 +556,59 @@ func validatePaymentOrder(order dto.PaymentOrderPayloadDTO) error {
 		return err
 	}
 
+	// Validate the token is supported on the network, Tron only supports USDT and may be disabled
+	if _, err := conf.GetTokenAddress(order.Symbol, order.Network); err != nil {
+		return fmt.Errorf("%s is not supported on network %s", order.Symbol, order.Network)
+	}
+
+	// Validate the expiry time of the order
+	if order.ExpiredOrderTime > 0 {
+		minExpiredTime, maxExpiredTime := conf.GetExpiredOrderTimeBounds()
+		expiredTime := time.Duration(order.ExpiredOrderTime) * time.Minute
+		if expiredTime < minExpiredTime || expiredTime > maxExpiredTime {
+			return fmt.Errorf("expired_order_time must be between %s and %s", minExpiredTime, maxExpiredTime)
+		}
+	}
+
+	// Validate payment mode, router payments need a payment router deployed on the network
+	switch order.PaymentMode {
+	case "", constants.PaymentModeAddress:
+	case constants.PaymentModeRouter:
+		if conf.GetPaymentRouterAddress(order.Network) == "" {
+			return fmt.Errorf("payment router is not configured for network %s", order.Network)
+		}
+	default:
+		return fmt.Errorf("unsupported payment mode: %s", order.PaymentMode)
+	}
+
+	return validatePaymentOptions(order)
+}
+
+// validatePaymentOptions checks the options of a multi-chain order: supported tokens on networks of the chain family
+// of the order network, so that the payer pays all of them to the same payment address.
+func validatePaymentOptions(order dto.PaymentOrderPayloadDTO) error {
+	if len(order.Options) == 0 {
+		return nil
+	}
+	if order.PaymentMode == constants.PaymentModeRouter {
+		return fmt.Errorf("options are not supported for %s payments", constants.PaymentModeRouter)
+	}
+
+	seen := make(map[dto.PaymentOptionDTO]bool)
+	for _, option := range order.Options {
+		if err := utils.ValidateNetworkType(option.Network); err != nil {
+			return fmt.Errorf("invalid option: %w", err)
+		}
+		if _, err := conf.GetTokenAddress(option.Symbol, option.Network); err != nil {
+			return fmt.Errorf("invalid option: %s is not supported on network %s", option.Symbol, option.Network)
+		}
+		if chain.ForNetwork(constants.NetworkType(option.Network)) != chain.ForNetwork(constants.NetworkType(order.Network)) {
+			return fmt.Errorf("invalid option: network %s is not paid to the same address as network %s", option.Network, order.Network)
+		}
+		if seen[option] {
+			return fmt.Errorf("duplicate option: %s on network %s", option.Symbol, option.Network)
+		}
+		seen[option] = true
+	}
 	return nil
 }
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+//go:embed templates/payment_page.html
+var paymentPageHTML string
+
+var paymentPageTemplate = template.Must(template.New("payment_page").Parse(paymentPageHTML))
+
+// paymentPageData is rendered by the payment page template. The URIs are typed as template.URL
+// so that the ethereum: and data: schemes are not sanitized away.
+type paymentPageData struct {
+	Order           dto.PaymentOrderDTOResponse
+	PaymentURI      template.URL
+	QRCode          template.URL
+	RouterAddress   string
+	RouterOrderID   string
+	RefreshInterval int64 // Milliseconds between two order status refreshes
+}
+
+type paymentPageHandler struct {
+	ucase ucasetypes.PaymentOrderUCase
+}
+
+func NewPaymentPageHandler(ucase ucasetypes.PaymentOrderUCase) *paymentPageHandler {
+	return &paymentPageHandler{
+		ucase: ucase,
+	}
+}
+
+// GetPaymentPage serves the hosted checkout page of a payment order.
+// @Summary Hosted payment page
+// @Description This endpoint serves an HTML checkout page showing the amount, token, network, a countdown to expiry and a QR code of the EIP-681 payment URI. The status refreshes live.
+// @Tags payment-order
+// @Produce html
+// @Param vendor_id path string true "Vendor ID of the order"
+// @Param request_id path string true "Payment order request ID"
+// @Success 200 {string} string "Payment page"
+// @Failure 404 {string} string "Payment order not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /pay/{vendor_id}/{request_id} [get]
+func (h *paymentPageHandler) GetPaymentPage(ctx *gin.Context) {
+	vendorID := ctx.Param("vendor_id")
+	requestID := ctx.Param("request_id")
+
+	// Request IDs are unique per vendor, the page is opened by the payer who has no Vendor-Id header to send
+	order, err := h.ucase.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.String(http.StatusNotFound, "Payment order not found")
+			return
+		}
+		logger.GetLogger().Errorf("Failed to retrieve payment order for payment page %s: %v", requestID, err)
+		ctx.String(http.StatusInternalServerError, "Failed to retrieve payment order")
+		return
+	}
+	order.PaymentURI = h.ucase.GetPaymentURI(ctx, order)
+
+	data := paymentPageData{
+		Order:           order,
+		PaymentURI:      template.URL(order.PaymentURI),
+		RefreshInterval: constants.PaymentPageRefreshInterval.Milliseconds(),
+	}
+	if order.PaymentMode == constants.PaymentModeRouter {
+		data.RouterAddress = conf.GetPaymentRouterAddress(order.Network)
+		data.RouterOrderID = dto.RouterOrderID(order.ID)
+	}
+	if order.PaymentURI != "" {
+		qrCode, err := utils.GenerateQRCodeDataURI(order.PaymentURI, constants.QRCodeFormatSVG)
+		if err != nil {
+			logger.GetLogger().Errorf("Failed to generate QR code for payment page %s: %v", requestID, err)
+		}
+		data.QRCode = template.URL(qrCode)
+	}
+
+	var page bytes.Buffer
+	if err := paymentPageTemplate.Execute(&page, data); err != nil {
+		logger.GetLogger().Errorf("Failed to render payment page %s: %v", requestID, err)
+		ctx.String(http.StatusInternalServerError, "Failed to render payment page")
+		return
+	}
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", page.Bytes())
+}
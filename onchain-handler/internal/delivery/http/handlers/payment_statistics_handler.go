@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -14,18 +15,24 @@ import (
 )
 
 type paymentStatisticsHandler struct {
-	ucase ucasetypes.PaymentStatisticsUCase
+	ucase        ucasetypes.PaymentStatisticsUCase
+	gasCostUCase ucasetypes.GasCostUCase
 }
 
-func NewPaymentStatisticsHandler(ucase ucasetypes.PaymentStatisticsUCase) *paymentStatisticsHandler {
+func NewPaymentStatisticsHandler(
+	ucase ucasetypes.PaymentStatisticsUCase,
+	gasCostUCase ucasetypes.GasCostUCase,
+) *paymentStatisticsHandler {
 	return &paymentStatisticsHandler{
-		ucase: ucase,
+		ucase:        ucase,
+		gasCostUCase: gasCostUCase,
 	}
 }
 
 // GetPaymentStatistics retrieves payment statistics by granularity and time range.
 // @Summary Retrieve payment statistics
 // @Description This endpoint retrieves payment statistics based on granularity and time range.
+// @Description total_network_fee is the USD value of the network fees of the sweeps attributed to the orders.
 // @Tags payment-statistics
 // @Accept json
 // @Produce json
@@ -52,32 +59,85 @@ func (h *paymentStatisticsHandler) GetPaymentStatistics(ctx *gin.Context) {
 		return
 	}
 
+	startTime, endTime, symbols, ok := parseStatisticsQuery(ctx)
+	if !ok {
+		return
+	}
+
+	// Call the use case to retrieve statistics
+	paymentStatistics, err := h.ucase.GetStatisticsByTimeRangeAndGranularity(ctx, granularity, startTime, endTime, vendorID, symbols)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to retrieve payment statistics: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve payment statistics", err)
+		return
+	}
+
+	// Return the response
+	ctx.JSON(http.StatusOK, paymentStatistics)
+}
+
+// GetNetworkFeeStatistics retrieves the network fees attributed to the orders of the vendor.
+// @Summary Retrieve network fee statistics
+// @Description This endpoint retrieves, by network and token, the gas costs of the sweeps of the payment wallets attributed to the
+// @Description orders of the vendor in the time range. A sweep fee is split between the orders whose funds it moved, proportionally
+// @Description to their amounts. Fees are in native token units and priced in USD when they are attributed.
+// @Tags payment-statistics
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param start_time query int true "Start time in UNIX timestamp format"
+// @Param end_time query int true "End time in UNIX timestamp format"
+// @Param symbols query []string false "Filter by one or more symbols (e.g., USDT, USDC)"
+// @Success 200 {object} []dto.NetworkFeeStats "Network fee statistics retrieved successfully"
+// @Failure 400 {object} http.GeneralError "Invalid parameters"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/payment-statistics/network-fees [get]
+func (h *paymentStatisticsHandler) GetNetworkFeeStatistics(ctx *gin.Context) {
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	startTime, endTime, symbols, ok := parseStatisticsQuery(ctx)
+	if !ok {
+		return
+	}
+
+	networkFees, err := h.gasCostUCase.GetNetworkFeeStatistics(ctx, vendorID, startTime, endTime, symbols)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to retrieve network fee statistics: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve network fee statistics", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, networkFees)
+}
+
+// parseStatisticsQuery reads the start_time, end_time and optional symbols query parameters,
+// it writes the error response and returns false when they are invalid.
+func parseStatisticsQuery(ctx *gin.Context) (startTime, endTime time.Time, symbols []string, ok bool) {
 	// Parse and validate start_time parameter
-	startTime, err := utils.ParseOptionalUnixTimestamp(ctx.Query("start_time"))
+	start, err := utils.ParseOptionalUnixTimestamp(ctx.Query("start_time"))
 	if err != nil {
 		logger.GetLogger().Errorf("Invalid start_time: %v", err)
 		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid start_time. Provide a valid UNIX timestamp.", err)
-		return
+		return time.Time{}, time.Time{}, nil, false
 	}
 
 	// Parse and validate end_time parameter
-	endTime, err := utils.ParseOptionalUnixTimestamp(ctx.Query("end_time"))
+	end, err := utils.ParseOptionalUnixTimestamp(ctx.Query("end_time"))
 	if err != nil {
 		logger.GetLogger().Errorf("Invalid end_time: %v", err)
 		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid end_time. Provide a valid UNIX timestamp.", err)
-		return
+		return time.Time{}, time.Time{}, nil, false
 	}
 
 	// Return an error if start_time is later than end_time
-	if startTime.After(*endTime) {
+	if start.After(*end) {
 		httpresponse.Error(ctx, http.StatusBadRequest, "start_time must be earlier than end_time", nil)
-		return
+		return time.Time{}, time.Time{}, nil, false
 	}
 
 	// Optional parse symbols[] query param: symbols=USDT,USDC
 	symbolsParam := ctx.Query("symbols")
 	symbolsMap := make(map[string]struct{})
-	var symbols []string
 
 	if symbolsParam != "" {
 		for _, s := range strings.Split(symbolsParam, ",") {
@@ -91,14 +151,5 @@ func (h *paymentStatisticsHandler) GetPaymentStatistics(ctx *gin.Context) {
 		}
 	}
 
-	// Call the use case to retrieve statistics
-	paymentStatistics, err := h.ucase.GetStatisticsByTimeRangeAndGranularity(ctx, granularity, *startTime, *endTime, vendorID, symbols)
-	if err != nil {
-		logger.GetLogger().Errorf("Failed to retrieve payment statistics: %v", err)
-		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve payment statistics", err)
-		return
-	}
-
-	// Return the response
-	ctx.JSON(http.StatusOK, paymentStatistics)
+	return *start, *end, symbols, true
 }
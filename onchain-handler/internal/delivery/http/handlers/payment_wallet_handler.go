@@ -124,11 +124,64 @@ func (h *paymentWalletHandler) GetReceivingWalletAddress(ctx *gin.Context) {
 	}
 
 	// Return JSON response with both address and balances
-	ctx.JSON(http.StatusOK, gin.H{
+	response := gin.H{
 		"success":                  true,
 		"receiving_wallet_address": address,
 		"native_balances":          balances,
-	})
+	}
+	if !h.addTronWalletAddress(ctx, response, "tron_receiving_wallet_address", constants.ReceivingWallet) {
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetGasSourceWalletAddress retrieves the gas source wallet address along with native balances across networks.
+// @Summary Retrieves the gas source wallet address and its native balances.
+// @Description Retrieves the address of the wallet refilling the receiving wallet with native gas when it drops below its floor,
+// @Description and its native balances across different networks. Keep it funded with the native token of each network.
+// @Tags payment-wallet
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Success response: {\"success\": true, \"gas_source_wallet_address\": \"0x123...abc\", \"native_balances\": {\"BSC\": \"12.5\", \"AVAX C-Chain\": \"20.3\"}}"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/payment-wallets/gas-source-address [get]
+func (h *paymentWalletHandler) GetGasSourceWalletAddress(ctx *gin.Context) {
+	address, balances, err := h.ucase.GetGasSourceWalletAddressWithBalances(
+		ctx, h.config.Wallet.Mnemonic, h.config.Wallet.Passphrase, h.config.Wallet.Salt,
+	)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get gas source wallet address", err)
+		return
+	}
+
+	response := gin.H{
+		"success":                   true,
+		"gas_source_wallet_address": address,
+		"native_balances":           balances,
+	}
+	if !h.addTronWalletAddress(ctx, response, "tron_gas_source_wallet_address", constants.GasSourceWallet) {
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// addTronWalletAddress adds the address of the wallet on Tron to the response when Tron is enabled,
+// the wallet is derived with the Tron coin type so its address differs from the EVM one.
+func (h *paymentWalletHandler) addTronWalletAddress(
+	ctx *gin.Context, response gin.H, key string, walletType constants.WalletType,
+) bool {
+	if !conf.IsTronEnabled() {
+		return true
+	}
+	address, err := h.ucase.GetNetworkWalletAddress(
+		constants.Tron, walletType, h.config.Wallet.Mnemonic, h.config.Wallet.Passphrase, h.config.Wallet.Salt,
+	)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get Tron wallet address", err)
+		return false
+	}
+	response[key] = address
+	return true
 }
 
 // SyncPaymentWalletBalance syncs the balances of a specific payment wallet for multiple tokens.
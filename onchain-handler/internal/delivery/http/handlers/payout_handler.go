@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/database/postgresql"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+type payoutHandler struct {
+	ucase ucasetypes.PayoutUCase
+}
+
+func NewPayoutHandler(ucase ucasetypes.PayoutUCase) *payoutHandler {
+	return &payoutHandler{
+		ucase: ucase,
+	}
+}
+
+// RegisterUserWallet registers the wallet the payouts of a user are sent to.
+// @Summary Register a user wallet
+// @Description Registers the wallet address of a user, replacing the one registered before. Payouts requested for the user are sent to it.
+// @Tags payout
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param payload body dto.UserWalletPayloadDTO true "User ID and wallet address"
+// @Success 200 {object} dto.UserWalletDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 409 {object} http.GeneralError "Address already registered for another user"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/user-wallets [put]
+func (h *payoutHandler) RegisterUserWallet(ctx *gin.Context) {
+	var req dto.UserWalletPayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to register user wallet, invalid payload", err)
+		return
+	}
+	if req.UserID == 0 || !common.IsHexAddress(req.Address) {
+		err := fmt.Errorf("user_id and a valid address are required")
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to register user wallet, invalid payload", err)
+		return
+	}
+
+	wallet, err := h.ucase.RegisterUserWallet(ctx, req)
+	if err != nil {
+		if postgresql.IsUniqueViolation(err) {
+			logger.GetLogger().Warnf("Address %s is already registered for another user: %v", req.Address, err)
+			httpresponse.Error(ctx, http.StatusConflict, "Failed to register user wallet, address already registered for another user", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to register wallet of user %d: %v", req.UserID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to register user wallet", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, wallet)
+}
+
+// GetUserWallet retrieves the wallet registered for a user.
+// @Summary Retrieve a user wallet
+// @Description Retrieves the wallet address the payouts of the user are sent to.
+// @Tags payout
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param user_id path int true "User ID"
+// @Success 200 {object} dto.UserWalletDTO
+// @Failure 400 {object} http.GeneralError "Invalid user ID"
+// @Failure 404 {object} http.GeneralError "User wallet not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/user-wallet/{user_id} [get]
+func (h *payoutHandler) GetUserWallet(ctx *gin.Context) {
+	userID, err := strconv.ParseUint(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid user ID", err)
+		return
+	}
+
+	wallet, err := h.ucase.GetUserWallet(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ucasetypes.ErrUserWalletNotFound) {
+			logger.GetLogger().Warnf("User wallet not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "User wallet not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to retrieve wallet of user %d: %v", userID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve user wallet", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, wallet)
+}
+
+// GetPayoutWalletAddress returns the payout wallet address.
+// @Summary Get payout wallet address
+// @Description This endpoint returns the address of the payout wallet the payouts are sent from. It has to hold the paid out tokens and the native token for gas.
+// @Tags payout
+// @Produce json
+// @Success 200 {object} map[string]string "Payout wallet address"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/payouts/payout-address [get]
+func (h *payoutHandler) GetPayoutWalletAddress(ctx *gin.Context) {
+	address, err := h.ucase.GetPayoutWalletAddress()
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get payout wallet address: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get payout wallet address", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"address": address})
+}
+
+// CreatePayouts requests payouts to user wallets.
+// @Summary Create payouts
+// @Description Creates the payouts, none of them is created when one is invalid. Each payout is sent to the wallet registered for user_id,
+// @Description or to to_address, once approved. The transfer policy of its network and token makes it APPROVED, PENDING_APPROVAL
+// @Description or REJECTED, payouts wait for one approval when no policy matches. Its webhook_url receives the payout when it is sent, failed or rejected.
+// @Tags payout
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param Idempotency-Key header string false "Replays the first response when the same request is retried with this key"
+// @Param payload body []dto.PayoutPayloadDTO true "List of payouts. Each payout must include request id, user id or to address, amount, symbol (USDT or USDC) and network (AVAX C-Chain or BSC)."
+// @Success 201 {object} map[string]interface{} "Success created: {\"success\": true, \"data\": []dto.PayoutDTO}"
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 404 {object} http.GeneralError "User wallet not found"
+// @Failure 409 {object} http.GeneralError "Idempotency-Key reused with a different request or still in progress"
+// @Failure 412 {object} http.GeneralError "Request ID already used"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/payouts [post]
+func (h *payoutHandler) CreatePayouts(ctx *gin.Context) {
+	var req []dto.PayoutPayloadDTO
+
+	// Get the Vendor-Id from the header
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to create payouts, invalid payload", err)
+		return
+	}
+	if len(req) == 0 {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to create payouts, no payout in payload", nil)
+		return
+	}
+
+	// Validate each payout
+	requestIDs := make(map[string]struct{}, len(req))
+	for _, payout := range req {
+		if err := validatePayout(payout); err != nil {
+			logger.GetLogger().Errorf("Validation failed for request id %s: %v", payout.RequestID, err)
+			httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Failed to create payouts, validation failed for request id: %s", payout.RequestID), err)
+			return
+		}
+		if _, exists := requestIDs[payout.RequestID]; exists {
+			logger.GetLogger().Errorf("Duplicate request id in payload: %s", payout.RequestID)
+			httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Failed to create payouts, duplicate request id in payload: %s", payout.RequestID), nil)
+			return
+		}
+		requestIDs[payout.RequestID] = struct{}{}
+	}
+
+	payouts, err := h.ucase.CreatePayouts(ctx, vendorID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ucasetypes.ErrUserWalletNotFound):
+			logger.GetLogger().Warnf("Failed to create payouts: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Failed to create payouts, user wallet not found", err)
+		case postgresql.IsUniqueViolation(err):
+			logger.GetLogger().Warnf("Failed to create payouts: %v", err)
+			httpresponse.Error(ctx, http.StatusPreconditionFailed, "Failed to create payouts, request id already used", err)
+		default:
+			logger.GetLogger().Errorf("Failed to create payouts of vendor %s: %v", vendorID, err)
+			httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to create payouts", err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    payouts,
+	})
+}
+
+// GetPayout retrieves a payout of the vendor.
+// @Summary Retrieve a payout
+// @Description Retrieves a payout of the vendor by its request ID, with its status and transaction.
+// @Tags payout
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param request_id path string true "Payout request ID"
+// @Success 200 {object} dto.PayoutDTO
+// @Failure 404 {object} http.GeneralError "Payout not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/payout/{request_id} [get]
+func (h *payoutHandler) GetPayout(ctx *gin.Context) {
+	vendorID := ctx.GetHeader("Vendor-Id")
+	requestID := ctx.Param("request_id")
+
+	payout, err := h.ucase.GetPayout(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, ucasetypes.ErrPayoutNotFound) {
+			logger.GetLogger().Warnf("Payout not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Payout not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to retrieve payout %s: %v", requestID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve payout", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payout)
+}
+
+// GetPayouts lists the payouts of every vendor.
+// @Summary List payouts
+// @Description Lists the payouts, e.g. the PENDING_APPROVAL ones to review or the PROCESSING ones whose transaction outcome is unknown.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param status query string false "PENDING_APPROVAL, APPROVED, PROCESSING, SUCCESS, FAILED or REJECTED, empty for any status"
+// @Success 200 {array} dto.PayoutDTO
+// @Failure 400 {object} http.GeneralError "Invalid status"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/payouts [get]
+func (h *payoutHandler) GetPayouts(ctx *gin.Context) {
+	status := ctx.Query("status")
+	switch status {
+	case "", constants.OutboundPendingApproval, constants.OutboundApproved, constants.OutboundProcessing,
+		constants.OutboundSuccess, constants.OutboundFailed, constants.OutboundRejected:
+	default:
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid status: %s", status), nil)
+		return
+	}
+
+	payouts, err := h.ucase.GetPayouts(ctx, status)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get payouts: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get payouts", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payouts)
+}
+
+// ApprovePayout approves a payout pending approval.
+// @Summary Approve a payout
+// @Description Records the approval of a PENDING_APPROVAL payout by the approver. Once it has the approvals required by its
+// @Description transfer policy the payout is APPROVED and the payout worker of its network sends it from the payout wallet.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Approver-Key header string true "Key of the approver recorded in the decision audit"
+// @Param id path int true "Payout ID"
+// @Param payload body dto.TransferReviewPayloadDTO false "Approval reason"
+// @Success 200 {object} dto.PayoutDTO
+// @Failure 400 {object} http.GeneralError "Invalid payout ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin or approver key"
+// @Failure 403 {object} http.GeneralError "Transfer reviews are disabled or payout requested by the approver"
+// @Failure 404 {object} http.GeneralError "Payout not found"
+// @Failure 409 {object} http.GeneralError "Payout is not pending approval or already approved by the approver"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/payouts/{id}/approve [post]
+func (h *payoutHandler) ApprovePayout(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid payout ID", err)
+		return
+	}
+	approver, req, ok := bindTransferReview(ctx)
+	if !ok {
+		return
+	}
+
+	payout, err := h.ucase.ApprovePayout(ctx, id, approver, req.Reason)
+	if err != nil {
+		h.reviewError(ctx, id, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payout)
+}
+
+// RejectPayout rejects a payout pending approval.
+// @Summary Reject a payout
+// @Description Rejects a PENDING_APPROVAL payout, it is never sent and its webhook_url receives it with the reason.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Approver-Key header string true "Key of the approver recorded in the decision audit"
+// @Param id path int true "Payout ID"
+// @Param payload body dto.TransferReviewPayloadDTO false "Rejection reason"
+// @Success 200 {object} dto.PayoutDTO
+// @Failure 400 {object} http.GeneralError "Invalid payout ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin or approver key"
+// @Failure 403 {object} http.GeneralError "Transfer reviews are disabled"
+// @Failure 404 {object} http.GeneralError "Payout not found"
+// @Failure 409 {object} http.GeneralError "Payout is not pending approval"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/payouts/{id}/reject [post]
+func (h *payoutHandler) RejectPayout(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid payout ID", err)
+		return
+	}
+	approver, req, ok := bindTransferReview(ctx)
+	if !ok {
+		return
+	}
+
+	payout, err := h.ucase.RejectPayout(ctx, id, approver, req.Reason)
+	if err != nil {
+		h.reviewError(ctx, id, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payout)
+}
+
+func (h *payoutHandler) reviewError(ctx *gin.Context, id uint64, err error) {
+	switch {
+	case errors.Is(err, ucasetypes.ErrPayoutNotFound):
+		logger.GetLogger().Warnf("Payout not found: %v", err)
+		httpresponse.Error(ctx, http.StatusNotFound, "Payout not found", nil)
+	case errors.Is(err, ucasetypes.ErrPayoutNotReviewable):
+		logger.GetLogger().Warnf("Payout %d is not reviewable: %v", id, err)
+		httpresponse.Error(ctx, http.StatusConflict, "Payout is not pending approval", err)
+	case errors.Is(err, ucasetypes.ErrDuplicateApproval) || postgresql.IsUniqueViolation(err):
+		logger.GetLogger().Warnf("Payout %d is already approved by the approver: %v", id, err)
+		httpresponse.Error(ctx, http.StatusConflict, "Payout already approved by this approver", err)
+	case errors.Is(err, ucasetypes.ErrSelfApproval):
+		logger.GetLogger().Warnf("Payout %d is approved by its requester: %v", id, err)
+		httpresponse.Error(ctx, http.StatusForbidden, "Payout cannot be approved by its requester", err)
+	default:
+		logger.GetLogger().Errorf("Failed to review payout %d: %v", id, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to review payout", err)
+	}
+}
+
+func validatePayout(payout dto.PayoutPayloadDTO) error {
+	if (payout.UserID == nil) == (payout.ToAddress == "") {
+		return fmt.Errorf("exactly one of user_id and to_address must be set")
+	}
+	if payout.ToAddress != "" && !common.IsHexAddress(payout.ToAddress) {
+		return fmt.Errorf("invalid to_address: %s", payout.ToAddress)
+	}
+
+	// The amount, symbol and network follow the payment order rules
+	if err := validatePaymentOrder(dto.PaymentOrderPayloadDTO{
+		Amount:  payout.Amount,
+		Symbol:  payout.Symbol,
+		Network: payout.Network,
+	}); err != nil {
+		return err
+	}
+	if payout.Network == constants.Tron.String() {
+		return fmt.Errorf("payouts are not supported on network %s", payout.Network)
+	}
+	if amount, ok := new(big.Float).SetString(payout.Amount); !ok || amount.Sign() <= 0 {
+		return fmt.Errorf("amount must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	listenertypes "github.com/genefriendway/onchain-handler/internal/listeners/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+type rescanHandler struct {
+	rescanners map[string]listenertypes.TransferRescanner
+}
+
+func NewRescanHandler(rescanners map[string]listenertypes.TransferRescanner) *rescanHandler {
+	return &rescanHandler{
+		rescanners: rescanners,
+	}
+}
+
+// Rescan re-scans a block range of a network for missed payments.
+// @Summary Re-scan a block range
+// @Description Re-processes the token transfers of a block range. Transfers already in the payment event history are skipped.
+// @Description Without apply it is a dry-run reporting what would change. The listener's last processed block is not moved.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param payload body dto.RescanPayloadDTO true "Network, block range and apply flag"
+// @Success 200 {object} dto.RescanResultDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/rescans [post]
+func (h *rescanHandler) Rescan(ctx *gin.Context) {
+	var req dto.RescanPayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to rescan, invalid payload", err)
+		return
+	}
+
+	rescanner, exists := h.rescanners[req.Network]
+	if !exists {
+		logger.GetLogger().Errorf(errLogUnsupportedNetwork, req.Network)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to rescan, unsupported network", nil)
+		return
+	}
+
+	result, err := rescanner.Rescan(ctx, req.FromBlock, req.ToBlock, req.Apply)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to rescan network %s from block %d to %d: %v", req.Network, req.FromBlock, req.ToBlock, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to rescan", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
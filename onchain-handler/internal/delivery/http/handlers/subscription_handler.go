@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+type subscriptionHandler struct {
+	ucase ucasetypes.SubscriptionUCase
+}
+
+func NewSubscriptionHandler(ucase ucasetypes.SubscriptionUCase) *subscriptionHandler {
+	return &subscriptionHandler{
+		ucase: ucase,
+	}
+}
+
+// CreateSubscriptionPlan creates a recurring plan of the vendor.
+// @Summary Create a subscription plan
+// @Description Creates a plan billed every interval with a payment order of its amount, token and network.
+// @Description The order of a cycle can be paid for grace_period minutes before the subscription is PAST_DUE.
+// @Tags subscription
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param payload body dto.SubscriptionPlanPayloadDTO true "Plan name, amount, symbol, network, billing interval (DAILY, WEEKLY, MONTHLY or YEARLY) and grace period"
+// @Success 201 {object} dto.SubscriptionPlanDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/subscription-plans [post]
+func (h *subscriptionHandler) CreateSubscriptionPlan(ctx *gin.Context) {
+	var req dto.SubscriptionPlanPayloadDTO
+
+	// Get the Vendor-Id from the header
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to create subscription plan, invalid payload", err)
+		return
+	}
+	if err := validateSubscriptionPlan(req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to create subscription plan, invalid payload", err)
+		return
+	}
+
+	plan, err := h.ucase.CreateSubscriptionPlan(ctx, vendorID, req)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to create subscription plan of vendor %s: %v", vendorID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to create subscription plan", err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, plan)
+}
+
+// GetSubscriptionPlans lists the subscription plans of the vendor.
+// @Summary List subscription plans
+// @Description Lists the subscription plans of the vendor.
+// @Tags subscription
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Success 200 {array} dto.SubscriptionPlanDTO
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/subscription-plans [get]
+func (h *subscriptionHandler) GetSubscriptionPlans(ctx *gin.Context) {
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	plans, err := h.ucase.GetSubscriptionPlans(ctx, vendorID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get subscription plans of vendor %s: %v", vendorID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get subscription plans", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, plans)
+}
+
+// CreateSubscription subscribes a customer to a plan of the vendor.
+// @Summary Create a subscription
+// @Description Subscribes a customer to a plan and bills its first cycle right away. Each cycle is billed with a payment order,
+// @Description and the subscription is posted to its webhook_url with the order payment address when a cycle is billed and when its status changes.
+// @Tags subscription
+// @Accept json
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param payload body dto.SubscriptionPayloadDTO true "Plan ID, customer ID and webhook url"
+// @Success 201 {object} dto.SubscriptionDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 404 {object} http.GeneralError "Subscription plan not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/subscriptions [post]
+func (h *subscriptionHandler) CreateSubscription(ctx *gin.Context) {
+	var req dto.SubscriptionPayloadDTO
+
+	// Get the Vendor-Id from the header
+	vendorID := ctx.GetHeader("Vendor-Id")
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to create subscription, invalid payload", err)
+		return
+	}
+
+	subscription, err := h.ucase.CreateSubscription(auditContext(ctx), vendorID, req)
+	if err != nil {
+		if errors.Is(err, ucasetypes.ErrSubscriptionPlanNotFound) {
+			logger.GetLogger().Warnf("Subscription plan not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Subscription plan not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to create subscription of vendor %s: %v", vendorID, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to create subscription", err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, subscription)
+}
+
+// GetSubscription retrieves a subscription of the vendor.
+// @Summary Retrieve a subscription
+// @Description Retrieves a subscription with its plan and the order of the cycle being billed.
+// @Tags subscription
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param id path int true "Subscription ID"
+// @Success 200 {object} dto.SubscriptionDTO
+// @Failure 400 {object} http.GeneralError "Invalid subscription ID"
+// @Failure 404 {object} http.GeneralError "Subscription not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/subscription/{id} [get]
+func (h *subscriptionHandler) GetSubscription(ctx *gin.Context) {
+	vendorID := ctx.GetHeader("Vendor-Id")
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid subscription ID", err)
+		return
+	}
+
+	subscription, err := h.ucase.GetSubscription(ctx, vendorID, id)
+	if err != nil {
+		if errors.Is(err, ucasetypes.ErrSubscriptionNotFound) {
+			logger.GetLogger().Warnf("Subscription not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Subscription not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to retrieve subscription %d: %v", id, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to retrieve subscription", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, subscription)
+}
+
+// CancelSubscription cancels a subscription of the vendor.
+// @Summary Cancel a subscription
+// @Description Stops billing the subscription. The order of the cycle being billed is cancelled when it is still PENDING.
+// @Tags subscription
+// @Produce json
+// @Param Vendor-Id header string true "Vendor ID for authentication"
+// @Param id path int true "Subscription ID"
+// @Success 200 {object} dto.SubscriptionDTO
+// @Failure 400 {object} http.GeneralError "Invalid subscription ID"
+// @Failure 404 {object} http.GeneralError "Subscription not found"
+// @Failure 409 {object} http.GeneralError "Subscription is already cancelled"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/subscription/{id}/cancel [post]
+func (h *subscriptionHandler) CancelSubscription(ctx *gin.Context) {
+	vendorID := ctx.GetHeader("Vendor-Id")
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid subscription ID", err)
+		return
+	}
+
+	subscription, err := h.ucase.CancelSubscription(auditContext(ctx), vendorID, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ucasetypes.ErrSubscriptionNotFound):
+			logger.GetLogger().Warnf("Subscription not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Subscription not found", nil)
+		case errors.Is(err, ucasetypes.ErrSubscriptionNotCancellable):
+			logger.GetLogger().Warnf("Rejected cancellation of subscription %d: %v", id, err)
+			httpresponse.Error(ctx, http.StatusConflict, "Subscription is already cancelled", err)
+		default:
+			logger.GetLogger().Errorf("Failed to cancel subscription %d: %v", id, err)
+			httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to cancel subscription", err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, subscription)
+}
+
+func validateSubscriptionPlan(req dto.SubscriptionPlanPayloadDTO) error {
+	// Each cycle is billed with a payment order, the grace period is the expiry time of the order
+	if err := validatePaymentOrder(dto.PaymentOrderPayloadDTO{
+		Amount:           req.Amount,
+		Symbol:           req.Symbol,
+		Network:          req.Network,
+		ExpiredOrderTime: req.GracePeriod,
+	}); err != nil {
+		return err
+	}
+
+	switch req.BillingInterval {
+	case constants.SubscriptionDaily, constants.SubscriptionWeekly, constants.SubscriptionMonthly, constants.SubscriptionYearly:
+		return nil
+	}
+	return fmt.Errorf("unsupported billing interval: %s", req.BillingInterval)
+}
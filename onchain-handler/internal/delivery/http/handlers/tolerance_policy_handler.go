@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type tolerancePolicyHandler struct {
+	ucase ucasetypes.TolerancePolicyUCase
+}
+
+func NewTolerancePolicyHandler(ucase ucasetypes.TolerancePolicyUCase) *tolerancePolicyHandler {
+	return &tolerancePolicyHandler{
+		ucase: ucase,
+	}
+}
+
+// GetTolerancePolicies lists the payment tolerance policies.
+// @Summary List tolerance policies
+// @Description Lists the payment tolerance policies. An empty vendor_id or symbol matches any vendor or token.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Success 200 {array} dto.TolerancePolicyDTO
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/tolerance-policies [get]
+func (h *tolerancePolicyHandler) GetTolerancePolicies(ctx *gin.Context) {
+	policies, err := h.ucase.GetTolerancePolicies(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get tolerance policies: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get tolerance policies", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policies)
+}
+
+// UpsertTolerancePolicy creates or replaces the tolerance policy of a vendor and token.
+// @Summary Set a tolerance policy
+// @Description Sets how far the transferred amount of the orders of a vendor and token may be from the order amount.
+// @Description Absolute limits are in token units and percentage limits are of the order amount, a null limit is not set.
+// @Description When both limits of a side are set the smaller one applies. Orders paid above the over tolerance are OVERPAID.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param payload body dto.TolerancePolicyPayloadDTO true "Vendor, token and limits"
+// @Success 200 {object} dto.TolerancePolicyDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/tolerance-policies [put]
+func (h *tolerancePolicyHandler) UpsertTolerancePolicy(ctx *gin.Context) {
+	var req dto.TolerancePolicyPayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to set tolerance policy, invalid payload", err)
+		return
+	}
+	if err := validateTolerancePolicy(req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to set tolerance policy, invalid payload", err)
+		return
+	}
+
+	policy, err := h.ucase.UpsertTolerancePolicy(ctx, req)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to set tolerance policy of vendor %q, symbol %q: %v", req.VendorID, req.Symbol, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to set tolerance policy", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
+
+// DeleteTolerancePolicy deletes the tolerance policy of a vendor and token.
+// @Summary Delete a tolerance policy
+// @Description Deletes the tolerance policy of a vendor and token, its orders then use the next matching policy.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param vendor_id query string false "Vendor ID, empty for any vendor"
+// @Param symbol query string false "Token symbol, empty for any token"
+// @Success 200 {object} map[string]bool "Success response: {\"success\": true}"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Tolerance policy not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/tolerance-policies [delete]
+func (h *tolerancePolicyHandler) DeleteTolerancePolicy(ctx *gin.Context) {
+	vendorID := ctx.Query("vendor_id")
+	symbol := ctx.Query("symbol")
+
+	if err := h.ucase.DeleteTolerancePolicy(ctx, vendorID, symbol); err != nil {
+		if errors.Is(err, ucasetypes.ErrTolerancePolicyNotFound) {
+			logger.GetLogger().Warnf("Tolerance policy not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Tolerance policy not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to delete tolerance policy of vendor %q, symbol %q: %v", vendorID, symbol, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to delete tolerance policy", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func validateTolerancePolicy(req dto.TolerancePolicyPayloadDTO) error {
+	if req.Symbol != "" {
+		if err := utils.ValidateSymbol(req.Symbol); err != nil {
+			return err
+		}
+	}
+
+	for name, limit := range map[string]*float64{"under_absolute": req.UnderAbsolute, "over_absolute": req.OverAbsolute} {
+		if limit != nil && *limit < 0 {
+			return fmt.Errorf("%s must be greater than or equal 0", name)
+		}
+	}
+	for name, limit := range map[string]*float64{"under_percentage": req.UnderPercentage, "over_percentage": req.OverPercentage} {
+		if limit != nil && (*limit < 0 || *limit > 100) {
+			return fmt.Errorf("%s must be between 0 and 100", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type transferPolicyHandler struct {
+	ucase ucasetypes.TransferPolicyUCase
+}
+
+func NewTransferPolicyHandler(ucase ucasetypes.TransferPolicyUCase) *transferPolicyHandler {
+	return &transferPolicyHandler{
+		ucase: ucase,
+	}
+}
+
+// GetTransferPolicies lists the outbound transfer policies.
+// @Summary List transfer policies
+// @Description Lists the policies of the payouts and withdrawals. An empty network or symbol matches any network or token.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Success 200 {array} dto.TransferPolicyDTO
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/transfer-policies [get]
+func (h *transferPolicyHandler) GetTransferPolicies(ctx *gin.Context) {
+	policies, err := h.ucase.GetTransferPolicies(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get transfer policies: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get transfer policies", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policies)
+}
+
+// UpsertTransferPolicy creates or replaces the transfer policy of a network and token.
+// @Summary Set a transfer policy
+// @Description Sets how the payouts and withdrawals of a network and token are sent. Transfers above approval_threshold
+// @Description wait in PENDING_APPROVAL for required_approvals distinct approvers, 0 holds every transfer and null lets every transfer through.
+// @Description daily_limit caps the total sent per UTC day and allow_list_only blocks transfers to addresses off the allow-list.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param payload body dto.TransferPolicyPayloadDTO true "Network, token and rules"
+// @Success 200 {object} dto.TransferPolicyDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/transfer-policies [put]
+func (h *transferPolicyHandler) UpsertTransferPolicy(ctx *gin.Context) {
+	var req dto.TransferPolicyPayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to set transfer policy, invalid payload", err)
+		return
+	}
+	if err := validateTransferPolicy(req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to set transfer policy, invalid payload", err)
+		return
+	}
+
+	policy, err := h.ucase.UpsertTransferPolicy(ctx, req)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to set transfer policy of network %q, symbol %q: %v", req.Network, req.Symbol, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to set transfer policy", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
+
+// DeleteTransferPolicy deletes the transfer policy of a network and token.
+// @Summary Delete a transfer policy
+// @Description Deletes the transfer policy of a network and token, its transfers then use the next matching policy.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param network query string false "Network, empty for any network"
+// @Param symbol query string false "Token symbol, empty for any token"
+// @Success 200 {object} map[string]bool "Success response: {\"success\": true}"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Transfer policy not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/transfer-policies [delete]
+func (h *transferPolicyHandler) DeleteTransferPolicy(ctx *gin.Context) {
+	network := ctx.Query("network")
+	symbol := ctx.Query("symbol")
+
+	if err := h.ucase.DeleteTransferPolicy(ctx, network, symbol); err != nil {
+		if errors.Is(err, ucasetypes.ErrTransferPolicyNotFound) {
+			logger.GetLogger().Warnf("Transfer policy not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Transfer policy not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to delete transfer policy of network %q, symbol %q: %v", network, symbol, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to delete transfer policy", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GetAllowedAddresses lists the transfer allow-list.
+// @Summary List allowed addresses
+// @Description Lists the destination addresses the allow_list_only transfer policies send to.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Success 200 {array} dto.TransferAllowedAddressDTO
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/transfer-allow-list [get]
+func (h *transferPolicyHandler) GetAllowedAddresses(ctx *gin.Context) {
+	addresses, err := h.ucase.GetAllowedAddresses(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get allowed addresses: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get allowed addresses", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, addresses)
+}
+
+// UpsertAllowedAddress adds an address to the transfer allow-list of a network.
+// @Summary Allow an address
+// @Description Adds a destination address to the allow-list of a network, or replaces its label.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param payload body dto.TransferAllowedAddressPayloadDTO true "Network, address and label"
+// @Success 200 {object} dto.TransferAllowedAddressDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/transfer-allow-list [put]
+func (h *transferPolicyHandler) UpsertAllowedAddress(ctx *gin.Context) {
+	var req dto.TransferAllowedAddressPayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to allow address, invalid payload", err)
+		return
+	}
+	if err := utils.ValidateNetworkType(req.Network); err != nil {
+		logger.GetLogger().Errorf(errLogUnsupportedNetwork, req.Network)
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf(errLogUnsupportedNetwork, req.Network), err)
+		return
+	}
+	if !utils.IsValidAddress(req.Network, req.Address) {
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid address: %s", req.Address), nil)
+		return
+	}
+
+	allowedAddress, err := h.ucase.UpsertAllowedAddress(ctx, req)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to allow address %s on network %s: %v", req.Address, req.Network, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to allow address", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, allowedAddress)
+}
+
+// DeleteAllowedAddress removes an address from the transfer allow-list of a network.
+// @Summary Remove an allowed address
+// @Description Removes a destination address from the allow-list of a network.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param network query string true "Network"
+// @Param address query string true "Address"
+// @Success 200 {object} map[string]bool "Success response: {\"success\": true}"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Address is not on the allow-list"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/transfer-allow-list [delete]
+func (h *transferPolicyHandler) DeleteAllowedAddress(ctx *gin.Context) {
+	network := ctx.Query("network")
+	address := ctx.Query("address")
+
+	if err := h.ucase.DeleteAllowedAddress(ctx, network, address); err != nil {
+		if errors.Is(err, ucasetypes.ErrAllowedAddressNotFound) {
+			logger.GetLogger().Warnf("Allowed address not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Address is not on the allow-list", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to remove allowed address %s on network %s: %v", address, network, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to remove allowed address", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GetTransferDecisions lists the audited decisions on the outbound transfers.
+// @Summary List transfer decisions
+// @Description Lists the policy decisions, approvals and rejections of the payouts or withdrawals, oldest first.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param transfer_kind query string true "PAYOUT or WITHDRAWAL"
+// @Param transfer_id query int false "Payout or withdrawal request ID, empty for every transfer of the kind"
+// @Success 200 {array} dto.TransferDecisionDTO
+// @Failure 400 {object} http.GeneralError "Invalid transfer kind or ID"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/transfer-decisions [get]
+func (h *transferPolicyHandler) GetTransferDecisions(ctx *gin.Context) {
+	transferKind := ctx.Query("transfer_kind")
+	if transferKind != constants.OutboundPayout && transferKind != constants.OutboundWithdrawal {
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid transfer kind: %s", transferKind), nil)
+		return
+	}
+
+	var transferID *uint64
+	if transferIDStr := ctx.Query("transfer_id"); transferIDStr != "" {
+		id, err := strconv.ParseUint(transferIDStr, 10, 64)
+		if err != nil {
+			httpresponse.Error(ctx, http.StatusBadRequest, "Invalid transfer ID", err)
+			return
+		}
+		transferID = &id
+	}
+
+	decisions, err := h.ucase.GetTransferDecisions(ctx, transferKind, transferID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get transfer decisions: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get transfer decisions", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, decisions)
+}
+
+func validateTransferPolicy(req dto.TransferPolicyPayloadDTO) error {
+	if req.Network != "" {
+		if err := utils.ValidateNetworkType(req.Network); err != nil {
+			return err
+		}
+	}
+	if req.Symbol != "" {
+		if err := utils.ValidateSymbol(req.Symbol); err != nil {
+			return err
+		}
+	}
+
+	for name, limit := range map[string]*float64{"approval_threshold": req.ApprovalThreshold, "daily_limit": req.DailyLimit} {
+		if limit != nil && *limit < 0 {
+			return fmt.Errorf("%s must be greater than or equal 0", name)
+		}
+	}
+	return nil
+}
+
+// bindTransferReview reads the approver authenticated by its approver key and the optional reason of an approval
+// or rejection of a held transfer, it responds with an error and returns false when they are invalid.
+func bindTransferReview(ctx *gin.Context) (string, dto.TransferReviewPayloadDTO, bool) {
+	var req dto.TransferReviewPayloadDTO
+
+	approver := ctx.GetString(constants.ApproverContextKey)
+	if approver == "" {
+		httpresponse.Error(ctx, http.StatusUnauthorized, "Invalid approver key", nil)
+		return "", req, false
+	}
+
+	// The reason is optional, an empty body reviews without one
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			logger.GetLogger().Errorf(errLogInvalidPayload, err)
+			httpresponse.Error(ctx, http.StatusBadRequest, "Failed to review transfer, invalid payload", err)
+			return "", req, false
+		}
+	}
+	return approver, req, true
+}
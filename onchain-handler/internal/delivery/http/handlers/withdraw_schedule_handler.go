@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type withdrawScheduleHandler struct {
+	ucase ucasetypes.WithdrawScheduleUCase
+}
+
+func NewWithdrawScheduleHandler(ucase ucasetypes.WithdrawScheduleUCase) *withdrawScheduleHandler {
+	return &withdrawScheduleHandler{
+		ucase: ucase,
+	}
+}
+
+// GetWithdrawSchedules lists the sweep schedules of the payment wallets with their next run.
+// @Summary List withdraw schedules
+// @Description Lists the schedules of the sweeps of the payment wallets to the master wallet, with their next run and the outcome of the last one.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param network query string false "Network, empty for any network"
+// @Param symbol query string false "Token symbol, empty for any token"
+// @Success 200 {array} dto.WithdrawScheduleDTO
+// @Failure 400 {object} http.GeneralError "Invalid network or symbol"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdraw-schedules [get]
+func (h *withdrawScheduleHandler) GetWithdrawSchedules(ctx *gin.Context) {
+	network, symbol, ok := bindWithdrawScheduleQuery(ctx)
+	if !ok {
+		return
+	}
+
+	schedules, err := h.ucase.GetWithdrawSchedules(ctx, network, symbol)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get withdraw schedules: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get withdraw schedules", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schedules)
+}
+
+// UpsertWithdrawSchedule sets the sweep schedule of a network and token.
+// @Summary Set a withdraw schedule
+// @Description Sets when the payment wallets of a network and token are swept to the master wallet. The cron expression has five fields
+// @Description (minute, hour, day of month, month, day of week) evaluated in UTC, an empty one uses WITHDRAW_WORKER_INTERVAL.
+// @Description A due sweep is skipped while the payment wallets hold less than min_batch_value token units together, and deferred
+// @Description while the gas price is above max_gas_price_gwei. A null limit is not set. The next run is recomputed from now.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param payload body dto.WithdrawSchedulePayloadDTO true "Network, token, cron expression and limits"
+// @Success 200 {object} dto.WithdrawScheduleDTO
+// @Failure 400 {object} http.GeneralError "Invalid payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdraw-schedules [put]
+func (h *withdrawScheduleHandler) UpsertWithdrawSchedule(ctx *gin.Context) {
+	var req dto.WithdrawSchedulePayloadDTO
+
+	// Parse and validate the request payload
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to set withdraw schedule, invalid payload", err)
+		return
+	}
+	if err := utils.ValidateNetworkType(req.Network); err != nil {
+		logger.GetLogger().Errorf(errLogUnsupportedNetwork, req.Network)
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf(errLogUnsupportedNetwork, req.Network), err)
+		return
+	}
+	if err := validateWithdrawSchedule(req); err != nil {
+		logger.GetLogger().Errorf(errLogInvalidPayload, err)
+		httpresponse.Error(ctx, http.StatusBadRequest, "Failed to set withdraw schedule, invalid payload", err)
+		return
+	}
+
+	schedule, err := h.ucase.UpsertWithdrawSchedule(ctx, req)
+	if err != nil {
+		if errors.Is(err, ucasetypes.ErrInvalidWithdrawSchedule) {
+			logger.GetLogger().Errorf(errLogInvalidPayload, err)
+			httpresponse.Error(ctx, http.StatusBadRequest, "Failed to set withdraw schedule, invalid cron expression", err)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to set withdraw schedule of %s on network %s: %v", req.Symbol, req.Network, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to set withdraw schedule", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, schedule)
+}
+
+// RequestWithdrawSweep sweeps the payment wallets of a network and token now.
+// @Summary Sweep now
+// @Description Makes the withdraw worker sweep the payment wallets of the network and token within a minute, regardless of the schedule,
+// @Description the minimum batch value and the gas price ceiling. Paused schedules are not swept.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param network query string false "Network, empty for any network"
+// @Param symbol query string false "Token symbol, empty for any token"
+// @Success 200 {object} map[string]bool "Success response: {\"success\": true}"
+// @Failure 400 {object} http.GeneralError "Invalid network or symbol"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Withdraw schedule not found"
+// @Failure 409 {object} http.GeneralError "Withdraw schedule is paused"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdraw-schedules/sweep [post]
+func (h *withdrawScheduleHandler) RequestWithdrawSweep(ctx *gin.Context) {
+	network, symbol, ok := bindWithdrawScheduleQuery(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.ucase.RequestWithdrawSweep(ctx, network, symbol); err != nil {
+		if errors.Is(err, ucasetypes.ErrWithdrawScheduleNotFound) {
+			logger.GetLogger().Warnf("Withdraw schedule not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Withdraw schedule not found", nil)
+			return
+		}
+		if errors.Is(err, ucasetypes.ErrWithdrawSchedulePaused) {
+			logger.GetLogger().Warnf("Withdraw schedule is paused: %v", err)
+			httpresponse.Error(ctx, http.StatusConflict, "Withdraw schedule is paused", err)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to request sweep of network %q, symbol %q: %v", network, symbol, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to request sweep", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// PauseWithdrawSchedules pauses the sweeps of a network and token.
+// @Summary Pause sweeps
+// @Description Pauses the scheduled and requested sweeps of the payment wallets of the network and token until they are resumed.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param network query string false "Network, empty for any network"
+// @Param symbol query string false "Token symbol, empty for any token"
+// @Success 200 {object} map[string]bool "Success response: {\"success\": true}"
+// @Failure 400 {object} http.GeneralError "Invalid network or symbol"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Withdraw schedule not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdraw-schedules/pause [post]
+func (h *withdrawScheduleHandler) PauseWithdrawSchedules(ctx *gin.Context) {
+	h.setWithdrawSchedulesPaused(ctx, true)
+}
+
+// ResumeWithdrawSchedules resumes the sweeps of a network and token.
+// @Summary Resume sweeps
+// @Description Resumes the paused sweeps of the payment wallets of the network and token, from their next scheduled run after now.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param network query string false "Network, empty for any network"
+// @Param symbol query string false "Token symbol, empty for any token"
+// @Success 200 {object} map[string]bool "Success response: {\"success\": true}"
+// @Failure 400 {object} http.GeneralError "Invalid network or symbol"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 404 {object} http.GeneralError "Withdraw schedule not found"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdraw-schedules/resume [post]
+func (h *withdrawScheduleHandler) ResumeWithdrawSchedules(ctx *gin.Context) {
+	h.setWithdrawSchedulesPaused(ctx, false)
+}
+
+func (h *withdrawScheduleHandler) setWithdrawSchedulesPaused(ctx *gin.Context, paused bool) {
+	network, symbol, ok := bindWithdrawScheduleQuery(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.ucase.SetWithdrawSchedulesPaused(ctx, network, symbol, paused); err != nil {
+		if errors.Is(err, ucasetypes.ErrWithdrawScheduleNotFound) {
+			logger.GetLogger().Warnf("Withdraw schedule not found: %v", err)
+			httpresponse.Error(ctx, http.StatusNotFound, "Withdraw schedule not found", nil)
+			return
+		}
+		logger.GetLogger().Errorf("Failed to update withdraw schedules of network %q, symbol %q: %v", network, symbol, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to update withdraw schedules", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// bindWithdrawScheduleQuery reads the optional network and symbol query parameters,
+// it writes the error response and returns false when one is invalid.
+func bindWithdrawScheduleQuery(ctx *gin.Context) (network, symbol string, ok bool) {
+	network = ctx.Query("network")
+	symbol = ctx.Query("symbol")
+
+	if network != "" {
+		if err := utils.ValidateNetworkType(network); err != nil {
+			logger.GetLogger().Errorf(errLogUnsupportedNetwork, network)
+			httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf(errLogUnsupportedNetwork, network), err)
+			return "", "", false
+		}
+	}
+	if symbol != "" {
+		if err := utils.ValidateSymbol(symbol); err != nil {
+			httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid symbol: %s", symbol), err)
+			return "", "", false
+		}
+	}
+	return network, symbol, true
+}
+
+func validateWithdrawSchedule(req dto.WithdrawSchedulePayloadDTO) error {
+	if err := utils.ValidateSymbol(req.Symbol); err != nil {
+		return err
+	}
+	if req.MinBatchValue != nil && *req.MinBatchValue < 0 {
+		return fmt.Errorf("min_batch_value must be greater than or equal 0")
+	}
+	if req.MaxGasPriceGwei != nil && *req.MaxGasPriceGwei <= 0 {
+		return fmt.Errorf("max_gas_price_gwei must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/database/postgresql"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+type withdrawalRequestHandler struct {
+	ucase ucasetypes.WithdrawalRequestUCase
+}
+
+func NewWithdrawalRequestHandler(ucase ucasetypes.WithdrawalRequestUCase) *withdrawalRequestHandler {
+	return &withdrawalRequestHandler{
+		ucase: ucase,
+	}
+}
+
+// GetWithdrawalRequests lists the withdrawals to the master wallet held by their transfer policy.
+// @Summary List withdrawal requests
+// @Description Lists the withdrawals from the receiving wallet to the master wallet held for approval by their transfer policy.
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param status query string false "PENDING_APPROVAL, APPROVED, PROCESSING, SUCCESS, FAILED or REJECTED, empty for any status"
+// @Success 200 {array} dto.WithdrawalRequestDTO
+// @Failure 400 {object} http.GeneralError "Invalid status"
+// @Failure 401 {object} http.GeneralError "Invalid admin key"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdrawal-requests [get]
+func (h *withdrawalRequestHandler) GetWithdrawalRequests(ctx *gin.Context) {
+	status := ctx.Query("status")
+	switch status {
+	case "", constants.OutboundPendingApproval, constants.OutboundApproved, constants.OutboundProcessing,
+		constants.OutboundSuccess, constants.OutboundFailed, constants.OutboundRejected:
+	default:
+		httpresponse.Error(ctx, http.StatusBadRequest, fmt.Sprintf("Invalid status: %s", status), nil)
+		return
+	}
+
+	requests, err := h.ucase.GetWithdrawalRequests(ctx, status)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get withdrawal requests: %v", err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to get withdrawal requests", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, requests)
+}
+
+// ApproveWithdrawalRequest approves a withdrawal request pending approval.
+// @Summary Approve a withdrawal request
+// @Description Records the approval of a PENDING_APPROVAL withdrawal request by the approver. Once it has the approvals
+// @Description required by its transfer policy it is APPROVED and the next withdrawal of its network sends up to its amount.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Approver-Key header string true "Key of the approver recorded in the decision audit"
+// @Param id path int true "Withdrawal request ID"
+// @Param payload body dto.TransferReviewPayloadDTO false "Approval reason"
+// @Success 200 {object} dto.WithdrawalRequestDTO
+// @Failure 400 {object} http.GeneralError "Invalid withdrawal request ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin or approver key"
+// @Failure 403 {object} http.GeneralError "Transfer reviews are disabled"
+// @Failure 404 {object} http.GeneralError "Withdrawal request not found"
+// @Failure 409 {object} http.GeneralError "Withdrawal request is not pending approval or already approved by the approver"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdrawal-requests/{id}/approve [post]
+func (h *withdrawalRequestHandler) ApproveWithdrawalRequest(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid withdrawal request ID", err)
+		return
+	}
+	approver, req, ok := bindTransferReview(ctx)
+	if !ok {
+		return
+	}
+
+	request, err := h.ucase.ApproveWithdrawalRequest(ctx, id, approver, req.Reason)
+	if err != nil {
+		h.reviewError(ctx, id, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, request)
+}
+
+// RejectWithdrawalRequest rejects a withdrawal request pending approval.
+// @Summary Reject a withdrawal request
+// @Description Rejects a PENDING_APPROVAL withdrawal request, the balance stays in the receiving wallet until the next withdrawal.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param X-Approver-Key header string true "Key of the approver recorded in the decision audit"
+// @Param id path int true "Withdrawal request ID"
+// @Param payload body dto.TransferReviewPayloadDTO false "Rejection reason"
+// @Success 200 {object} dto.WithdrawalRequestDTO
+// @Failure 400 {object} http.GeneralError "Invalid withdrawal request ID or payload"
+// @Failure 401 {object} http.GeneralError "Invalid admin or approver key"
+// @Failure 403 {object} http.GeneralError "Transfer reviews are disabled"
+// @Failure 404 {object} http.GeneralError "Withdrawal request not found"
+// @Failure 409 {object} http.GeneralError "Withdrawal request is not pending approval"
+// @Failure 500 {object} http.GeneralError "Internal server error"
+// @Router /api/v1/admin/withdrawal-requests/{id}/reject [post]
+func (h *withdrawalRequestHandler) RejectWithdrawalRequest(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpresponse.Error(ctx, http.StatusBadRequest, "Invalid withdrawal request ID", err)
+		return
+	}
+	approver, req, ok := bindTransferReview(ctx)
+	if !ok {
+		return
+	}
+
+	request, err := h.ucase.RejectWithdrawalRequest(ctx, id, approver, req.Reason)
+	if err != nil {
+		h.reviewError(ctx, id, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, request)
+}
+
+func (h *withdrawalRequestHandler) reviewError(ctx *gin.Context, id uint64, err error) {
+	switch {
+	case errors.Is(err, ucasetypes.ErrWithdrawalRequestNotFound):
+		logger.GetLogger().Warnf("Withdrawal request not found: %v", err)
+		httpresponse.Error(ctx, http.StatusNotFound, "Withdrawal request not found", nil)
+	case errors.Is(err, ucasetypes.ErrWithdrawalRequestNotReviewable):
+		logger.GetLogger().Warnf("Withdrawal request %d is not reviewable: %v", id, err)
+		httpresponse.Error(ctx, http.StatusConflict, "Withdrawal request is not pending approval", err)
+	case errors.Is(err, ucasetypes.ErrDuplicateApproval) || postgresql.IsUniqueViolation(err):
+		logger.GetLogger().Warnf("Withdrawal request %d is already approved by the approver: %v", id, err)
+		httpresponse.Error(ctx, http.StatusConflict, "Withdrawal request already approved by this approver", err)
+	case errors.Is(err, ucasetypes.ErrSelfApproval):
+		logger.GetLogger().Warnf("Withdrawal request %d is approved by its requester: %v", id, err)
+		httpresponse.Error(ctx, http.StatusForbidden, "Withdrawal request cannot be approved by its requester", err)
+	default:
+		logger.GetLogger().Errorf("Failed to review withdrawal request %d: %v", id, err)
+		httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to review withdrawal request", err)
+	}
+}
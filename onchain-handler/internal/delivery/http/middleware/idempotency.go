@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+const idempotencyKeyMaxLength = 255
+
+// Idempotency replays the stored response when the vendor retries a request with the same Idempotency-Key header.
+// Requests without the header are processed as usual. Server errors are not stored so that the retry is processed again.
+func Idempotency(idempotencyUCase ucasetypes.IdempotencyUCase) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader("Idempotency-Key")
+		if key == "" {
+			ctx.Next()
+			return
+		}
+		if len(key) > idempotencyKeyMaxLength {
+			logger.GetLogger().Infof("Validation failed: Idempotency-Key header exceeds max length (%d characters)", len(key))
+			httpresponse.Error(ctx, http.StatusBadRequest, "Invalid request headers", nil)
+			return
+		}
+		vendorID := ctx.GetHeader("Vendor-Id")
+
+		// Step 1: Hash the request, the body is restored for the handler
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			logger.GetLogger().Errorf("Failed to read request body: %v", err)
+			httpresponse.Error(ctx, http.StatusBadRequest, "Failed to read request body", err)
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		hash := sha256.New()
+		hash.Write([]byte(ctx.Request.Method + " " + ctx.Request.URL.RequestURI() + "\n"))
+		hash.Write(body)
+		requestHash := hex.EncodeToString(hash.Sum(nil))
+
+		// Step 2: Reserve the key or replay the response of the first request
+		stored, err := idempotencyUCase.BeginRequest(ctx, vendorID, key, requestHash)
+		if err != nil {
+			switch {
+			case errors.Is(err, ucasetypes.ErrIdempotencyKeyMismatch):
+				logger.GetLogger().Warnf("Idempotency-Key %q of vendor %s reused with a different request", key, vendorID)
+				httpresponse.Error(ctx, http.StatusConflict, "Idempotency-Key is already used with a different request", nil)
+			case errors.Is(err, ucasetypes.ErrIdempotencyKeyInProgress):
+				logger.GetLogger().Warnf("Idempotency-Key %q of vendor %s is still in progress", key, vendorID)
+				httpresponse.Error(ctx, http.StatusConflict, "A request with this Idempotency-Key is still in progress", nil)
+			default:
+				logger.GetLogger().Errorf("Failed to check Idempotency-Key %q of vendor %s: %v", key, vendorID, err)
+				httpresponse.Error(ctx, http.StatusInternalServerError, "Failed to check Idempotency-Key", err)
+			}
+			return
+		}
+		if stored != nil {
+			ctx.Header("Idempotent-Replayed", "true")
+			ctx.Data(stored.StatusCode, "application/json; charset=utf-8", []byte(stored.Body))
+			ctx.Abort()
+			return
+		}
+
+		// Step 3: Process the request and store its response
+		recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = recorder
+		ctx.Next()
+
+		if recorder.Status() >= http.StatusInternalServerError {
+			if err := idempotencyUCase.ReleaseRequest(ctx, vendorID, key); err != nil {
+				logger.GetLogger().Errorf("Failed to release Idempotency-Key %q of vendor %s: %v", key, vendorID, err)
+			}
+			return
+		}
+		if err := idempotencyUCase.CompleteRequest(ctx, vendorID, key, recorder.Status(), recorder.body.String()); err != nil {
+			logger.GetLogger().Errorf("Failed to store the response of Idempotency-Key %q of vendor %s: %v", key, vendorID, err)
+		}
+	}
+}
+
+// responseRecorder keeps a copy of the response body written by the handler.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
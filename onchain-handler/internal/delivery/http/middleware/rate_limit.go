@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+// rateLimitBucket is the token bucket of a client, refilled continuously up to the limit.
+type rateLimitBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// RateLimit lets up to limit requests per interval through from each client IP, the others are rejected
+// with 429 Too Many Requests. A zero limit disables the rate limit.
+func RateLimit(limit uint, interval time.Duration) gin.HandlerFunc {
+	var (
+		mu      sync.Mutex
+		buckets = make(map[string]*rateLimitBucket)
+		swept   = time.Now()
+	)
+	rate := float64(limit) / interval.Seconds()
+
+	return func(ctx *gin.Context) {
+		if limit == 0 {
+			ctx.Next()
+			return
+		}
+
+		clientIP := ctx.ClientIP()
+		now := time.Now()
+
+		mu.Lock()
+		// Forget the clients whose bucket is full again
+		if now.Sub(swept) > interval {
+			for ip, bucket := range buckets {
+				if now.Sub(bucket.updatedAt) > interval {
+					delete(buckets, ip)
+				}
+			}
+			swept = now
+		}
+
+		bucket, exists := buckets[clientIP]
+		if !exists {
+			bucket = &rateLimitBucket{tokens: float64(limit), updatedAt: now}
+			buckets[clientIP] = bucket
+		}
+		bucket.tokens = min(float64(limit), bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*rate)
+		bucket.updatedAt = now
+		allowed := bucket.tokens >= 1
+		if allowed {
+			bucket.tokens--
+		}
+		mu.Unlock()
+
+		if !allowed {
+			logger.GetLogger().Warnf("Rate limit exceeded for client %s on %s", clientIP, ctx.FullPath())
+			httpresponse.Error(ctx, http.StatusTooManyRequests, "Too many requests", nil)
+			return
+		}
+
+		ctx.Next() // Continue to the next handler
+	}
+}
@@ -1,10 +1,12 @@
 package middleware
 
 import (
+	"crypto/subtle"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/genefriendway/onchain-handler/constants"
 	httpresponse "github.com/genefriendway/onchain-handler/pkg/http"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
 )
@@ -34,3 +36,69 @@ func ValidateVendorID() gin.HandlerFunc {
 		ctx.Next() // Continue to the next handler
 	}
 }
+
+// ValidateAdminKey only lets requests carrying the configured admin key through.
+// Admin routes are disabled when no key is configured.
+func ValidateAdminKey(adminAPIKey string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if adminAPIKey == "" {
+			logger.GetLogger().Info("Admin API request rejected: ADMIN_API_KEY is not configured")
+			httpresponse.Error(ctx, http.StatusForbidden, "Admin API is disabled", nil)
+			ctx.Abort()
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(ctx.GetHeader("X-Admin-Key")), []byte(adminAPIKey)) != 1 {
+			logger.GetLogger().Info("Validation failed: invalid X-Admin-Key header")
+			httpresponse.Error(ctx, http.StatusUnauthorized, "Invalid admin key", nil)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next() // Continue to the next handler
+	}
+}
+
+// ValidateOperator requires the X-Operator header naming who runs an audited admin operation.
+func ValidateOperator() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader("X-Operator") == "" {
+			logger.GetLogger().Info("Validation failed: X-Operator header is missing")
+			httpresponse.Error(ctx, http.StatusBadRequest, "Missing X-Operator header", nil)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next() // Continue to the next handler
+	}
+}
+
+// AuthenticateApprover only lets requests carrying the key of one of the approvers through, and sets the approver
+// of the key in the context. Reviews of held transfers are disabled when no approver is configured.
+func AuthenticateApprover(approvers map[string]string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(approvers) == 0 {
+			logger.GetLogger().Info("Review request rejected: TRANSFER_APPROVER_KEYS is not configured")
+			httpresponse.Error(ctx, http.StatusForbidden, "Transfer reviews are disabled", nil)
+			ctx.Abort()
+			return
+		}
+
+		approverKey := []byte(ctx.GetHeader("X-Approver-Key"))
+		approver := ""
+		for key, name := range approvers {
+			if subtle.ConstantTimeCompare(approverKey, []byte(key)) == 1 {
+				approver = name
+			}
+		}
+		if approver == "" {
+			logger.GetLogger().Info("Validation failed: invalid X-Approver-Key header")
+			httpresponse.Error(ctx, http.StatusUnauthorized, "Invalid approver key", nil)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Set(constants.ApproverContextKey, approver)
+		ctx.Next() // Continue to the next handler
+	}
+}
@@ -2,6 +2,7 @@ package route
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/genefriendway/onchain-handler/internal/delivery/http/handlers"
 	"github.com/genefriendway/onchain-handler/internal/delivery/http/middleware"
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	listenertypes "github.com/genefriendway/onchain-handler/internal/listeners/types"
 )
 
 func RegisterRoutes(
@@ -23,6 +25,19 @@ func RegisterRoutes(
 	paymentWalletUCase ucasetypes.PaymentWalletUCase,
 	metadataUCase ucasetypes.MetadataUCase,
 	paymentStatisticsUCase ucasetypes.PaymentStatisticsUCase,
+	gaslessPaymentUCase ucasetypes.GaslessPaymentUCase,
+	tolerancePolicyUCase ucasetypes.TolerancePolicyUCase,
+	idempotencyUCase ucasetypes.IdempotencyUCase,
+	invoiceUCase ucasetypes.InvoiceUCase,
+	subscriptionUCase ucasetypes.SubscriptionUCase,
+	payoutUCase ucasetypes.PayoutUCase,
+	transferPolicyUCase ucasetypes.TransferPolicyUCase,
+	withdrawalRequestUCase ucasetypes.WithdrawalRequestUCase,
+	withdrawScheduleUCase ucasetypes.WithdrawScheduleUCase,
+	gasCostUCase ucasetypes.GasCostUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
+	adminUCase ucasetypes.AdminUCase,
+	rescanners map[string]listenertypes.TransferRescanner,
 ) {
 	v1 := r.Group("/api/v1")
 	appRouter := v1.Group("")
@@ -34,17 +49,33 @@ func RegisterRoutes(
 
 	// SECTION: payment order
 	paymentOrderHandler := handlers.NewPaymentOrderHandler(paymentOrderUCase)
-	appRouter.POST("/payment-orders", middleware.ValidateVendorID(), paymentOrderHandler.CreateOrders)
+	appRouter.POST("/payment-orders", middleware.ValidateVendorID(), middleware.Idempotency(idempotencyUCase), paymentOrderHandler.CreateOrders)
 	appRouter.GET("/payment-orders", middleware.ValidateVendorID(), paymentOrderHandler.GetPaymentOrders)
-	appRouter.GET("/payment-order/:request_id", paymentOrderHandler.GetPaymentOrderByRequestID)
-	appRouter.PUT("/payment-order/:request_id", paymentOrderHandler.UpdatePaymentOrderByRequestID)
-	appRouter.PUT("/payment-order/network", paymentOrderHandler.UpdatePaymentOrderNetwork)
+	appRouter.GET("/payment-order/:request_id", middleware.ValidateVendorID(), paymentOrderHandler.GetPaymentOrderByRequestID)
+	appRouter.PUT("/payment-order/:request_id", middleware.ValidateVendorID(), paymentOrderHandler.UpdatePaymentOrderByRequestID)
+	appRouter.POST("/payment-order/:request_id/cancel", middleware.ValidateVendorID(), paymentOrderHandler.CancelPaymentOrder)
+	appRouter.POST("/payment-order/:request_id/extend", middleware.ValidateVendorID(), paymentOrderHandler.ExtendPaymentOrder)
+	appRouter.PUT("/payment-order/network", middleware.ValidateVendorID(), paymentOrderHandler.UpdatePaymentOrderNetwork)
+
+	// SECTION: invoice
+	invoiceHandler := handlers.NewInvoiceHandler(invoiceUCase)
+	appRouter.POST("/invoices", middleware.ValidateVendorID(), middleware.Idempotency(idempotencyUCase), invoiceHandler.CreateInvoice)
+	appRouter.GET("/invoice/:request_id", middleware.ValidateVendorID(), invoiceHandler.GetInvoice)
+
+	// SECTION: subscription
+	subscriptionHandler := handlers.NewSubscriptionHandler(subscriptionUCase)
+	appRouter.POST("/subscription-plans", middleware.ValidateVendorID(), subscriptionHandler.CreateSubscriptionPlan)
+	appRouter.GET("/subscription-plans", middleware.ValidateVendorID(), subscriptionHandler.GetSubscriptionPlans)
+	appRouter.POST("/subscriptions", middleware.ValidateVendorID(), middleware.Idempotency(idempotencyUCase), subscriptionHandler.CreateSubscription)
+	appRouter.GET("/subscription/:id", middleware.ValidateVendorID(), subscriptionHandler.GetSubscription)
+	appRouter.POST("/subscription/:id/cancel", middleware.ValidateVendorID(), subscriptionHandler.CancelSubscription)
 
 	// SECTION: payment wallet
 	paymentWalletHander := handlers.NewPaymentWalletHandler(paymentWalletUCase, config)
 	appRouter.GET("/payment-wallet/:address", paymentWalletHander.GetPaymentWalletByAddress)
 	appRouter.GET("/payment-wallets/balances", paymentWalletHander.GetPaymentWalletsWithBalances)
 	appRouter.GET("/payment-wallets/receiving-address", paymentWalletHander.GetReceivingWalletAddress)
+	appRouter.GET("/payment-wallets/gas-source-address", paymentWalletHander.GetGasSourceWalletAddress)
 	appRouter.PUT("payment-wallets/balance/sync", paymentWalletHander.SyncPaymentWalletBalance)
 
 	// SECTION: metadata
@@ -53,6 +84,89 @@ func RegisterRoutes(
 	appRouter.GET("/metadata/tokens", metadataHandler.GetTokensMetadata)
 
 	// SECTION: payment statistics
-	paymentStatisticsHandler := handlers.NewPaymentStatisticsHandler(paymentStatisticsUCase)
+	paymentStatisticsHandler := handlers.NewPaymentStatisticsHandler(paymentStatisticsUCase, gasCostUCase)
 	appRouter.GET("payment-statistics", paymentStatisticsHandler.GetPaymentStatistics)
+	appRouter.GET("payment-statistics/network-fees", paymentStatisticsHandler.GetNetworkFeeStatistics)
+
+	// SECTION: hosted payment page
+	if conf.IsPaymentPageEnabled() {
+		paymentPageHandler := handlers.NewPaymentPageHandler(paymentOrderUCase)
+		r.GET("/pay/:vendor_id/:request_id", paymentPageHandler.GetPaymentPage)
+	}
+
+	// SECTION: gasless payment
+	if conf.IsGaslessPaymentEnabled() {
+		gaslessPaymentHandler := handlers.NewGaslessPaymentHandler(gaslessPaymentUCase)
+		appRouter.POST(
+			"/gasless-payments",
+			middleware.RateLimit(conf.GetGaslessRateLimit(), time.Minute),
+			middleware.ValidateVendorID(),
+			gaslessPaymentHandler.SubmitGaslessPayment,
+		)
+		appRouter.GET("/gasless-payments/relayer-address", gaslessPaymentHandler.GetRelayerAddress)
+		appRouter.GET("/gasless-payments/relayer-fees", middleware.ValidateVendorID(), gaslessPaymentHandler.GetRelayerFees)
+	}
+
+	// SECTION: payout
+	payoutHandler := handlers.NewPayoutHandler(payoutUCase)
+	if conf.IsPayoutEnabled() {
+		appRouter.PUT("/user-wallets", middleware.ValidateVendorID(), payoutHandler.RegisterUserWallet)
+		appRouter.GET("/user-wallet/:user_id", middleware.ValidateVendorID(), payoutHandler.GetUserWallet)
+		appRouter.POST("/payouts", middleware.ValidateVendorID(), middleware.Idempotency(idempotencyUCase), payoutHandler.CreatePayouts)
+		appRouter.GET("/payouts/payout-address", payoutHandler.GetPayoutWalletAddress)
+		appRouter.GET("/payout/:request_id", middleware.ValidateVendorID(), payoutHandler.GetPayout)
+	}
+
+	// SECTION: admin
+	adminRouter := v1.Group("/admin", middleware.ValidateAdminKey(config.AdminAPIKey))
+	rescanHandler := handlers.NewRescanHandler(rescanners)
+	adminRouter.POST("/rescans", rescanHandler.Rescan)
+	authenticateApprover := middleware.AuthenticateApprover(conf.GetTransferApprovers())
+	tolerancePolicyHandler := handlers.NewTolerancePolicyHandler(tolerancePolicyUCase)
+	adminRouter.GET("/tolerance-policies", tolerancePolicyHandler.GetTolerancePolicies)
+	adminRouter.PUT("/tolerance-policies", tolerancePolicyHandler.UpsertTolerancePolicy)
+	adminRouter.DELETE("/tolerance-policies", tolerancePolicyHandler.DeleteTolerancePolicy)
+	if conf.IsPayoutEnabled() {
+		adminRouter.GET("/payouts", payoutHandler.GetPayouts)
+		adminRouter.POST("/payouts/:id/approve", authenticateApprover, payoutHandler.ApprovePayout)
+		adminRouter.POST("/payouts/:id/reject", authenticateApprover, payoutHandler.RejectPayout)
+	}
+	transferPolicyHandler := handlers.NewTransferPolicyHandler(transferPolicyUCase)
+	adminRouter.GET("/transfer-policies", transferPolicyHandler.GetTransferPolicies)
+	adminRouter.PUT("/transfer-policies", transferPolicyHandler.UpsertTransferPolicy)
+	adminRouter.DELETE("/transfer-policies", transferPolicyHandler.DeleteTransferPolicy)
+	adminRouter.GET("/transfer-allow-list", transferPolicyHandler.GetAllowedAddresses)
+	adminRouter.PUT("/transfer-allow-list", transferPolicyHandler.UpsertAllowedAddress)
+	adminRouter.DELETE("/transfer-allow-list", transferPolicyHandler.DeleteAllowedAddress)
+	adminRouter.GET("/transfer-decisions", transferPolicyHandler.GetTransferDecisions)
+	withdrawalRequestHandler := handlers.NewWithdrawalRequestHandler(withdrawalRequestUCase)
+	adminRouter.GET("/withdrawal-requests", withdrawalRequestHandler.GetWithdrawalRequests)
+	adminRouter.POST("/withdrawal-requests/:id/approve", authenticateApprover, withdrawalRequestHandler.ApproveWithdrawalRequest)
+	adminRouter.POST("/withdrawal-requests/:id/reject", authenticateApprover, withdrawalRequestHandler.RejectWithdrawalRequest)
+	withdrawScheduleHandler := handlers.NewWithdrawScheduleHandler(withdrawScheduleUCase)
+	adminRouter.GET("/withdraw-schedules", withdrawScheduleHandler.GetWithdrawSchedules)
+	adminRouter.PUT("/withdraw-schedules", withdrawScheduleHandler.UpsertWithdrawSchedule)
+	adminRouter.POST("/withdraw-schedules/sweep", withdrawScheduleHandler.RequestWithdrawSweep)
+	adminRouter.POST("/withdraw-schedules/pause", withdrawScheduleHandler.PauseWithdrawSchedules)
+	adminRouter.POST("/withdraw-schedules/resume", withdrawScheduleHandler.ResumeWithdrawSchedules)
+	complianceHandler := handlers.NewComplianceHandler(complianceUCase)
+	adminRouter.GET("/compliance/denied-addresses", complianceHandler.GetDeniedAddresses)
+	adminRouter.PUT("/compliance/denied-addresses", complianceHandler.UpsertDeniedAddress)
+	adminRouter.DELETE("/compliance/denied-addresses", complianceHandler.DeleteDeniedAddress)
+	adminRouter.GET("/compliance/holds", complianceHandler.GetComplianceHolds)
+	adminRouter.POST("/compliance/holds/:id/release", authenticateApprover, complianceHandler.ReleaseComplianceHold)
+	adminRouter.POST("/compliance/holds/:id/reject", authenticateApprover, complianceHandler.RejectComplianceHold)
+
+	// SECTION: admin operations, every call is audited under the X-Operator header
+	operationsRouter := r.Group("/admin/v1", middleware.ValidateAdminKey(config.AdminAPIKey), middleware.ValidateOperator())
+	adminHandler := handlers.NewAdminHandler(adminUCase)
+	operationsRouter.GET("/block-states", adminHandler.GetBlockStates)
+	operationsRouter.PUT("/block-states/:network", adminHandler.ResetLastProcessedBlock)
+	operationsRouter.POST("/payment-wallets/:id/release", adminHandler.ReleasePaymentWallet)
+	operationsRouter.POST("/payment-wallets/:id/lock", adminHandler.LockPaymentWallet)
+	operationsRouter.POST("/payment-wallets/balances/sync", adminHandler.SyncWalletBalances)
+	operationsRouter.POST("/payment-orders/:request_id/resolve", middleware.ValidateVendorID(), adminHandler.ResolvePaymentOrder)
+	operationsRouter.GET("/payment-orders/:request_id/audit", middleware.ValidateVendorID(), adminHandler.GetPaymentOrderAudits)
+	operationsRouter.GET("/order-set", adminHandler.GetOrderSet)
+	operationsRouter.GET("/audit-logs", adminHandler.GetAuditLogs)
 }
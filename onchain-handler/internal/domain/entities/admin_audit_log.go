@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// AdminAuditLog is an audit record of an operation run through the admin API.
+type AdminAuditLog struct {
+	ID        uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	Reason    string    `json:"reason"`
+	Details   string    `json:"details"` // JSON of the action parameters and outcome
+	Succeeded bool      `json:"succeeded"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (m *AdminAuditLog) TableName() string {
+	return "admin_audit_log"
+}
+
+func (m *AdminAuditLog) ToDto() dto.AdminAuditLogDTO {
+	return dto.AdminAuditLogDTO{
+		ID:        m.ID,
+		Actor:     m.Actor,
+		Action:    m.Action,
+		Target:    m.Target,
+		Reason:    m.Reason,
+		Details:   m.Details,
+		Succeeded: m.Succeeded,
+		Error:     m.Error,
+		CreatedAt: m.CreatedAt,
+	}
+}
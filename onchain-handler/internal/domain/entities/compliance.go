@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// DeniedAddress is a payer address flagged by the deny-list screening provider.
+type DeniedAddress struct {
+	ID        uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Address   string    `json:"address"` // Lower case
+	Network   string    `json:"network"` // Empty for any network
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (m *DeniedAddress) TableName() string {
+	return "denied_address"
+}
+
+func (m *DeniedAddress) ToDto() dto.DeniedAddressDTO {
+	return dto.DeniedAddressDTO{
+		ID:        m.ID,
+		Address:   m.Address,
+		Network:   m.Network,
+		Reason:    m.Reason,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// ComplianceHold is the audit record of a payment flagged by the payer screening, held until it is reviewed.
+type ComplianceHold struct {
+	ID              uint64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	PaymentOrderID  uint64     `json:"payment_order_id"`
+	VendorID        string     `json:"vendor_id"`
+	WalletID        *uint64    `json:"wallet_id"`
+	Network         string     `json:"network"`
+	Symbol          string     `json:"symbol"`
+	TransactionHash string     `json:"transaction_hash"`
+	LogIndex        uint       `json:"log_index"`
+	FromAddress     string     `json:"from_address"`
+	ToAddress       string     `json:"to_address"`
+	Amount          string     `json:"amount"`
+	Provider        string     `json:"provider"`
+	Reason          string     `json:"reason"`
+	Status          string     `json:"status" gorm:"default:HELD"`
+	ReviewedBy      string     `json:"reviewed_by"`
+	ReviewNote      string     `json:"review_note"`
+	ReviewedAt      *time.Time `json:"reviewed_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func (m *ComplianceHold) TableName() string {
+	return "compliance_hold"
+}
+
+func (m *ComplianceHold) ToDto() dto.ComplianceHoldDTO {
+	return dto.ComplianceHoldDTO{
+		ID:              m.ID,
+		PaymentOrderID:  m.PaymentOrderID,
+		VendorID:        m.VendorID,
+		WalletID:        m.WalletID,
+		Network:         m.Network,
+		Symbol:          m.Symbol,
+		TransactionHash: m.TransactionHash,
+		LogIndex:        m.LogIndex,
+		FromAddress:     m.FromAddress,
+		ToAddress:       m.ToAddress,
+		Amount:          m.Amount,
+		Provider:        m.Provider,
+		Reason:          m.Reason,
+		Status:          m.Status,
+		ReviewedBy:      m.ReviewedBy,
+		ReviewNote:      m.ReviewNote,
+		ReviewedAt:      m.ReviewedAt,
+		CreatedAt:       m.CreatedAt,
+	}
+}
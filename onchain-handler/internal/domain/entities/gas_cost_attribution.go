@@ -0,0 +1,40 @@
+package entities
+
+import "time"
+
+// GasCostAttribution is the share of the gas cost of a sweep attributed to an order whose funds it moved.
+type GasCostAttribution struct {
+	ID              uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Network         string    `json:"network"`
+	Symbol          string    `json:"symbol"`
+	TransactionHash string    `json:"transaction_hash"`
+	TransferType    string    `json:"transfer_type"`
+	PaymentOrderID  uint64    `json:"payment_order_id"`
+	VendorID        string    `json:"vendor_id"`
+	Amount          string    `json:"amount"`
+	Fee             string    `json:"fee"`
+	NativePriceUSD  *string   `json:"native_price_usd"`
+	FeeUSD          *string   `json:"fee_usd"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (m *GasCostAttribution) TableName() string {
+	return "gas_cost_attribution"
+}
+
+// OrderAmount is the token amount of an order moved by a sweep.
+type OrderAmount struct {
+	PaymentOrderID uint64
+	VendorID       string
+	Amount         string
+}
+
+// NetworkFeeTotal is the gas cost attributed to the orders of a network and token.
+type NetworkFeeTotal struct {
+	Network     string
+	Symbol      string
+	TotalOrders uint64
+	TotalFee    string
+	TotalFeeUSD string
+	UnpricedFee string // Fee without a USD price, not in TotalFeeUSD
+}
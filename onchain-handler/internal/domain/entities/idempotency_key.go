@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+)
+
+// IdempotencyKey stores the response of a request sent with an Idempotency-Key header.
+// StatusCode is 0 while the first request is still being processed.
+type IdempotencyKey struct {
+	ID          uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	VendorID    string    `json:"vendor_id"`
+	Key         string    `json:"key"`
+	RequestHash string    `json:"request_hash"`
+	StatusCode  int       `json:"status_code"`
+	Response    string    `json:"response"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (m *IdempotencyKey) TableName() string {
+	return "idempotency_key"
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+)
+
+// Invoice groups the payment orders of its line items and is PAID once their payments meet its total.
+type Invoice struct {
+	ID            uint64        `json:"id" gorm:"primaryKey;autoIncrement"`
+	VendorID      string        `json:"vendor_id"`
+	RequestID     string        `json:"request_id"`
+	TotalAmount   string        `json:"total_amount"`
+	SettledAmount string        `json:"settled_amount"`
+	Status        string        `json:"status"`
+	WebhookURL    string        `json:"webhook_url"`
+	PaidAt        *time.Time    `json:"paid_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	Items         []InvoiceItem `json:"items" gorm:"foreignKey:InvoiceID"`
+}
+
+func (m *Invoice) TableName() string {
+	return "invoice"
+}
+
+// InvoiceItem is a line item of an invoice, paid by its own payment order.
+type InvoiceItem struct {
+	ID             uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	InvoiceID      uint64    `json:"invoice_id"`
+	PaymentOrderID uint64    `json:"payment_order_id"`
+	Description    string    `json:"description"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (m *InvoiceItem) TableName() string {
+	return "invoice_item"
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ListenerInstance represents a worker instance taking part in listener sharding.
+type ListenerInstance struct {
+	InstanceID  string    `json:"instance_id" gorm:"primaryKey"`
+	HeartbeatAt time.Time `json:"heartbeat_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (m *ListenerInstance) TableName() string {
+	return "listener_instance"
+}
+
+// ListenerShard represents a (network, payment address partition) pair owned by a single instance.
+type ListenerShard struct {
+	ID                 uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Network            string    `json:"network"`
+	PartitionIndex     uint32    `json:"partition_index"`
+	PartitionCount     uint32    `json:"partition_count"`
+	InstanceID         *string   `json:"instance_id"`
+	LastProcessedBlock uint64    `json:"last_processed_block"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+func (m *ListenerShard) TableName() string {
+	return "listener_shard"
+}
+
+func (m *ListenerShard) ToDto() dto.ListenerShardDTO {
+	instanceID := ""
+	if m.InstanceID != nil {
+		instanceID = *m.InstanceID
+	}
+	return dto.ListenerShardDTO{
+		ID:                 m.ID,
+		Network:            m.Network,
+		PartitionIndex:     m.PartitionIndex,
+		PartitionCount:     m.PartitionCount,
+		InstanceID:         instanceID,
+		LastProcessedBlock: m.LastProcessedBlock,
+	}
+}
@@ -29,6 +29,7 @@ func (m *TokenTransferHistory) TableName() string {
 
 func (m *TokenTransferHistory) ToDto() dto.TokenTransferHistoryDTO {
 	return dto.TokenTransferHistoryDTO{
+		RequestID:       m.RequestID,
 		Network:         m.Network,
 		TransactionHash: m.TransactionHash,
 		FromAddress:     m.FromAddress,
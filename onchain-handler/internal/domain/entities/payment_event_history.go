@@ -7,6 +7,7 @@ type PaymentEventHistory struct {
 	PaymentOrderID  uint64       `json:"payment_order_id"`
 	PaymentOrder    PaymentOrder `gorm:"foreignKey:PaymentOrderID"`
 	TransactionHash string       `json:"transaction_hash"`
+	LogIndex        *uint        `json:"log_index"`
 	FromAddress     string       `json:"from_address"`
 	ToAddress       string       `json:"to_address"`
 	ContractAddress string       `json:"contract_address"`
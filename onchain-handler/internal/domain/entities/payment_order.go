@@ -10,7 +10,7 @@ type PaymentOrder struct {
 	ID                    uint64                `json:"id" gorm:"primaryKey;autoIncrement"`
 	RequestID             string                `json:"request_id"`
 	VendorID              string                `json:"vendor_id"`
-	WalletID              uint64                `json:"wallet_id"`
+	WalletID              *uint64               `json:"wallet_id"` // Nil for ROUTER orders
 	Wallet                PaymentWallet         `gorm:"foreignKey:WalletID"`
 	BlockHeight           uint64                `json:"block_height"`
 	UpcomingBlockHeight   uint64                `json:"upcoming_block_height"`
@@ -18,6 +18,7 @@ type PaymentOrder struct {
 	Transferred           string                `json:"transferred"`
 	Symbol                string                `json:"symbol"`
 	Network               string                `json:"network"`
+	PaymentMode           string                `json:"payment_mode"`
 	Status                string                `json:"status"`
 	WebhookURL            string                `json:"webhook_url"`
 	SucceededAt           time.Time             `json:"succeeded_at"`
@@ -25,6 +26,7 @@ type PaymentOrder struct {
 	CreatedAt             time.Time             `json:"created_at"`
 	UpdatedAt             time.Time             `json:"updated_at"`
 	PaymentEventHistories []PaymentEventHistory `json:"payment_event_histories" gorm:"foreignKey:PaymentOrderID"`
+	Options               []PaymentOrderOption  `json:"options" gorm:"foreignKey:PaymentOrderID"` // Empty for single chain orders
 }
 
 func (m *PaymentOrder) TableName() string {
@@ -36,7 +38,7 @@ func (m *PaymentOrder) ToDto() dto.PaymentOrderDTO {
 		ID:                  m.ID,
 		RequestID:           m.RequestID,
 		VendorID:            m.VendorID,
-		PaymentAddress:      m.Wallet.Address,
+		PaymentAddress:      m.Wallet.AddressOn(m.Network),
 		Wallet:              m.Wallet.ToDto(),
 		BlockHeight:         m.BlockHeight,
 		UpcomingBlockHeight: m.UpcomingBlockHeight,
@@ -44,19 +46,93 @@ func (m *PaymentOrder) ToDto() dto.PaymentOrderDTO {
 		Transferred:         m.Transferred,
 		Symbol:              m.Symbol,
 		Network:             m.Network,
+		PaymentMode:         m.PaymentMode,
 		Status:              m.Status,
 		WebhookURL:          m.WebhookURL,
 		ExpiredTime:         m.ExpiredTime,
+		CreatedAt:           m.CreatedAt,
+		Options:             dto.EncodePaymentOptions(m.OptionDTOs()),
 	}
 }
 
+// ToSetItems returns the items of the order in the payment order set: the order itself, or one per option
+// of a multi-chain order, each with the network, token and block height of the option.
+func (m *PaymentOrder) ToSetItems() []dto.PaymentOrderDTO {
+	return m.toSetItems(func(PaymentOrderOption) bool { return true })
+}
+
+// ToSetItemsOn returns the items of the order in the payment order set listened for on the network.
+func (m *PaymentOrder) ToSetItemsOn(network string) []dto.PaymentOrderDTO {
+	return m.toSetItems(func(option PaymentOrderOption) bool { return option.Network == network })
+}
+
+func (m *PaymentOrder) toSetItems(accept func(PaymentOrderOption) bool) []dto.PaymentOrderDTO {
+	orderDTO := m.ToDto()
+	if len(m.Options) == 0 {
+		return []dto.PaymentOrderDTO{orderDTO}
+	}
+
+	var items []dto.PaymentOrderDTO
+	for _, option := range m.Options {
+		if !accept(option) {
+			continue
+		}
+		item := orderDTO
+		item.Network = option.Network
+		item.Symbol = option.Symbol
+		item.BlockHeight = option.BlockHeight
+		item.PaymentAddress = m.Wallet.AddressOn(option.Network)
+		if option.Network != m.Network || option.Symbol != m.Symbol {
+			// The upcoming block height is of the option currently selected
+			item.UpcomingBlockHeight = 0
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// OptionDTOs returns the networks and tokens a multi-chain order accepts.
+func (m *PaymentOrder) OptionDTOs() []dto.PaymentOptionDTO {
+	var options []dto.PaymentOptionDTO
+	for _, option := range m.Options {
+		options = append(options, dto.PaymentOptionDTO{Network: option.Network, Symbol: option.Symbol})
+	}
+	return options
+}
+
+// AcceptsOption reports whether the multi-chain order accepts the network and token.
+func (m *PaymentOrder) AcceptsOption(network, symbol string) bool {
+	for _, option := range m.Options {
+		if option.Network == network && option.Symbol == symbol {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *PaymentOrder) ToCreatedPaymentOrderDTO() dto.CreatedPaymentOrderDTO {
 	return dto.CreatedPaymentOrderDTO{
 		ID:             m.ID,
 		RequestID:      m.RequestID,
-		PaymentAddress: m.Wallet.Address,
+		PaymentAddress: m.Wallet.AddressOn(m.Network),
 		Amount:         m.Amount,
 		Symbol:         m.Symbol,
 		Network:        m.Network,
+		PaymentMode:    m.PaymentMode,
+		Options:        m.OptionDTOs(),
 	}
 }
+
+// PaymentOrderOption is a network and token a multi-chain order accepts.
+type PaymentOrderOption struct {
+	ID             uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	PaymentOrderID uint64    `json:"payment_order_id"`
+	Network        string    `json:"network"`
+	Symbol         string    `json:"symbol"`
+	BlockHeight    uint64    `json:"block_height"` // Block the option is listened from
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (m *PaymentOrderOption) TableName() string {
+	return "payment_order_option"
+}
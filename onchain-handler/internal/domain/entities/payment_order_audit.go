@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// PaymentOrderAudit is an append-only record of a change of a payment order.
+type PaymentOrderAudit struct {
+	ID             uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	PaymentOrderID uint64    `json:"payment_order_id"`
+	OldStatus      string    `json:"old_status"` // Empty for the creation of the order
+	NewStatus      string    `json:"new_status"`
+	OldTransferred string    `json:"old_transferred"`
+	NewTransferred string    `json:"new_transferred"`
+	Actor          string    `json:"actor"`
+	BlockNumber    *uint64   `json:"block_number"` // Nil for changes not made by a transfer
+	TxHash         string    `json:"tx_hash"`
+	Note           string    `json:"note"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (m *PaymentOrderAudit) TableName() string {
+	return "payment_order_audit"
+}
+
+func (m *PaymentOrderAudit) ToDto() dto.PaymentOrderAuditDTO {
+	return dto.PaymentOrderAuditDTO{
+		ID:             m.ID,
+		OldStatus:      m.OldStatus,
+		NewStatus:      m.NewStatus,
+		OldTransferred: m.OldTransferred,
+		NewTransferred: m.NewTransferred,
+		Actor:          m.Actor,
+		BlockNumber:    m.BlockNumber,
+		TxHash:         m.TxHash,
+		Note:           m.Note,
+		CreatedAt:      m.CreatedAt,
+	}
+}
@@ -16,6 +16,7 @@ type PaymentStatistics struct {
 	TotalOrders      uint64    `json:"total_orders"`
 	TotalAmount      string    `json:"total_amount"`
 	TotalTransferred string    `json:"total_transferred"`
+	TotalNetworkFee  string    `json:"total_network_fee" gorm:"default:0"` // USD
 	Symbol           string    `json:"symbol"`
 	VendorID         string    `json:"vendor_id"`
 	CreatedAt        time.Time `json:"created_at"`
@@ -49,11 +50,17 @@ func ToPeriodStatisticsDTO(data []PaymentStatistics) []dto.PeriodStatistics {
 			transferred = "0"
 		}
 
+		networkFee := stat.TotalNetworkFee
+		if networkFee == "" {
+			networkFee = "0"
+		}
+
 		grouped[periodStart][stat.Symbol] = dto.TokenStats{
 			Symbol:           stat.Symbol,
 			TotalOrders:      stat.TotalOrders,
 			TotalAmount:      amount,
 			TotalTransferred: transferred,
+			TotalNetworkFee:  networkFee,
 		}
 	}
 
@@ -77,6 +84,7 @@ func ToPeriodStatisticsDTO(data []PaymentStatistics) []dto.PeriodStatistics {
 				TotalOrders:      0,
 				TotalAmount:      "0",
 				TotalTransferred: "0",
+				TotalNetworkFee:  "0",
 			}
 		}
 		if _, ok := tokenMap[constants.USDT]; !ok {
@@ -85,6 +93,7 @@ func ToPeriodStatisticsDTO(data []PaymentStatistics) []dto.PeriodStatistics {
 				TotalOrders:      0,
 				TotalAmount:      "0",
 				TotalTransferred: "0",
+				TotalNetworkFee:  "0",
 			}
 		}
 
@@ -3,13 +3,16 @@ package entities
 import (
 	"time"
 
+	"github.com/genefriendway/onchain-handler/constants"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 )
 
 type PaymentWallet struct {
 	ID                    uint64                 `json:"id" gorm:"primaryKey;autoIncrement"`
 	Address               string                 `json:"address"`
+	TronAddress           string                 `json:"tron_address" gorm:"default:null"` // Empty until derived for the Tron network
 	InUse                 bool                   `json:"in_use"`
+	CooldownUntil         *time.Time             `json:"cooldown_until"` // Not claimed again before, set when released by a cancelled order
 	CreatedAt             time.Time              `json:"created_at"`
 	UpdatedAt             time.Time              `json:"updated_at"`
 	PaymentWalletBalances []PaymentWalletBalance `json:"payment_wallet_balance" gorm:"foreignKey:WalletID"`
@@ -21,8 +24,17 @@ func (m *PaymentWallet) TableName() string {
 
 func (m *PaymentWallet) ToDto() dto.PaymentWalletDTO {
 	return dto.PaymentWalletDTO{
-		ID:      m.ID,
-		Address: m.Address,
-		InUse:   m.InUse,
+		ID:          m.ID,
+		Address:     m.Address,
+		TronAddress: m.TronAddress,
+		InUse:       m.InUse,
 	}
 }
+
+// AddressOn returns the address the wallet receives payments at on the network
+func (m *PaymentWallet) AddressOn(network string) string {
+	if network == constants.Tron.String() {
+		return m.TronAddress
+	}
+	return m.Address
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// Payout is an outbound transfer of a vendor to a user wallet, sent from the payout wallet once approved.
+type Payout struct {
+	ID                uint64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	VendorID          string     `json:"vendor_id"`
+	RequestID         string     `json:"request_id"`
+	UserID            *uint64    `json:"user_id"` // Set when the payout was requested for a registered user wallet
+	ToAddress         string     `json:"to_address"`
+	Amount            string     `json:"amount"`
+	Symbol            string     `json:"symbol"`
+	Network           string     `json:"network"`
+	Status            string     `json:"status"`
+	RequiredApprovals uint       `json:"required_approvals"`
+	Approvals         uint       `json:"approvals"`
+	WebhookURL        string     `json:"webhook_url"`
+	TransactionHash   string     `json:"transaction_hash"`
+	Fee               string     `json:"fee" gorm:"default:0"`
+	ErrorMessage      string     `json:"error_message"`
+	ApprovedAt        *time.Time `json:"approved_at"`
+	ExecutedAt        *time.Time `json:"executed_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+func (m *Payout) TableName() string {
+	return "payout"
+}
+
+func (m *Payout) ToDto() dto.PayoutDTO {
+	return dto.PayoutDTO{
+		ID:                m.ID,
+		RequestID:         m.RequestID,
+		UserID:            m.UserID,
+		ToAddress:         m.ToAddress,
+		Amount:            m.Amount,
+		Symbol:            m.Symbol,
+		Network:           m.Network,
+		Status:            m.Status,
+		RequiredApprovals: m.RequiredApprovals,
+		Approvals:         m.Approvals,
+		WebhookURL:        m.WebhookURL,
+		TransactionHash:   m.TransactionHash,
+		Fee:               m.Fee,
+		ErrorMessage:      m.ErrorMessage,
+		ApprovedAt:        m.ApprovedAt,
+		ExecutedAt:        m.ExecutedAt,
+		CreatedAt:         m.CreatedAt,
+	}
+}
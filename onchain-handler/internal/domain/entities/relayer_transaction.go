@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// RelayerTransaction represents a transaction sent by the relayer wallet for a gasless payment.
+type RelayerTransaction struct {
+	ID              uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	VendorID        string    `json:"vendor_id"`
+	PaymentOrderID  uint64    `json:"payment_order_id"`
+	Network         string    `json:"network"`
+	TransactionHash string    `json:"transaction_hash"`
+	Method          string    `json:"method"`
+	GasUsed         uint64    `json:"gas_used"`
+	GasPrice        string    `json:"gas_price"`
+	Fee             string    `json:"fee"`
+	Status          bool      `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (m *RelayerTransaction) TableName() string {
+	return "relayer_transaction"
+}
+
+func (m *RelayerTransaction) ToDto() dto.RelayerTransactionDTO {
+	return dto.RelayerTransactionDTO{
+		TransactionHash: m.TransactionHash,
+		Method:          m.Method,
+		GasUsed:         m.GasUsed,
+		Fee:             m.Fee,
+		Status:          m.Status,
+	}
+}
+
+// RelayerFee is the gas spent by the relayer wallet for a vendor on a network.
+type RelayerFee struct {
+	Network          string `json:"network"`
+	TransactionCount uint64 `json:"transaction_count"`
+	TotalFee         string `json:"total_fee"`
+}
@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// SubscriptionPlan is a recurring plan of a vendor, each cycle is billed with a payment order of its amount.
+type SubscriptionPlan struct {
+	ID              uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	VendorID        string    `json:"vendor_id"`
+	Name            string    `json:"name"`
+	Amount          string    `json:"amount"`
+	Symbol          string    `json:"symbol"`
+	Network         string    `json:"network"`
+	BillingInterval string    `json:"billing_interval"`
+	GracePeriod     uint      `json:"grace_period"` // Minutes the order of a cycle can be paid before the subscription is PAST_DUE
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (m *SubscriptionPlan) TableName() string {
+	return "subscription_plan"
+}
+
+func (m *SubscriptionPlan) ToDto() dto.SubscriptionPlanDTO {
+	return dto.SubscriptionPlanDTO{
+		ID:              m.ID,
+		Name:            m.Name,
+		Amount:          m.Amount,
+		Symbol:          m.Symbol,
+		Network:         m.Network,
+		BillingInterval: m.BillingInterval,
+		GracePeriod:     m.GracePeriod,
+		CreatedAt:       m.CreatedAt,
+	}
+}
+
+// Subscription of a customer to a plan. The cycle n is billed at the billing anchor plus n intervals.
+type Subscription struct {
+	ID             uint64           `json:"id" gorm:"primaryKey;autoIncrement"`
+	PlanID         uint64           `json:"plan_id"`
+	Plan           SubscriptionPlan `gorm:"foreignKey:PlanID"`
+	VendorID       string           `json:"vendor_id"`
+	CustomerID     string           `json:"customer_id"`
+	WebhookURL     string           `json:"webhook_url"`
+	Status         string           `json:"status"`
+	BillingAnchor  time.Time        `json:"billing_anchor"`
+	Cycle          uint             `json:"cycle"`
+	NextBillingAt  time.Time        `json:"next_billing_at"`
+	CurrentOrderID *uint64          `json:"current_order_id"` // Order of the cycle being billed, nil once it is paid
+	CancelledAt    *time.Time       `json:"cancelled_at"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+func (m *Subscription) TableName() string {
+	return "subscription"
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// TolerancePolicy sets how far the transferred amount of the orders of a vendor and token may be from the order amount.
+type TolerancePolicy struct {
+	ID              uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	VendorID        string    `json:"vendor_id"`
+	Symbol          string    `json:"symbol"`
+	UnderAbsolute   *float64  `json:"under_absolute"`
+	UnderPercentage *float64  `json:"under_percentage"`
+	OverAbsolute    *float64  `json:"over_absolute"`
+	OverPercentage  *float64  `json:"over_percentage"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (m *TolerancePolicy) TableName() string {
+	return "tolerance_policy"
+}
+
+func (m *TolerancePolicy) ToDto() dto.TolerancePolicyDTO {
+	return dto.TolerancePolicyDTO{
+		ID:              m.ID,
+		VendorID:        m.VendorID,
+		Symbol:          m.Symbol,
+		UnderAbsolute:   m.UnderAbsolute,
+		UnderPercentage: m.UnderPercentage,
+		OverAbsolute:    m.OverAbsolute,
+		OverPercentage:  m.OverPercentage,
+	}
+}
@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// TransferPolicy guards the outbound transfers of a network and token.
+type TransferPolicy struct {
+	ID                uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Network           string    `json:"network"`
+	Symbol            string    `json:"symbol"`
+	ApprovalThreshold *float64  `json:"approval_threshold"`
+	RequiredApprovals uint      `json:"required_approvals"`
+	DailyLimit        *float64  `json:"daily_limit"`
+	AllowListOnly     bool      `json:"allow_list_only"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+func (m *TransferPolicy) TableName() string {
+	return "transfer_policy"
+}
+
+func (m *TransferPolicy) ToDto() dto.TransferPolicyDTO {
+	return dto.TransferPolicyDTO{
+		ID:                m.ID,
+		Network:           m.Network,
+		Symbol:            m.Symbol,
+		ApprovalThreshold: m.ApprovalThreshold,
+		RequiredApprovals: m.RequiredApprovals,
+		DailyLimit:        m.DailyLimit,
+		AllowListOnly:     m.AllowListOnly,
+	}
+}
+
+// TransferAllowedAddress is a destination allowed on a network by the allow-list only policies.
+type TransferAllowedAddress struct {
+	ID        uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	Network   string    `json:"network"`
+	Address   string    `json:"address"`
+	Label     string    `json:"label"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (m *TransferAllowedAddress) TableName() string {
+	return "transfer_allowed_address"
+}
+
+func (m *TransferAllowedAddress) ToDto() dto.TransferAllowedAddressDTO {
+	return dto.TransferAllowedAddressDTO{
+		Network:   m.Network,
+		Address:   m.Address,
+		Label:     m.Label,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// TransferDecision is an audit record of a policy decision or an approval on an outbound transfer.
+type TransferDecision struct {
+	ID           uint64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	TransferKind string    `json:"transfer_kind"`
+	TransferID   *uint64   `json:"transfer_id"` // Nil for withdrawals sent without a withdrawal request
+	Network      string    `json:"network"`
+	Symbol       string    `json:"symbol"`
+	ToAddress    string    `json:"to_address"`
+	Amount       string    `json:"amount"`
+	Decision     string    `json:"decision"`
+	Actor        string    `json:"actor"`
+	Reason       string    `json:"reason"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (m *TransferDecision) TableName() string {
+	return "transfer_decision"
+}
+
+func (m *TransferDecision) ToDto() dto.TransferDecisionDTO {
+	return dto.TransferDecisionDTO{
+		ID:           m.ID,
+		TransferKind: m.TransferKind,
+		TransferID:   m.TransferID,
+		Network:      m.Network,
+		Symbol:       m.Symbol,
+		ToAddress:    m.ToAddress,
+		Amount:       m.Amount,
+		Decision:     m.Decision,
+		Actor:        m.Actor,
+		Reason:       m.Reason,
+		CreatedAt:    m.CreatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// WithdrawSchedule is the sweep schedule of the payment wallets of a network and token.
+type WithdrawSchedule struct {
+	ID              uint64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	Network         string     `json:"network"`
+	Symbol          string     `json:"symbol"`
+	CronExpression  string     `json:"cron_expression"`
+	MinBatchValue   *float64   `json:"min_batch_value"`
+	MaxGasPriceGwei *float64   `json:"max_gas_price_gwei"`
+	Paused          bool       `json:"paused"`
+	SweepRequested  bool       `json:"sweep_requested"`
+	NextRunAt       time.Time  `json:"next_run_at"`
+	LastRunAt       *time.Time `json:"last_run_at"`
+	LastStatus      string     `json:"last_status"`
+	LastMessage     string     `json:"last_message"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func (m *WithdrawSchedule) TableName() string {
+	return "withdraw_schedule"
+}
+
+// ToDto converts the schedule, defaultSchedule is the cron expression used when the schedule has none.
+func (m *WithdrawSchedule) ToDto(defaultSchedule string) dto.WithdrawScheduleDTO {
+	schedule := m.CronExpression
+	if schedule == "" {
+		schedule = defaultSchedule
+	}
+	return dto.WithdrawScheduleDTO{
+		ID:              m.ID,
+		Network:         m.Network,
+		Symbol:          m.Symbol,
+		CronExpression:  m.CronExpression,
+		Schedule:        schedule,
+		MinBatchValue:   m.MinBatchValue,
+		MaxGasPriceGwei: m.MaxGasPriceGwei,
+		Paused:          m.Paused,
+		SweepRequested:  m.SweepRequested,
+		NextRunAt:       m.NextRunAt,
+		LastRunAt:       m.LastRunAt,
+		LastStatus:      m.LastStatus,
+		LastMessage:     m.LastMessage,
+	}
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// WithdrawalRequest is a withdrawal from the receiving wallet to the master wallet held by its transfer policy.
+type WithdrawalRequest struct {
+	ID                uint64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	Network           string     `json:"network"`
+	Symbol            string     `json:"symbol"`
+	FromAddress       string     `json:"from_address"`
+	ToAddress         string     `json:"to_address"`
+	Amount            string     `json:"amount"`
+	Status            string     `json:"status"`
+	RequiredApprovals uint       `json:"required_approvals"`
+	Approvals         uint       `json:"approvals"`
+	TransactionHash   string     `json:"transaction_hash"`
+	Fee               string     `json:"fee" gorm:"default:0"`
+	ErrorMessage      string     `json:"error_message"`
+	ApprovedAt        *time.Time `json:"approved_at"`
+	ExecutedAt        *time.Time `json:"executed_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+func (m *WithdrawalRequest) TableName() string {
+	return "withdrawal_request"
+}
+
+func (m *WithdrawalRequest) ToDto() dto.WithdrawalRequestDTO {
+	return dto.WithdrawalRequestDTO{
+		ID:                m.ID,
+		Network:           m.Network,
+		Symbol:            m.Symbol,
+		FromAddress:       m.FromAddress,
+		ToAddress:         m.ToAddress,
+		Amount:            m.Amount,
+		Status:            m.Status,
+		RequiredApprovals: m.RequiredApprovals,
+		Approvals:         m.Approvals,
+		TransactionHash:   m.TransactionHash,
+		Fee:               m.Fee,
+		ErrorMessage:      m.ErrorMessage,
+		ApprovedAt:        m.ApprovedAt,
+		ExecutedAt:        m.ExecutedAt,
+		CreatedAt:         m.CreatedAt,
+	}
+}
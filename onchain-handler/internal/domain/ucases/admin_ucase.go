@@ -0,0 +1,274 @@
+package ucases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	settypes "github.com/genefriendway/onchain-handler/internal/adapters/orderset/types"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type adminUCase struct {
+	adminAuditLogRepository repotypes.AdminAuditLogRepository
+	blockStateRepository    repotypes.BlockStateRepository
+	paymentWalletRepository repotypes.PaymentWalletRepository
+	paymentOrderUCase       ucasetypes.PaymentOrderUCase
+	paymentWalletUCase      ucasetypes.PaymentWalletUCase
+	paymentOrderSet         settypes.Set[dto.PaymentOrderDTO]
+}
+
+func NewAdminUCase(
+	adminAuditLogRepository repotypes.AdminAuditLogRepository,
+	blockStateRepository repotypes.BlockStateRepository,
+	paymentWalletRepository repotypes.PaymentWalletRepository,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+	paymentWalletUCase ucasetypes.PaymentWalletUCase,
+	paymentOrderSet settypes.Set[dto.PaymentOrderDTO],
+) ucasetypes.AdminUCase {
+	return &adminUCase{
+		adminAuditLogRepository: adminAuditLogRepository,
+		blockStateRepository:    blockStateRepository,
+		paymentWalletRepository: paymentWalletRepository,
+		paymentOrderUCase:       paymentOrderUCase,
+		paymentWalletUCase:      paymentWalletUCase,
+		paymentOrderSet:         paymentOrderSet,
+	}
+}
+
+func (u *adminUCase) GetBlockStates(ctx context.Context, actor string) ([]dto.BlockStateDTO, error) {
+	blockStates, err := u.blockStateRepository.GetBlockStates(ctx)
+	u.audit(ctx, actor, constants.AdminActionViewBlockStates, "", "", nil, err)
+	if err != nil {
+		return nil, err
+	}
+
+	blockStateDTOs := make([]dto.BlockStateDTO, 0, len(blockStates))
+	for _, blockState := range blockStates {
+		blockStateDTOs = append(blockStateDTOs, dto.BlockStateDTO{
+			Network:            blockState.Network,
+			LatestBlock:        blockState.LatestBlock,
+			LastProcessedBlock: blockState.LastProcessedBlock,
+		})
+	}
+	return blockStateDTOs, nil
+}
+
+// ResetLastProcessedBlock moves the listener cursor of the network, backwards to listen to blocks again
+// or forwards to skip them. The listener of the network resumes from the block after it.
+func (u *adminUCase) ResetLastProcessedBlock(
+	ctx context.Context,
+	actor string,
+	network constants.NetworkType,
+	blockNumber uint64,
+	reason string,
+) (dto.BlockStateDTO, error) {
+	blockState, details, err := u.resetLastProcessedBlock(ctx, network, blockNumber)
+	u.audit(ctx, actor, constants.AdminActionResetLastProcessedBlock, network.String(), reason, details, err)
+	return blockState, err
+}
+
+func (u *adminUCase) resetLastProcessedBlock(
+	ctx context.Context,
+	network constants.NetworkType,
+	blockNumber uint64,
+) (dto.BlockStateDTO, map[string]any, error) {
+	details := map[string]any{"last_processed_block": blockNumber}
+
+	latestBlock, err := u.blockStateRepository.GetLatestBlock(ctx, network.String())
+	if err != nil {
+		return dto.BlockStateDTO{}, details, fmt.Errorf("failed to get latest block of network %s: %w", network, err)
+	}
+	if latestBlock != 0 && blockNumber > latestBlock {
+		return dto.BlockStateDTO{}, details, fmt.Errorf("%w: block %d, latest block %d on network %s",
+			ucasetypes.ErrBlockAfterLatest, blockNumber, latestBlock, network)
+	}
+
+	previousBlock, err := u.blockStateRepository.GetLastProcessedBlock(ctx, network.String())
+	if err != nil {
+		return dto.BlockStateDTO{}, details, fmt.Errorf("failed to get last processed block of network %s: %w", network, err)
+	}
+	details["previous_last_processed_block"] = previousBlock
+
+	if err := u.blockStateRepository.UpdateLastProcessedBlock(ctx, blockNumber, network.String()); err != nil {
+		return dto.BlockStateDTO{}, details, fmt.Errorf("failed to reset last processed block of network %s: %w", network, err)
+	}
+
+	return dto.BlockStateDTO{
+		Network:            network.String(),
+		LatestBlock:        latestBlock,
+		LastProcessedBlock: blockNumber,
+	}, details, nil
+}
+
+// ReleasePaymentWallet releases the wallet even when an open order still uses it, it can be claimed right away.
+func (u *adminUCase) ReleasePaymentWallet(ctx context.Context, actor string, walletID uint64, reason string) (dto.PaymentWalletDTO, error) {
+	return u.updateWalletInUse(ctx, actor, constants.AdminActionReleaseWallet, walletID, false, reason)
+}
+
+// LockPaymentWallet keeps the wallet from being claimed by new orders until it is released.
+func (u *adminUCase) LockPaymentWallet(ctx context.Context, actor string, walletID uint64, reason string) (dto.PaymentWalletDTO, error) {
+	return u.updateWalletInUse(ctx, actor, constants.AdminActionLockWallet, walletID, true, reason)
+}
+
+func (u *adminUCase) updateWalletInUse(
+	ctx context.Context,
+	actor, action string,
+	walletID uint64,
+	inUse bool,
+	reason string,
+) (dto.PaymentWalletDTO, error) {
+	wallet, err := u.paymentWalletRepository.UpdateWalletInUse(ctx, walletID, inUse)
+	u.audit(ctx, actor, action, strconv.FormatUint(walletID, 10), reason, nil, err)
+	if err != nil {
+		return dto.PaymentWalletDTO{}, err
+	}
+	return wallet.ToDto(), nil
+}
+
+// ResolvePaymentOrder manually marks the order of the vendor SUCCESS or FAILED and sends its webhook again.
+func (u *adminUCase) ResolvePaymentOrder(
+	ctx context.Context,
+	actor, vendorID, requestID, status, reason string,
+) (dto.PaymentOrderDTOResponse, error) {
+	// The order audit trail records the operator, with the reason as note
+	ctx = utils.WithAuditSource(ctx, utils.AuditSource{
+		Actor: utils.AuditActor(constants.AuditActorAdmin, actor),
+		Note:  reason,
+	})
+	order, err := u.paymentOrderUCase.ResolvePaymentOrder(ctx, vendorID, requestID, status)
+	u.audit(ctx, actor, constants.AdminActionResolveOrder, requestID, reason, map[string]any{"vendor_id": vendorID, "status": status}, err)
+	return order, err
+}
+
+// SyncWalletBalances re-syncs the token balances of the payment wallet from the chain, or of every payment wallet
+// with an address on the network when no wallet is given. The balances are returned by wallet address.
+func (u *adminUCase) SyncWalletBalances(
+	ctx context.Context,
+	actor string,
+	payload dto.AdminSyncBalancesPayloadDTO,
+	tokenSymbols []string,
+) (map[string]map[string]string, error) {
+	balances, details, err := u.syncWalletBalances(ctx, payload, tokenSymbols)
+	target := payload.Network.String()
+	if payload.WalletAddress != "" {
+		target += ":" + payload.WalletAddress
+	}
+	u.audit(ctx, actor, constants.AdminActionSyncBalances, target, payload.Reason, details, err)
+	return balances, err
+}
+
+func (u *adminUCase) syncWalletBalances(
+	ctx context.Context,
+	payload dto.AdminSyncBalancesPayloadDTO,
+	tokenSymbols []string,
+) (map[string]map[string]string, map[string]any, error) {
+	addresses := []string{payload.WalletAddress}
+	if payload.WalletAddress == "" {
+		wallets, err := u.paymentWalletRepository.GetPaymentWallets(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get payment wallets: %w", err)
+		}
+		addresses = addresses[:0]
+		for _, wallet := range wallets {
+			if address := wallet.AddressOn(payload.Network.String()); address != "" {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+
+	// A wallet failing to sync does not stop the others
+	balances := make(map[string]map[string]string, len(addresses))
+	var failedAddresses []string
+	var lastErr error
+	for _, address := range addresses {
+		walletBalances, err := u.paymentWalletUCase.SyncWalletBalances(ctx, address, payload.Network, tokenSymbols)
+		if err != nil {
+			logger.GetLogger().Errorf("Failed to sync balances of wallet %s on network %s: %v", address, payload.Network, err)
+			failedAddresses = append(failedAddresses, address)
+			lastErr = err
+			continue
+		}
+		balances[address] = walletBalances
+	}
+
+	details := map[string]any{"synced_wallets": len(balances)}
+	if len(failedAddresses) > 0 {
+		details["failed_wallets"] = failedAddresses
+	}
+	if len(balances) == 0 && lastErr != nil {
+		return nil, details, lastErr
+	}
+	return balances, details, nil
+}
+
+// GetOrderSet returns the orders the listeners of this instance currently watch, one item per option
+// of a multi-chain order.
+func (u *adminUCase) GetOrderSet(ctx context.Context, actor string) []dto.PaymentOrderDTO {
+	orders := u.paymentOrderSet.GetAll()
+	u.audit(ctx, actor, constants.AdminActionViewOrderSet, "", "", map[string]any{"size": len(orders)}, nil)
+	return orders
+}
+
+func (u *adminUCase) GetAuditLogs(ctx context.Context, action *string, page, size int) (dto.PaginationDTOResponse, error) {
+	// Fetch one more record to know whether there is a next page
+	auditLogs, err := u.adminAuditLogRepository.GetAuditLogs(ctx, size+1, (page-1)*size, action)
+	if err != nil {
+		return dto.PaginationDTOResponse{}, err
+	}
+
+	auditLogDTOs := make([]any, 0, size)
+	for i := range auditLogs {
+		if i >= size {
+			break
+		}
+		auditLogDTOs = append(auditLogDTOs, auditLogs[i].ToDto())
+	}
+
+	nextPage := page
+	if len(auditLogs) > size {
+		nextPage++
+	}
+
+	return dto.PaginationDTOResponse{
+		NextPage: nextPage,
+		Page:     page,
+		Size:     size,
+		Data:     auditLogDTOs,
+	}, nil
+}
+
+// audit records the outcome of an action, a failure to record it is logged and does not fail the action.
+func (u *adminUCase) audit(ctx context.Context, actor, action, target, reason string, details map[string]any, actionErr error) {
+	auditLog := entities.AdminAuditLog{
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		Reason:    reason,
+		Succeeded: actionErr == nil,
+	}
+	if len(details) > 0 {
+		if encodedDetails, err := json.Marshal(details); err == nil {
+			auditLog.Details = string(encodedDetails)
+		}
+	}
+	if actionErr != nil {
+		auditLog.Error = actionErr.Error()
+	}
+
+	if err := u.adminAuditLogRepository.CreateAuditLog(ctx, &auditLog); err != nil {
+		logger.GetLogger().Errorf("Failed to audit admin action %s on %q by %s: %v", action, target, actor, err)
+	}
+}
+
+// GetPaymentOrderAudits lists the recorded status and transferred amount changes of the order of the vendor, oldest first.
+func (u *adminUCase) GetPaymentOrderAudits(ctx context.Context, vendorID, requestID string) ([]dto.PaymentOrderAuditDTO, error) {
+	return u.paymentOrderUCase.GetPaymentOrderAudits(ctx, vendorID, requestID)
+}
@@ -0,0 +1,505 @@
+package ucases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/screening"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type complianceUCase struct {
+	complianceRepository           repotypes.ComplianceRepository
+	paymentEventHistoryRepository  repotypes.PaymentEventHistoryRepository
+	paymentStatisticsRepository    repotypes.PaymentStatisticsRepository
+	paymentWalletBalanceRepository repotypes.PaymentWalletBalanceRepository
+	paymentOrderUCase              ucasetypes.PaymentOrderUCase
+	providers                      []screening.Provider // Empty when payments are not screened
+	failClosed                     bool
+	webhookURL                     string
+}
+
+// NewComplianceUCase creates the compliance use case screening the payers with the provider, deny_list or http,
+// or with none when it is empty. The deny-list always applies, the http provider is asked once it passes.
+func NewComplianceUCase(
+	complianceRepository repotypes.ComplianceRepository,
+	paymentEventHistoryRepository repotypes.PaymentEventHistoryRepository,
+	paymentStatisticsRepository repotypes.PaymentStatisticsRepository,
+	paymentWalletBalanceRepository repotypes.PaymentWalletBalanceRepository,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+	provider string,
+	config *conf.ComplianceConfiguration,
+) ucasetypes.ComplianceUCase {
+	u := &complianceUCase{
+		complianceRepository:           complianceRepository,
+		paymentEventHistoryRepository:  paymentEventHistoryRepository,
+		paymentStatisticsRepository:    paymentStatisticsRepository,
+		paymentWalletBalanceRepository: paymentWalletBalanceRepository,
+		paymentOrderUCase:              paymentOrderUCase,
+		failClosed:                     config.ScreeningFailClosed,
+		webhookURL:                     config.ComplianceWebhookURL,
+	}
+	if provider == "" {
+		return u
+	}
+
+	var entries map[string]string
+	if config.ScreeningDenyListFile != "" {
+		var err error
+		if entries, err = screening.LoadDenyListFile(config.ScreeningDenyListFile); err != nil {
+			logger.GetLogger().Errorf("Failed to load the deny-list file, only the denied address table is screened: %v", err)
+		}
+	}
+	u.providers = append(u.providers, screening.NewDenyListProvider(entries, u.lookupDeniedAddress))
+
+	if provider == constants.ScreeningProviderHTTP {
+		u.providers = append(u.providers, screening.NewHTTPProvider(
+			config.ScreeningHTTPUrl, config.ScreeningHTTPAPIKey, constants.ScreeningTimeout,
+		))
+	}
+	return u
+}
+
+// ScreenPayment screens the payer of a payment to an order before it is recorded. A flagged payment, any payment
+// to an order with a held payment, or a payment that cannot be screened, is held and a payment screened before keeps
+// its hold. The hold is returned for RecordHeldPayment, nil when the payment is not held and can be credited.
+// An error means the payment could not be screened, it must not be recorded so that it is screened again.
+func (u *complianceUCase) ScreenPayment(
+	ctx context.Context, payload dto.ComplianceHoldPayloadDTO,
+) (*dto.ComplianceHoldDTO, error) {
+	hold := entities.ComplianceHold{
+		PaymentOrderID:  payload.PaymentOrderID,
+		VendorID:        payload.VendorID,
+		WalletID:        payload.WalletID,
+		Network:         payload.Network,
+		Symbol:          payload.Symbol,
+		TransactionHash: payload.TransactionHash,
+		LogIndex:        payload.LogIndex,
+		FromAddress:     payload.FromAddress,
+		ToAddress:       payload.ToAddress,
+		Amount:          payload.Amount,
+		Status:          constants.ComplianceHoldHeld,
+	}
+
+	// The payments of an order under review are held with it, the holds of the order are unknown when they cannot be read
+	orderHolds, err := u.complianceRepository.GetComplianceHoldsByOrderID(ctx, payload.PaymentOrderID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get compliance holds of order %d, holding the payment: %v", payload.PaymentOrderID, err)
+		hold.Reason = fmt.Sprintf("screening failed: %v", err)
+	}
+	for _, orderHold := range orderHolds {
+		if orderHold.TransactionHash == payload.TransactionHash && orderHold.LogIndex == payload.LogIndex {
+			holdDTO := orderHold.ToDto()
+			return &holdDTO, nil
+		}
+		if hold.Reason == "" && orderHold.Status == constants.ComplianceHoldHeld {
+			hold.Reason = fmt.Sprintf("order has held payment %d", orderHold.ID)
+		}
+	}
+
+	if hold.Reason == "" {
+		result, err := u.screen(ctx, payload.Network, payload.FromAddress)
+		if err != nil {
+			if !u.failClosed {
+				logger.GetLogger().Errorf("Failed to screen payer %s of order %d, accepting the payment: %v",
+					payload.FromAddress, payload.PaymentOrderID, err)
+				return nil, nil
+			}
+			result = screening.Result{Flagged: true, Provider: result.Provider, Reason: fmt.Sprintf("screening failed: %v", err)}
+		}
+		if !result.Flagged {
+			return nil, nil
+		}
+		hold.Provider = result.Provider
+		hold.Reason = result.Reason
+	}
+
+	holdDTO := hold.ToDto()
+	return &holdDTO, nil
+}
+
+// RecordHeldPayment stores the payment event together with its compliance hold in the same transaction, then sends
+// a new hold to the compliance webhook and puts the order ON_HOLD while the payment is held. It returns false,
+// holding nothing, when the event was already recorded. An order that could not be put on hold is held again
+// by ResumeHold when the payment is processed again.
+func (u *complianceUCase) RecordHeldPayment(
+	ctx context.Context, holdDTO dto.ComplianceHoldDTO, payload dto.PaymentEventPayloadDTO,
+) (bool, error) {
+	hold := entities.ComplianceHold{
+		ID:              holdDTO.ID,
+		PaymentOrderID:  holdDTO.PaymentOrderID,
+		VendorID:        holdDTO.VendorID,
+		WalletID:        holdDTO.WalletID,
+		Network:         holdDTO.Network,
+		Symbol:          holdDTO.Symbol,
+		TransactionHash: holdDTO.TransactionHash,
+		LogIndex:        holdDTO.LogIndex,
+		FromAddress:     holdDTO.FromAddress,
+		ToAddress:       holdDTO.ToAddress,
+		Amount:          holdDTO.Amount,
+		Provider:        holdDTO.Provider,
+		Reason:          holdDTO.Reason,
+		Status:          holdDTO.Status,
+	}
+
+	var holdCreated bool
+	createdEvents, err := u.paymentEventHistoryRepository.CreatePaymentEventHistory(
+		ctx,
+		[]entities.PaymentEventHistory{toPaymentEventHistory(payload)},
+		func(tx *gorm.DB, _ entities.PaymentEventHistory) error {
+			if hold.ID != 0 {
+				// Held when the payment was screened before
+				return nil
+			}
+			var err error
+			holdCreated, err = u.complianceRepository.CreateComplianceHoldInTx(tx, &hold)
+			return err
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	if len(createdEvents) == 0 {
+		return false, nil
+	}
+	logger.GetLogger().Warnf("Held payment %s of %s %s from %s to order %d: %s",
+		hold.TransactionHash, hold.Amount, hold.Symbol, hold.FromAddress, hold.PaymentOrderID, hold.Reason)
+
+	if holdCreated {
+		u.sendComplianceWebhook(hold.ToDto())
+	}
+	if err := u.ResumeHold(ctx, hold.ToDto()); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// ResumeHold puts the order of a payment still held ON_HOLD, in case it could not be put on hold when
+// the payment was recorded.
+func (u *complianceUCase) ResumeHold(ctx context.Context, hold dto.ComplianceHoldDTO) error {
+	if hold.Status != constants.ComplianceHoldHeld {
+		return nil
+	}
+	return u.holdOrder(ctx, hold.PaymentOrderID)
+}
+
+// holdOrder puts the order of a held payment ON_HOLD, orders already paid keep their status and only the payment is held.
+func (u *complianceUCase) holdOrder(ctx context.Context, orderID uint64) error {
+	order, err := u.paymentOrderUCase.GetPaymentOrderByID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to get held order %d: %w", orderID, err)
+	}
+	if constants.IsPaidStatus(order.Status) || order.Status == constants.OnHold {
+		return nil
+	}
+	status := constants.OnHold
+	if err := u.paymentOrderUCase.UpdatePaymentOrder(ctx, order.ID, nil, nil, &status, nil, nil); err != nil {
+		return fmt.Errorf("failed to put order %d on hold: %w", order.ID, err)
+	}
+	return nil
+}
+
+// GetPaymentHold returns the compliance hold of a payment to the order, nil when the payment was never held.
+func (u *complianceUCase) GetPaymentHold(
+	ctx context.Context, orderID uint64, txHash string, logIndex uint,
+) (*dto.ComplianceHoldDTO, error) {
+	holds, err := u.complianceRepository.GetComplianceHoldsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	for _, hold := range holds {
+		if hold.TransactionHash == txHash && hold.LogIndex == logIndex {
+			holdDTO := hold.ToDto()
+			return &holdDTO, nil
+		}
+	}
+	return nil, nil
+}
+
+// screen returns the result of the first provider flagging the address, or the clean result of the last one.
+func (u *complianceUCase) screen(ctx context.Context, network, address string) (screening.Result, error) {
+	var result screening.Result
+	for _, provider := range u.providers {
+		var err error
+		result, err = provider.Screen(ctx, network, address)
+		if err != nil {
+			return screening.Result{Provider: provider.Name()}, err
+		}
+		if result.Flagged {
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+func (u *complianceUCase) lookupDeniedAddress(ctx context.Context, network, address string) (string, bool, error) {
+	deniedAddress, err := u.complianceRepository.GetDeniedAddress(ctx, network, chain.NormalizeAddress(address))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return deniedAddress.Reason, true, nil
+}
+
+func (u *complianceUCase) GetDeniedAddresses(ctx context.Context) ([]dto.DeniedAddressDTO, error) {
+	deniedAddresses, err := u.complianceRepository.GetDeniedAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deniedAddressesDTO := make([]dto.DeniedAddressDTO, 0, len(deniedAddresses))
+	for _, deniedAddress := range deniedAddresses {
+		deniedAddressesDTO = append(deniedAddressesDTO, deniedAddress.ToDto())
+	}
+	return deniedAddressesDTO, nil
+}
+
+func (u *complianceUCase) UpsertDeniedAddress(
+	ctx context.Context, payload dto.DeniedAddressPayloadDTO,
+) (dto.DeniedAddressDTO, error) {
+	deniedAddress := entities.DeniedAddress{
+		Address: common.HexToAddress(chain.NormalizeAddress(payload.Address)).Hex(),
+		Network: payload.Network,
+		Reason:  payload.Reason,
+	}
+	if err := u.complianceRepository.UpsertDeniedAddress(ctx, &deniedAddress); err != nil {
+		return dto.DeniedAddressDTO{}, err
+	}
+	return deniedAddress.ToDto(), nil
+}
+
+func (u *complianceUCase) DeleteDeniedAddress(ctx context.Context, address, network string) error {
+	address = chain.NormalizeAddress(address)
+	deleted, err := u.complianceRepository.DeleteDeniedAddress(ctx, address, network)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("address %s, network %q: %w", address, network, ucasetypes.ErrDeniedAddressNotFound)
+	}
+	return nil
+}
+
+func (u *complianceUCase) GetComplianceHolds(ctx context.Context, status string) ([]dto.ComplianceHoldDTO, error) {
+	holds, err := u.complianceRepository.GetComplianceHolds(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	holdsDTO := make([]dto.ComplianceHoldDTO, 0, len(holds))
+	for _, hold := range holds {
+		holdsDTO = append(holdsDTO, hold.ToDto())
+	}
+	return holdsDTO, nil
+}
+
+// ReleaseComplianceHold releases a held payment: it is credited to its order, added to the balance of its payment wallet
+// for the withdraw worker to sweep and the order is settled once it has no other held payment. The hold stays HELD
+// when the payment cannot be credited.
+func (u *complianceUCase) ReleaseComplianceHold(
+	ctx context.Context, id uint64, reviewer, note string,
+) (dto.ComplianceHoldDTO, error) {
+	granularity := constants.Daily
+	periodStart := utils.GetPeriodStart(granularity, time.Now())
+
+	hold, err := u.reviewComplianceHold(ctx, id, constants.ComplianceHoldReleased, reviewer, note,
+		func(tx *gorm.DB, hold entities.ComplianceHold) error {
+			if err := u.paymentStatisticsRepository.IncrementStatisticsInTx(
+				tx, granularity, periodStart, nil, &hold.Amount, hold.Symbol, hold.VendorID,
+			); err != nil {
+				return fmt.Errorf("failed to increment payment statistics of released hold %d: %w", hold.ID, err)
+			}
+			if hold.WalletID == nil {
+				return nil
+			}
+			if err := u.paymentWalletBalanceRepository.AddPaymentWalletBalanceInTx(
+				tx, *hold.WalletID, hold.Amount, hold.Network, hold.Symbol,
+			); err != nil {
+				return fmt.Errorf("failed to add payment wallet balance of released hold %d: %w", hold.ID, err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return dto.ComplianceHoldDTO{}, err
+	}
+
+	u.settleOrder(ctx, hold.PaymentOrderID)
+	return hold, nil
+}
+
+// RejectComplianceHold rejects a held payment: it is never credited nor swept, the funds are handled out of band.
+// The order is settled without it once it has no other held payment.
+func (u *complianceUCase) RejectComplianceHold(
+	ctx context.Context, id uint64, reviewer, note string,
+) (dto.ComplianceHoldDTO, error) {
+	hold, err := u.reviewComplianceHold(ctx, id, constants.ComplianceHoldRejected, reviewer, note, nil)
+	if err != nil {
+		return dto.ComplianceHoldDTO{}, err
+	}
+
+	u.settleOrder(ctx, hold.PaymentOrderID)
+	return hold, nil
+}
+
+// reviewComplianceHold moves a HELD compliance hold to the status, sends it to the compliance webhook and returns it.
+// onReviewed, when not nil, runs in the transaction of the status change.
+func (u *complianceUCase) reviewComplianceHold(
+	ctx context.Context, id uint64, status, reviewer, note string,
+	onReviewed func(tx *gorm.DB, hold entities.ComplianceHold) error,
+) (dto.ComplianceHoldDTO, error) {
+	updated, err := u.complianceRepository.UpdateComplianceHoldStatus(ctx, id, constants.ComplianceHoldHeld, map[string]any{
+		"status":      status,
+		"reviewed_by": reviewer,
+		"review_note": note,
+		"reviewed_at": time.Now().UTC(),
+	}, onReviewed)
+	if err != nil {
+		return dto.ComplianceHoldDTO{}, err
+	}
+
+	hold, err := u.complianceRepository.GetComplianceHoldByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.ComplianceHoldDTO{}, fmt.Errorf("%w: %d", ucasetypes.ErrComplianceHoldNotFound, id)
+		}
+		return dto.ComplianceHoldDTO{}, err
+	}
+	if !updated {
+		return dto.ComplianceHoldDTO{}, fmt.Errorf("%w: compliance hold %d is %s", ucasetypes.ErrComplianceHoldNotReviewable, id, hold.Status)
+	}
+
+	holdDTO := hold.ToDto()
+	u.sendComplianceWebhook(holdDTO)
+	return holdDTO, nil
+}
+
+// settleOrder moves an ON_HOLD order without held payments to the status of its payments, rejected payments left out,
+// and sends its webhook. Errors are logged, the review of the hold is already recorded.
+func (u *complianceUCase) settleOrder(ctx context.Context, orderID uint64) {
+	holds, err := u.complianceRepository.GetComplianceHoldsByOrderID(ctx, orderID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get compliance holds of order %d: %v", orderID, err)
+		return
+	}
+
+	rejected := big.NewInt(0)
+	for _, hold := range holds {
+		switch hold.Status {
+		case constants.ComplianceHoldHeld:
+			logger.GetLogger().Infof("Order %d stays on hold, payment %d is still held", orderID, hold.ID)
+			return
+		case constants.ComplianceHoldRejected:
+			amount, err := utils.ConvertFloatTokenToSmallestUnit(hold.Amount, constants.PaymentAmountDecimalPlaces)
+			if err != nil {
+				logger.GetLogger().Errorf("Failed to convert amount of compliance hold %d: %v", hold.ID, err)
+				return
+			}
+			rejected.Add(rejected, amount)
+		}
+	}
+
+	order, err := u.paymentOrderUCase.GetPaymentOrderByID(ctx, orderID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get order %d to settle: %v", orderID, err)
+		return
+	}
+	if order.Status != constants.OnHold {
+		return
+	}
+
+	status, transferred, err := u.evaluateOrder(ctx, order, rejected)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to evaluate payments of order %d: %v", orderID, err)
+		return
+	}
+
+	if err := u.paymentOrderUCase.UpdatePaymentOrder(ctx, orderID, nil, nil, nil, &transferred, nil); err != nil {
+		logger.GetLogger().Errorf("Failed to update transferred amount of order %d: %v", orderID, err)
+		return
+	}
+	if constants.IsPaidStatus(status) {
+		_, err = u.paymentOrderUCase.UpdateOrderToSuccessAndReleaseWallet(ctx, orderID, status)
+	} else {
+		err = u.paymentOrderUCase.UpdatePaymentOrder(ctx, orderID, nil, nil, &status, nil, nil)
+	}
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to update order %d to %s: %v", orderID, status, err)
+		return
+	}
+	logger.GetLogger().Infof("Settled order %d on hold to %s with transferred amount %s", orderID, status, transferred)
+
+	order.Status = status
+	order.Transferred = transferred
+	go func() {
+		if err := utils.SendWebhook(order, order.WebhookURL); err != nil {
+			logger.GetLogger().Errorf("Failed to send webhook for settled order %d: %v", orderID, err)
+		}
+	}()
+}
+
+// evaluateOrder returns the status and transferred amount of an order from its payments, the rejected amount left out.
+// An order that is not fully paid is EXPIRED once its expiry is past.
+func (u *complianceUCase) evaluateOrder(
+	ctx context.Context, order dto.PaymentOrderDTOResponse, rejected *big.Int,
+) (string, string, error) {
+	amount, err := utils.ConvertFloatTokenToSmallestUnit(order.Amount, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert order amount: %w", err)
+	}
+
+	transferred := new(big.Int).Neg(rejected)
+	for _, event := range order.CountedEventHistories(order.Network, order.Symbol, conf.GetSplitPaymentPolicy()) {
+		eventAmount, err := utils.ConvertFloatTokenToSmallestUnit(event.Amount, constants.PaymentAmountDecimalPlaces)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to convert event amount (tx: %s): %w", event.TransactionHash, err)
+		}
+		transferred.Add(transferred, eventAmount)
+	}
+	if transferred.Sign() < 0 {
+		transferred.SetInt64(0)
+	}
+
+	status, err := u.paymentOrderUCase.EvaluatePayment(
+		ctx, order.VendorID, order.Symbol, amount, transferred, constants.PaymentAmountDecimalPlaces,
+	)
+	if err != nil {
+		return "", "", err
+	}
+	if !constants.IsPaidStatus(status) && time.Now().After(time.Unix(int64(order.Expired), 0)) {
+		status = constants.Expired
+	}
+
+	transferredAmount, err := utils.ConvertSmallestUnitToFloatToken(transferred.String(), constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert transferred amount: %w", err)
+	}
+	return status, transferredAmount, nil
+}
+
+func (u *complianceUCase) sendComplianceWebhook(hold dto.ComplianceHoldDTO) {
+	if u.webhookURL == "" {
+		return
+	}
+	go func() {
+		if err := utils.SendWebhook(hold, u.webhookURL); err != nil {
+			logger.GetLogger().Errorf("Failed to send compliance webhook for hold of payment %s: %v", hold.TransactionHash, err)
+		}
+	}()
+}
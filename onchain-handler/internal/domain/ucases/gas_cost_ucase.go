@@ -0,0 +1,195 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type gasCostUCase struct {
+	gasCostRepository           repotypes.GasCostRepository
+	tokenTransferRepository     repotypes.TokenTransferRepository
+	paymentStatisticsRepository repotypes.PaymentStatisticsRepository
+}
+
+func NewGasCostUCase(
+	gasCostRepository repotypes.GasCostRepository,
+	tokenTransferRepository repotypes.TokenTransferRepository,
+	paymentStatisticsRepository repotypes.PaymentStatisticsRepository,
+) ucasetypes.GasCostUCase {
+	return &gasCostUCase{
+		gasCostRepository:           gasCostRepository,
+		tokenTransferRepository:     tokenTransferRepository,
+		paymentStatisticsRepository: paymentStatisticsRepository,
+	}
+}
+
+// AttributeSweepFee splits the fees, in native token units, of the sweep of a payment wallet into the receiving wallet
+// between the orders paid into the wallet since its previous sweep, proportionally to their paid amounts.
+func (u *gasCostUCase) AttributeSweepFee(
+	ctx context.Context,
+	network, symbol, walletAddress, transactionHash, nativePriceUSD string,
+	fees []string,
+) error {
+	since, err := u.tokenTransferRepository.GetLastTransferTime(
+		ctx, network, symbol, constants.InternalTransfer, walletAddress, transactionHash,
+	)
+	if err != nil {
+		return err
+	}
+	amounts, err := u.gasCostRepository.GetPaidOrderAmounts(ctx, network, symbol, walletAddress, since)
+	if err != nil {
+		return err
+	}
+	return u.attributeFee(ctx, network, symbol, transactionHash, constants.InternalTransfer, nativePriceUSD, fees, amounts)
+}
+
+// AttributeWithdrawFee splits the fees, in native token units, of the withdrawal from the receiving wallet to the master
+// wallet between the orders swept into the receiving wallet since the previous withdrawal, proportionally to their swept amounts.
+func (u *gasCostUCase) AttributeWithdrawFee(
+	ctx context.Context,
+	network, symbol, receivingWalletAddress, transactionHash, nativePriceUSD string,
+	fees []string,
+) error {
+	since, err := u.tokenTransferRepository.GetLastTransferTime(
+		ctx, network, symbol, constants.Withdraw, receivingWalletAddress, transactionHash,
+	)
+	if err != nil {
+		return err
+	}
+	amounts, err := u.gasCostRepository.GetSweptOrderAmounts(ctx, network, symbol, since)
+	if err != nil {
+		return err
+	}
+	return u.attributeFee(ctx, network, symbol, transactionHash, constants.Withdraw, nativePriceUSD, fees, amounts)
+}
+
+func (u *gasCostUCase) GetNetworkFeeStatistics(
+	ctx context.Context,
+	vendorID string,
+	startTime, endTime time.Time,
+	symbols []string,
+) ([]dto.NetworkFeeStats, error) {
+	totals, err := u.gasCostRepository.GetNetworkFeeTotals(ctx, vendorID, startTime, endTime, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]dto.NetworkFeeStats, 0, len(totals))
+	for _, total := range totals {
+		nativeSymbol, err := blockchain.GetNativeTokenSymbol(constants.NetworkType(total.Network))
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, dto.NetworkFeeStats{
+			Network:      total.Network,
+			Symbol:       total.Symbol,
+			NativeSymbol: nativeSymbol,
+			TotalOrders:  total.TotalOrders,
+			TotalFee:     total.TotalFee,
+			TotalFeeUSD:  total.TotalFeeUSD,
+			UnpricedFee:  total.UnpricedFee,
+		})
+	}
+	return stats, nil
+}
+
+// attributeFee records the share of the fees of each order and adds the USD value of the shares to the daily
+// statistics of the vendors. Without any order the fees are not attributed.
+func (u *gasCostUCase) attributeFee(
+	ctx context.Context,
+	network, symbol, transactionHash, transferType, nativePriceUSD string,
+	fees []string,
+	amounts []entities.OrderAmount,
+) error {
+	if len(amounts) == 0 {
+		logger.GetLogger().Infof(
+			"No order to attribute the fee of %s transfer %s on network %s to", symbol, transactionHash, network,
+		)
+		return nil
+	}
+
+	// Step 1: Add up the fees and weight the orders by their amounts
+	totalFee := big.NewInt(0)
+	for _, fee := range fees {
+		feeAmount, err := utils.ConvertFloatTokenToSmallestUnit(fee, constants.NativeTokenDecimalPlaces)
+		if err != nil {
+			return fmt.Errorf("failed to convert fee %s: %w", fee, err)
+		}
+		totalFee.Add(totalFee, feeAmount)
+	}
+	weights := make([]*big.Int, 0, len(amounts))
+	for _, amount := range amounts {
+		weight, err := utils.ConvertFloatTokenToSmallestUnit(amount.Amount, constants.PaymentAmountDecimalPlaces)
+		if err != nil {
+			return fmt.Errorf("failed to convert amount %s of order %d: %w", amount.Amount, amount.PaymentOrderID, err)
+		}
+		weights = append(weights, weight)
+	}
+
+	// Step 2: Split the fee and price the shares in USD
+	var price *string
+	if nativePriceUSD != "" {
+		price = &nativePriceUSD
+	}
+	vendorFeesUSD := make(map[string]*big.Rat)
+	attributions := make([]entities.GasCostAttribution, 0, len(amounts))
+	for i, share := range payment.SplitProportionally(totalFee, weights) {
+		fee, err := utils.ConvertSmallestUnitToFloatToken(share.String(), constants.NativeTokenDecimalPlaces)
+		if err != nil {
+			return fmt.Errorf("failed to convert fee share %s: %w", share.String(), err)
+		}
+		attribution := entities.GasCostAttribution{
+			Network:         network,
+			Symbol:          symbol,
+			TransactionHash: transactionHash,
+			TransferType:    transferType,
+			PaymentOrderID:  amounts[i].PaymentOrderID,
+			VendorID:        amounts[i].VendorID,
+			Amount:          amounts[i].Amount,
+			Fee:             fee,
+			NativePriceUSD:  price,
+		}
+		if price != nil {
+			feeUSD, err := payment.ConvertToUSD(fee, *price, constants.PaymentAmountDecimalPlaces)
+			if err != nil {
+				return fmt.Errorf("failed to price fee share %s: %w", fee, err)
+			}
+			attribution.FeeUSD = &feeUSD
+
+			vendorFeeUSD, exists := vendorFeesUSD[attribution.VendorID]
+			if !exists {
+				vendorFeeUSD = new(big.Rat)
+				vendorFeesUSD[attribution.VendorID] = vendorFeeUSD
+			}
+			feeUSDRat, _ := new(big.Rat).SetString(feeUSD)
+			vendorFeeUSD.Add(vendorFeeUSD, feeUSDRat)
+		}
+		attributions = append(attributions, attribution)
+	}
+
+	// Step 3: Persist the shares and the statistics
+	if err := u.gasCostRepository.CreateGasCostAttributions(ctx, attributions); err != nil {
+		return err
+	}
+	periodStart := utils.GetPeriodStart(constants.Daily, time.Now())
+	for vendorID, feeUSD := range vendorFeesUSD {
+		if err := u.paymentStatisticsRepository.IncrementNetworkFee(
+			ctx, constants.Daily, periodStart, feeUSD.FloatString(constants.PaymentAmountDecimalPlaces), symbol, vendorID,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
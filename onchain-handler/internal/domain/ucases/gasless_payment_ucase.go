@@ -0,0 +1,372 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/internal/wire/instances"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+	"github.com/genefriendway/onchain-handler/pkg/crypto"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type gaslessPaymentUCase struct {
+	paymentOrderRepository       repotypes.PaymentOrderRepository
+	relayerTransactionRepository repotypes.RelayerTransactionRepository
+	mnemonic                     string
+	passphrase                   string
+	salt                         string
+	mu                           sync.Mutex                            // Protects relayerLocks
+	relayerLocks                 map[constants.NetworkType]*sync.Mutex // Serialize the relayer transactions of a network so their nonces do not collide
+}
+
+// NewGaslessPaymentUCase constructs a gaslessPaymentUCase relaying signed payments from the relayer wallet.
+func NewGaslessPaymentUCase(
+	paymentOrderRepository repotypes.PaymentOrderRepository,
+	relayerTransactionRepository repotypes.RelayerTransactionRepository,
+	mnemonic, passphrase, salt string,
+) ucasetypes.GaslessPaymentUCase {
+	return &gaslessPaymentUCase{
+		paymentOrderRepository:       paymentOrderRepository,
+		relayerTransactionRepository: relayerTransactionRepository,
+		mnemonic:                     mnemonic,
+		passphrase:                   passphrase,
+		salt:                         salt,
+		relayerLocks:                 make(map[constants.NetworkType]*sync.Mutex),
+	}
+}
+
+// GetRelayerAddress returns the address of the relayer wallet, the spender of EIP-2612 permits.
+func (u *gaslessPaymentUCase) GetRelayerAddress() (string, error) {
+	account, _, err := payment.GetRelayerWallet(u.mnemonic, u.passphrase, u.salt)
+	if err != nil {
+		return "", err
+	}
+	return account.Address.Hex(), nil
+}
+
+// SubmitGaslessPayment validates a signed EIP-3009 authorization or EIP-2612 permit for the order and relays it
+// from the relayer wallet. The resulting transfer to the payment address is credited by the listener.
+// Request IDs are unique per vendor, only the orders of the vendor are found.
+func (u *gaslessPaymentUCase) SubmitGaslessPayment(
+	ctx context.Context,
+	vendorID string,
+	payload dto.GaslessPaymentPayloadDTO,
+) (dto.GaslessPaymentDTO, error) {
+	// Step 1: Retrieve the order of the vendor and check it can still be paid to its payment address
+	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, payload.RequestID)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to retrieve payment order with request id %s: %w", payload.RequestID, err)
+	}
+	if order.PaymentMode == constants.PaymentModeRouter {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: order %s is paid through the payment router", ucasetypes.ErrInvalidGaslessPayment, payload.RequestID)
+	}
+	if chain.ForNetwork(constants.NetworkType(order.Network)) != chain.EVM {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: gasless payments are not supported on network %s", ucasetypes.ErrInvalidGaslessPayment, order.Network)
+	}
+	if order.Status != constants.Pending && order.Status != constants.Partial {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: order %s has status %s", ucasetypes.ErrInvalidGaslessPayment, payload.RequestID, order.Status)
+	}
+	if time.Now().UTC().After(order.ExpiredTime) {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: order %s has expired", ucasetypes.ErrInvalidGaslessPayment, payload.RequestID)
+	}
+
+	network := constants.NetworkType(order.Network)
+	tokenAddress, err := conf.GetTokenAddress(order.Symbol, order.Network)
+	if err != nil || tokenAddress == "" {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to get %s token address on network %s: %w", order.Symbol, order.Network, err)
+	}
+	paymentAddress := common.HexToAddress(order.Wallet.Address)
+
+	// Step 2: Parse the signed fields
+	if !common.IsHexAddress(payload.From) {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: invalid from address %s", ucasetypes.ErrInvalidGaslessPayment, payload.From)
+	}
+	from := common.HexToAddress(payload.From)
+	value, err := parseUint256("value", payload.Value)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, err
+	}
+	v, r, s, err := crypto.SplitSignature(payload.Signature)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: %v", ucasetypes.ErrInvalidGaslessPayment, err)
+	}
+
+	// Step 3: Prepare the relayer
+	rpcUrls, err := conf.GetRPCUrls(network)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to get RPC URLs: %w", err)
+	}
+	ethClient, err := instances.ETHClientInstance(network, rpcUrls)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to initialize Ethereum client: %w", err)
+	}
+	chainID, err := conf.GetChainID(network)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, err
+	}
+	relayer, relayerPrivateKey, err := payment.GetRelayerWallet(u.mnemonic, u.passphrase, u.salt)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, err
+	}
+	relayerPrivateKeyHex, err := crypto.PrivateKeyToHex(relayerPrivateKey)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to encode relayer private key: %w", err)
+	}
+
+	// Step 4: Check the value covers the gas of the relayer and what is left to pay on the order,
+	// the relayer wallet does not spend its gas on dust payments
+	tokenDecimals, err := ethClient.GetTokenDecimals(ctx, tokenAddress)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to get decimals of token %s: %w", tokenAddress, err)
+	}
+	minValue, err := minGaslessValue(order, tokenDecimals)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, err
+	}
+	if value.Sign() == 0 || value.Cmp(minValue) < 0 {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: value %s is below the minimum value %s for order %s",
+			ucasetypes.ErrInvalidGaslessPayment, value.String(), minValue.String(), payload.RequestID)
+	}
+
+	outputs, err := ethClient.CallContract(ctx, common.HexToAddress(tokenAddress), constants.GaslessTokenABI, constants.DomainSeparatorMethod)
+	if err != nil {
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to get domain separator of token %s: %w", tokenAddress, err)
+	}
+	domainSeparator := common.Hash(outputs[0].([32]byte))
+
+	// Step 5: Verify the signature and build the relayer calls
+	now := big.NewInt(time.Now().Unix())
+	type relayerCall struct {
+		method string
+		args   []any
+	}
+	var calls []relayerCall
+
+	switch payload.Type {
+	case constants.GaslessTransferWithAuthorization:
+		validAfter, err := parseUint256("valid_after", payload.ValidAfter)
+		if err != nil {
+			return dto.GaslessPaymentDTO{}, err
+		}
+		validBefore, err := parseUint256("valid_before", payload.ValidBefore)
+		if err != nil {
+			return dto.GaslessPaymentDTO{}, err
+		}
+		if validAfter.Cmp(now) >= 0 || validBefore.Cmp(now) <= 0 {
+			return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: authorization is not valid now", ucasetypes.ErrInvalidGaslessPayment)
+		}
+		nonceBytes, err := hexutil.Decode(payload.Nonce)
+		if err != nil || len(nonceBytes) != common.HashLength {
+			return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: nonce must be a bytes32 hex string", ucasetypes.ErrInvalidGaslessPayment)
+		}
+		nonce := common.BytesToHash(nonceBytes)
+
+		digest := crypto.TransferWithAuthorizationDigest(domainSeparator, from, paymentAddress, value, validAfter, validBefore, nonce)
+		if err := verifySigner(digest, payload.Signature, from); err != nil {
+			return dto.GaslessPaymentDTO{}, err
+		}
+
+		calls = append(calls, relayerCall{
+			method: constants.TransferWithAuthorizationMethod,
+			args:   []any{from, paymentAddress, value, validAfter, validBefore, [32]byte(nonce), v, r, s},
+		})
+	case constants.GaslessPermit:
+		deadline, err := parseUint256("deadline", payload.Deadline)
+		if err != nil {
+			return dto.GaslessPaymentDTO{}, err
+		}
+		if deadline.Cmp(now) <= 0 {
+			return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: permit deadline has passed", ucasetypes.ErrInvalidGaslessPayment)
+		}
+		outputs, err := ethClient.CallContract(ctx, common.HexToAddress(tokenAddress), constants.GaslessTokenABI, constants.NoncesMethod, from)
+		if err != nil {
+			return dto.GaslessPaymentDTO{}, fmt.Errorf("failed to get permit nonce of %s: %w", from.Hex(), err)
+		}
+		nonce := outputs[0].(*big.Int)
+
+		digest := crypto.PermitDigest(domainSeparator, from, relayer.Address, value, nonce, deadline)
+		if err := verifySigner(digest, payload.Signature, from); err != nil {
+			return dto.GaslessPaymentDTO{}, err
+		}
+
+		calls = append(calls,
+			relayerCall{
+				method: constants.PermitMethod,
+				args:   []any{from, relayer.Address, value, deadline, v, r, s},
+			},
+			relayerCall{
+				method: constants.TransferFromMethod,
+				args:   []any{from, paymentAddress, value},
+			},
+		)
+	default:
+		return dto.GaslessPaymentDTO{}, fmt.Errorf("%w: unsupported type %s", ucasetypes.ErrInvalidGaslessPayment, payload.Type)
+	}
+
+	// Step 6: Relay the calls one after the other and record the gas spent for the vendor
+	relayerLock := u.relayerLock(network)
+	relayerLock.Lock()
+	defer relayerLock.Unlock()
+
+	result := dto.GaslessPaymentDTO{
+		RequestID:      order.RequestID,
+		Network:        order.Network,
+		PaymentAddress: order.Wallet.Address,
+	}
+	for _, call := range calls {
+		txHash, gasUsed, gasPrice, receiptStatus, err := ethClient.SendContractTransaction(
+			ctx, chainID, common.HexToAddress(tokenAddress), relayerPrivateKeyHex, constants.GaslessTokenABI, call.method, call.args...,
+		)
+		if err != nil {
+			return result, fmt.Errorf("failed to relay %s for order %s: %w", call.method, order.RequestID, err)
+		}
+
+		relayerTransaction := u.recordRelayerTransaction(ctx, order, call.method, txHash, gasUsed, gasPrice, receiptStatus)
+		result.Transactions = append(result.Transactions, relayerTransaction.ToDto())
+
+		if !relayerTransaction.Status {
+			return result, fmt.Errorf("relayed %s transaction %s for order %s failed", call.method, txHash.Hex(), order.RequestID)
+		}
+	}
+
+	logger.GetLogger().Infof("Relayed %s gasless payment of %s for order %s on network %s", payload.Type, value.String(), order.RequestID, order.Network)
+	return result, nil
+}
+
+// relayerLock returns the lock serializing the transactions of the relayer wallet on the network.
+func (u *gaslessPaymentUCase) relayerLock(network constants.NetworkType) *sync.Mutex {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	lock, exists := u.relayerLocks[network]
+	if !exists {
+		lock = &sync.Mutex{}
+		u.relayerLocks[network] = lock
+	}
+	return lock
+}
+
+// minGaslessValue returns the smallest value relayed for the order in the smallest unit of the token:
+// the amount left to pay on the order, or the minimum amount covering the gas of the relayer when larger.
+func minGaslessValue(order *entities.PaymentOrder, tokenDecimals uint8) (*big.Int, error) {
+	amount, err := utils.ConvertFloatTokenToSmallestUnit(order.Amount, tokenDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert amount of order %s: %w", order.RequestID, err)
+	}
+	outstanding := amount
+	if order.Transferred != "" {
+		transferred, err := utils.ConvertFloatTokenToSmallestUnit(order.Transferred, tokenDecimals)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert transferred amount of order %s: %w", order.RequestID, err)
+		}
+		outstanding = new(big.Int).Sub(amount, transferred)
+	}
+
+	minValue := big.NewInt(0)
+	if minAmount := conf.GetGaslessMinAmount(); minAmount != "" {
+		minValue, err = utils.ConvertFloatTokenToSmallestUnit(minAmount, tokenDecimals)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert gasless minimum amount %s: %w", minAmount, err)
+		}
+	}
+	if outstanding.Cmp(minValue) > 0 {
+		return outstanding, nil
+	}
+	return minValue, nil
+}
+
+// recordRelayerTransaction stores the gas spent by the relayer wallet against the vendor of the order.
+// A failure to record is logged only, the transaction is already on chain.
+func (u *gaslessPaymentUCase) recordRelayerTransaction(
+	ctx context.Context,
+	order *entities.PaymentOrder,
+	method string,
+	txHash common.Hash,
+	gasUsed uint64,
+	gasPrice *big.Int,
+	receiptStatus uint64,
+) entities.RelayerTransaction {
+	feeWei := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice)
+	fee, err := utils.ConvertSmallestUnitToFloatToken(feeWei.String(), constants.NativeTokenDecimalPlaces)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to convert relayer fee of transaction %s: %v", txHash.Hex(), err)
+		fee = "0"
+	}
+
+	relayerTransaction := entities.RelayerTransaction{
+		VendorID:        order.VendorID,
+		PaymentOrderID:  order.ID,
+		Network:         order.Network,
+		TransactionHash: txHash.Hex(),
+		Method:          method,
+		GasUsed:         gasUsed,
+		GasPrice:        gasPrice.String(),
+		Fee:             fee,
+		Status:          receiptStatus == 1,
+	}
+	if err := u.relayerTransactionRepository.CreateRelayerTransaction(ctx, relayerTransaction); err != nil {
+		logger.GetLogger().Errorf("Failed to record relayer transaction %s for order %s: %v", txHash.Hex(), order.RequestID, err)
+	}
+	return relayerTransaction
+}
+
+// GetRelayerFees returns the gas paid by the relayer wallet for the vendor, per network.
+func (u *gaslessPaymentUCase) GetRelayerFees(ctx context.Context, vendorID string) ([]dto.RelayerFeeDTO, error) {
+	fees, err := u.relayerTransactionRepository.GetRelayerFeesByVendorID(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.RelayerFeeDTO, 0, len(fees))
+	for _, fee := range fees {
+		nativeSymbol, err := blockchain.GetNativeTokenSymbol(constants.NetworkType(fee.Network))
+		if err != nil {
+			logger.GetLogger().Warnf("Unknown native token of network %s: %v", fee.Network, err)
+		}
+		response = append(response, dto.RelayerFeeDTO{
+			Network:          fee.Network,
+			NativeSymbol:     nativeSymbol,
+			TransactionCount: fee.TransactionCount,
+			TotalFee:         fee.TotalFee,
+		})
+	}
+	return response, nil
+}
+
+// parseUint256 parses a decimal uint256 field of a gasless payment payload.
+func parseUint256(name, value string) (*big.Int, error) {
+	parsed, ok := new(big.Int).SetString(strings.TrimSpace(value), 10)
+	if !ok || parsed.Sign() < 0 || parsed.BitLen() > 256 {
+		return nil, fmt.Errorf("%w: invalid %s %q", ucasetypes.ErrInvalidGaslessPayment, name, value)
+	}
+	return parsed, nil
+}
+
+// verifySigner checks that the EIP-712 digest was signed by the expected address.
+func verifySigner(digest common.Hash, signature string, expected common.Address) error {
+	signer, err := crypto.RecoverTypedDataSigner(digest, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ucasetypes.ErrInvalidGaslessPayment, err)
+	}
+	if signer != expected {
+		return fmt.Errorf("%w: signature is from %s, not %s", ucasetypes.ErrInvalidGaslessPayment, signer.Hex(), expected.Hex())
+	}
+	return nil
+}
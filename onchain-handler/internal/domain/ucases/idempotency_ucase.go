@@ -0,0 +1,90 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+)
+
+type idempotencyUCase struct {
+	idempotencyKeyRepository repotypes.IdempotencyKeyRepository
+	keyTTL                   time.Duration
+}
+
+func NewIdempotencyUCase(
+	idempotencyKeyRepository repotypes.IdempotencyKeyRepository,
+	keyTTL time.Duration,
+) ucasetypes.IdempotencyUCase {
+	return &idempotencyUCase{
+		idempotencyKeyRepository: idempotencyKeyRepository,
+		keyTTL:                   keyTTL,
+	}
+}
+
+// BeginRequest reserves the key of the vendor for the request. It returns the stored response when the same request
+// was already completed with the key, and nil when the request should be processed.
+func (u *idempotencyUCase) BeginRequest(
+	ctx context.Context, vendorID, key, requestHash string,
+) (*dto.IdempotentResponseDTO, error) {
+	// Step 1: Reserve the key, an expired key is freed and reserved again
+	for range 2 {
+		created, err := u.idempotencyKeyRepository.CreateIdempotencyKey(ctx, &entities.IdempotencyKey{
+			VendorID:    vendorID,
+			Key:         key,
+			RequestHash: requestHash,
+			ExpiresAt:   time.Now().UTC().Add(u.keyTTL),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			return nil, nil
+		}
+
+		// Step 2: The key is already used, check the request it was used with
+		existing, err := u.idempotencyKeyRepository.GetIdempotencyKey(ctx, vendorID, key)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			// Released in between by the first request
+			continue
+		}
+		if existing.ExpiresAt.Before(time.Now().UTC()) {
+			if err := u.idempotencyKeyRepository.DeleteIdempotencyKey(ctx, vendorID, key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if existing.RequestHash != requestHash {
+			return nil, ucasetypes.ErrIdempotencyKeyMismatch
+		}
+		if existing.StatusCode == 0 {
+			return nil, ucasetypes.ErrIdempotencyKeyInProgress
+		}
+
+		// Step 3: Same request, replay its response
+		return &dto.IdempotentResponseDTO{
+			StatusCode: existing.StatusCode,
+			Body:       existing.Response,
+		}, nil
+	}
+	return nil, fmt.Errorf("failed to reserve idempotency key %q: %w", key, ucasetypes.ErrIdempotencyKeyInProgress)
+}
+
+// CompleteRequest stores the response replayed for the retries of the request sent with the key.
+func (u *idempotencyUCase) CompleteRequest(
+	ctx context.Context, vendorID, key string, statusCode int, response string,
+) error {
+	return u.idempotencyKeyRepository.CompleteIdempotencyKey(ctx, vendorID, key, statusCode, response)
+}
+
+// ReleaseRequest frees the key when the request failed, so that a retry is processed again.
+func (u *idempotencyUCase) ReleaseRequest(ctx context.Context, vendorID, key string) error {
+	return u.idempotencyKeyRepository.DeleteIdempotencyKey(ctx, vendorID, key)
+}
@@ -0,0 +1,215 @@
+package ucases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type invoiceUCase struct {
+	invoiceRepository repotypes.InvoiceRepository
+	paymentOrderUCase ucasetypes.PaymentOrderUCase
+}
+
+func NewInvoiceUCase(
+	invoiceRepository repotypes.InvoiceRepository,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+) ucasetypes.InvoiceUCase {
+	return &invoiceUCase{
+		invoiceRepository: invoiceRepository,
+		paymentOrderUCase: paymentOrderUCase,
+	}
+}
+
+// CreateInvoice creates a payment order for each line item and the invoice grouping them.
+// The total is the sum of the item amounts, USDT and USDC are counted at face value.
+func (u *invoiceUCase) CreateInvoice(
+	ctx context.Context,
+	payload dto.InvoicePayloadDTO,
+	vendorID string,
+	expiredOrderTime time.Duration,
+) (dto.CreatedInvoiceDTO, error) {
+	// Step 1: Reject a request ID already used by an invoice of the vendor
+	_, err := u.invoiceRepository.GetInvoiceByRequestID(ctx, vendorID, payload.RequestID)
+	if err == nil {
+		return dto.CreatedInvoiceDTO{}, fmt.Errorf("%w: invoice %s", ucasetypes.ErrDuplicateRequestID, payload.RequestID)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return dto.CreatedInvoiceDTO{}, err
+	}
+
+	// Step 2: Sum the item amounts
+	total := big.NewInt(0)
+	orderPayloads := make([]dto.PaymentOrderPayloadDTO, 0, len(payload.Items))
+	descriptions := make(map[string]string, len(payload.Items))
+	for _, item := range payload.Items {
+		amount, err := utils.ConvertFloatTokenToSmallestUnit(item.Amount, constants.PaymentAmountDecimalPlaces)
+		if err != nil {
+			return dto.CreatedInvoiceDTO{}, fmt.Errorf("invalid amount of item %s: %w", item.RequestID, err)
+		}
+		total.Add(total, amount)
+		orderPayloads = append(orderPayloads, item.PaymentOrderPayloadDTO)
+		descriptions[item.RequestID] = item.Description
+	}
+	totalAmount, err := utils.ConvertSmallestUnitToFloatToken(total.String(), constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return dto.CreatedInvoiceDTO{}, fmt.Errorf("failed to convert invoice total: %w", err)
+	}
+
+	// Step 3: Create the orders of the items
+	orders, err := u.paymentOrderUCase.CreatePaymentOrders(ctx, orderPayloads, vendorID, expiredOrderTime)
+	if err != nil {
+		return dto.CreatedInvoiceDTO{}, err
+	}
+
+	// Step 4: Create the invoice. The orders are already created, if this fails they expire like standalone orders
+	invoice := &entities.Invoice{
+		VendorID:      vendorID,
+		RequestID:     payload.RequestID,
+		TotalAmount:   totalAmount,
+		SettledAmount: "0",
+		Status:        constants.InvoicePending,
+		WebhookURL:    payload.WebhookURL,
+	}
+	for _, order := range orders {
+		invoice.Items = append(invoice.Items, entities.InvoiceItem{
+			PaymentOrderID: order.ID,
+			Description:    descriptions[order.RequestID],
+		})
+	}
+	if err := u.invoiceRepository.CreateInvoice(ctx, invoice); err != nil {
+		return dto.CreatedInvoiceDTO{}, err
+	}
+
+	return dto.CreatedInvoiceDTO{
+		ID:          invoice.ID,
+		RequestID:   invoice.RequestID,
+		TotalAmount: invoice.TotalAmount,
+		Status:      invoice.Status,
+		Orders:      orders,
+	}, nil
+}
+
+// GetInvoice returns the invoice of the vendor with the current state of its orders.
+func (u *invoiceUCase) GetInvoice(ctx context.Context, vendorID, requestID string) (dto.InvoiceDTO, error) {
+	invoice, err := u.invoiceRepository.GetInvoiceByRequestID(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.InvoiceDTO{}, fmt.Errorf("%w: %s", ucasetypes.ErrInvoiceNotFound, requestID)
+		}
+		return dto.InvoiceDTO{}, err
+	}
+
+	return u.refreshInvoice(ctx, *invoice)
+}
+
+// ReconcileOpenInvoices updates the state of the PENDING and PARTIAL invoices from their orders,
+// sending the invoice webhook when the status changes.
+func (u *invoiceUCase) ReconcileOpenInvoices(ctx context.Context) error {
+	invoices, err := u.invoiceRepository.GetOpenInvoices(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, invoice := range invoices {
+		if _, err := u.refreshInvoice(ctx, invoice); err != nil {
+			logger.GetLogger().Errorf("Failed to reconcile invoice %s of vendor %s: %v", invoice.RequestID, invoice.VendorID, err)
+		}
+	}
+	return nil
+}
+
+// refreshInvoice derives the state of the invoice from its orders and stores it when it changed.
+func (u *invoiceUCase) refreshInvoice(ctx context.Context, invoice entities.Invoice) (dto.InvoiceDTO, error) {
+	// Step 1: Load the orders of the items
+	items := make([]dto.InvoiceItemDTO, 0, len(invoice.Items))
+	lines := make([]payment.InvoiceLine, 0, len(invoice.Items))
+	for _, item := range invoice.Items {
+		order, err := u.paymentOrderUCase.GetPaymentOrderByID(ctx, item.PaymentOrderID)
+		if err != nil {
+			return dto.InvoiceDTO{}, fmt.Errorf("failed to retrieve order %d of invoice %s: %w", item.PaymentOrderID, invoice.RequestID, err)
+		}
+		items = append(items, dto.InvoiceItemDTO{Description: item.Description, Order: order})
+
+		amount, err := utils.ConvertFloatTokenToSmallestUnit(order.Amount, constants.PaymentAmountDecimalPlaces)
+		if err != nil {
+			return dto.InvoiceDTO{}, fmt.Errorf("invalid amount of order %d: %w", order.ID, err)
+		}
+		transferred, err := utils.ConvertFloatTokenToSmallestUnit(order.Transferred, constants.PaymentAmountDecimalPlaces)
+		if err != nil {
+			return dto.InvoiceDTO{}, fmt.Errorf("invalid transferred amount of order %d: %w", order.ID, err)
+		}
+		lines = append(lines, payment.InvoiceLine{Amount: amount, Transferred: transferred, Status: order.Status})
+	}
+
+	// Step 2: Aggregate the payments of the orders
+	total, err := utils.ConvertFloatTokenToSmallestUnit(invoice.TotalAmount, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return dto.InvoiceDTO{}, fmt.Errorf("invalid total of invoice %s: %w", invoice.RequestID, err)
+	}
+	status, settled := payment.EvaluateInvoice(total, lines)
+	settledAmount, err := utils.ConvertSmallestUnitToFloatToken(settled.String(), constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return dto.InvoiceDTO{}, fmt.Errorf("failed to convert settled amount of invoice %s: %w", invoice.RequestID, err)
+	}
+
+	// Step 3: Store the new state of an open invoice, only the update that changes the status sends the webhook
+	isOpen := invoice.Status == constants.InvoicePending || invoice.Status == constants.InvoicePartial
+	previousSettled, err := utils.ConvertFloatTokenToSmallestUnit(invoice.SettledAmount, constants.PaymentAmountDecimalPlaces)
+	if isOpen && (err != nil || previousSettled.Cmp(settled) != 0 || invoice.Status != status) {
+		var paidAt *time.Time
+		if status == constants.InvoicePaid && invoice.Status != constants.InvoicePaid {
+			now := time.Now().UTC()
+			paidAt = &now
+			invoice.PaidAt = paidAt
+		}
+
+		updated, err := u.invoiceRepository.UpdateInvoiceState(ctx, invoice.ID, invoice.Status, status, settledAmount, paidAt)
+		if err != nil {
+			return dto.InvoiceDTO{}, err
+		}
+
+		statusChanged := updated && invoice.Status != status
+		invoice.Status = status
+		invoice.SettledAmount = settledAmount
+
+		if statusChanged {
+			invoiceDTO := mapInvoiceToDTO(invoice, items)
+			go func() {
+				if err := utils.SendWebhook(invoiceDTO, invoiceDTO.WebhookURL); err != nil {
+					logger.GetLogger().Errorf("Failed to send webhook for invoice %s: %v", invoiceDTO.RequestID, err)
+				}
+			}()
+		}
+	}
+
+	return mapInvoiceToDTO(invoice, items), nil
+}
+
+func mapInvoiceToDTO(invoice entities.Invoice, items []dto.InvoiceItemDTO) dto.InvoiceDTO {
+	return dto.InvoiceDTO{
+		ID:            invoice.ID,
+		RequestID:     invoice.RequestID,
+		VendorID:      invoice.VendorID,
+		TotalAmount:   invoice.TotalAmount,
+		SettledAmount: invoice.SettledAmount,
+		Status:        invoice.Status,
+		WebhookURL:    invoice.WebhookURL,
+		PaidAt:        invoice.PaidAt,
+		CreatedAt:     invoice.CreatedAt,
+		Items:         items,
+	}
+}
@@ -0,0 +1,136 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type listenerShardUCase struct {
+	db                *gorm.DB
+	listenerShardRepo repotypes.ListenerShardRepository
+}
+
+func NewListenerShardUCase(
+	db *gorm.DB,
+	listenerShardRepo repotypes.ListenerShardRepository,
+) ucasetypes.ListenerShardUCase {
+	return &listenerShardUCase{
+		db:                db,
+		listenerShardRepo: listenerShardRepo,
+	}
+}
+
+func (u *listenerShardUCase) Heartbeat(ctx context.Context, instanceID string) error {
+	return u.listenerShardRepo.UpsertInstanceHeartbeat(ctx, instanceID)
+}
+
+// Rebalance evicts instances without a recent heartbeat and redistributes the shards of the given networks
+// across the remaining instances. Concurrent calls from several instances are serialized by an advisory lock.
+func (u *listenerShardUCase) Rebalance(
+	ctx context.Context,
+	networks []constants.NetworkType,
+	partitionCount uint32,
+	instanceTimeout time.Duration,
+) error {
+	if partitionCount == 0 {
+		partitionCount = 1
+	}
+
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		// Step 1: Serialize rebalancing across instances
+		if err := u.listenerShardRepo.LockShardsForRebalance(tx, ctx); err != nil {
+			return fmt.Errorf("failed to lock shards for rebalance: %w", err)
+		}
+
+		// Step 2: Drop instances that stopped sending heartbeats
+		if err := u.listenerShardRepo.DeleteStaleInstances(tx, ctx, time.Now().UTC().Add(-instanceTimeout)); err != nil {
+			return fmt.Errorf("failed to delete stale instances: %w", err)
+		}
+
+		// Step 3: Make sure every network has the configured partitions
+		for _, network := range networks {
+			if err := u.listenerShardRepo.EnsureShards(tx, ctx, network.String(), partitionCount); err != nil {
+				return err
+			}
+		}
+
+		// Step 4: Compute the new assignment
+		instanceIDs, err := u.listenerShardRepo.GetInstanceIDs(tx, ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get instances: %w", err)
+		}
+
+		shards, err := u.listenerShardRepo.GetShards(tx, ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get shards: %w", err)
+		}
+
+		current := make(map[string]string, len(shards))
+		shardKeys := make([]string, 0, len(shards))
+		for _, shard := range shards {
+			shardDTO := shard.ToDto()
+			shardKeys = append(shardKeys, shardDTO.Key())
+			if shardDTO.InstanceID != "" {
+				current[shardDTO.Key()] = shardDTO.InstanceID
+			}
+		}
+		assignments := utils.BalanceShardAssignments(current, shardKeys, instanceIDs)
+
+		// Step 5: Persist the shards whose owner changed
+		for _, shard := range shards {
+			key := shard.ToDto().Key()
+			owner, assigned := assignments[key]
+			if current[key] == owner {
+				continue
+			}
+
+			var instanceID *string
+			if assigned {
+				instanceID = &owner
+			}
+			if err := u.listenerShardRepo.UpdateShardOwner(tx, ctx, shard.ID, instanceID); err != nil {
+				return fmt.Errorf("failed to update owner of shard %s: %w", key, err)
+			}
+			logger.GetLogger().Infof("Shard %s moved from instance '%s' to '%s'", key, current[key], owner)
+		}
+
+		return nil
+	})
+}
+
+func (u *listenerShardUCase) GetAssignedShards(ctx context.Context, instanceID string) ([]dto.ListenerShardDTO, error) {
+	shards, err := u.listenerShardRepo.GetShardsByInstanceID(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shards of instance %s: %w", instanceID, err)
+	}
+
+	shardDTOs := make([]dto.ListenerShardDTO, 0, len(shards))
+	for _, shard := range shards {
+		shardDTOs = append(shardDTOs, shard.ToDto())
+	}
+	return shardDTOs, nil
+}
+
+func (u *listenerShardUCase) Deregister(ctx context.Context, instanceID string) error {
+	return u.listenerShardRepo.DeleteInstance(ctx, instanceID)
+}
+
+func (u *listenerShardUCase) UpdateShardLastProcessedBlock(
+	ctx context.Context,
+	shard dto.ListenerShardDTO,
+	blockNumber uint64,
+) error {
+	return u.listenerShardRepo.UpdateShardLastProcessedBlock(
+		ctx, shard.Network, shard.PartitionIndex, shard.InstanceID, blockNumber,
+	)
+}
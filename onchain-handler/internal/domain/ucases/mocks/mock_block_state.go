@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/block_state.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/block_state.go -destination=internal/domain/ucases/mocks/mock_block_state.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	constants "github.com/genefriendway/onchain-handler/constants"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlockStateUCase is a mock of BlockStateUCase interface.
+type MockBlockStateUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockStateUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockBlockStateUCaseMockRecorder is the mock recorder for MockBlockStateUCase.
+type MockBlockStateUCaseMockRecorder struct {
+	mock *MockBlockStateUCase
+}
+
+// NewMockBlockStateUCase creates a new mock instance.
+func NewMockBlockStateUCase(ctrl *gomock.Controller) *MockBlockStateUCase {
+	mock := &MockBlockStateUCase{ctrl: ctrl}
+	mock.recorder = &MockBlockStateUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockStateUCase) EXPECT() *MockBlockStateUCaseMockRecorder {
+	return m.recorder
+}
+
+// GetLastProcessedBlock mocks base method.
+func (m *MockBlockStateUCase) GetLastProcessedBlock(ctx context.Context, network constants.NetworkType) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastProcessedBlock", ctx, network)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastProcessedBlock indicates an expected call of GetLastProcessedBlock.
+func (mr *MockBlockStateUCaseMockRecorder) GetLastProcessedBlock(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastProcessedBlock", reflect.TypeOf((*MockBlockStateUCase)(nil).GetLastProcessedBlock), ctx, network)
+}
+
+// GetLatestBlock mocks base method.
+func (m *MockBlockStateUCase) GetLatestBlock(ctx context.Context, network constants.NetworkType) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestBlock", ctx, network)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestBlock indicates an expected call of GetLatestBlock.
+func (mr *MockBlockStateUCaseMockRecorder) GetLatestBlock(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestBlock", reflect.TypeOf((*MockBlockStateUCase)(nil).GetLatestBlock), ctx, network)
+}
+
+// UpdateLastProcessedBlock mocks base method.
+func (m *MockBlockStateUCase) UpdateLastProcessedBlock(ctx context.Context, blockNumber uint64, network constants.NetworkType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastProcessedBlock", ctx, blockNumber, network)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastProcessedBlock indicates an expected call of UpdateLastProcessedBlock.
+func (mr *MockBlockStateUCaseMockRecorder) UpdateLastProcessedBlock(ctx, blockNumber, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastProcessedBlock", reflect.TypeOf((*MockBlockStateUCase)(nil).UpdateLastProcessedBlock), ctx, blockNumber, network)
+}
+
+// UpdateLatestBlock mocks base method.
+func (m *MockBlockStateUCase) UpdateLatestBlock(ctx context.Context, blockNumber uint64, network constants.NetworkType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLatestBlock", ctx, blockNumber, network)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLatestBlock indicates an expected call of UpdateLatestBlock.
+func (mr *MockBlockStateUCaseMockRecorder) UpdateLatestBlock(ctx, blockNumber, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLatestBlock", reflect.TypeOf((*MockBlockStateUCase)(nil).UpdateLatestBlock), ctx, blockNumber, network)
+}
@@ -0,0 +1,190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/compliance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/compliance.go -destination=internal/domain/ucases/mocks/mock_compliance.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockComplianceUCase is a mock of ComplianceUCase interface.
+type MockComplianceUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockComplianceUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockComplianceUCaseMockRecorder is the mock recorder for MockComplianceUCase.
+type MockComplianceUCaseMockRecorder struct {
+	mock *MockComplianceUCase
+}
+
+// NewMockComplianceUCase creates a new mock instance.
+func NewMockComplianceUCase(ctrl *gomock.Controller) *MockComplianceUCase {
+	mock := &MockComplianceUCase{ctrl: ctrl}
+	mock.recorder = &MockComplianceUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComplianceUCase) EXPECT() *MockComplianceUCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteDeniedAddress mocks base method.
+func (m *MockComplianceUCase) DeleteDeniedAddress(ctx context.Context, address, network string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeniedAddress", ctx, address, network)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeniedAddress indicates an expected call of DeleteDeniedAddress.
+func (mr *MockComplianceUCaseMockRecorder) DeleteDeniedAddress(ctx, address, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeniedAddress", reflect.TypeOf((*MockComplianceUCase)(nil).DeleteDeniedAddress), ctx, address, network)
+}
+
+// GetComplianceHolds mocks base method.
+func (m *MockComplianceUCase) GetComplianceHolds(ctx context.Context, status string) ([]dto.ComplianceHoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceHolds", ctx, status)
+	ret0, _ := ret[0].([]dto.ComplianceHoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceHolds indicates an expected call of GetComplianceHolds.
+func (mr *MockComplianceUCaseMockRecorder) GetComplianceHolds(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceHolds", reflect.TypeOf((*MockComplianceUCase)(nil).GetComplianceHolds), ctx, status)
+}
+
+// GetDeniedAddresses mocks base method.
+func (m *MockComplianceUCase) GetDeniedAddresses(ctx context.Context) ([]dto.DeniedAddressDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeniedAddresses", ctx)
+	ret0, _ := ret[0].([]dto.DeniedAddressDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeniedAddresses indicates an expected call of GetDeniedAddresses.
+func (mr *MockComplianceUCaseMockRecorder) GetDeniedAddresses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeniedAddresses", reflect.TypeOf((*MockComplianceUCase)(nil).GetDeniedAddresses), ctx)
+}
+
+// GetPaymentHold mocks base method.
+func (m *MockComplianceUCase) GetPaymentHold(ctx context.Context, orderID uint64, txHash string, logIndex uint) (*dto.ComplianceHoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentHold", ctx, orderID, txHash, logIndex)
+	ret0, _ := ret[0].(*dto.ComplianceHoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentHold indicates an expected call of GetPaymentHold.
+func (mr *MockComplianceUCaseMockRecorder) GetPaymentHold(ctx, orderID, txHash, logIndex any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentHold", reflect.TypeOf((*MockComplianceUCase)(nil).GetPaymentHold), ctx, orderID, txHash, logIndex)
+}
+
+// RecordHeldPayment mocks base method.
+func (m *MockComplianceUCase) RecordHeldPayment(ctx context.Context, hold dto.ComplianceHoldDTO, payload dto.PaymentEventPayloadDTO) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordHeldPayment", ctx, hold, payload)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordHeldPayment indicates an expected call of RecordHeldPayment.
+func (mr *MockComplianceUCaseMockRecorder) RecordHeldPayment(ctx, hold, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHeldPayment", reflect.TypeOf((*MockComplianceUCase)(nil).RecordHeldPayment), ctx, hold, payload)
+}
+
+// RejectComplianceHold mocks base method.
+func (m *MockComplianceUCase) RejectComplianceHold(ctx context.Context, id uint64, reviewer, note string) (dto.ComplianceHoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectComplianceHold", ctx, id, reviewer, note)
+	ret0, _ := ret[0].(dto.ComplianceHoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectComplianceHold indicates an expected call of RejectComplianceHold.
+func (mr *MockComplianceUCaseMockRecorder) RejectComplianceHold(ctx, id, reviewer, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectComplianceHold", reflect.TypeOf((*MockComplianceUCase)(nil).RejectComplianceHold), ctx, id, reviewer, note)
+}
+
+// ReleaseComplianceHold mocks base method.
+func (m *MockComplianceUCase) ReleaseComplianceHold(ctx context.Context, id uint64, reviewer, note string) (dto.ComplianceHoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseComplianceHold", ctx, id, reviewer, note)
+	ret0, _ := ret[0].(dto.ComplianceHoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseComplianceHold indicates an expected call of ReleaseComplianceHold.
+func (mr *MockComplianceUCaseMockRecorder) ReleaseComplianceHold(ctx, id, reviewer, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseComplianceHold", reflect.TypeOf((*MockComplianceUCase)(nil).ReleaseComplianceHold), ctx, id, reviewer, note)
+}
+
+// ResumeHold mocks base method.
+func (m *MockComplianceUCase) ResumeHold(ctx context.Context, hold dto.ComplianceHoldDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeHold", ctx, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeHold indicates an expected call of ResumeHold.
+func (mr *MockComplianceUCaseMockRecorder) ResumeHold(ctx, hold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeHold", reflect.TypeOf((*MockComplianceUCase)(nil).ResumeHold), ctx, hold)
+}
+
+// ScreenPayment mocks base method.
+func (m *MockComplianceUCase) ScreenPayment(ctx context.Context, payload dto.ComplianceHoldPayloadDTO) (*dto.ComplianceHoldDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScreenPayment", ctx, payload)
+	ret0, _ := ret[0].(*dto.ComplianceHoldDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScreenPayment indicates an expected call of ScreenPayment.
+func (mr *MockComplianceUCaseMockRecorder) ScreenPayment(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenPayment", reflect.TypeOf((*MockComplianceUCase)(nil).ScreenPayment), ctx, payload)
+}
+
+// UpsertDeniedAddress mocks base method.
+func (m *MockComplianceUCase) UpsertDeniedAddress(ctx context.Context, payload dto.DeniedAddressPayloadDTO) (dto.DeniedAddressDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDeniedAddress", ctx, payload)
+	ret0, _ := ret[0].(dto.DeniedAddressDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertDeniedAddress indicates an expected call of UpsertDeniedAddress.
+func (mr *MockComplianceUCaseMockRecorder) UpsertDeniedAddress(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDeniedAddress", reflect.TypeOf((*MockComplianceUCase)(nil).UpsertDeniedAddress), ctx, payload)
+}
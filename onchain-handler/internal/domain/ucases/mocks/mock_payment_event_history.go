@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/payment_event_history.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/payment_event_history.go -destination=internal/domain/ucases/mocks/mock_payment_event_history.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	constants "github.com/genefriendway/onchain-handler/constants"
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentEventHistoryUCase is a mock of PaymentEventHistoryUCase interface.
+type MockPaymentEventHistoryUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentEventHistoryUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentEventHistoryUCaseMockRecorder is the mock recorder for MockPaymentEventHistoryUCase.
+type MockPaymentEventHistoryUCaseMockRecorder struct {
+	mock *MockPaymentEventHistoryUCase
+}
+
+// NewMockPaymentEventHistoryUCase creates a new mock instance.
+func NewMockPaymentEventHistoryUCase(ctrl *gomock.Controller) *MockPaymentEventHistoryUCase {
+	mock := &MockPaymentEventHistoryUCase{ctrl: ctrl}
+	mock.recorder = &MockPaymentEventHistoryUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentEventHistoryUCase) EXPECT() *MockPaymentEventHistoryUCaseMockRecorder {
+	return m.recorder
+}
+
+// CreatePaymentEventHistory mocks base method.
+func (m *MockPaymentEventHistoryUCase) CreatePaymentEventHistory(ctx context.Context, payloads []dto.PaymentEventPayloadDTO) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentEventHistory", ctx, payloads)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentEventHistory indicates an expected call of CreatePaymentEventHistory.
+func (mr *MockPaymentEventHistoryUCaseMockRecorder) CreatePaymentEventHistory(ctx, payloads any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentEventHistory", reflect.TypeOf((*MockPaymentEventHistoryUCase)(nil).CreatePaymentEventHistory), ctx, payloads)
+}
+
+// CreditPaymentEvent mocks base method.
+func (m *MockPaymentEventHistoryUCase) CreditPaymentEvent(ctx context.Context, payload dto.PaymentEventPayloadDTO, vendorID string, walletID *uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreditPaymentEvent", ctx, payload, vendorID, walletID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreditPaymentEvent indicates an expected call of CreditPaymentEvent.
+func (mr *MockPaymentEventHistoryUCaseMockRecorder) CreditPaymentEvent(ctx, payload, vendorID, walletID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreditPaymentEvent", reflect.TypeOf((*MockPaymentEventHistoryUCase)(nil).CreditPaymentEvent), ctx, payload, vendorID, walletID)
+}
+
+// IsPaymentEventRecorded mocks base method.
+func (m *MockPaymentEventHistoryUCase) IsPaymentEventRecorded(ctx context.Context, network constants.NetworkType, transactionHash string, logIndex uint, toAddress, contractAddress string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaymentEventRecorded", ctx, network, transactionHash, logIndex, toAddress, contractAddress)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPaymentEventRecorded indicates an expected call of IsPaymentEventRecorded.
+func (mr *MockPaymentEventHistoryUCaseMockRecorder) IsPaymentEventRecorded(ctx, network, transactionHash, logIndex, toAddress, contractAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaymentEventRecorded", reflect.TypeOf((*MockPaymentEventHistoryUCase)(nil).IsPaymentEventRecorded), ctx, network, transactionHash, logIndex, toAddress, contractAddress)
+}
@@ -0,0 +1,412 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/payment_order.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/payment_order.go -destination=internal/domain/ucases/mocks/mock_payment_order.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+	time "time"
+
+	constants "github.com/genefriendway/onchain-handler/constants"
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentOrderUCase is a mock of PaymentOrderUCase interface.
+type MockPaymentOrderUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentOrderUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentOrderUCaseMockRecorder is the mock recorder for MockPaymentOrderUCase.
+type MockPaymentOrderUCaseMockRecorder struct {
+	mock *MockPaymentOrderUCase
+}
+
+// NewMockPaymentOrderUCase creates a new mock instance.
+func NewMockPaymentOrderUCase(ctrl *gomock.Controller) *MockPaymentOrderUCase {
+	mock := &MockPaymentOrderUCase{ctrl: ctrl}
+	mock.recorder = &MockPaymentOrderUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentOrderUCase) EXPECT() *MockPaymentOrderUCaseMockRecorder {
+	return m.recorder
+}
+
+// BatchUpdateOrderBlockHeights mocks base method.
+func (m *MockPaymentOrderUCase) BatchUpdateOrderBlockHeights(ctx context.Context, orders []dto.PaymentOrderDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateOrderBlockHeights", ctx, orders)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpdateOrderBlockHeights indicates an expected call of BatchUpdateOrderBlockHeights.
+func (mr *MockPaymentOrderUCaseMockRecorder) BatchUpdateOrderBlockHeights(ctx, orders any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateOrderBlockHeights", reflect.TypeOf((*MockPaymentOrderUCase)(nil).BatchUpdateOrderBlockHeights), ctx, orders)
+}
+
+// BatchUpdateOrdersToExpired mocks base method.
+func (m *MockPaymentOrderUCase) BatchUpdateOrdersToExpired(ctx context.Context, orderIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateOrdersToExpired", ctx, orderIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpdateOrdersToExpired indicates an expected call of BatchUpdateOrdersToExpired.
+func (mr *MockPaymentOrderUCaseMockRecorder) BatchUpdateOrdersToExpired(ctx, orderIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateOrdersToExpired", reflect.TypeOf((*MockPaymentOrderUCase)(nil).BatchUpdateOrdersToExpired), ctx, orderIDs)
+}
+
+// CancelPaymentOrder mocks base method.
+func (m *MockPaymentOrderUCase) CancelPaymentOrder(ctx context.Context, vendorID, requestID string) (dto.PaymentOrderDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPaymentOrder", ctx, vendorID, requestID)
+	ret0, _ := ret[0].(dto.PaymentOrderDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPaymentOrder indicates an expected call of CancelPaymentOrder.
+func (mr *MockPaymentOrderUCaseMockRecorder) CancelPaymentOrder(ctx, vendorID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPaymentOrder", reflect.TypeOf((*MockPaymentOrderUCase)(nil).CancelPaymentOrder), ctx, vendorID, requestID)
+}
+
+// CreatePaymentOrders mocks base method.
+func (m *MockPaymentOrderUCase) CreatePaymentOrders(ctx context.Context, payloads []dto.PaymentOrderPayloadDTO, vendorID string, expiredOrderTime time.Duration) ([]dto.CreatedPaymentOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentOrders", ctx, payloads, vendorID, expiredOrderTime)
+	ret0, _ := ret[0].([]dto.CreatedPaymentOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentOrders indicates an expected call of CreatePaymentOrders.
+func (mr *MockPaymentOrderUCaseMockRecorder) CreatePaymentOrders(ctx, payloads, vendorID, expiredOrderTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentOrders", reflect.TypeOf((*MockPaymentOrderUCase)(nil).CreatePaymentOrders), ctx, payloads, vendorID, expiredOrderTime)
+}
+
+// EvaluatePayment mocks base method.
+func (m *MockPaymentOrderUCase) EvaluatePayment(ctx context.Context, vendorID, symbol string, amount, transferred *big.Int, tokenDecimals uint8) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluatePayment", ctx, vendorID, symbol, amount, transferred, tokenDecimals)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluatePayment indicates an expected call of EvaluatePayment.
+func (mr *MockPaymentOrderUCaseMockRecorder) EvaluatePayment(ctx, vendorID, symbol, amount, transferred, tokenDecimals any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluatePayment", reflect.TypeOf((*MockPaymentOrderUCase)(nil).EvaluatePayment), ctx, vendorID, symbol, amount, transferred, tokenDecimals)
+}
+
+// ExtendPaymentOrder mocks base method.
+func (m *MockPaymentOrderUCase) ExtendPaymentOrder(ctx context.Context, vendorID, requestID string, extendTime time.Duration) (dto.PaymentOrderDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendPaymentOrder", ctx, vendorID, requestID, extendTime)
+	ret0, _ := ret[0].(dto.PaymentOrderDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendPaymentOrder indicates an expected call of ExtendPaymentOrder.
+func (mr *MockPaymentOrderUCaseMockRecorder) ExtendPaymentOrder(ctx, vendorID, requestID, extendTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendPaymentOrder", reflect.TypeOf((*MockPaymentOrderUCase)(nil).ExtendPaymentOrder), ctx, vendorID, requestID, extendTime)
+}
+
+// GetActivePaymentOrders mocks base method.
+func (m *MockPaymentOrderUCase) GetActivePaymentOrders(ctx context.Context) ([]dto.PaymentOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivePaymentOrders", ctx)
+	ret0, _ := ret[0].([]dto.PaymentOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivePaymentOrders indicates an expected call of GetActivePaymentOrders.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetActivePaymentOrders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivePaymentOrders", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetActivePaymentOrders), ctx)
+}
+
+// GetExpiredPaymentOrders mocks base method.
+func (m *MockPaymentOrderUCase) GetExpiredPaymentOrders(ctx context.Context, network constants.NetworkType) ([]dto.PaymentOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredPaymentOrders", ctx, network)
+	ret0, _ := ret[0].([]dto.PaymentOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredPaymentOrders indicates an expected call of GetExpiredPaymentOrders.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetExpiredPaymentOrders(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredPaymentOrders", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetExpiredPaymentOrders), ctx, network)
+}
+
+// GetPaymentOrderAudits mocks base method.
+func (m *MockPaymentOrderUCase) GetPaymentOrderAudits(ctx context.Context, vendorID, requestID string) ([]dto.PaymentOrderAuditDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrderAudits", ctx, vendorID, requestID)
+	ret0, _ := ret[0].([]dto.PaymentOrderAuditDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrderAudits indicates an expected call of GetPaymentOrderAudits.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetPaymentOrderAudits(ctx, vendorID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderAudits", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetPaymentOrderAudits), ctx, vendorID, requestID)
+}
+
+// GetPaymentOrderByID mocks base method.
+func (m *MockPaymentOrderUCase) GetPaymentOrderByID(ctx context.Context, id uint64) (dto.PaymentOrderDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrderByID", ctx, id)
+	ret0, _ := ret[0].(dto.PaymentOrderDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrderByID indicates an expected call of GetPaymentOrderByID.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetPaymentOrderByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderByID", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetPaymentOrderByID), ctx, id)
+}
+
+// GetPaymentOrderByRequestID mocks base method.
+func (m *MockPaymentOrderUCase) GetPaymentOrderByRequestID(ctx context.Context, vendorID, requestID string) (dto.PaymentOrderDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrderByRequestID", ctx, vendorID, requestID)
+	ret0, _ := ret[0].(dto.PaymentOrderDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrderByRequestID indicates an expected call of GetPaymentOrderByRequestID.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetPaymentOrderByRequestID(ctx, vendorID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderByRequestID", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetPaymentOrderByRequestID), ctx, vendorID, requestID)
+}
+
+// GetPaymentOrders mocks base method.
+func (m *MockPaymentOrderUCase) GetPaymentOrders(ctx context.Context, vendorID string, requestIDs []string, status, orderBy, fromAddress, network *string, orderDirection constants.OrderDirection, startTime, endTime *time.Time, timeFilterField *string, page, size int) (dto.PaginationDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrders", ctx, vendorID, requestIDs, status, orderBy, fromAddress, network, orderDirection, startTime, endTime, timeFilterField, page, size)
+	ret0, _ := ret[0].(dto.PaginationDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrders indicates an expected call of GetPaymentOrders.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetPaymentOrders(ctx, vendorID, requestIDs, status, orderBy, fromAddress, network, orderDirection, startTime, endTime, timeFilterField, page, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrders", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetPaymentOrders), ctx, vendorID, requestIDs, status, orderBy, fromAddress, network, orderDirection, startTime, endTime, timeFilterField, page, size)
+}
+
+// GetPaymentOrdersByIDs mocks base method.
+func (m *MockPaymentOrderUCase) GetPaymentOrdersByIDs(ctx context.Context, ids []uint64) ([]dto.PaymentOrderDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrdersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]dto.PaymentOrderDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrdersByIDs indicates an expected call of GetPaymentOrdersByIDs.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetPaymentOrdersByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrdersByIDs", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetPaymentOrdersByIDs), ctx, ids)
+}
+
+// GetPaymentOrdersOpenBetween mocks base method.
+func (m *MockPaymentOrderUCase) GetPaymentOrdersOpenBetween(ctx context.Context, network constants.NetworkType, startTime, endTime time.Time) ([]dto.PaymentOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrdersOpenBetween", ctx, network, startTime, endTime)
+	ret0, _ := ret[0].([]dto.PaymentOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrdersOpenBetween indicates an expected call of GetPaymentOrdersOpenBetween.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetPaymentOrdersOpenBetween(ctx, network, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrdersOpenBetween", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetPaymentOrdersOpenBetween), ctx, network, startTime, endTime)
+}
+
+// GetPaymentURI mocks base method.
+func (m *MockPaymentOrderUCase) GetPaymentURI(ctx context.Context, order dto.PaymentOrderDTOResponse) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentURI", ctx, order)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPaymentURI indicates an expected call of GetPaymentURI.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetPaymentURI(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentURI", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetPaymentURI), ctx, order)
+}
+
+// GetProcessingOrdersExpired mocks base method.
+func (m *MockPaymentOrderUCase) GetProcessingOrdersExpired(ctx context.Context, network constants.NetworkType) ([]dto.PaymentOrderDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessingOrdersExpired", ctx, network)
+	ret0, _ := ret[0].([]dto.PaymentOrderDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessingOrdersExpired indicates an expected call of GetProcessingOrdersExpired.
+func (mr *MockPaymentOrderUCaseMockRecorder) GetProcessingOrdersExpired(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessingOrdersExpired", reflect.TypeOf((*MockPaymentOrderUCase)(nil).GetProcessingOrdersExpired), ctx, network)
+}
+
+// ReleaseWalletsForSuccessfulOrders mocks base method.
+func (m *MockPaymentOrderUCase) ReleaseWalletsForSuccessfulOrders(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWalletsForSuccessfulOrders", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseWalletsForSuccessfulOrders indicates an expected call of ReleaseWalletsForSuccessfulOrders.
+func (mr *MockPaymentOrderUCaseMockRecorder) ReleaseWalletsForSuccessfulOrders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWalletsForSuccessfulOrders", reflect.TypeOf((*MockPaymentOrderUCase)(nil).ReleaseWalletsForSuccessfulOrders), ctx)
+}
+
+// ResolvePaymentOrder mocks base method.
+func (m *MockPaymentOrderUCase) ResolvePaymentOrder(ctx context.Context, vendorID, requestID, status string) (dto.PaymentOrderDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePaymentOrder", ctx, vendorID, requestID, status)
+	ret0, _ := ret[0].(dto.PaymentOrderDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePaymentOrder indicates an expected call of ResolvePaymentOrder.
+func (mr *MockPaymentOrderUCaseMockRecorder) ResolvePaymentOrder(ctx, vendorID, requestID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePaymentOrder", reflect.TypeOf((*MockPaymentOrderUCase)(nil).ResolvePaymentOrder), ctx, vendorID, requestID, status)
+}
+
+// SelectPaymentOption mocks base method.
+func (m *MockPaymentOrderUCase) SelectPaymentOption(ctx context.Context, orderID uint64, network, symbol string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectPaymentOption", ctx, orderID, network, symbol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectPaymentOption indicates an expected call of SelectPaymentOption.
+func (mr *MockPaymentOrderUCaseMockRecorder) SelectPaymentOption(ctx, orderID, network, symbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectPaymentOption", reflect.TypeOf((*MockPaymentOrderUCase)(nil).SelectPaymentOption), ctx, orderID, network, symbol)
+}
+
+// UpdateActiveOrdersToExpired mocks base method.
+func (m *MockPaymentOrderUCase) UpdateActiveOrdersToExpired(ctx context.Context) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActiveOrdersToExpired", ctx)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActiveOrdersToExpired indicates an expected call of UpdateActiveOrdersToExpired.
+func (mr *MockPaymentOrderUCaseMockRecorder) UpdateActiveOrdersToExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActiveOrdersToExpired", reflect.TypeOf((*MockPaymentOrderUCase)(nil).UpdateActiveOrdersToExpired), ctx)
+}
+
+// UpdateExpiredOrdersToFailed mocks base method.
+func (m *MockPaymentOrderUCase) UpdateExpiredOrdersToFailed(ctx context.Context) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExpiredOrdersToFailed", ctx)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExpiredOrdersToFailed indicates an expected call of UpdateExpiredOrdersToFailed.
+func (mr *MockPaymentOrderUCaseMockRecorder) UpdateExpiredOrdersToFailed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExpiredOrdersToFailed", reflect.TypeOf((*MockPaymentOrderUCase)(nil).UpdateExpiredOrdersToFailed), ctx)
+}
+
+// UpdateOrderMetaByRequestID mocks base method.
+func (m *MockPaymentOrderUCase) UpdateOrderMetaByRequestID(ctx context.Context, vendorID, requestID string, payloadf dto.UpdatePaymentOrderPayloadDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderMetaByRequestID", ctx, vendorID, requestID, payloadf)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderMetaByRequestID indicates an expected call of UpdateOrderMetaByRequestID.
+func (mr *MockPaymentOrderUCaseMockRecorder) UpdateOrderMetaByRequestID(ctx, vendorID, requestID, payloadf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderMetaByRequestID", reflect.TypeOf((*MockPaymentOrderUCase)(nil).UpdateOrderMetaByRequestID), ctx, vendorID, requestID, payloadf)
+}
+
+// UpdateOrderNetwork mocks base method.
+func (m *MockPaymentOrderUCase) UpdateOrderNetwork(ctx context.Context, vendorID, requestID string, network constants.NetworkType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderNetwork", ctx, vendorID, requestID, network)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderNetwork indicates an expected call of UpdateOrderNetwork.
+func (mr *MockPaymentOrderUCaseMockRecorder) UpdateOrderNetwork(ctx, vendorID, requestID, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderNetwork", reflect.TypeOf((*MockPaymentOrderUCase)(nil).UpdateOrderNetwork), ctx, vendorID, requestID, network)
+}
+
+// UpdateOrderToSuccessAndReleaseWallet mocks base method.
+func (m *MockPaymentOrderUCase) UpdateOrderToSuccessAndReleaseWallet(ctx context.Context, orderID uint64, status string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderToSuccessAndReleaseWallet", ctx, orderID, status)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderToSuccessAndReleaseWallet indicates an expected call of UpdateOrderToSuccessAndReleaseWallet.
+func (mr *MockPaymentOrderUCaseMockRecorder) UpdateOrderToSuccessAndReleaseWallet(ctx, orderID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderToSuccessAndReleaseWallet", reflect.TypeOf((*MockPaymentOrderUCase)(nil).UpdateOrderToSuccessAndReleaseWallet), ctx, orderID, status)
+}
+
+// UpdatePaymentOrder mocks base method.
+func (m *MockPaymentOrderUCase) UpdatePaymentOrder(ctx context.Context, orderID uint64, blockHeight, upcomingBlockHeight *uint64, status, transferredAmount, network *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentOrder", ctx, orderID, blockHeight, upcomingBlockHeight, status, transferredAmount, network)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentOrder indicates an expected call of UpdatePaymentOrder.
+func (mr *MockPaymentOrderUCaseMockRecorder) UpdatePaymentOrder(ctx, orderID, blockHeight, upcomingBlockHeight, status, transferredAmount, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentOrder", reflect.TypeOf((*MockPaymentOrderUCase)(nil).UpdatePaymentOrder), ctx, orderID, blockHeight, upcomingBlockHeight, status, transferredAmount, network)
+}
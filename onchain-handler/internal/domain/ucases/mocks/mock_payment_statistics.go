@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/payment_statistics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/payment_statistics.go -destination=internal/domain/ucases/mocks/mock_payment_statistics.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentStatisticsUCase is a mock of PaymentStatisticsUCase interface.
+type MockPaymentStatisticsUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentStatisticsUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentStatisticsUCaseMockRecorder is the mock recorder for MockPaymentStatisticsUCase.
+type MockPaymentStatisticsUCaseMockRecorder struct {
+	mock *MockPaymentStatisticsUCase
+}
+
+// NewMockPaymentStatisticsUCase creates a new mock instance.
+func NewMockPaymentStatisticsUCase(ctrl *gomock.Controller) *MockPaymentStatisticsUCase {
+	mock := &MockPaymentStatisticsUCase{ctrl: ctrl}
+	mock.recorder = &MockPaymentStatisticsUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentStatisticsUCase) EXPECT() *MockPaymentStatisticsUCaseMockRecorder {
+	return m.recorder
+}
+
+// GetStatisticsByTimeRangeAndGranularity mocks base method.
+func (m *MockPaymentStatisticsUCase) GetStatisticsByTimeRangeAndGranularity(ctx context.Context, granularity string, startTime, endTime time.Time, vendorID string, symbols []string) ([]dto.PeriodStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatisticsByTimeRangeAndGranularity", ctx, granularity, startTime, endTime, vendorID, symbols)
+	ret0, _ := ret[0].([]dto.PeriodStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatisticsByTimeRangeAndGranularity indicates an expected call of GetStatisticsByTimeRangeAndGranularity.
+func (mr *MockPaymentStatisticsUCaseMockRecorder) GetStatisticsByTimeRangeAndGranularity(ctx, granularity, startTime, endTime, vendorID, symbols any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatisticsByTimeRangeAndGranularity", reflect.TypeOf((*MockPaymentStatisticsUCase)(nil).GetStatisticsByTimeRangeAndGranularity), ctx, granularity, startTime, endTime, vendorID, symbols)
+}
+
+// IncrementStatistics mocks base method.
+func (m *MockPaymentStatisticsUCase) IncrementStatistics(ctx context.Context, granularity string, periodStart time.Time, amount, transferred *string, symbol, vendorID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementStatistics", ctx, granularity, periodStart, amount, transferred, symbol, vendorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementStatistics indicates an expected call of IncrementStatistics.
+func (mr *MockPaymentStatisticsUCaseMockRecorder) IncrementStatistics(ctx, granularity, periodStart, amount, transferred, symbol, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementStatistics", reflect.TypeOf((*MockPaymentStatisticsUCase)(nil).IncrementStatistics), ctx, granularity, periodStart, amount, transferred, symbol, vendorID)
+}
@@ -0,0 +1,236 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/payment_wallet.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/payment_wallet.go -destination=internal/domain/ucases/mocks/mock_payment_wallet.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	constants "github.com/genefriendway/onchain-handler/constants"
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentWalletUCase is a mock of PaymentWalletUCase interface.
+type MockPaymentWalletUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentWalletUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentWalletUCaseMockRecorder is the mock recorder for MockPaymentWalletUCase.
+type MockPaymentWalletUCaseMockRecorder struct {
+	mock *MockPaymentWalletUCase
+}
+
+// NewMockPaymentWalletUCase creates a new mock instance.
+func NewMockPaymentWalletUCase(ctrl *gomock.Controller) *MockPaymentWalletUCase {
+	mock := &MockPaymentWalletUCase{ctrl: ctrl}
+	mock.recorder = &MockPaymentWalletUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentWalletUCase) EXPECT() *MockPaymentWalletUCaseMockRecorder {
+	return m.recorder
+}
+
+// AddPaymentWalletBalance mocks base method.
+func (m *MockPaymentWalletUCase) AddPaymentWalletBalance(ctx context.Context, walletID uint64, newBalance string, network constants.NetworkType, symbol string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPaymentWalletBalance", ctx, walletID, newBalance, network, symbol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPaymentWalletBalance indicates an expected call of AddPaymentWalletBalance.
+func (mr *MockPaymentWalletUCaseMockRecorder) AddPaymentWalletBalance(ctx, walletID, newBalance, network, symbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaymentWalletBalance", reflect.TypeOf((*MockPaymentWalletUCase)(nil).AddPaymentWalletBalance), ctx, walletID, newBalance, network, symbol)
+}
+
+// AssignMissingTronAddresses mocks base method.
+func (m *MockPaymentWalletUCase) AssignMissingTronAddresses(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignMissingTronAddresses", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignMissingTronAddresses indicates an expected call of AssignMissingTronAddresses.
+func (mr *MockPaymentWalletUCaseMockRecorder) AssignMissingTronAddresses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignMissingTronAddresses", reflect.TypeOf((*MockPaymentWalletUCase)(nil).AssignMissingTronAddresses), ctx)
+}
+
+// CreateAndGenerateWallet mocks base method.
+func (m *MockPaymentWalletUCase) CreateAndGenerateWallet(ctx context.Context, mnemonic, passphrase, salt string, inUse bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndGenerateWallet", ctx, mnemonic, passphrase, salt, inUse)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAndGenerateWallet indicates an expected call of CreateAndGenerateWallet.
+func (mr *MockPaymentWalletUCaseMockRecorder) CreateAndGenerateWallet(ctx, mnemonic, passphrase, salt, inUse any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndGenerateWallet", reflect.TypeOf((*MockPaymentWalletUCase)(nil).CreateAndGenerateWallet), ctx, mnemonic, passphrase, salt, inUse)
+}
+
+// GetGasSourceWalletAddressWithBalances mocks base method.
+func (m *MockPaymentWalletUCase) GetGasSourceWalletAddressWithBalances(ctx context.Context, mnemonic, passphrase, salt string) (string, map[constants.NetworkType]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGasSourceWalletAddressWithBalances", ctx, mnemonic, passphrase, salt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(map[constants.NetworkType]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGasSourceWalletAddressWithBalances indicates an expected call of GetGasSourceWalletAddressWithBalances.
+func (mr *MockPaymentWalletUCaseMockRecorder) GetGasSourceWalletAddressWithBalances(ctx, mnemonic, passphrase, salt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGasSourceWalletAddressWithBalances", reflect.TypeOf((*MockPaymentWalletUCase)(nil).GetGasSourceWalletAddressWithBalances), ctx, mnemonic, passphrase, salt)
+}
+
+// GetNetworkWalletAddress mocks base method.
+func (m *MockPaymentWalletUCase) GetNetworkWalletAddress(network constants.NetworkType, walletType constants.WalletType, mnemonic, passphrase, salt string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkWalletAddress", network, walletType, mnemonic, passphrase, salt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkWalletAddress indicates an expected call of GetNetworkWalletAddress.
+func (mr *MockPaymentWalletUCaseMockRecorder) GetNetworkWalletAddress(network, walletType, mnemonic, passphrase, salt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkWalletAddress", reflect.TypeOf((*MockPaymentWalletUCase)(nil).GetNetworkWalletAddress), network, walletType, mnemonic, passphrase, salt)
+}
+
+// GetPaymentWalletByAddress mocks base method.
+func (m *MockPaymentWalletUCase) GetPaymentWalletByAddress(ctx context.Context, address string) (dto.PaymentWalletBalanceDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentWalletByAddress", ctx, address)
+	ret0, _ := ret[0].(dto.PaymentWalletBalanceDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentWalletByAddress indicates an expected call of GetPaymentWalletByAddress.
+func (mr *MockPaymentWalletUCaseMockRecorder) GetPaymentWalletByAddress(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentWalletByAddress", reflect.TypeOf((*MockPaymentWalletUCase)(nil).GetPaymentWalletByAddress), ctx, address)
+}
+
+// GetPaymentWallets mocks base method.
+func (m *MockPaymentWalletUCase) GetPaymentWallets(ctx context.Context) ([]dto.PaymentWalletDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentWallets", ctx)
+	ret0, _ := ret[0].([]dto.PaymentWalletDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentWallets indicates an expected call of GetPaymentWallets.
+func (mr *MockPaymentWalletUCaseMockRecorder) GetPaymentWallets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentWallets", reflect.TypeOf((*MockPaymentWalletUCase)(nil).GetPaymentWallets), ctx)
+}
+
+// GetPaymentWalletsWithBalances mocks base method.
+func (m *MockPaymentWalletUCase) GetPaymentWalletsWithBalances(ctx context.Context, network *constants.NetworkType, symbols []string) ([]dto.PaymentWalletBalanceDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentWalletsWithBalances", ctx, network, symbols)
+	ret0, _ := ret[0].([]dto.PaymentWalletBalanceDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentWalletsWithBalances indicates an expected call of GetPaymentWalletsWithBalances.
+func (mr *MockPaymentWalletUCaseMockRecorder) GetPaymentWalletsWithBalances(ctx, network, symbols any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentWalletsWithBalances", reflect.TypeOf((*MockPaymentWalletUCase)(nil).GetPaymentWalletsWithBalances), ctx, network, symbols)
+}
+
+// GetPaymentWalletsWithBalancesPagination mocks base method.
+func (m *MockPaymentWalletUCase) GetPaymentWalletsWithBalancesPagination(ctx context.Context, page, size int, network *constants.NetworkType, tokenSymbols []string) (dto.PaginationDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentWalletsWithBalancesPagination", ctx, page, size, network, tokenSymbols)
+	ret0, _ := ret[0].(dto.PaginationDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentWalletsWithBalancesPagination indicates an expected call of GetPaymentWalletsWithBalancesPagination.
+func (mr *MockPaymentWalletUCaseMockRecorder) GetPaymentWalletsWithBalancesPagination(ctx, page, size, network, tokenSymbols any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentWalletsWithBalancesPagination", reflect.TypeOf((*MockPaymentWalletUCase)(nil).GetPaymentWalletsWithBalancesPagination), ctx, page, size, network, tokenSymbols)
+}
+
+// GetReceivingWalletAddressWithBalances mocks base method.
+func (m *MockPaymentWalletUCase) GetReceivingWalletAddressWithBalances(ctx context.Context, mnemonic, passphrase, salt string) (string, map[constants.NetworkType]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceivingWalletAddressWithBalances", ctx, mnemonic, passphrase, salt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(map[constants.NetworkType]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReceivingWalletAddressWithBalances indicates an expected call of GetReceivingWalletAddressWithBalances.
+func (mr *MockPaymentWalletUCaseMockRecorder) GetReceivingWalletAddressWithBalances(ctx, mnemonic, passphrase, salt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceivingWalletAddressWithBalances", reflect.TypeOf((*MockPaymentWalletUCase)(nil).GetReceivingWalletAddressWithBalances), ctx, mnemonic, passphrase, salt)
+}
+
+// IsRowExist mocks base method.
+func (m *MockPaymentWalletUCase) IsRowExist(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRowExist", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRowExist indicates an expected call of IsRowExist.
+func (mr *MockPaymentWalletUCaseMockRecorder) IsRowExist(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRowExist", reflect.TypeOf((*MockPaymentWalletUCase)(nil).IsRowExist), ctx)
+}
+
+// SubtractPaymentWalletBalance mocks base method.
+func (m *MockPaymentWalletUCase) SubtractPaymentWalletBalance(ctx context.Context, walletID uint64, amountToSubtract string, network constants.NetworkType, symbol string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubtractPaymentWalletBalance", ctx, walletID, amountToSubtract, network, symbol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubtractPaymentWalletBalance indicates an expected call of SubtractPaymentWalletBalance.
+func (mr *MockPaymentWalletUCaseMockRecorder) SubtractPaymentWalletBalance(ctx, walletID, amountToSubtract, network, symbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubtractPaymentWalletBalance", reflect.TypeOf((*MockPaymentWalletUCase)(nil).SubtractPaymentWalletBalance), ctx, walletID, amountToSubtract, network, symbol)
+}
+
+// SyncWalletBalances mocks base method.
+func (m *MockPaymentWalletUCase) SyncWalletBalances(ctx context.Context, walletAddress string, network constants.NetworkType, tokenSymbols []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncWalletBalances", ctx, walletAddress, network, tokenSymbols)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncWalletBalances indicates an expected call of SyncWalletBalances.
+func (mr *MockPaymentWalletUCaseMockRecorder) SyncWalletBalances(ctx, walletAddress, network, tokenSymbols any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWalletBalances", reflect.TypeOf((*MockPaymentWalletUCase)(nil).SyncWalletBalances), ctx, walletAddress, network, tokenSymbols)
+}
@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/payout.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/payout.go -destination=internal/domain/ucases/mocks/mock_payout.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPayoutUCase is a mock of PayoutUCase interface.
+type MockPayoutUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayoutUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPayoutUCaseMockRecorder is the mock recorder for MockPayoutUCase.
+type MockPayoutUCaseMockRecorder struct {
+	mock *MockPayoutUCase
+}
+
+// NewMockPayoutUCase creates a new mock instance.
+func NewMockPayoutUCase(ctrl *gomock.Controller) *MockPayoutUCase {
+	mock := &MockPayoutUCase{ctrl: ctrl}
+	mock.recorder = &MockPayoutUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayoutUCase) EXPECT() *MockPayoutUCaseMockRecorder {
+	return m.recorder
+}
+
+// ApprovePayout mocks base method.
+func (m *MockPayoutUCase) ApprovePayout(ctx context.Context, id uint64, approver, reason string) (dto.PayoutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovePayout", ctx, id, approver, reason)
+	ret0, _ := ret[0].(dto.PayoutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApprovePayout indicates an expected call of ApprovePayout.
+func (mr *MockPayoutUCaseMockRecorder) ApprovePayout(ctx, id, approver, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovePayout", reflect.TypeOf((*MockPayoutUCase)(nil).ApprovePayout), ctx, id, approver, reason)
+}
+
+// ClaimApprovedPayouts mocks base method.
+func (m *MockPayoutUCase) ClaimApprovedPayouts(ctx context.Context, network string, limit int) ([]dto.PayoutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimApprovedPayouts", ctx, network, limit)
+	ret0, _ := ret[0].([]dto.PayoutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimApprovedPayouts indicates an expected call of ClaimApprovedPayouts.
+func (mr *MockPayoutUCaseMockRecorder) ClaimApprovedPayouts(ctx, network, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimApprovedPayouts", reflect.TypeOf((*MockPayoutUCase)(nil).ClaimApprovedPayouts), ctx, network, limit)
+}
+
+// CompletePayout mocks base method.
+func (m *MockPayoutUCase) CompletePayout(ctx context.Context, id uint64, status, transactionHash, fee, errorMessage string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletePayout", ctx, id, status, transactionHash, fee, errorMessage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompletePayout indicates an expected call of CompletePayout.
+func (mr *MockPayoutUCaseMockRecorder) CompletePayout(ctx, id, status, transactionHash, fee, errorMessage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePayout", reflect.TypeOf((*MockPayoutUCase)(nil).CompletePayout), ctx, id, status, transactionHash, fee, errorMessage)
+}
+
+// CreatePayouts mocks base method.
+func (m *MockPayoutUCase) CreatePayouts(ctx context.Context, vendorID string, payloads []dto.PayoutPayloadDTO) ([]dto.PayoutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayouts", ctx, vendorID, payloads)
+	ret0, _ := ret[0].([]dto.PayoutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayouts indicates an expected call of CreatePayouts.
+func (mr *MockPayoutUCaseMockRecorder) CreatePayouts(ctx, vendorID, payloads any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayouts", reflect.TypeOf((*MockPayoutUCase)(nil).CreatePayouts), ctx, vendorID, payloads)
+}
+
+// GetPayout mocks base method.
+func (m *MockPayoutUCase) GetPayout(ctx context.Context, vendorID, requestID string) (dto.PayoutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayout", ctx, vendorID, requestID)
+	ret0, _ := ret[0].(dto.PayoutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayout indicates an expected call of GetPayout.
+func (mr *MockPayoutUCaseMockRecorder) GetPayout(ctx, vendorID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayout", reflect.TypeOf((*MockPayoutUCase)(nil).GetPayout), ctx, vendorID, requestID)
+}
+
+// GetPayoutWalletAddress mocks base method.
+func (m *MockPayoutUCase) GetPayoutWalletAddress() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoutWalletAddress")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoutWalletAddress indicates an expected call of GetPayoutWalletAddress.
+func (mr *MockPayoutUCaseMockRecorder) GetPayoutWalletAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoutWalletAddress", reflect.TypeOf((*MockPayoutUCase)(nil).GetPayoutWalletAddress))
+}
+
+// GetPayouts mocks base method.
+func (m *MockPayoutUCase) GetPayouts(ctx context.Context, status string) ([]dto.PayoutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayouts", ctx, status)
+	ret0, _ := ret[0].([]dto.PayoutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayouts indicates an expected call of GetPayouts.
+func (mr *MockPayoutUCaseMockRecorder) GetPayouts(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayouts", reflect.TypeOf((*MockPayoutUCase)(nil).GetPayouts), ctx, status)
+}
+
+// GetUserWallet mocks base method.
+func (m *MockPayoutUCase) GetUserWallet(ctx context.Context, userID uint64) (dto.UserWalletDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWallet", ctx, userID)
+	ret0, _ := ret[0].(dto.UserWalletDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWallet indicates an expected call of GetUserWallet.
+func (mr *MockPayoutUCaseMockRecorder) GetUserWallet(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWallet", reflect.TypeOf((*MockPayoutUCase)(nil).GetUserWallet), ctx, userID)
+}
+
+// RegisterUserWallet mocks base method.
+func (m *MockPayoutUCase) RegisterUserWallet(ctx context.Context, payload dto.UserWalletPayloadDTO) (dto.UserWalletDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterUserWallet", ctx, payload)
+	ret0, _ := ret[0].(dto.UserWalletDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterUserWallet indicates an expected call of RegisterUserWallet.
+func (mr *MockPayoutUCaseMockRecorder) RegisterUserWallet(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserWallet", reflect.TypeOf((*MockPayoutUCase)(nil).RegisterUserWallet), ctx, payload)
+}
+
+// RejectPayout mocks base method.
+func (m *MockPayoutUCase) RejectPayout(ctx context.Context, id uint64, approver, reason string) (dto.PayoutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectPayout", ctx, id, approver, reason)
+	ret0, _ := ret[0].(dto.PayoutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectPayout indicates an expected call of RejectPayout.
+func (mr *MockPayoutUCaseMockRecorder) RejectPayout(ctx, id, approver, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectPayout", reflect.TypeOf((*MockPayoutUCase)(nil).RejectPayout), ctx, id, approver, reason)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/subscription.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/subscription.go -destination=internal/domain/ucases/mocks/mock_subscription.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSubscriptionUCase is a mock of SubscriptionUCase interface.
+type MockSubscriptionUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionUCaseMockRecorder is the mock recorder for MockSubscriptionUCase.
+type MockSubscriptionUCaseMockRecorder struct {
+	mock *MockSubscriptionUCase
+}
+
+// NewMockSubscriptionUCase creates a new mock instance.
+func NewMockSubscriptionUCase(ctrl *gomock.Controller) *MockSubscriptionUCase {
+	mock := &MockSubscriptionUCase{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionUCase) EXPECT() *MockSubscriptionUCaseMockRecorder {
+	return m.recorder
+}
+
+// BillDueSubscriptions mocks base method.
+func (m *MockSubscriptionUCase) BillDueSubscriptions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillDueSubscriptions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BillDueSubscriptions indicates an expected call of BillDueSubscriptions.
+func (mr *MockSubscriptionUCaseMockRecorder) BillDueSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillDueSubscriptions", reflect.TypeOf((*MockSubscriptionUCase)(nil).BillDueSubscriptions), ctx)
+}
+
+// CancelSubscription mocks base method.
+func (m *MockSubscriptionUCase) CancelSubscription(ctx context.Context, vendorID string, id uint64) (dto.SubscriptionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSubscription", ctx, vendorID, id)
+	ret0, _ := ret[0].(dto.SubscriptionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSubscription indicates an expected call of CancelSubscription.
+func (mr *MockSubscriptionUCaseMockRecorder) CancelSubscription(ctx, vendorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSubscription", reflect.TypeOf((*MockSubscriptionUCase)(nil).CancelSubscription), ctx, vendorID, id)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockSubscriptionUCase) CreateSubscription(ctx context.Context, vendorID string, payload dto.SubscriptionPayloadDTO) (dto.SubscriptionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, vendorID, payload)
+	ret0, _ := ret[0].(dto.SubscriptionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockSubscriptionUCaseMockRecorder) CreateSubscription(ctx, vendorID, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockSubscriptionUCase)(nil).CreateSubscription), ctx, vendorID, payload)
+}
+
+// CreateSubscriptionPlan mocks base method.
+func (m *MockSubscriptionUCase) CreateSubscriptionPlan(ctx context.Context, vendorID string, payload dto.SubscriptionPlanPayloadDTO) (dto.SubscriptionPlanDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscriptionPlan", ctx, vendorID, payload)
+	ret0, _ := ret[0].(dto.SubscriptionPlanDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscriptionPlan indicates an expected call of CreateSubscriptionPlan.
+func (mr *MockSubscriptionUCaseMockRecorder) CreateSubscriptionPlan(ctx, vendorID, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscriptionPlan", reflect.TypeOf((*MockSubscriptionUCase)(nil).CreateSubscriptionPlan), ctx, vendorID, payload)
+}
+
+// GetSubscription mocks base method.
+func (m *MockSubscriptionUCase) GetSubscription(ctx context.Context, vendorID string, id uint64) (dto.SubscriptionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, vendorID, id)
+	ret0, _ := ret[0].(dto.SubscriptionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockSubscriptionUCaseMockRecorder) GetSubscription(ctx, vendorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockSubscriptionUCase)(nil).GetSubscription), ctx, vendorID, id)
+}
+
+// GetSubscriptionPlans mocks base method.
+func (m *MockSubscriptionUCase) GetSubscriptionPlans(ctx context.Context, vendorID string) ([]dto.SubscriptionPlanDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionPlans", ctx, vendorID)
+	ret0, _ := ret[0].([]dto.SubscriptionPlanDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionPlans indicates an expected call of GetSubscriptionPlans.
+func (mr *MockSubscriptionUCaseMockRecorder) GetSubscriptionPlans(ctx, vendorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionPlans", reflect.TypeOf((*MockSubscriptionUCase)(nil).GetSubscriptionPlans), ctx, vendorID)
+}
+
+// TrackBillingSubscriptions mocks base method.
+func (m *MockSubscriptionUCase) TrackBillingSubscriptions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackBillingSubscriptions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackBillingSubscriptions indicates an expected call of TrackBillingSubscriptions.
+func (mr *MockSubscriptionUCaseMockRecorder) TrackBillingSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackBillingSubscriptions", reflect.TypeOf((*MockSubscriptionUCase)(nil).TrackBillingSubscriptions), ctx)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/token_transfer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/token_transfer.go -destination=internal/domain/ucases/mocks/mock_token_transfer.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	constants "github.com/genefriendway/onchain-handler/constants"
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTokenTransferUCase is a mock of TokenTransferUCase interface.
+type MockTokenTransferUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenTransferUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenTransferUCaseMockRecorder is the mock recorder for MockTokenTransferUCase.
+type MockTokenTransferUCaseMockRecorder struct {
+	mock *MockTokenTransferUCase
+}
+
+// NewMockTokenTransferUCase creates a new mock instance.
+func NewMockTokenTransferUCase(ctrl *gomock.Controller) *MockTokenTransferUCase {
+	mock := &MockTokenTransferUCase{ctrl: ctrl}
+	mock.recorder = &MockTokenTransferUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenTransferUCase) EXPECT() *MockTokenTransferUCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateTokenTransferHistories mocks base method.
+func (m *MockTokenTransferUCase) CreateTokenTransferHistories(ctx context.Context, payloads []dto.TokenTransferHistoryDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTokenTransferHistories", ctx, payloads)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTokenTransferHistories indicates an expected call of CreateTokenTransferHistories.
+func (mr *MockTokenTransferUCaseMockRecorder) CreateTokenTransferHistories(ctx, payloads any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTokenTransferHistories", reflect.TypeOf((*MockTokenTransferUCase)(nil).CreateTokenTransferHistories), ctx, payloads)
+}
+
+// GetTokenTransferHistories mocks base method.
+func (m *MockTokenTransferUCase) GetTokenTransferHistories(ctx context.Context, startTime, endTime *time.Time, orderBy *string, orderDirection constants.OrderDirection, page, size int, fromAddress, toAddress *string) (dto.PaginationDTOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenTransferHistories", ctx, startTime, endTime, orderBy, orderDirection, page, size, fromAddress, toAddress)
+	ret0, _ := ret[0].(dto.PaginationDTOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenTransferHistories indicates an expected call of GetTokenTransferHistories.
+func (mr *MockTokenTransferUCaseMockRecorder) GetTokenTransferHistories(ctx, startTime, endTime, orderBy, orderDirection, page, size, fromAddress, toAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenTransferHistories", reflect.TypeOf((*MockTokenTransferUCase)(nil).GetTokenTransferHistories), ctx, startTime, endTime, orderBy, orderDirection, page, size, fromAddress, toAddress)
+}
+
+// GetTotalTokenAmount mocks base method.
+func (m *MockTokenTransferUCase) GetTotalTokenAmount(ctx context.Context, startTime, endTime *time.Time, fromAddress, toAddress *string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalTokenAmount", ctx, startTime, endTime, fromAddress, toAddress)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalTokenAmount indicates an expected call of GetTotalTokenAmount.
+func (mr *MockTokenTransferUCaseMockRecorder) GetTotalTokenAmount(ctx, startTime, endTime, fromAddress, toAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalTokenAmount", reflect.TypeOf((*MockTokenTransferUCase)(nil).GetTotalTokenAmount), ctx, startTime, endTime, fromAddress, toAddress)
+}
@@ -0,0 +1,205 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/ucases/types/transfer_policy.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/ucases/types/transfer_policy.go -destination=internal/domain/ucases/mocks/mock_transfer_policy.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	dto "github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransferPolicyUCase is a mock of TransferPolicyUCase interface.
+type MockTransferPolicyUCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferPolicyUCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTransferPolicyUCaseMockRecorder is the mock recorder for MockTransferPolicyUCase.
+type MockTransferPolicyUCaseMockRecorder struct {
+	mock *MockTransferPolicyUCase
+}
+
+// NewMockTransferPolicyUCase creates a new mock instance.
+func NewMockTransferPolicyUCase(ctrl *gomock.Controller) *MockTransferPolicyUCase {
+	mock := &MockTransferPolicyUCase{ctrl: ctrl}
+	mock.recorder = &MockTransferPolicyUCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferPolicyUCase) EXPECT() *MockTransferPolicyUCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteAllowedAddress mocks base method.
+func (m *MockTransferPolicyUCase) DeleteAllowedAddress(ctx context.Context, network, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllowedAddress", ctx, network, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllowedAddress indicates an expected call of DeleteAllowedAddress.
+func (mr *MockTransferPolicyUCaseMockRecorder) DeleteAllowedAddress(ctx, network, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllowedAddress", reflect.TypeOf((*MockTransferPolicyUCase)(nil).DeleteAllowedAddress), ctx, network, address)
+}
+
+// DeleteTransferPolicy mocks base method.
+func (m *MockTransferPolicyUCase) DeleteTransferPolicy(ctx context.Context, network, symbol string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransferPolicy", ctx, network, symbol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransferPolicy indicates an expected call of DeleteTransferPolicy.
+func (mr *MockTransferPolicyUCaseMockRecorder) DeleteTransferPolicy(ctx, network, symbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransferPolicy", reflect.TypeOf((*MockTransferPolicyUCase)(nil).DeleteTransferPolicy), ctx, network, symbol)
+}
+
+// EvaluateTransfer mocks base method.
+func (m *MockTransferPolicyUCase) EvaluateTransfer(ctx context.Context, transferKind, network, symbol, toAddress, amount string) (dto.TransferEvaluationDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateTransfer", ctx, transferKind, network, symbol, toAddress, amount)
+	ret0, _ := ret[0].(dto.TransferEvaluationDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateTransfer indicates an expected call of EvaluateTransfer.
+func (mr *MockTransferPolicyUCaseMockRecorder) EvaluateTransfer(ctx, transferKind, network, symbol, toAddress, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateTransfer", reflect.TypeOf((*MockTransferPolicyUCase)(nil).EvaluateTransfer), ctx, transferKind, network, symbol, toAddress, amount)
+}
+
+// GetAllowedAddresses mocks base method.
+func (m *MockTransferPolicyUCase) GetAllowedAddresses(ctx context.Context) ([]dto.TransferAllowedAddressDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllowedAddresses", ctx)
+	ret0, _ := ret[0].([]dto.TransferAllowedAddressDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllowedAddresses indicates an expected call of GetAllowedAddresses.
+func (mr *MockTransferPolicyUCaseMockRecorder) GetAllowedAddresses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllowedAddresses", reflect.TypeOf((*MockTransferPolicyUCase)(nil).GetAllowedAddresses), ctx)
+}
+
+// GetDailyLimitRoom mocks base method.
+func (m *MockTransferPolicyUCase) GetDailyLimitRoom(ctx context.Context, network, symbol string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyLimitRoom", ctx, network, symbol)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyLimitRoom indicates an expected call of GetDailyLimitRoom.
+func (mr *MockTransferPolicyUCaseMockRecorder) GetDailyLimitRoom(ctx, network, symbol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyLimitRoom", reflect.TypeOf((*MockTransferPolicyUCase)(nil).GetDailyLimitRoom), ctx, network, symbol)
+}
+
+// GetTransferDecisions mocks base method.
+func (m *MockTransferPolicyUCase) GetTransferDecisions(ctx context.Context, transferKind string, transferID *uint64) ([]dto.TransferDecisionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferDecisions", ctx, transferKind, transferID)
+	ret0, _ := ret[0].([]dto.TransferDecisionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferDecisions indicates an expected call of GetTransferDecisions.
+func (mr *MockTransferPolicyUCaseMockRecorder) GetTransferDecisions(ctx, transferKind, transferID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferDecisions", reflect.TypeOf((*MockTransferPolicyUCase)(nil).GetTransferDecisions), ctx, transferKind, transferID)
+}
+
+// GetTransferPolicies mocks base method.
+func (m *MockTransferPolicyUCase) GetTransferPolicies(ctx context.Context) ([]dto.TransferPolicyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferPolicies", ctx)
+	ret0, _ := ret[0].([]dto.TransferPolicyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferPolicies indicates an expected call of GetTransferPolicies.
+func (mr *MockTransferPolicyUCaseMockRecorder) GetTransferPolicies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferPolicies", reflect.TypeOf((*MockTransferPolicyUCase)(nil).GetTransferPolicies), ctx)
+}
+
+// RecordApproval mocks base method.
+func (m *MockTransferPolicyUCase) RecordApproval(ctx context.Context, decision dto.TransferDecisionDTO, requester string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordApproval", ctx, decision, requester)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordApproval indicates an expected call of RecordApproval.
+func (mr *MockTransferPolicyUCaseMockRecorder) RecordApproval(ctx, decision, requester any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordApproval", reflect.TypeOf((*MockTransferPolicyUCase)(nil).RecordApproval), ctx, decision, requester)
+}
+
+// RecordTransferDecisions mocks base method.
+func (m *MockTransferPolicyUCase) RecordTransferDecisions(ctx context.Context, decisions []dto.TransferDecisionDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordTransferDecisions", ctx, decisions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordTransferDecisions indicates an expected call of RecordTransferDecisions.
+func (mr *MockTransferPolicyUCaseMockRecorder) RecordTransferDecisions(ctx, decisions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTransferDecisions", reflect.TypeOf((*MockTransferPolicyUCase)(nil).RecordTransferDecisions), ctx, decisions)
+}
+
+// UpsertAllowedAddress mocks base method.
+func (m *MockTransferPolicyUCase) UpsertAllowedAddress(ctx context.Context, payload dto.TransferAllowedAddressPayloadDTO) (dto.TransferAllowedAddressDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAllowedAddress", ctx, payload)
+	ret0, _ := ret[0].(dto.TransferAllowedAddressDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAllowedAddress indicates an expected call of UpsertAllowedAddress.
+func (mr *MockTransferPolicyUCaseMockRecorder) UpsertAllowedAddress(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAllowedAddress", reflect.TypeOf((*MockTransferPolicyUCase)(nil).UpsertAllowedAddress), ctx, payload)
+}
+
+// UpsertTransferPolicy mocks base method.
+func (m *MockTransferPolicyUCase) UpsertTransferPolicy(ctx context.Context, payload dto.TransferPolicyPayloadDTO) (dto.TransferPolicyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTransferPolicy", ctx, payload)
+	ret0, _ := ret[0].(dto.TransferPolicyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertTransferPolicy indicates an expected call of UpsertTransferPolicy.
+func (mr *MockTransferPolicyUCaseMockRecorder) UpsertTransferPolicy(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTransferPolicy", reflect.TypeOf((*MockTransferPolicyUCase)(nil).UpsertTransferPolicy), ctx, payload)
+}
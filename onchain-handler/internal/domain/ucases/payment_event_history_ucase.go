@@ -2,43 +2,112 @@ package ucases
 
 import (
 	"context"
+	"time"
 
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
 	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 	"github.com/genefriendway/onchain-handler/internal/domain/entities"
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
 )
 
 type paymentEventHistoryUCase struct {
-	paymentEventHistoryRepository repotypes.PaymentEventHistoryRepository
+	paymentEventHistoryRepository  repotypes.PaymentEventHistoryRepository
+	paymentStatisticsRepository    repotypes.PaymentStatisticsRepository
+	paymentWalletBalanceRepository repotypes.PaymentWalletBalanceRepository
 }
 
 func NewPaymentEventHistoryUCase(
 	paymentEventHistoryRepository repotypes.PaymentEventHistoryRepository,
+	paymentStatisticsRepository repotypes.PaymentStatisticsRepository,
+	paymentWalletBalanceRepository repotypes.PaymentWalletBalanceRepository,
 ) ucasetypes.PaymentEventHistoryUCase {
 	return &paymentEventHistoryUCase{
-		paymentEventHistoryRepository: paymentEventHistoryRepository,
+		paymentEventHistoryRepository:  paymentEventHistoryRepository,
+		paymentStatisticsRepository:    paymentStatisticsRepository,
+		paymentWalletBalanceRepository: paymentWalletBalanceRepository,
 	}
 }
 
+// CreatePaymentEventHistory stores the payment events and returns how many were newly recorded.
+// Events already recorded for the same transaction hash and log index are skipped.
 func (u *paymentEventHistoryUCase) CreatePaymentEventHistory(
 	ctx context.Context,
 	payloads []dto.PaymentEventPayloadDTO,
-) error {
+) (int, error) {
 	var eventHistories []entities.PaymentEventHistory
 	for _, payload := range payloads {
-		eventHistory := entities.PaymentEventHistory{
-			PaymentOrderID:  payload.PaymentOrderID,
-			TransactionHash: payload.TransactionHash,
-			FromAddress:     payload.FromAddress,
-			ToAddress:       payload.ToAddress,
-			ContractAddress: payload.ContractAddress,
-			TokenSymbol:     payload.TokenSymbol,
-			Amount:          payload.Amount,
-			Network:         payload.Network,
-		}
-		eventHistories = append(eventHistories, eventHistory)
+		eventHistories = append(eventHistories, toPaymentEventHistory(payload))
+	}
+	createdEvents, err := u.paymentEventHistoryRepository.CreatePaymentEventHistory(ctx, eventHistories, nil)
+	if err != nil {
+		return 0, err
+	}
+	return len(createdEvents), nil
+}
+
+// CreditPaymentEvent stores the payment event and credits its amount to the daily statistics of the vendor and to
+// the balance of the payment wallet, nil for router payments, in the same transaction. It returns false, crediting
+// nothing, when the event was already recorded.
+func (u *paymentEventHistoryUCase) CreditPaymentEvent(
+	ctx context.Context,
+	payload dto.PaymentEventPayloadDTO,
+	vendorID string,
+	walletID *uint64,
+) (bool, error) {
+	granularity := constants.Daily
+	periodStart := utils.GetPeriodStart(granularity, time.Now())
+
+	createdEvents, err := u.paymentEventHistoryRepository.CreatePaymentEventHistory(
+		ctx,
+		[]entities.PaymentEventHistory{toPaymentEventHistory(payload)},
+		func(tx *gorm.DB, paymentEvent entities.PaymentEventHistory) error {
+			if err := u.paymentStatisticsRepository.IncrementStatisticsInTx(
+				tx, granularity, periodStart, nil, &paymentEvent.Amount, paymentEvent.TokenSymbol, vendorID,
+			); err != nil {
+				return err
+			}
+			if walletID == nil {
+				return nil
+			}
+			return u.paymentWalletBalanceRepository.AddPaymentWalletBalanceInTx(
+				tx, *walletID, paymentEvent.Amount, paymentEvent.Network, paymentEvent.TokenSymbol,
+			)
+		},
+	)
+	if err != nil {
+		return false, err
 	}
-	_, err := u.paymentEventHistoryRepository.CreatePaymentEventHistory(ctx, eventHistories)
-	return err
+	return len(createdEvents) > 0, nil
+}
+
+func toPaymentEventHistory(payload dto.PaymentEventPayloadDTO) entities.PaymentEventHistory {
+	logIndex := payload.LogIndex
+	return entities.PaymentEventHistory{
+		PaymentOrderID:  payload.PaymentOrderID,
+		TransactionHash: payload.TransactionHash,
+		LogIndex:        &logIndex,
+		FromAddress:     payload.FromAddress,
+		ToAddress:       payload.ToAddress,
+		ContractAddress: payload.ContractAddress,
+		TokenSymbol:     payload.TokenSymbol,
+		Amount:          payload.Amount,
+		Network:         payload.Network,
+	}
+}
+
+// IsPaymentEventRecorded reports whether the transfer log is already stored in the payment event history.
+func (u *paymentEventHistoryUCase) IsPaymentEventRecorded(
+	ctx context.Context,
+	network constants.NetworkType,
+	transactionHash string,
+	logIndex uint,
+	toAddress, contractAddress string,
+) (bool, error) {
+	return u.paymentEventHistoryRepository.IsPaymentEventRecorded(
+		ctx, network.String(), transactionHash, logIndex, toAddress, contractAddress,
+	)
 }
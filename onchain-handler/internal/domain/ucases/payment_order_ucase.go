@@ -4,17 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/genefriendway/onchain-handler/conf"
 	"github.com/genefriendway/onchain-handler/constants"
+	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
 	settypes "github.com/genefriendway/onchain-handler/internal/adapters/orderset/types"
 	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 	"github.com/genefriendway/onchain-handler/internal/domain/entities"
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/internal/wire/instances"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
 	"github.com/genefriendway/onchain-handler/pkg/utils"
 )
 
@@ -25,6 +35,10 @@ type paymentOrderUCase struct {
 	blockStateRepo              repotypes.BlockStateRepository        // Repository to fetch the latest block state
 	paymentStatisticsRepository repotypes.PaymentStatisticsRepository // Repository to interact with payment statistics
 	paymentOrderSet             settypes.Set[dto.PaymentOrderDTO]     // Payment order set
+	cacheRepository             cachetypes.CacheRepository            // Cache of the token decimals
+	tolerancePolicyRepository   repotypes.TolerancePolicyRepository   // Repository of the payment tolerance policies
+	paymentOrderAuditRepository repotypes.PaymentOrderAuditRepository // Repository of the audit trail of the orders
+	tokenDecimals               sync.Map                              // Decimals of the payment URI tokens by network and address
 }
 
 // NewPaymentOrderUCase constructs a new paymentOrderUCase with the provided dependencies.
@@ -35,6 +49,9 @@ func NewPaymentOrderUCase(
 	blockStateRepo repotypes.BlockStateRepository,
 	paymentStatisticsRepository repotypes.PaymentStatisticsRepository,
 	paymentOrderSet settypes.Set[dto.PaymentOrderDTO],
+	cacheRepository cachetypes.CacheRepository,
+	tolerancePolicyRepository repotypes.TolerancePolicyRepository,
+	paymentOrderAuditRepository repotypes.PaymentOrderAuditRepository,
 ) ucasetypes.PaymentOrderUCase {
 	return &paymentOrderUCase{
 		db:                          db,
@@ -43,6 +60,9 @@ func NewPaymentOrderUCase(
 		blockStateRepo:              blockStateRepo,
 		paymentStatisticsRepository: paymentStatisticsRepository,
 		paymentOrderSet:             paymentOrderSet,
+		cacheRepository:             cacheRepository,
+		tolerancePolicyRepository:   tolerancePolicyRepository,
+		paymentOrderAuditRepository: paymentOrderAuditRepository,
 	}
 }
 
@@ -55,6 +75,19 @@ func (u *paymentOrderUCase) CreatePaymentOrders(
 	vendorID string,
 	expiredOrderTime time.Duration,
 ) ([]dto.CreatedPaymentOrderDTO, error) {
+	// Reject request IDs the vendor already used before claiming any wallet
+	requestIDs := make([]string, 0, len(payloads))
+	for _, payload := range payloads {
+		requestIDs = append(requestIDs, payload.RequestID)
+	}
+	existingRequestIDs, err := u.paymentOrderRepository.GetExistingRequestIDs(ctx, vendorID, requestIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing request IDs: %w", err)
+	}
+	if len(existingRequestIDs) > 0 {
+		return nil, fmt.Errorf("%w: %s", ucasetypes.ErrDuplicateRequestID, strings.Join(existingRequestIDs, ", "))
+	}
+
 	// Group payloads by network
 	networkPayloads := make(map[string][]dto.PaymentOrderPayloadDTO)
 	for _, payload := range payloads {
@@ -128,28 +161,42 @@ func (u *paymentOrderUCase) processOrderPayloads(
 		var orders []entities.PaymentOrder
 
 		for _, payload := range payloads {
-			// Step 1: Claim an available wallet inside the transaction
-			wallet, err := u.paymentWalletRepository.ClaimFirstAvailableWallet(tx, ctx)
-			if err != nil {
-				return fmt.Errorf("failed to claim available wallet: %w", err)
+			// Step 1: Create a new payment order, expiring after its own expiry time when given
+			orderExpiredTime := expiredOrderTime
+			if payload.ExpiredOrderTime > 0 {
+				orderExpiredTime = time.Duration(payload.ExpiredOrderTime) * time.Minute
 			}
-
-			// Track claimed wallet IDs
-			claimedWalletIDs = append(claimedWalletIDs, wallet.ID)
-
-			// Step 2: Create a new payment order
 			order := entities.PaymentOrder{
 				Amount:      payload.Amount,
-				WalletID:    wallet.ID,
-				Wallet:      *wallet,
 				Transferred: "0",
 				RequestID:   payload.RequestID,
 				Symbol:      payload.Symbol,
 				Network:     payload.Network,
+				PaymentMode: constants.PaymentModeAddress,
 				WebhookURL:  payload.WebhookURL,
 				BlockHeight: latestBlock,
 				Status:      constants.Pending,
-				ExpiredTime: time.Now().UTC().Add(expiredOrderTime),
+				ExpiredTime: time.Now().UTC().Add(orderExpiredTime),
+			}
+			options, err := u.buildOrderOptions(ctx, payload, latestBlock)
+			if err != nil {
+				return err
+			}
+			order.Options = options
+
+			// Step 2: Claim an available wallet inside the transaction, router orders are paid through the contract
+			if payload.PaymentMode == constants.PaymentModeRouter {
+				order.PaymentMode = constants.PaymentModeRouter
+			} else {
+				wallet, err := u.paymentWalletRepository.ClaimFirstAvailableWallet(tx, ctx)
+				if err != nil {
+					return fmt.Errorf("failed to claim available wallet: %w", err)
+				}
+
+				// Track claimed wallet IDs
+				claimedWalletIDs = append(claimedWalletIDs, wallet.ID)
+				order.WalletID = &wallet.ID
+				order.Wallet = *wallet
 			}
 			orders = append(orders, order)
 		}
@@ -171,16 +218,18 @@ func (u *paymentOrderUCase) processOrderPayloads(
 		return err // Return error if transaction fails
 	}
 
-	// Step 4: Add orders to the payment order set (AFTER transaction commit)
+	// Step 4: Add orders to the payment order set (AFTER transaction commit), an item per option of multi-chain orders
 	for _, order := range createdOrders {
-		if addErr := u.paymentOrderSet.Add(order.ToDto()); addErr != nil {
-			// Log error instead of failing the whole process
-			logger.GetLogger().Errorf("Failed to add order to payment order set: %v", addErr)
+		for _, item := range order.ToSetItems() {
+			if addErr := u.paymentOrderSet.Add(item); addErr != nil {
+				// Log error instead of failing the whole process
+				logger.GetLogger().Errorf("Failed to add order to payment order set: %v", addErr)
+			}
 		}
 	}
 
 	// Step 5: Map order IDs
-	responseWithIDs, err := u.mapOrderIDs(createdOrders)
+	responseWithIDs, err := u.mapOrderIDs(ctx, createdOrders)
 	if err != nil {
 		return fmt.Errorf("failed to map order IDs and sign payloads: %w", err)
 	}
@@ -189,21 +238,371 @@ func (u *paymentOrderUCase) processOrderPayloads(
 	return nil
 }
 
+// buildOrderOptions returns the options of a multi-chain order, the network and token of the payload first,
+// each listened from the latest block of its network. It returns nil for single chain orders.
+func (u *paymentOrderUCase) buildOrderOptions(
+	ctx context.Context,
+	payload dto.PaymentOrderPayloadDTO,
+	latestBlock uint64,
+) ([]entities.PaymentOrderOption, error) {
+	if len(payload.Options) == 0 {
+		return nil, nil
+	}
+
+	options := []entities.PaymentOrderOption{{Network: payload.Network, Symbol: payload.Symbol, BlockHeight: latestBlock}}
+	for _, option := range payload.Options {
+		if option.Network == payload.Network && option.Symbol == payload.Symbol {
+			continue
+		}
+		blockHeight := latestBlock
+		if option.Network != payload.Network {
+			var err error
+			blockHeight, err = u.blockStateRepo.GetLatestBlock(ctx, option.Network)
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve latest block of network %s: %w", option.Network, err)
+			}
+		}
+		options = append(options, entities.PaymentOrderOption{Network: option.Network, Symbol: option.Symbol, BlockHeight: blockHeight})
+	}
+	return options, nil
+}
+
 // mapOrderIDs maps order IDs.
 func (u *paymentOrderUCase) mapOrderIDs(
+	ctx context.Context,
 	orders []entities.PaymentOrder,
 ) ([]dto.CreatedPaymentOrderDTO, error) {
 	var response []dto.CreatedPaymentOrderDTO
 
 	for _, order := range orders {
 		orderDTO := order.ToCreatedPaymentOrderDTO()
-		orderDTO.PaymentAddress = order.Wallet.Address
+		orderDTO.PaymentAddress = order.Wallet.AddressOn(order.Network)
 		orderDTO.Expired = uint64(order.ExpiredTime.Unix())
+		if order.PaymentMode == constants.PaymentModeRouter {
+			orderDTO.RouterAddress = conf.GetPaymentRouterAddress(order.Network)
+			orderDTO.RouterOrderID = dto.RouterOrderID(order.ID)
+		}
+		orderDTO.PaymentURI = u.GetPaymentURI(ctx, mapOrderToDTO(order))
 		response = append(response, orderDTO)
 	}
 	return response, nil
 }
 
+// GetPaymentURI returns the EIP-681 URI paying what is left of the order to its payment address.
+// It is empty for router orders, closed orders, networks outside of the EVM or when the token cannot be resolved.
+func (u *paymentOrderUCase) GetPaymentURI(ctx context.Context, order dto.PaymentOrderDTOResponse) string {
+	if order.PaymentMode == constants.PaymentModeRouter || (order.Status != constants.Pending && order.Status != constants.Partial) {
+		return ""
+	}
+
+	network := constants.NetworkType(order.Network)
+	if chain.ForNetwork(network) != chain.EVM {
+		return ""
+	}
+	tokenAddress, err := conf.GetTokenAddress(order.Symbol, order.Network)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to get %s token address for payment URI of order %s: %v", order.Symbol, order.RequestID, err)
+		return ""
+	}
+	chainID, err := conf.GetChainID(network)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to get chain ID for payment URI of order %s: %v", order.RequestID, err)
+		return ""
+	}
+
+	decimals, err := u.tokenDecimalsOf(ctx, network, tokenAddress)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to get token decimals for payment URI of order %s: %v", order.RequestID, err)
+		return ""
+	}
+
+	// Request the remaining amount of partially paid orders
+	amount, err := utils.ConvertFloatTokenToSmallestUnit(order.Amount, decimals)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to convert amount for payment URI of order %s: %v", order.RequestID, err)
+		return ""
+	}
+	if order.Transferred != "" {
+		transferred, err := utils.ConvertFloatTokenToSmallestUnit(order.Transferred, decimals)
+		if err != nil {
+			logger.GetLogger().Warnf("Failed to convert transferred amount for payment URI of order %s: %v", order.RequestID, err)
+			return ""
+		}
+		amount = new(big.Int).Sub(amount, transferred)
+	}
+	if amount.Sign() <= 0 {
+		return ""
+	}
+
+	return payment.BuildEIP681TransferURI(tokenAddress, chainID, order.PaymentAddress, amount)
+}
+
+// tokenDecimalsOf returns the decimals of the token, kept once known as they never change.
+// On a miss they are read from the shared cache, or from the chain.
+func (u *paymentOrderUCase) tokenDecimalsOf(ctx context.Context, network constants.NetworkType, tokenAddress string) (uint8, error) {
+	key := network.String() + ":" + tokenAddress
+	if decimals, ok := u.tokenDecimals.Load(key); ok {
+		return decimals.(uint8), nil
+	}
+
+	rpcUrls, err := conf.GetRPCUrls(network)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RPC URLs: %w", err)
+	}
+	ethClient, err := instances.ETHClientInstance(network, rpcUrls)
+	if err != nil {
+		return 0, fmt.Errorf("failed to initialize Ethereum client: %w", err)
+	}
+	decimals, err := blockchain.FetchTokenDecimals(ctx, ethClient, tokenAddress, network.String(), u.cacheRepository)
+	if err != nil {
+		return 0, err
+	}
+	u.tokenDecimals.Store(key, decimals)
+	return decimals, nil
+}
+
+// CancelPaymentOrder cancels a PENDING order of the vendor: its wallet is released after the wallet release cooldown,
+// it leaves the payment order set, its statistics are reverted and its webhook is sent.
+// The orders of other vendors are not found.
+func (u *paymentOrderUCase) CancelPaymentOrder(ctx context.Context, vendorID, requestID string) (dto.PaymentOrderDTOResponse, error) {
+	// Step 1: Retrieve the order of the vendor and check it is pending
+	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.PaymentOrderDTOResponse{}, gorm.ErrRecordNotFound
+		}
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("failed to retrieve payment order: %w", err)
+	}
+	if order.Status != constants.Pending {
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("%w: order %s has status %s", ucasetypes.ErrPaymentOrderNotCancellable, requestID, order.Status)
+	}
+
+	// Step 2: Cancel the order and release its wallet, the status may have changed in the meantime
+	cancelled, err := u.paymentOrderRepository.CancelOrderAndReleaseWallet(ctx, order.ID, time.Now().UTC().Add(conf.GetWalletReleaseCooldown()))
+	if err != nil {
+		return dto.PaymentOrderDTOResponse{}, err
+	}
+	if !cancelled {
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("%w: order %s is no longer PENDING", ucasetypes.ErrPaymentOrderNotCancellable, requestID)
+	}
+
+	// Step 3: Stop listening for payments of the order, on every option of a multi-chain order
+	keys := order.ToDto().SetKeys()
+	isOrderItem := func(item dto.PaymentOrderDTO) bool {
+		return slices.Contains(keys, item.SetKey())
+	}
+	for u.paymentOrderSet.Remove(isOrderItem) {
+		// Remove drops a single item per call
+	}
+
+	// Step 4: Revert the statistics counted when the order was created
+	granularity := constants.Daily
+	if err := u.paymentStatisticsRepository.DecrementStatistics(
+		ctx,
+		granularity,
+		utils.GetPeriodStart(granularity, order.CreatedAt),
+		&order.Amount,
+		order.Symbol,
+		order.VendorID,
+	); err != nil {
+		logger.GetLogger().Errorf("Failed to revert payment statistics of cancelled order %s: %v", requestID, err)
+	}
+
+	// Step 5: Notify the vendor
+	order.Status = constants.Cancelled
+	orderDTO := mapOrderToDTO(*order)
+	if err := utils.SendWebhook(orderDTO, orderDTO.WebhookURL); err != nil {
+		logger.GetLogger().Errorf("Failed to send webhook for cancelled order %s: %v", requestID, err)
+	}
+
+	return orderDTO, nil
+}
+
+// ResolvePaymentOrder manually marks an order SUCCESS or FAILED whatever its payments, releases its wallet
+// and notifies the vendor again. The order is no longer listened for. Paid and cancelled orders are not resolvable,
+// a failed order may still be marked SUCCESS but its wallet is left as is. The outstanding amount of an order
+// marked SUCCESS is counted as transferred in the statistics.
+func (u *paymentOrderUCase) ResolvePaymentOrder(
+	ctx context.Context,
+	vendorID, requestID, status string,
+) (dto.PaymentOrderDTOResponse, error) {
+	// Step 1: Retrieve the order of the vendor
+	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.PaymentOrderDTOResponse{}, gorm.ErrRecordNotFound
+		}
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("failed to retrieve payment order: %w", err)
+	}
+
+	// Step 2: Update the status, the wallet is released along with it
+	var outstanding string
+	err = u.paymentOrderRepository.UpdatePaymentOrder(ctx, order.ID, func(order *entities.PaymentOrder) error {
+		if order.Status == status {
+			return fmt.Errorf("%w: order %s already has status %s", ucasetypes.ErrPaymentOrderNotResolvable, requestID, status)
+		}
+		if constants.IsPaidStatus(order.Status) || order.Status == constants.Cancelled {
+			return fmt.Errorf("%w: order %s is already %s", ucasetypes.ErrPaymentOrderNotResolvable, requestID, order.Status)
+		}
+		if status == constants.Success {
+			remaining, err := outstandingAmount(order.Amount, order.Transferred)
+			if err != nil {
+				return err
+			}
+			outstanding = remaining
+			order.SucceededAt = time.Now().UTC()
+		}
+		order.Status = status
+		return nil
+	})
+	if err != nil {
+		return dto.PaymentOrderDTOResponse{}, err
+	}
+
+	// Count the outstanding amount of an order marked SUCCESS as transferred, as a payment would have
+	if outstanding != "" {
+		granularity := constants.Daily
+		if err := u.paymentStatisticsRepository.IncrementStatistics(
+			ctx,
+			granularity,
+			utils.GetPeriodStart(granularity, time.Now()),
+			nil,
+			&outstanding,
+			order.Symbol,
+			order.VendorID,
+		); err != nil {
+			logger.GetLogger().Errorf("Failed to increment payment statistics for resolved order %s: %v", requestID, err)
+		}
+	}
+
+	// Step 3: Stop listening for payments of the order, on every option of a multi-chain order
+	keys := order.ToDto().SetKeys()
+	isOrderItem := func(item dto.PaymentOrderDTO) bool {
+		return slices.Contains(keys, item.SetKey())
+	}
+	for u.paymentOrderSet.Remove(isOrderItem) {
+		// Remove drops a single item per call
+	}
+
+	// Step 4: Notify the vendor with the order as stored
+	resolvedOrder, err := u.paymentOrderRepository.GetPaymentOrderByID(ctx, order.ID)
+	if err != nil {
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("failed to retrieve resolved payment order: %w", err)
+	}
+	orderDTO := mapOrderToDTO(*resolvedOrder)
+	if err := utils.SendWebhook(orderDTO, orderDTO.WebhookURL); err != nil {
+		logger.GetLogger().Errorf("Failed to send webhook for resolved order %s: %v", requestID, err)
+	}
+
+	return orderDTO, nil
+}
+
+// statisticsAmountDecimals is the scale of the amounts computed for the statistics, that of the largest token decimals.
+const statisticsAmountDecimals = 18
+
+// outstandingAmount returns the part of the order amount not transferred yet, empty when nothing is outstanding.
+func outstandingAmount(amount, transferred string) (string, error) {
+	remaining, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return "", fmt.Errorf("invalid order amount: %s", amount)
+	}
+	if transferred != "" {
+		paid, ok := new(big.Rat).SetString(transferred)
+		if !ok {
+			return "", fmt.Errorf("invalid order transferred amount: %s", transferred)
+		}
+		remaining.Sub(remaining, paid)
+	}
+	if remaining.Sign() <= 0 {
+		return "", nil
+	}
+	return remaining.FloatString(statisticsAmountDecimals), nil
+}
+
+// GetPaymentOrderAudits retrieves the recorded changes of an order of the vendor, oldest first
+func (u *paymentOrderUCase) GetPaymentOrderAudits(ctx context.Context, vendorID, requestID string) ([]dto.PaymentOrderAuditDTO, error) {
+	orderID, err := u.paymentOrderRepository.GetPaymentOrderIDByRequestID(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	if orderID == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	audits, err := u.paymentOrderAuditRepository.GetPaymentOrderAudits(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditDTOs := make([]dto.PaymentOrderAuditDTO, 0, len(audits))
+	for _, audit := range audits {
+		auditDTOs = append(auditDTOs, audit.ToDto())
+	}
+	return auditDTOs, nil
+}
+
+// ExtendPaymentOrder pushes out the expiry of a PENDING or PARTIAL order of the vendor by extendTime, counted from now
+// when the order is already past its expiry. The order cannot expire later than the maximum expiry time from now.
+// The orders of other vendors are not found.
+func (u *paymentOrderUCase) ExtendPaymentOrder(
+	ctx context.Context,
+	vendorID, requestID string,
+	extendTime time.Duration,
+) (dto.PaymentOrderDTOResponse, error) {
+	// Step 1: Retrieve the order of the vendor and check it is still open
+	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.PaymentOrderDTOResponse{}, gorm.ErrRecordNotFound
+		}
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("failed to retrieve payment order: %w", err)
+	}
+	if order.Status != constants.Pending && order.Status != constants.Partial {
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("%w: order %s has status %s", ucasetypes.ErrPaymentOrderNotExtendable, requestID, order.Status)
+	}
+
+	// Step 2: Compute the new expiry within the bounds
+	now := time.Now().UTC()
+	expiredTime := order.ExpiredTime.UTC()
+	if expiredTime.Before(now) {
+		expiredTime = now
+	}
+	expiredTime = expiredTime.Add(extendTime)
+	if _, maxExpiredTime := conf.GetExpiredOrderTimeBounds(); expiredTime.Sub(now) > maxExpiredTime {
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("%w: order %s would expire in more than %s", ucasetypes.ErrPaymentOrderNotExtendable, requestID, maxExpiredTime)
+	}
+
+	// Step 3: Update DB + cache, the status may have changed in the meantime
+	extended, err := u.paymentOrderRepository.ExtendOrderExpiry(ctx, order.ID, expiredTime)
+	if err != nil {
+		return dto.PaymentOrderDTOResponse{}, err
+	}
+	if !extended {
+		return dto.PaymentOrderDTOResponse{}, fmt.Errorf("%w: order %s is no longer PENDING or PARTIAL", ucasetypes.ErrPaymentOrderNotExtendable, requestID)
+	}
+	order.ExpiredTime = expiredTime
+
+	// Step 4: Update the memory set, adding the order back if the listener dropped it as expired meanwhile
+	for _, item := range order.ToSetItems() {
+		key := item.SetKey()
+		if orderInSet, exists := u.paymentOrderSet.GetItem(key); exists {
+			orderInSet.ExpiredTime = expiredTime
+			if err := u.paymentOrderSet.UpdateItem(key, orderInSet); err != nil {
+				logger.GetLogger().Errorf("Failed to update order set for key %s: %v", key, err)
+				return dto.PaymentOrderDTOResponse{}, fmt.Errorf("failed to update payment order in memory: %w", err)
+			}
+		} else if err := u.paymentOrderSet.Add(item); err != nil {
+			logger.GetLogger().Errorf("Failed to add order to set for key %s: %v", key, err)
+			return dto.PaymentOrderDTOResponse{}, fmt.Errorf("failed to add payment order in memory: %w", err)
+		}
+	}
+
+	return mapOrderToDTO(*order), nil
+}
+
 func (u *paymentOrderUCase) UpdateExpiredOrdersToFailed(ctx context.Context) ([]uint64, error) {
 	return u.paymentOrderRepository.UpdateExpiredOrdersToFailed(ctx)
 }
@@ -219,8 +618,24 @@ func (u *paymentOrderUCase) GetExpiredPaymentOrders(ctx context.Context, network
 		return nil, err
 	}
 	for _, order := range expiredOrders {
-		orderDto := order.ToDto()
-		orderDtos = append(orderDtos, orderDto)
+		orderDtos = append(orderDtos, order.ToSetItemsOn(network.String())...)
+	}
+	return orderDtos, nil
+}
+
+func (u *paymentOrderUCase) GetPaymentOrdersOpenBetween(
+	ctx context.Context,
+	network constants.NetworkType,
+	startTime, endTime time.Time,
+) ([]dto.PaymentOrderDTO, error) {
+	orders, err := u.paymentOrderRepository.GetPaymentOrdersOpenBetween(ctx, network.String(), startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	orderDtos := make([]dto.PaymentOrderDTO, 0, len(orders))
+	for _, order := range orders {
+		orderDtos = append(orderDtos, order.ToSetItemsOn(network.String())...)
 	}
 	return orderDtos, nil
 }
@@ -234,7 +649,7 @@ func (u *paymentOrderUCase) UpdatePaymentOrder(
 	return u.paymentOrderRepository.UpdatePaymentOrder(ctx, orderID, func(order *entities.PaymentOrder) error {
 		if status != nil {
 			order.Status = *status
-			if *status == constants.Success {
+			if constants.IsPaidStatus(*status) {
 				order.SucceededAt = time.Now().UTC()
 			}
 		}
@@ -265,19 +680,36 @@ func (u *paymentOrderUCase) UpdatePaymentOrder(
 
 func (u *paymentOrderUCase) UpdateOrderMetaByRequestID(
 	ctx context.Context,
-	requestID string,
+	vendorID, requestID string,
 	payload dto.UpdatePaymentOrderPayloadDTO,
 ) error {
-	// Step 1: Retrieve original order before update
-	originalOrder, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, requestID)
+	// Step 1: Retrieve original order of the vendor before update
+	originalOrder, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve original payment order: %w", err)
 	}
 
-	// Step 2: Prepare update fields
+	// Step 2: Prepare update fields, multi-chain orders are paid on one of their options
+	if len(originalOrder.Options) > 0 {
+		return fmt.Errorf("%w: multi-chain order %s is paid on one of its options", ucasetypes.ErrUnsupportedOrderChange, requestID)
+	}
+	network, symbol := originalOrder.Network, originalOrder.Symbol
+	if payload.Network != "" {
+		network = payload.Network
+	}
+	if payload.Symbol != "" {
+		symbol = payload.Symbol
+	}
+	if err := validateOrderChange(originalOrder.Network, network, symbol); err != nil {
+		return err
+	}
+
 	updates := make(map[string]any)
 
 	if payload.Network != "" {
+		if originalOrder.PaymentMode == constants.PaymentModeRouter && conf.GetPaymentRouterAddress(payload.Network) == "" {
+			return fmt.Errorf("payment router is not configured for network %s", payload.Network)
+		}
 		latestBlock, err := u.blockStateRepo.GetLatestBlock(ctx, payload.Network)
 		if err != nil {
 			return fmt.Errorf("failed to get latest block for network %s: %w", payload.Network, err)
@@ -297,6 +729,7 @@ func (u *paymentOrderUCase) UpdateOrderMetaByRequestID(
 	// Step 3: Update DB + cache
 	if err := u.paymentOrderRepository.UpdateOrderFieldsByRequestIDAndStatus(
 		ctx,
+		vendorID,
 		requestID,
 		constants.Pending,
 		updates,
@@ -325,7 +758,7 @@ func (u *paymentOrderUCase) UpdateOrderMetaByRequestID(
 	}
 
 	// Step 5: Re-fetch updated order and update memory set
-	updatedOrder, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, requestID)
+	updatedOrder, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve updated payment order: %w", err)
 	}
@@ -333,12 +766,12 @@ func (u *paymentOrderUCase) UpdateOrderMetaByRequestID(
 	// Step 6: Delete old order from memory set
 	originalOrderDTO := originalOrder.ToDto()
 	u.paymentOrderSet.Remove(func(item dto.PaymentOrderDTO) bool {
-		return item.PaymentAddress == originalOrderDTO.PaymentAddress && item.Symbol == originalOrderDTO.Symbol
+		return item.SetKey() == originalOrderDTO.SetKey()
 	})
 
 	// Step 7: Add updated order to memory set
 	orderDTO := updatedOrder.ToDto()
-	key := orderDTO.PaymentAddress + "_" + orderDTO.Symbol
+	key := orderDTO.SetKey()
 	if err := u.paymentOrderSet.Add(orderDTO); err != nil {
 		logger.GetLogger().Errorf("Failed to update order set for key %s: %v", key, err)
 		return fmt.Errorf("failed to update payment order in memory: %w", err)
@@ -347,20 +780,44 @@ func (u *paymentOrderUCase) UpdateOrderMetaByRequestID(
 	return nil
 }
 
+// UpdateOrderToSuccessAndReleaseWallet marks the order as paid with the given status, SUCCESS or OVERPAID,
+// and releases its wallet. It returns false when the order was already paid.
 func (u *paymentOrderUCase) UpdateOrderToSuccessAndReleaseWallet(
 	ctx context.Context,
 	orderID uint64,
-) error {
+	status string,
+) (bool, error) {
 	return u.paymentOrderRepository.UpdateOrderToSuccessAndReleaseWallet(
 		ctx,
 		orderID,
+		status,
 		time.Now().UTC(),
 	)
 }
 
-func (u *paymentOrderUCase) UpdateOrderNetwork(ctx context.Context, requestID string, network constants.NetworkType) error {
-	// Step 1: Retrieve the payment order by request ID
-	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, requestID)
+// EvaluatePayment returns the status an order of the vendor and token gets once the transferred amount is paid to it,
+// under the tolerance policy of the vendor and token. Amounts are in the token smallest unit.
+func (u *paymentOrderUCase) EvaluatePayment(
+	ctx context.Context,
+	vendorID, symbol string,
+	amount, transferred *big.Int,
+	tokenDecimals uint8,
+) (string, error) {
+	policies, err := u.tolerancePolicyRepository.GetTolerancePolicies(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tolerance policies: %w", err)
+	}
+	policy := resolveTolerancePolicy(policies, vendorID, symbol)
+	return payment.EvaluatePayment(amount, transferred, policy, tokenDecimals), nil
+}
+
+func (u *paymentOrderUCase) UpdateOrderNetwork(
+	ctx context.Context,
+	vendorID, requestID string,
+	network constants.NetworkType,
+) error {
+	// Step 1: Retrieve the payment order of the vendor by request ID
+	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve payment order with request id %s: %w", requestID, err)
 	}
@@ -370,6 +827,19 @@ func (u *paymentOrderUCase) UpdateOrderNetwork(ctx context.Context, requestID st
 		return fmt.Errorf("failed to update payment order with id %d: order status is not PENDING", order.ID)
 	}
 
+	if len(order.Options) > 0 {
+		return fmt.Errorf("failed to update payment order with id %d: %w: multi-chain orders are paid on one of their options",
+			order.ID, ucasetypes.ErrUnsupportedOrderChange)
+	}
+	if err := validateOrderChange(order.Network, network.String(), order.Symbol); err != nil {
+		return fmt.Errorf("failed to update payment order with id %d: %w", order.ID, err)
+	}
+
+	// Router orders can only move to a network with a payment router
+	if order.PaymentMode == constants.PaymentModeRouter && conf.GetPaymentRouterAddress(network.String()) == "" {
+		return fmt.Errorf("failed to update payment order with id %d: payment router is not configured for network %s", order.ID, network)
+	}
+
 	// Step 3: Fetch the latest block height for the given network
 	latestBlock, err := u.blockStateRepo.GetLatestBlock(ctx, network.String())
 	if err != nil {
@@ -377,7 +847,7 @@ func (u *paymentOrderUCase) UpdateOrderNetwork(ctx context.Context, requestID st
 	}
 
 	// Step 4: Update the order in the database
-	err = u.paymentOrderRepository.UpdateOrderNetwork(ctx, requestID, network.String(), latestBlock)
+	err = u.paymentOrderRepository.UpdateOrderNetwork(ctx, vendorID, requestID, network.String(), latestBlock)
 	if err != nil {
 		return fmt.Errorf("failed to update order network: %w", err)
 	}
@@ -388,7 +858,7 @@ func (u *paymentOrderUCase) UpdateOrderNetwork(ctx context.Context, requestID st
 
 	// Step 6: Update the payment order set
 	orderDTO := order.ToDto()
-	key := orderDTO.PaymentAddress + "_" + orderDTO.Symbol
+	key := orderDTO.SetKey()
 	err = u.paymentOrderSet.UpdateItem(key, orderDTO)
 	if err != nil {
 		logger.GetLogger().Errorf("Failed to update payment order in set: %v", err)
@@ -398,6 +868,53 @@ func (u *paymentOrderUCase) UpdateOrderNetwork(ctx context.Context, requestID st
 	return nil
 }
 
+// SelectPaymentOption moves a multi-chain order to the network and token it is being paid with,
+// the statistics counted for its previous token move with it.
+func (u *paymentOrderUCase) SelectPaymentOption(ctx context.Context, orderID uint64, network, symbol string) error {
+	var previous entities.PaymentOrder
+	err := u.paymentOrderRepository.UpdatePaymentOrder(ctx, orderID, func(order *entities.PaymentOrder) error {
+		if !order.AcceptsOption(network, symbol) {
+			return fmt.Errorf("%w: order ID %d does not accept %s on %s", ucasetypes.ErrUnsupportedOrderChange, orderID, symbol, network)
+		}
+		previous = *order
+		order.Network = network
+		order.Symbol = symbol
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if previous.Symbol == symbol {
+		return nil
+	}
+
+	granularity := constants.Daily
+	if err := u.paymentStatisticsRepository.RevertAndIncrementStatistics(
+		ctx,
+		granularity,
+		utils.GetPeriodStart(granularity, previous.CreatedAt),
+		&previous.Amount,
+		previous.Symbol,
+		symbol,
+		previous.VendorID,
+	); err != nil {
+		return fmt.Errorf("failed to update statistics after option change: %w", err)
+	}
+	return nil
+}
+
+// validateOrderChange checks an order can move to the network and token: the payer was given an address of the
+// chain family of the order network, so it stays in that family, and the token has to be supported on the network.
+func validateOrderChange(currentNetwork, network, symbol string) error {
+	if chain.ForNetwork(constants.NetworkType(currentNetwork)) != chain.ForNetwork(constants.NetworkType(network)) {
+		return fmt.Errorf("%w: order on %s cannot move to %s", ucasetypes.ErrUnsupportedOrderChange, currentNetwork, network)
+	}
+	if _, err := conf.GetTokenAddress(symbol, network); err != nil {
+		return fmt.Errorf("%w: %s is not supported on %s", ucasetypes.ErrUnsupportedOrderChange, symbol, network)
+	}
+	return nil
+}
+
 func (u *paymentOrderUCase) BatchUpdateOrdersToExpired(ctx context.Context, orderIDs []uint64) error {
 	return u.paymentOrderRepository.BatchUpdateOrdersToExpired(ctx, orderIDs)
 }
@@ -405,11 +922,20 @@ func (u *paymentOrderUCase) BatchUpdateOrdersToExpired(ctx context.Context, orde
 func (u *paymentOrderUCase) BatchUpdateOrderBlockHeights(ctx context.Context, orders []dto.PaymentOrderDTO) error {
 	var orderIDs []uint64
 	var blockHeights []uint64
-	// Parse orders to extract orderIDs and newStatuses
+	// Parse orders to extract orderIDs and newStatuses, the block heights of multi-chain orders are per option
 	for _, order := range orders {
+		if order.IsMultiChain() {
+			if err := u.paymentOrderRepository.UpdateOptionBlockHeight(ctx, order.ID, order.Network, order.Symbol, order.BlockHeight); err != nil {
+				return err
+			}
+			continue
+		}
 		orderIDs = append(orderIDs, order.ID)
 		blockHeights = append(blockHeights, order.BlockHeight)
 	}
+	if len(orderIDs) == 0 {
+		return nil
+	}
 
 	return u.paymentOrderRepository.BatchUpdateOrderBlockHeights(ctx, orderIDs, blockHeights)
 }
@@ -421,8 +947,7 @@ func (u *paymentOrderUCase) GetActivePaymentOrders(ctx context.Context) ([]dto.P
 	}
 	var orderDTOs []dto.PaymentOrderDTO
 	for _, order := range orders {
-		orderDTO := order.ToDto()
-		orderDTOs = append(orderDTOs, orderDTO)
+		orderDTOs = append(orderDTOs, order.ToSetItems()...)
 	}
 	return orderDTOs, nil
 }
@@ -482,9 +1007,7 @@ func (u *paymentOrderUCase) GetPaymentOrderByID(ctx context.Context, id uint64)
 	}
 
 	// Map the order to a DTO
-	orderDTO := mapOrderToDTO(*order)
-
-	return orderDTO, nil
+	return mapOrderToDTO(*order), nil
 }
 
 func (u *paymentOrderUCase) GetPaymentOrdersByIDs(ctx context.Context, ids []uint64) ([]dto.PaymentOrderDTOResponse, error) {
@@ -503,9 +1026,13 @@ func (u *paymentOrderUCase) GetPaymentOrdersByIDs(ctx context.Context, ids []uin
 	return orderDTOs, nil
 }
 
-func (u *paymentOrderUCase) GetPaymentOrderByRequestID(ctx context.Context, requestID string) (dto.PaymentOrderDTOResponse, error) {
+// GetPaymentOrderByRequestID retrieves the payment order of the vendor by its request ID.
+func (u *paymentOrderUCase) GetPaymentOrderByRequestID(
+	ctx context.Context,
+	vendorID, requestID string,
+) (dto.PaymentOrderDTOResponse, error) {
 	// Fetch the payment order by request ID
-	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, requestID)
+	order, err := u.paymentOrderRepository.GetPaymentOrderByRequestID(ctx, vendorID, requestID)
 	if err != nil {
 		// Return a clean not found error
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -515,9 +1042,7 @@ func (u *paymentOrderUCase) GetPaymentOrderByRequestID(ctx context.Context, requ
 	}
 
 	// Map the order to a DTO
-	orderDTO := mapOrderToDTO(*order)
-
-	return orderDTO, nil
+	return mapOrderToDTO(*order), nil
 }
 
 // Helper function to map PaymentOrder to PaymentOrderDTOResponse
@@ -525,6 +1050,7 @@ func mapOrderToDTO(order entities.PaymentOrder) dto.PaymentOrderDTOResponse {
 	dto := dto.PaymentOrderDTOResponse{
 		ID:                  order.ID,
 		RequestID:           order.RequestID,
+		VendorID:            order.VendorID,
 		Network:             order.Network,
 		Amount:              order.Amount,
 		Transferred:         order.Transferred,
@@ -533,12 +1059,14 @@ func mapOrderToDTO(order entities.PaymentOrder) dto.PaymentOrderDTOResponse {
 		Symbol:              order.Symbol,
 		BlockHeight:         order.BlockHeight,
 		UpcomingBlockHeight: order.UpcomingBlockHeight,
-		PaymentAddress:      order.Wallet.Address,
+		PaymentAddress:      order.Wallet.AddressOn(order.Network),
+		PaymentMode:         order.PaymentMode,
 		CreatedAt:           order.CreatedAt,
 		Expired:             uint64(order.ExpiredTime.Unix()),
 		EventHistories:      mapEventHistoriesToDTO(order.PaymentEventHistories),
+		Options:             order.OptionDTOs(),
 	}
-	if order.Status == constants.Success {
+	if constants.IsPaidStatus(order.Status) {
 		dto.SucceededAt = &order.SucceededAt
 	}
 	return dto
@@ -550,6 +1078,7 @@ func mapEventHistoriesToDTO(eventHistories []entities.PaymentEventHistory) []dto
 	for i, eventHistory := range eventHistories {
 		eventHistoriesDTO[i] = dto.PaymentHistoryDTO{
 			TransactionHash: eventHistory.TransactionHash,
+			LogIndex:        eventHistory.LogIndex,
 			FromAddress:     eventHistory.FromAddress,
 			ToAddress:       eventHistory.ToAddress,
 			Amount:          eventHistory.Amount,
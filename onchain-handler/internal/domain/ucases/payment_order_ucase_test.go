@@ -0,0 +1,313 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/adapters/cache"
+	"github.com/genefriendway/onchain-handler/internal/adapters/orderset"
+	settypes "github.com/genefriendway/onchain-handler/internal/adapters/orderset/types"
+	"github.com/genefriendway/onchain-handler/internal/adapters/repositories/mocks"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+)
+
+type paymentOrderMocks struct {
+	paymentOrderRepository      *mocks.MockPaymentOrderRepository
+	paymentStatisticsRepository *mocks.MockPaymentStatisticsRepository
+	paymentOrderSet             settypes.Set[dto.PaymentOrderDTO]
+}
+
+func newTestPaymentOrderUCase(t *testing.T, ctrl *gomock.Controller) (ucasetypes.PaymentOrderUCase, paymentOrderMocks) {
+	ctx := context.Background()
+	paymentOrderSet, err := orderset.NewSet(
+		ctx,
+		func(order dto.PaymentOrderDTO) string { return order.SetKey() },
+		cache.NewCachingRepository(ctx, cache.NewGoCacheClient()),
+	)
+	require.NoError(t, err)
+
+	m := paymentOrderMocks{
+		paymentOrderRepository:      mocks.NewMockPaymentOrderRepository(ctrl),
+		paymentStatisticsRepository: mocks.NewMockPaymentStatisticsRepository(ctrl),
+		paymentOrderSet:             paymentOrderSet,
+	}
+	return NewPaymentOrderUCase(
+		nil, m.paymentOrderRepository, nil, nil, m.paymentStatisticsRepository, m.paymentOrderSet, nil, nil, nil,
+	), m
+}
+
+func testPaymentOrder(status string) *entities.PaymentOrder {
+	walletID := uint64(7)
+	return &entities.PaymentOrder{
+		ID:          1,
+		RequestID:   "request-1",
+		VendorID:    "vendor-1",
+		WalletID:    &walletID,
+		Wallet:      entities.PaymentWallet{ID: walletID, Address: "0x1111111111111111111111111111111111111111"},
+		Amount:      "10",
+		Transferred: "0",
+		Symbol:      constants.USDT,
+		Network:     constants.Bsc.String(),
+		Status:      status,
+		ExpiredTime: time.Now().UTC().Add(time.Hour),
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+func TestCreatePaymentOrdersDuplicateRequestID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ucase, m := newTestPaymentOrderUCase(t, ctrl)
+	payloads := []dto.PaymentOrderPayloadDTO{{RequestID: "request-1"}, {RequestID: "request-2"}}
+
+	// Only the request IDs of the vendor count, request-2 may be used by another vendor
+	m.paymentOrderRepository.EXPECT().
+		GetExistingRequestIDs(gomock.Any(), "vendor-1", []string{"request-1", "request-2"}).
+		Return([]string{"request-1"}, nil)
+
+	_, err := ucase.CreatePaymentOrders(context.Background(), payloads, "vendor-1", time.Hour)
+	require.ErrorIs(t, err, ucasetypes.ErrDuplicateRequestID)
+	require.Contains(t, err.Error(), "request-1")
+	require.NotContains(t, err.Error(), "request-2")
+}
+
+func TestCancelPaymentOrder(t *testing.T) {
+	t.Run("CancelsPendingOrder", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPaymentOrderUCase(t, ctrl)
+		order := testPaymentOrder(constants.Pending)
+		require.NoError(t, m.paymentOrderSet.Add(order.ToDto()))
+
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").Return(order, nil)
+		m.paymentOrderRepository.EXPECT().CancelOrderAndReleaseWallet(gomock.Any(), uint64(1), gomock.Any()).Return(true, nil)
+		m.paymentStatisticsRepository.EXPECT().
+			DecrementStatistics(gomock.Any(), constants.Daily, gomock.Any(), gomock.Any(), constants.USDT, "vendor-1").
+			Return(nil)
+
+		cancelledOrder, err := ucase.CancelPaymentOrder(context.Background(), "vendor-1", "request-1")
+		require.NoError(t, err)
+		require.Equal(t, constants.Cancelled, cancelledOrder.Status)
+		require.False(t, m.paymentOrderSet.Contains(order.ToDto().SetKey()), "the cancelled order is no longer listened for")
+	})
+
+	t.Run("OrderOfAnotherVendor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPaymentOrderUCase(t, ctrl)
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-2", "request-1").
+			Return(nil, fmt.Errorf("payment order not found: %w", gorm.ErrRecordNotFound))
+
+		_, err := ucase.CancelPaymentOrder(context.Background(), "vendor-2", "request-1")
+		require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("OrderNotPending", func(t *testing.T) {
+		for _, status := range []string{
+			constants.Processing, constants.Partial, constants.Success, constants.Expired, constants.Cancelled, constants.OnHold,
+		} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestPaymentOrderUCase(t, ctrl)
+				m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").
+					Return(testPaymentOrder(status), nil)
+
+				_, err := ucase.CancelPaymentOrder(context.Background(), "vendor-1", "request-1")
+				require.ErrorIs(t, err, ucasetypes.ErrPaymentOrderNotCancellable)
+			})
+		}
+	})
+
+	t.Run("OrderPaidMeanwhile", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPaymentOrderUCase(t, ctrl)
+		order := testPaymentOrder(constants.Pending)
+		require.NoError(t, m.paymentOrderSet.Add(order.ToDto()))
+
+		// The listener moved the order out of PENDING between the read and the update
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").Return(order, nil)
+		m.paymentOrderRepository.EXPECT().CancelOrderAndReleaseWallet(gomock.Any(), uint64(1), gomock.Any()).Return(false, nil)
+
+		_, err := ucase.CancelPaymentOrder(context.Background(), "vendor-1", "request-1")
+		require.ErrorIs(t, err, ucasetypes.ErrPaymentOrderNotCancellable)
+		require.True(t, m.paymentOrderSet.Contains(order.ToDto().SetKey()), "the order is still listened for")
+	})
+}
+
+func TestExtendPaymentOrder(t *testing.T) {
+	t.Run("ExtendsOpenOrder", func(t *testing.T) {
+		for _, status := range []string{constants.Pending, constants.Partial} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestPaymentOrderUCase(t, ctrl)
+				order := testPaymentOrder(status)
+				expiredTime := order.ExpiredTime.Add(30 * time.Minute)
+				require.NoError(t, m.paymentOrderSet.Add(order.ToDto()))
+
+				m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").Return(order, nil)
+				m.paymentOrderRepository.EXPECT().ExtendOrderExpiry(gomock.Any(), uint64(1), expiredTime).Return(true, nil)
+
+				extendedOrder, err := ucase.ExtendPaymentOrder(context.Background(), "vendor-1", "request-1", 30*time.Minute)
+				require.NoError(t, err)
+				require.Equal(t, uint64(expiredTime.Unix()), extendedOrder.Expired)
+
+				orderInSet, exists := m.paymentOrderSet.GetItem(order.ToDto().SetKey())
+				require.True(t, exists)
+				require.True(t, expiredTime.Equal(orderInSet.ExpiredTime))
+			})
+		}
+	})
+
+	t.Run("OrderOfAnotherVendor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPaymentOrderUCase(t, ctrl)
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-2", "request-1").
+			Return(nil, fmt.Errorf("payment order not found: %w", gorm.ErrRecordNotFound))
+
+		_, err := ucase.ExtendPaymentOrder(context.Background(), "vendor-2", "request-1", 30*time.Minute)
+		require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("OrderNotOpen", func(t *testing.T) {
+		for _, status := range []string{
+			constants.Processing, constants.Success, constants.Failed, constants.Expired, constants.Cancelled, constants.OnHold,
+		} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestPaymentOrderUCase(t, ctrl)
+				m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").
+					Return(testPaymentOrder(status), nil)
+
+				_, err := ucase.ExtendPaymentOrder(context.Background(), "vendor-1", "request-1", 30*time.Minute)
+				require.ErrorIs(t, err, ucasetypes.ErrPaymentOrderNotExtendable)
+			})
+		}
+	})
+
+	t.Run("BeyondMaximumExpiry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPaymentOrderUCase(t, ctrl)
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").
+			Return(testPaymentOrder(constants.Pending), nil)
+
+		_, maxExpiredTime := conf.GetExpiredOrderTimeBounds()
+		_, err := ucase.ExtendPaymentOrder(context.Background(), "vendor-1", "request-1", maxExpiredTime)
+		require.ErrorIs(t, err, ucasetypes.ErrPaymentOrderNotExtendable)
+	})
+
+	t.Run("OrderPaidMeanwhile", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPaymentOrderUCase(t, ctrl)
+		order := testPaymentOrder(constants.Pending)
+		require.NoError(t, m.paymentOrderSet.Add(order.ToDto()))
+
+		// The listener moved the order out of PENDING between the read and the update
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").Return(order, nil)
+		m.paymentOrderRepository.EXPECT().ExtendOrderExpiry(gomock.Any(), uint64(1), gomock.Any()).Return(false, nil)
+
+		_, err := ucase.ExtendPaymentOrder(context.Background(), "vendor-1", "request-1", 30*time.Minute)
+		require.ErrorIs(t, err, ucasetypes.ErrPaymentOrderNotExtendable)
+
+		orderInSet, exists := m.paymentOrderSet.GetItem(order.ToDto().SetKey())
+		require.True(t, exists)
+		require.True(t, order.ExpiredTime.Equal(orderInSet.ExpiredTime), "the expiry of the order is unchanged")
+	})
+}
+
+func TestResolvePaymentOrder(t *testing.T) {
+	// expectResolve runs the update of the resolution against the stored order and returns the result
+	expectResolve := func(m paymentOrderMocks, order *entities.PaymentOrder) {
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "request-1").Return(order, nil)
+		m.paymentOrderRepository.EXPECT().UpdatePaymentOrder(gomock.Any(), uint64(1), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint64, updateFunc func(order *entities.PaymentOrder) error) error {
+				return updateFunc(order)
+			})
+	}
+
+	t.Run("MarksOrderSuccess", func(t *testing.T) {
+		for _, status := range []string{constants.Partial, constants.Expired, constants.Failed} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestPaymentOrderUCase(t, ctrl)
+				order := testPaymentOrder(status)
+				order.Transferred = "2.5"
+				require.NoError(t, m.paymentOrderSet.Add(order.ToDto()))
+
+				outstanding := "7.500000000000000000"
+				expectResolve(m, order)
+				m.paymentStatisticsRepository.EXPECT().
+					IncrementStatistics(gomock.Any(), constants.Daily, gomock.Any(), nil, &outstanding, constants.USDT, "vendor-1").
+					Return(nil)
+				m.paymentOrderRepository.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).Return(order, nil)
+
+				resolvedOrder, err := ucase.ResolvePaymentOrder(context.Background(), "vendor-1", "request-1", constants.Success)
+				require.NoError(t, err)
+				require.Equal(t, constants.Success, resolvedOrder.Status)
+				require.False(t, m.paymentOrderSet.Contains(order.ToDto().SetKey()), "the resolved order is no longer listened for")
+			})
+		}
+	})
+
+	t.Run("MarksOrderFailed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPaymentOrderUCase(t, ctrl)
+		order := testPaymentOrder(constants.Pending)
+
+		// The statistics are left as they are
+		expectResolve(m, order)
+		m.paymentOrderRepository.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).Return(order, nil)
+
+		resolvedOrder, err := ucase.ResolvePaymentOrder(context.Background(), "vendor-1", "request-1", constants.Failed)
+		require.NoError(t, err)
+		require.Equal(t, constants.Failed, resolvedOrder.Status)
+	})
+
+	t.Run("OrderNotResolvable", func(t *testing.T) {
+		for _, status := range []string{constants.Success, constants.Overpaid, constants.Cancelled} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestPaymentOrderUCase(t, ctrl)
+				order := testPaymentOrder(status)
+				expectResolve(m, order)
+
+				_, err := ucase.ResolvePaymentOrder(context.Background(), "vendor-1", "request-1", constants.Failed)
+				require.ErrorIs(t, err, ucasetypes.ErrPaymentOrderNotResolvable)
+				require.Equal(t, status, order.Status, "the order keeps its status")
+			})
+		}
+	})
+}
@@ -13,6 +13,7 @@ import (
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
 	"github.com/genefriendway/onchain-handler/internal/wire/instances"
 	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
 	"github.com/genefriendway/onchain-handler/pkg/payment"
 	"github.com/genefriendway/onchain-handler/pkg/utils"
@@ -22,17 +23,20 @@ type paymentWalletUCase struct {
 	db                             *gorm.DB
 	paymentWalletRepository        repotypes.PaymentWalletRepository
 	paymentWalletBalanceRepository repotypes.PaymentWalletBalanceRepository
+	complianceRepository           repotypes.ComplianceRepository
 }
 
 func NewPaymentWalletUCase(
 	db *gorm.DB,
 	paymentWalletRepository repotypes.PaymentWalletRepository,
 	paymentWalletBalanceRepository repotypes.PaymentWalletBalanceRepository,
+	complianceRepository repotypes.ComplianceRepository,
 ) ucasetypes.PaymentWalletUCase {
 	return &paymentWalletUCase{
 		db:                             db,
 		paymentWalletRepository:        paymentWalletRepository,
 		paymentWalletBalanceRepository: paymentWalletBalanceRepository,
+		complianceRepository:           complianceRepository,
 	}
 }
 
@@ -47,6 +51,18 @@ func (u *paymentWalletUCase) CreateAndGenerateWallet(ctx context.Context, mnemon
 	})
 }
 
+// AssignMissingTronAddresses derives the Tron address of the wallets created before Tron was supported
+func (u *paymentWalletUCase) AssignMissingTronAddresses(ctx context.Context) error {
+	assigned, err := u.paymentWalletRepository.AssignMissingTronAddresses(ctx)
+	if err != nil {
+		return err
+	}
+	if assigned > 0 {
+		logger.GetLogger().Infof("Assigned Tron addresses to %d payment wallets", assigned)
+	}
+	return nil
+}
+
 func (u *paymentWalletUCase) IsRowExist(ctx context.Context) (bool, error) {
 	return u.paymentWalletRepository.IsRowExist(ctx)
 }
@@ -89,6 +105,7 @@ func (u *paymentWalletUCase) GetPaymentWalletByAddress(
 	return dto.PaymentWalletBalanceDTO{
 		ID:              wallet.ID,
 		Address:         wallet.Address,
+		TronAddress:     wallet.TronAddress,
 		NetworkBalances: networkBalanceDTOs,
 	}, nil
 }
@@ -166,6 +183,7 @@ func (u *paymentWalletUCase) GetPaymentWalletsWithBalances(
 		dtos = append(dtos, dto.PaymentWalletBalanceDTO{
 			ID:              wallet.ID,
 			Address:         wallet.Address,
+			TronAddress:     wallet.TronAddress,
 			NetworkBalances: networkBalanceDTOs,
 		})
 	}
@@ -233,6 +251,7 @@ func (u *paymentWalletUCase) GetPaymentWalletsWithBalancesPagination(
 		dto := dto.PaymentWalletBalanceDTO{
 			ID:              wallet.ID,
 			Address:         wallet.Address,
+			TronAddress:     wallet.TronAddress,
 			NetworkBalances: networkBalanceDTOs,
 		}
 		dtos = append(dtos, dto)
@@ -262,8 +281,36 @@ func (u *paymentWalletUCase) GetReceivingWalletAddressWithBalances(
 	if err != nil {
 		return "", nil, err
 	}
-	walletAddress := account.Address.Hex()
+	return u.getAddressWithNativeBalances(ctx, account.Address.Hex(), constants.ReceivingWallet, mnemonic, passphrase, salt)
+}
+
+// GetGasSourceWalletAddressWithBalances returns the wallet refilling the receiving wallet with gas and its native balances.
+func (u *paymentWalletUCase) GetGasSourceWalletAddressWithBalances(
+	ctx context.Context, mnemonic, passphrase, salt string,
+) (string, map[constants.NetworkType]string, error) {
+	account, _, err := payment.GetGasSourceWallet(mnemonic, passphrase, salt)
+	if err != nil {
+		return "", nil, err
+	}
+	return u.getAddressWithNativeBalances(ctx, account.Address.Hex(), constants.GasSourceWallet, mnemonic, passphrase, salt)
+}
+
+// GetNetworkWalletAddress returns the address of the wallet of the type on the network, as written on the network
+func (u *paymentWalletUCase) GetNetworkWalletAddress(
+	network constants.NetworkType, walletType constants.WalletType, mnemonic, passphrase, salt string,
+) (string, error) {
+	account, _, err := payment.GetNetworkWallet(network, walletType, mnemonic, passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	return chain.ForNetwork(network).FormatAddress(account.Address), nil
+}
 
+// getAddressWithNativeBalances returns the wallet address with its native balance on each network,
+// the balance on a network with its own coin type is the one of the wallet derived for it.
+func (u *paymentWalletUCase) getAddressWithNativeBalances(
+	ctx context.Context, walletAddress string, walletType constants.WalletType, mnemonic, passphrase, salt string,
+) (string, map[constants.NetworkType]string, error) {
 	// Define supported networks
 	networks := conf.GetNetworks()
 
@@ -272,7 +319,15 @@ func (u *paymentWalletUCase) GetReceivingWalletAddressWithBalances(
 
 	// Fetch balance for each network
 	for _, network := range networks {
-		balance, err := u.getNativeBalanceOnchain(ctx, walletAddress, network)
+		networkAddress := walletAddress
+		if chain.ForNetwork(network) != chain.EVM {
+			var err error
+			if networkAddress, err = u.GetNetworkWalletAddress(network, walletType, mnemonic, passphrase, salt); err != nil {
+				return "", nil, err
+			}
+		}
+
+		balance, err := u.getNativeBalanceOnchain(ctx, networkAddress, network)
 		if err != nil {
 			// Log the error but don't return it (continue with other networks)
 			logger.GetLogger().Errorf("Failed to fetch balance for %s: %v", network, err)
@@ -291,10 +346,18 @@ func (u *paymentWalletUCase) SyncWalletBalances(
 	network constants.NetworkType,
 	tokenSymbols []string,
 ) (map[string]string, error) {
-	// Check if the wallet exists and get its ID
-	walletID, err := u.paymentWalletRepository.GetWalletIDByAddress(ctx, walletAddress)
+	// Check if the wallet exists, either of its addresses identifies it
+	wallet, err := u.paymentWalletRepository.GetPaymentWalletByAddress(ctx, walletAddress)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get wallet ID by address: %w", err)
+		return nil, fmt.Errorf("failed to get wallet by address: %w", err)
+	}
+	if wallet == nil {
+		return nil, fmt.Errorf("wallet not found for address: %s", walletAddress)
+	}
+	walletID := wallet.ID
+	walletAddress = wallet.AddressOn(network.String())
+	if walletAddress == "" {
+		return nil, fmt.Errorf("wallet %d has no address on %s", walletID, network)
 	}
 
 	balances := make(map[string]string)
@@ -307,6 +370,13 @@ func (u *paymentWalletUCase) SyncWalletBalances(
 			continue // Skip this token, do not stop the whole process
 		}
 
+		// Funds held by the payer screening stay out of the balance, the withdraw worker never sweeps them
+		tokenAmount, err = u.withoutHeldAmount(ctx, walletID, network, symbol, tokenAmount)
+		if err != nil {
+			logger.GetLogger().Errorf("Failed to leave held funds out of the balance for token %s: %v", symbol, err)
+			continue
+		}
+
 		err = u.paymentWalletBalanceRepository.UpsertPaymentWalletBalance(ctx, walletID, tokenAmount, network.String(), symbol)
 		if err != nil {
 			logger.GetLogger().Errorf("Failed to upsert balance for token %s: %v", symbol, err)
@@ -319,6 +389,34 @@ func (u *paymentWalletUCase) SyncWalletBalances(
 	return balances, nil
 }
 
+// withoutHeldAmount returns the balance of the wallet less the amount of the token held in it by the payer screening,
+// never below 0.
+func (u *paymentWalletUCase) withoutHeldAmount(
+	ctx context.Context, walletID uint64, network constants.NetworkType, symbol, balance string,
+) (string, error) {
+	heldAmount, err := u.complianceRepository.GetHeldWalletAmount(ctx, walletID, network.String(), symbol)
+	if err != nil {
+		return "", err
+	}
+
+	held, err := utils.ConvertFloatTokenToSmallestUnit(heldAmount, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert held amount: %w", err)
+	}
+	if held.Sign() == 0 {
+		return balance, nil
+	}
+	available, err := utils.ConvertFloatTokenToSmallestUnit(balance, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert balance: %w", err)
+	}
+	available.Sub(available, held)
+	if available.Sign() < 0 {
+		available.SetInt64(0)
+	}
+	return utils.ConvertSmallestUnitToFloatToken(available.String(), constants.PaymentAmountDecimalPlaces)
+}
+
 // getBalanceOnchain fetches and converts the on-chain token balance for a given wallet
 func (u *paymentWalletUCase) getTokenBalanceOnchain(
 	ctx context.Context, walletAddress string, network constants.NetworkType, symbol string,
@@ -0,0 +1,401 @@
+package ucases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type payoutUCase struct {
+	payoutRepository     repotypes.PayoutRepository
+	userWalletRepository repotypes.UserWalletRepository
+	transferPolicyUCase  ucasetypes.TransferPolicyUCase
+	mnemonic             string
+	passphrase           string
+	salt                 string
+}
+
+func NewPayoutUCase(
+	payoutRepository repotypes.PayoutRepository,
+	userWalletRepository repotypes.UserWalletRepository,
+	transferPolicyUCase ucasetypes.TransferPolicyUCase,
+	mnemonic, passphrase, salt string,
+) ucasetypes.PayoutUCase {
+	return &payoutUCase{
+		payoutRepository:     payoutRepository,
+		userWalletRepository: userWalletRepository,
+		transferPolicyUCase:  transferPolicyUCase,
+		mnemonic:             mnemonic,
+		passphrase:           passphrase,
+		salt:                 salt,
+	}
+}
+
+// RegisterUserWallet registers the wallet the payouts of the user are sent to, replacing the one registered before.
+func (u *payoutUCase) RegisterUserWallet(ctx context.Context, payload dto.UserWalletPayloadDTO) (dto.UserWalletDTO, error) {
+	wallet := &entities.UserWallet{
+		UserID:  payload.UserID,
+		Address: common.HexToAddress(payload.Address).Hex(),
+	}
+	if err := u.userWalletRepository.UpsertUserWallet(ctx, wallet); err != nil {
+		return dto.UserWalletDTO{}, err
+	}
+	return wallet.ToDto(), nil
+}
+
+func (u *payoutUCase) GetUserWallet(ctx context.Context, userID uint64) (dto.UserWalletDTO, error) {
+	wallet, err := u.userWalletRepository.GetUserWalletByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.UserWalletDTO{}, fmt.Errorf("%w: user %d", ucasetypes.ErrUserWalletNotFound, userID)
+		}
+		return dto.UserWalletDTO{}, err
+	}
+	return wallet.ToDto(), nil
+}
+
+// GetPayoutWalletAddress returns the address of the payout wallet, which has to hold the paid out tokens and the gas.
+func (u *payoutUCase) GetPayoutWalletAddress() (string, error) {
+	account, _, err := payment.GetPayoutWallet(u.mnemonic, u.passphrase, u.salt)
+	if err != nil {
+		return "", err
+	}
+	return account.Address.Hex(), nil
+}
+
+// CreatePayouts creates the payouts with the decision of their transfer policy: APPROVED when the policy lets them
+// through, PENDING_APPROVAL when they need approvals and REJECTED when the policy blocks them. The payouts requested
+// for a user are sent to the wallet registered for it at this time, none of the payouts is created when one of
+// the users has no wallet.
+func (u *payoutUCase) CreatePayouts(
+	ctx context.Context,
+	vendorID string,
+	payloads []dto.PayoutPayloadDTO,
+) ([]dto.PayoutDTO, error) {
+	// Step 1: Resolve the wallets of the users
+	var userIDs []uint64
+	for _, payload := range payloads {
+		if payload.UserID != nil {
+			userIDs = append(userIDs, *payload.UserID)
+		}
+	}
+	userAddresses := make(map[uint64]string, len(userIDs))
+	if len(userIDs) > 0 {
+		wallets, err := u.userWalletRepository.GetUserWalletsByUserIDs(ctx, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, wallet := range wallets {
+			userAddresses[wallet.UserID] = wallet.Address
+		}
+	}
+
+	// Step 2: Evaluate the payouts against their transfer policy
+	payouts := make([]entities.Payout, 0, len(payloads))
+	reasons := make([]string, 0, len(payloads))
+	for _, payload := range payloads {
+		toAddress := payload.ToAddress
+		if payload.UserID != nil {
+			address, exists := userAddresses[*payload.UserID]
+			if !exists {
+				return nil, fmt.Errorf("%w: user %d", ucasetypes.ErrUserWalletNotFound, *payload.UserID)
+			}
+			toAddress = address
+		}
+
+		payout := entities.Payout{
+			VendorID:   vendorID,
+			RequestID:  payload.RequestID,
+			UserID:     payload.UserID,
+			ToAddress:  common.HexToAddress(toAddress).Hex(),
+			Amount:     payload.Amount,
+			Symbol:     payload.Symbol,
+			Network:    payload.Network,
+			WebhookURL: payload.WebhookURL,
+		}
+		evaluation, err := u.transferPolicyUCase.EvaluateTransfer(
+			ctx, constants.OutboundPayout, payout.Network, payout.Symbol, payout.ToAddress, payout.Amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		switch evaluation.Decision {
+		case constants.TransferDecisionBlocked:
+			payout.Status = constants.OutboundRejected
+			payout.ErrorMessage = evaluation.Reason
+		case constants.TransferDecisionHeld:
+			payout.Status = constants.OutboundPendingApproval
+			payout.RequiredApprovals = evaluation.RequiredApprovals
+		default:
+			now := time.Now().UTC()
+			payout.Status = constants.OutboundApproved
+			payout.ApprovedAt = &now
+		}
+
+		payouts = append(payouts, payout)
+		reasons = append(reasons, evaluation.Reason)
+	}
+
+	// Step 3: Create the payouts
+	if err := u.payoutRepository.CreatePayouts(ctx, payouts); err != nil {
+		return nil, err
+	}
+
+	// Step 4: Record the decisions, the payouts are created whether or not the audit is written
+	decisions := make([]dto.TransferDecisionDTO, 0, len(payouts))
+	payoutDTOs := make([]dto.PayoutDTO, 0, len(payouts))
+	for i, payout := range payouts {
+		decisions = append(decisions, payoutDecision(payout, policyDecision(payout.Status), constants.TransferPolicyActor, reasons[i]))
+		payoutDTOs = append(payoutDTOs, payout.ToDto())
+		if payout.Status == constants.OutboundRejected {
+			u.sendPayoutWebhook(payout.ToDto())
+		}
+	}
+	if err := u.transferPolicyUCase.RecordTransferDecisions(ctx, decisions); err != nil {
+		logger.GetLogger().Errorf("Failed to record transfer decisions of payouts of vendor %s: %v", vendorID, err)
+	}
+	return payoutDTOs, nil
+}
+
+func (u *payoutUCase) GetPayout(ctx context.Context, vendorID, requestID string) (dto.PayoutDTO, error) {
+	payout, err := u.payoutRepository.GetPayoutByRequestID(ctx, vendorID, requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.PayoutDTO{}, fmt.Errorf("%w: %s", ucasetypes.ErrPayoutNotFound, requestID)
+		}
+		return dto.PayoutDTO{}, err
+	}
+	return payout.ToDto(), nil
+}
+
+func (u *payoutUCase) GetPayouts(ctx context.Context, status string) ([]dto.PayoutDTO, error) {
+	payouts, err := u.payoutRepository.GetPayouts(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	payoutDTOs := make([]dto.PayoutDTO, 0, len(payouts))
+	for _, payout := range payouts {
+		payoutDTOs = append(payoutDTOs, payout.ToDto())
+	}
+	return payoutDTOs, nil
+}
+
+// ApprovePayout records the approval of a PENDING_APPROVAL payout by the approver, the payout is APPROVED once it has
+// the approvals required by its transfer policy and the payout worker of its network then sends it. The vendor
+// requesting the payout cannot approve it.
+func (u *payoutUCase) ApprovePayout(ctx context.Context, id uint64, approver, reason string) (dto.PayoutDTO, error) {
+	payout, err := u.getReviewablePayout(ctx, id)
+	if err != nil {
+		return dto.PayoutDTO{}, err
+	}
+
+	approvals, err := u.transferPolicyUCase.RecordApproval(
+		ctx, payoutDecision(*payout, constants.TransferDecisionApproved, approver, reason), payout.VendorID,
+	)
+	if err != nil {
+		return dto.PayoutDTO{}, err
+	}
+
+	updates := map[string]any{"approvals": approvals}
+	if approvals >= payout.RequiredApprovals {
+		updates["status"] = constants.OutboundApproved
+		updates["approved_at"] = time.Now().UTC()
+	}
+	return u.reviewPayout(ctx, id, updates)
+}
+
+// RejectPayout rejects a PENDING_APPROVAL payout with the reason, it is never sent.
+func (u *payoutUCase) RejectPayout(ctx context.Context, id uint64, approver, reason string) (dto.PayoutDTO, error) {
+	payout, err := u.getReviewablePayout(ctx, id)
+	if err != nil {
+		return dto.PayoutDTO{}, err
+	}
+
+	rejected, err := u.reviewPayout(ctx, id, map[string]any{
+		"status":        constants.OutboundRejected,
+		"error_message": reason,
+	})
+	if err != nil {
+		return dto.PayoutDTO{}, err
+	}
+
+	decision := payoutDecision(*payout, constants.TransferDecisionRejected, approver, reason)
+	if err := u.transferPolicyUCase.RecordTransferDecisions(ctx, []dto.TransferDecisionDTO{decision}); err != nil {
+		logger.GetLogger().Errorf("Failed to record rejection of payout %s: %v", payout.RequestID, err)
+	}
+
+	u.sendPayoutWebhook(rejected)
+	return rejected, nil
+}
+
+// ClaimApprovedPayouts moves the oldest approved payouts of the network to PROCESSING and returns them,
+// a payout claimed by another worker in the meantime is left out. A payout above what is left of the daily limit
+// of its token stays APPROVED until the limit allows it.
+func (u *payoutUCase) ClaimApprovedPayouts(ctx context.Context, network string, limit int) ([]dto.PayoutDTO, error) {
+	payouts, err := u.payoutRepository.GetApprovedPayouts(ctx, network, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyLimitRooms := make(map[string]*big.Int)
+	var claimed []dto.PayoutDTO
+	for _, payout := range payouts {
+		room, exists := dailyLimitRooms[payout.Symbol]
+		if !exists {
+			room, err = u.transferPolicyUCase.GetDailyLimitRoom(ctx, network, payout.Symbol)
+			if err != nil {
+				logger.GetLogger().Errorf("Failed to get daily limit of %s on network %s: %v", payout.Symbol, network, err)
+				continue
+			}
+			dailyLimitRooms[payout.Symbol] = room
+		}
+		if room != nil {
+			amount, err := utils.ConvertFloatTokenToSmallestUnit(payout.Amount, constants.PaymentAmountDecimalPlaces)
+			if err != nil {
+				logger.GetLogger().Errorf("Failed to convert amount of payout %s: %v", payout.RequestID, err)
+				continue
+			}
+			if amount.Cmp(room) > 0 {
+				logger.GetLogger().Infof("Payout %s is above the daily limit left of %s on network %s, deferring it", payout.RequestID, payout.Symbol, network)
+				continue
+			}
+			room.Sub(room, amount)
+		}
+
+		updated, err := u.payoutRepository.UpdatePayoutStatus(ctx, payout.ID, constants.OutboundApproved, map[string]any{
+			"status": constants.OutboundProcessing,
+		})
+		if err != nil {
+			logger.GetLogger().Errorf("Failed to claim payout %s: %v", payout.RequestID, err)
+			continue
+		}
+		if !updated {
+			continue
+		}
+		payout.Status = constants.OutboundProcessing
+		claimed = append(claimed, payout.ToDto())
+	}
+	return claimed, nil
+}
+
+// CompletePayout records the outcome of the transfer of a PROCESSING payout. The payout stays PROCESSING when
+// the status is PROCESSING, i.e. the transaction is sent but its outcome is unknown, otherwise its webhook is sent.
+func (u *payoutUCase) CompletePayout(
+	ctx context.Context,
+	id uint64,
+	status, transactionHash, fee, errorMessage string,
+) error {
+	updates := map[string]any{
+		"transaction_hash": transactionHash,
+		"error_message":    errorMessage,
+	}
+	if fee != "" {
+		updates["fee"] = fee
+	}
+	if status != constants.OutboundProcessing {
+		updates["status"] = status
+		updates["executed_at"] = time.Now().UTC()
+	}
+
+	updated, err := u.payoutRepository.UpdatePayoutStatus(ctx, id, constants.OutboundProcessing, updates)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return fmt.Errorf("payout %d is no longer processing", id)
+	}
+	if status == constants.OutboundProcessing {
+		return nil
+	}
+
+	payout, err := u.payoutRepository.GetPayoutByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	u.sendPayoutWebhook(payout.ToDto())
+	return nil
+}
+
+// getReviewablePayout returns the payout when it is PENDING_APPROVAL.
+func (u *payoutUCase) getReviewablePayout(ctx context.Context, id uint64) (*entities.Payout, error) {
+	payout, err := u.payoutRepository.GetPayoutByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %d", ucasetypes.ErrPayoutNotFound, id)
+		}
+		return nil, err
+	}
+	if payout.Status != constants.OutboundPendingApproval {
+		return nil, fmt.Errorf("%w: payout %d is %s", ucasetypes.ErrPayoutNotReviewable, id, payout.Status)
+	}
+	return payout, nil
+}
+
+// reviewPayout applies the updates to a PENDING_APPROVAL payout and returns it.
+func (u *payoutUCase) reviewPayout(ctx context.Context, id uint64, updates map[string]any) (dto.PayoutDTO, error) {
+	updated, err := u.payoutRepository.UpdatePayoutStatus(ctx, id, constants.OutboundPendingApproval, updates)
+	if err != nil {
+		return dto.PayoutDTO{}, err
+	}
+
+	payout, err := u.payoutRepository.GetPayoutByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.PayoutDTO{}, fmt.Errorf("%w: %d", ucasetypes.ErrPayoutNotFound, id)
+		}
+		return dto.PayoutDTO{}, err
+	}
+	if !updated {
+		return dto.PayoutDTO{}, fmt.Errorf("%w: payout %d is %s", ucasetypes.ErrPayoutNotReviewable, id, payout.Status)
+	}
+	return payout.ToDto(), nil
+}
+
+func (u *payoutUCase) sendPayoutWebhook(payout dto.PayoutDTO) {
+	go func() {
+		if err := utils.SendWebhook(payout, payout.WebhookURL); err != nil {
+			logger.GetLogger().Errorf("Failed to send webhook for payout %s: %v", payout.RequestID, err)
+		}
+	}()
+}
+
+func payoutDecision(payout entities.Payout, decision, actor, reason string) dto.TransferDecisionDTO {
+	return dto.TransferDecisionDTO{
+		TransferKind: constants.OutboundPayout,
+		TransferID:   &payout.ID,
+		Network:      payout.Network,
+		Symbol:       payout.Symbol,
+		ToAddress:    payout.ToAddress,
+		Amount:       payout.Amount,
+		Decision:     decision,
+		Actor:        actor,
+		Reason:       reason,
+	}
+}
+
+// policyDecision returns the policy decision that gave a new outbound transfer its status.
+func policyDecision(status string) string {
+	switch status {
+	case constants.OutboundRejected:
+		return constants.TransferDecisionBlocked
+	case constants.OutboundPendingApproval:
+		return constants.TransferDecisionHeld
+	default:
+		return constants.TransferDecisionAutoApproved
+	}
+}
@@ -0,0 +1,527 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/adapters/repositories/mocks"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasemocks "github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+)
+
+const (
+	testPayoutAddress = "0x2222222222222222222222222222222222222222"
+	testUserAddress   = "0x3333333333333333333333333333333333333333"
+)
+
+type payoutMocks struct {
+	payoutRepository     *mocks.MockPayoutRepository
+	userWalletRepository *mocks.MockUserWalletRepository
+	transferPolicyUCase  *ucasemocks.MockTransferPolicyUCase
+}
+
+func newTestPayoutUCase(ctrl *gomock.Controller) (ucasetypes.PayoutUCase, payoutMocks) {
+	m := payoutMocks{
+		payoutRepository:     mocks.NewMockPayoutRepository(ctrl),
+		userWalletRepository: mocks.NewMockUserWalletRepository(ctrl),
+		transferPolicyUCase:  ucasemocks.NewMockTransferPolicyUCase(ctrl),
+	}
+	return NewPayoutUCase(m.payoutRepository, m.userWalletRepository, m.transferPolicyUCase, "", "", ""), m
+}
+
+func testPayout(id uint64, status, amount string) *entities.Payout {
+	return &entities.Payout{
+		ID:                id,
+		VendorID:          "vendor-1",
+		RequestID:         fmt.Sprintf("payout-%d", id),
+		ToAddress:         testPayoutAddress,
+		Amount:            amount,
+		Symbol:            constants.USDT,
+		Network:           constants.Bsc.String(),
+		Status:            status,
+		RequiredApprovals: 2,
+	}
+}
+
+// testTokens returns the amount in the smallest unit the daily limit room is expressed in.
+func testTokens(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), new(big.Int).Exp(big.NewInt(10), big.NewInt(constants.PaymentAmountDecimalPlaces), nil))
+}
+
+func TestCreatePayouts(t *testing.T) {
+	t.Run("UserWithoutWallet", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		userID, otherUserID := uint64(1), uint64(2)
+		m.userWalletRepository.EXPECT().GetUserWalletsByUserIDs(gomock.Any(), []uint64{2, 1}).
+			Return([]entities.UserWallet{{UserID: userID, Address: testUserAddress}}, nil)
+
+		// None of the payouts is evaluated or created
+		_, err := ucase.CreatePayouts(context.Background(), "vendor-1", []dto.PayoutPayloadDTO{
+			{RequestID: "payout-1", UserID: &otherUserID, Amount: "10", Symbol: constants.USDT, Network: constants.Bsc.String()},
+			{RequestID: "payout-2", UserID: &userID, Amount: "10", Symbol: constants.USDT, Network: constants.Bsc.String()},
+		})
+		require.ErrorIs(t, err, ucasetypes.ErrUserWalletNotFound)
+		require.Contains(t, err.Error(), "user 2")
+	})
+
+	t.Run("StatusFollowsPolicyDecision", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		userID := uint64(1)
+		lowerCaseAddress := "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"
+		m.userWalletRepository.EXPECT().GetUserWalletsByUserIDs(gomock.Any(), []uint64{1}).
+			Return([]entities.UserWallet{{UserID: userID, Address: testUserAddress}}, nil)
+		m.transferPolicyUCase.EXPECT().
+			EvaluateTransfer(gomock.Any(), constants.OutboundPayout, constants.Bsc.String(), constants.USDT, testUserAddress, "10").
+			Return(dto.TransferEvaluationDTO{Decision: constants.TransferDecisionAutoApproved}, nil)
+		m.transferPolicyUCase.EXPECT().
+			EvaluateTransfer(gomock.Any(), constants.OutboundPayout, constants.Bsc.String(), constants.USDT, testPayoutAddress, "5000").
+			Return(dto.TransferEvaluationDTO{Decision: constants.TransferDecisionHeld, Reason: "above threshold", RequiredApprovals: 2}, nil)
+		m.transferPolicyUCase.EXPECT().
+			EvaluateTransfer(gomock.Any(), constants.OutboundPayout, constants.Bsc.String(), constants.USDT, "0xABcdEFABcdEFabcdEfAbCdefabcdeFABcDEFabCD", "1").
+			Return(dto.TransferEvaluationDTO{Decision: constants.TransferDecisionBlocked, Reason: "not allow-listed"}, nil)
+
+		var created []entities.Payout
+		m.payoutRepository.EXPECT().CreatePayouts(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, payouts []entities.Payout) error {
+				created = payouts
+				return nil
+			})
+		m.transferPolicyUCase.EXPECT().RecordTransferDecisions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, decisions []dto.TransferDecisionDTO) error {
+				require.Len(t, decisions, 3)
+				require.Equal(t, constants.TransferDecisionAutoApproved, decisions[0].Decision)
+				require.Equal(t, constants.TransferDecisionHeld, decisions[1].Decision)
+				require.Equal(t, constants.TransferDecisionBlocked, decisions[2].Decision)
+				require.Equal(t, "not allow-listed", decisions[2].Reason)
+				require.Equal(t, constants.TransferPolicyActor, decisions[2].Actor)
+				return nil
+			})
+
+		payouts, err := ucase.CreatePayouts(context.Background(), "vendor-1", []dto.PayoutPayloadDTO{
+			{RequestID: "payout-1", UserID: &userID, Amount: "10", Symbol: constants.USDT, Network: constants.Bsc.String()},
+			{RequestID: "payout-2", ToAddress: testPayoutAddress, Amount: "5000", Symbol: constants.USDT, Network: constants.Bsc.String()},
+			{RequestID: "payout-3", ToAddress: lowerCaseAddress, Amount: "1", Symbol: constants.USDT, Network: constants.Bsc.String()},
+		})
+		require.NoError(t, err)
+		require.Len(t, created, 3)
+		require.Len(t, payouts, 3)
+
+		// The payout of a user is sent to its registered wallet
+		require.Equal(t, testUserAddress, payouts[0].ToAddress)
+		require.Equal(t, constants.OutboundApproved, payouts[0].Status)
+		require.NotNil(t, payouts[0].ApprovedAt)
+
+		require.Equal(t, constants.OutboundPendingApproval, payouts[1].Status)
+		require.Equal(t, uint(2), payouts[1].RequiredApprovals)
+		require.Nil(t, payouts[1].ApprovedAt)
+
+		require.Equal(t, constants.OutboundRejected, payouts[2].Status)
+		require.Equal(t, "not allow-listed", payouts[2].ErrorMessage)
+		require.Equal(t, "0xABcdEFABcdEFabcdEfAbCdefabcdeFABcDEFabCD", payouts[2].ToAddress)
+		for _, payout := range created {
+			require.Equal(t, "vendor-1", payout.VendorID)
+		}
+	})
+
+	t.Run("EvaluationFails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.transferPolicyUCase.EXPECT().EvaluateTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(dto.TransferEvaluationDTO{}, fmt.Errorf("policy unavailable"))
+
+		_, err := ucase.CreatePayouts(context.Background(), "vendor-1", []dto.PayoutPayloadDTO{
+			{RequestID: "payout-1", ToAddress: testPayoutAddress, Amount: "10", Symbol: constants.USDT, Network: constants.Bsc.String()},
+		})
+		require.ErrorContains(t, err, "policy unavailable")
+	})
+
+	t.Run("DecisionsNotRecorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.transferPolicyUCase.EXPECT().EvaluateTransfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(dto.TransferEvaluationDTO{Decision: constants.TransferDecisionAutoApproved}, nil)
+		m.payoutRepository.EXPECT().CreatePayouts(gomock.Any(), gomock.Len(1)).Return(nil)
+		m.transferPolicyUCase.EXPECT().RecordTransferDecisions(gomock.Any(), gomock.Any()).Return(fmt.Errorf("audit unavailable"))
+
+		// The payouts are created whether or not the audit is written
+		payouts, err := ucase.CreatePayouts(context.Background(), "vendor-1", []dto.PayoutPayloadDTO{
+			{RequestID: "payout-1", ToAddress: testPayoutAddress, Amount: "10", Symbol: constants.USDT, Network: constants.Bsc.String()},
+		})
+		require.NoError(t, err)
+		require.Len(t, payouts, 1)
+	})
+}
+
+func TestApprovePayout(t *testing.T) {
+	t.Run("PartialApprovalKeepsPayoutPending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		pending := testPayout(1, constants.OutboundPendingApproval, "5000")
+		approved := *pending
+		approved.Approvals = 1
+
+		gomock.InOrder(
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).Return(pending, nil),
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).Return(&approved, nil),
+		)
+		m.transferPolicyUCase.EXPECT().RecordApproval(gomock.Any(), gomock.Any(), "vendor-1").
+			DoAndReturn(func(_ context.Context, decision dto.TransferDecisionDTO, _ string) (uint, error) {
+				require.Equal(t, constants.TransferDecisionApproved, decision.Decision)
+				require.Equal(t, "approver-1", decision.Actor)
+				require.Equal(t, uint64(1), *decision.TransferID)
+				return 1, nil
+			})
+		m.payoutRepository.EXPECT().
+			UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundPendingApproval, map[string]any{"approvals": uint(1)}).
+			Return(true, nil)
+
+		payout, err := ucase.ApprovePayout(context.Background(), 1, "approver-1", "looks fine")
+		require.NoError(t, err)
+		require.Equal(t, constants.OutboundPendingApproval, payout.Status)
+		require.Equal(t, uint(1), payout.Approvals)
+	})
+
+	t.Run("RequiredApprovalsApprovePayout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		pending := testPayout(1, constants.OutboundPendingApproval, "5000")
+		pending.Approvals = 1
+		approved := *pending
+		approved.Approvals = 2
+		approved.Status = constants.OutboundApproved
+
+		gomock.InOrder(
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).Return(pending, nil),
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).Return(&approved, nil),
+		)
+		m.transferPolicyUCase.EXPECT().RecordApproval(gomock.Any(), gomock.Any(), "vendor-1").Return(uint(2), nil)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundPendingApproval, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint64, _ string, updates map[string]any) (bool, error) {
+				require.Equal(t, uint(2), updates["approvals"])
+				require.Equal(t, constants.OutboundApproved, updates["status"])
+				require.Contains(t, updates, "approved_at")
+				return true, nil
+			})
+
+		payout, err := ucase.ApprovePayout(context.Background(), 1, "approver-2", "")
+		require.NoError(t, err)
+		require.Equal(t, constants.OutboundApproved, payout.Status)
+	})
+
+	t.Run("PayoutNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := ucase.ApprovePayout(context.Background(), 1, "approver-1", "")
+		require.ErrorIs(t, err, ucasetypes.ErrPayoutNotFound)
+	})
+
+	t.Run("PayoutNotPendingApproval", func(t *testing.T) {
+		for _, status := range []string{
+			constants.OutboundApproved, constants.OutboundProcessing, constants.OutboundSuccess,
+			constants.OutboundFailed, constants.OutboundRejected,
+		} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestPayoutUCase(ctrl)
+				m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).Return(testPayout(1, status, "5000"), nil)
+
+				// The approval is never recorded
+				_, err := ucase.ApprovePayout(context.Background(), 1, "approver-1", "")
+				require.ErrorIs(t, err, ucasetypes.ErrPayoutNotReviewable)
+			})
+		}
+	})
+
+	t.Run("ApprovalByRequester", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+			Return(testPayout(1, constants.OutboundPendingApproval, "5000"), nil)
+		m.transferPolicyUCase.EXPECT().RecordApproval(gomock.Any(), gomock.Any(), "vendor-1").
+			Return(uint(0), ucasetypes.ErrSelfApproval)
+
+		_, err := ucase.ApprovePayout(context.Background(), 1, "vendor-1", "")
+		require.ErrorIs(t, err, ucasetypes.ErrSelfApproval)
+	})
+
+	t.Run("PayoutReviewedMeanwhile", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		gomock.InOrder(
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+				Return(testPayout(1, constants.OutboundPendingApproval, "5000"), nil),
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+				Return(testPayout(1, constants.OutboundRejected, "5000"), nil),
+		)
+		m.transferPolicyUCase.EXPECT().RecordApproval(gomock.Any(), gomock.Any(), "vendor-1").Return(uint(1), nil)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundPendingApproval, gomock.Any()).
+			Return(false, nil)
+
+		_, err := ucase.ApprovePayout(context.Background(), 1, "approver-1", "")
+		require.ErrorIs(t, err, ucasetypes.ErrPayoutNotReviewable)
+		require.Contains(t, err.Error(), constants.OutboundRejected)
+	})
+}
+
+func TestRejectPayout(t *testing.T) {
+	t.Run("RejectsPendingPayout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		rejected := testPayout(1, constants.OutboundRejected, "5000")
+		rejected.ErrorMessage = "unknown recipient"
+
+		gomock.InOrder(
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+				Return(testPayout(1, constants.OutboundPendingApproval, "5000"), nil),
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).Return(rejected, nil),
+		)
+		m.payoutRepository.EXPECT().
+			UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundPendingApproval, map[string]any{
+				"status":        constants.OutboundRejected,
+				"error_message": "unknown recipient",
+			}).
+			Return(true, nil)
+		m.transferPolicyUCase.EXPECT().RecordTransferDecisions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, decisions []dto.TransferDecisionDTO) error {
+				require.Len(t, decisions, 1)
+				require.Equal(t, constants.TransferDecisionRejected, decisions[0].Decision)
+				require.Equal(t, "approver-1", decisions[0].Actor)
+				return nil
+			})
+
+		payout, err := ucase.RejectPayout(context.Background(), 1, "approver-1", "unknown recipient")
+		require.NoError(t, err)
+		require.Equal(t, constants.OutboundRejected, payout.Status)
+		require.Equal(t, "unknown recipient", payout.ErrorMessage)
+	})
+
+	t.Run("PayoutApprovedMeanwhile", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		gomock.InOrder(
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+				Return(testPayout(1, constants.OutboundPendingApproval, "5000"), nil),
+			m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+				Return(testPayout(1, constants.OutboundApproved, "5000"), nil),
+		)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundPendingApproval, gomock.Any()).
+			Return(false, nil)
+
+		// The rejection is not recorded as the payout was not rejected
+		_, err := ucase.RejectPayout(context.Background(), 1, "approver-1", "unknown recipient")
+		require.ErrorIs(t, err, ucasetypes.ErrPayoutNotReviewable)
+	})
+}
+
+func TestClaimApprovedPayouts(t *testing.T) {
+	network := constants.Bsc.String()
+
+	t.Run("ClaimsWithinDailyLimit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().GetApprovedPayouts(gomock.Any(), network, 10).Return([]entities.Payout{
+			*testPayout(1, constants.OutboundApproved, "60"),
+			*testPayout(2, constants.OutboundApproved, "50"),
+			*testPayout(3, constants.OutboundApproved, "40"),
+		}, nil)
+
+		// The room is fetched once per token and shared by its payouts, the second payout is above what is left of it
+		m.transferPolicyUCase.EXPECT().GetDailyLimitRoom(gomock.Any(), network, constants.USDT).Return(testTokens(100), nil)
+		m.payoutRepository.EXPECT().
+			UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundApproved, map[string]any{"status": constants.OutboundProcessing}).
+			Return(true, nil)
+		m.payoutRepository.EXPECT().
+			UpdatePayoutStatus(gomock.Any(), uint64(3), constants.OutboundApproved, map[string]any{"status": constants.OutboundProcessing}).
+			Return(true, nil)
+
+		payouts, err := ucase.ClaimApprovedPayouts(context.Background(), network, 10)
+		require.NoError(t, err)
+		require.Len(t, payouts, 2)
+		require.Equal(t, uint64(1), payouts[0].ID)
+		require.Equal(t, uint64(3), payouts[1].ID)
+		for _, payout := range payouts {
+			require.Equal(t, constants.OutboundProcessing, payout.Status)
+		}
+	})
+
+	t.Run("NoDailyLimit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		usdc := testPayout(2, constants.OutboundApproved, "1000000")
+		usdc.Symbol = constants.USDC
+		m.payoutRepository.EXPECT().GetApprovedPayouts(gomock.Any(), network, 10).Return([]entities.Payout{
+			*testPayout(1, constants.OutboundApproved, "1000000"),
+			*usdc,
+		}, nil)
+		m.transferPolicyUCase.EXPECT().GetDailyLimitRoom(gomock.Any(), network, constants.USDT).Return(nil, nil)
+		m.transferPolicyUCase.EXPECT().GetDailyLimitRoom(gomock.Any(), network, constants.USDC).Return(nil, nil)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), gomock.Any(), constants.OutboundApproved, gomock.Any()).
+			Return(true, nil).Times(2)
+
+		payouts, err := ucase.ClaimApprovedPayouts(context.Background(), network, 10)
+		require.NoError(t, err)
+		require.Len(t, payouts, 2)
+	})
+
+	t.Run("DailyLimitUnavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().GetApprovedPayouts(gomock.Any(), network, 10).Return([]entities.Payout{
+			*testPayout(1, constants.OutboundApproved, "10"),
+		}, nil)
+		m.transferPolicyUCase.EXPECT().GetDailyLimitRoom(gomock.Any(), network, constants.USDT).
+			Return(nil, fmt.Errorf("database unavailable"))
+
+		// The payout is not claimed without knowing the limit
+		payouts, err := ucase.ClaimApprovedPayouts(context.Background(), network, 10)
+		require.NoError(t, err)
+		require.Empty(t, payouts)
+	})
+
+	t.Run("PayoutClaimedByAnotherWorker", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().GetApprovedPayouts(gomock.Any(), network, 10).Return([]entities.Payout{
+			*testPayout(1, constants.OutboundApproved, "10"),
+			*testPayout(2, constants.OutboundApproved, "10"),
+		}, nil)
+		m.transferPolicyUCase.EXPECT().GetDailyLimitRoom(gomock.Any(), network, constants.USDT).Return(nil, nil)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundApproved, gomock.Any()).
+			Return(false, nil)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(2), constants.OutboundApproved, gomock.Any()).
+			Return(false, fmt.Errorf("database unavailable"))
+
+		payouts, err := ucase.ClaimApprovedPayouts(context.Background(), network, 10)
+		require.NoError(t, err)
+		require.Empty(t, payouts)
+	})
+}
+
+func TestCompletePayout(t *testing.T) {
+	t.Run("SuccessfulTransfer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundProcessing, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint64, _ string, updates map[string]any) (bool, error) {
+				require.Equal(t, constants.OutboundSuccess, updates["status"])
+				require.Equal(t, "0xhash", updates["transaction_hash"])
+				require.Equal(t, "0.0001", updates["fee"])
+				require.Equal(t, "", updates["error_message"])
+				require.Contains(t, updates, "executed_at")
+				return true, nil
+			})
+		m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+			Return(testPayout(1, constants.OutboundSuccess, "10"), nil)
+
+		require.NoError(t, ucase.CompletePayout(context.Background(), 1, constants.OutboundSuccess, "0xhash", "0.0001", ""))
+	})
+
+	t.Run("FailedTransfer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundProcessing, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint64, _ string, updates map[string]any) (bool, error) {
+				require.Equal(t, constants.OutboundFailed, updates["status"])
+				require.Equal(t, "insufficient funds", updates["error_message"])
+				require.NotContains(t, updates, "fee", "no fee is paid for a transaction never sent")
+				return true, nil
+			})
+		m.payoutRepository.EXPECT().GetPayoutByID(gomock.Any(), uint64(1)).
+			Return(testPayout(1, constants.OutboundFailed, "10"), nil)
+
+		require.NoError(t, ucase.CompletePayout(context.Background(), 1, constants.OutboundFailed, "", "", "insufficient funds"))
+	})
+
+	t.Run("ReceiptUnavailableKeepsPayoutProcessing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundProcessing, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint64, _ string, updates map[string]any) (bool, error) {
+				require.NotContains(t, updates, "status")
+				require.NotContains(t, updates, "executed_at")
+				require.Equal(t, "0xhash", updates["transaction_hash"])
+				return true, nil
+			})
+
+		// The payout is not fetched and no webhook is sent
+		require.NoError(t, ucase.CompletePayout(
+			context.Background(), 1, constants.OutboundProcessing, "0xhash", "", "transaction receipt unavailable",
+		))
+	})
+
+	t.Run("PayoutNoLongerProcessing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundProcessing, gomock.Any()).
+			Return(false, nil)
+
+		err := ucase.CompletePayout(context.Background(), 1, constants.OutboundSuccess, "0xhash", "0.0001", "")
+		require.ErrorContains(t, err, "no longer processing")
+	})
+
+	t.Run("UpdateFails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestPayoutUCase(ctrl)
+		m.payoutRepository.EXPECT().UpdatePayoutStatus(gomock.Any(), uint64(1), constants.OutboundProcessing, gomock.Any()).
+			Return(false, fmt.Errorf("database unavailable"))
+
+		err := ucase.CompletePayout(context.Background(), 1, constants.OutboundSuccess, "0xhash", "0.0001", "")
+		require.ErrorContains(t, err, "database unavailable")
+	})
+}
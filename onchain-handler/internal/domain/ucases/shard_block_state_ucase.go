@@ -0,0 +1,77 @@
+package ucases
+
+import (
+	"context"
+	"sync"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+// shardBlockStateUCase keeps the listener cursor per shard instead of per network.
+// The latest block is still shared per network.
+type shardBlockStateUCase struct {
+	ucasetypes.BlockStateUCase
+	listenerShardUCase ucasetypes.ListenerShardUCase
+	shards             []dto.ListenerShardDTO
+	mu                 sync.Mutex // Protects the shard cursors shared by the listener goroutines
+}
+
+// NewShardBlockStateUCase wraps blockStateUCase so that a listener running the given shards of one network
+// resumes from the slowest shard cursor and advances all of them together.
+func NewShardBlockStateUCase(
+	blockStateUCase ucasetypes.BlockStateUCase,
+	listenerShardUCase ucasetypes.ListenerShardUCase,
+	shards []dto.ListenerShardDTO,
+) ucasetypes.BlockStateUCase {
+	return &shardBlockStateUCase{
+		BlockStateUCase:    blockStateUCase,
+		listenerShardUCase: listenerShardUCase,
+		shards:             shards,
+	}
+}
+
+// GetLastProcessedBlock returns the lowest cursor among the shards, falling back to the network cursor for new shards.
+func (u *shardBlockStateUCase) GetLastProcessedBlock(ctx context.Context, network constants.NetworkType) (uint64, error) {
+	u.mu.Lock()
+	var lastProcessedBlock uint64
+	for _, shard := range u.shards {
+		if shard.LastProcessedBlock == 0 {
+			lastProcessedBlock = 0
+			break
+		}
+		if lastProcessedBlock == 0 || shard.LastProcessedBlock < lastProcessedBlock {
+			lastProcessedBlock = shard.LastProcessedBlock
+		}
+	}
+	u.mu.Unlock()
+
+	if lastProcessedBlock == 0 {
+		return u.BlockStateUCase.GetLastProcessedBlock(ctx, network)
+	}
+	return lastProcessedBlock, nil
+}
+
+// UpdateLastProcessedBlock advances every shard cursor. The owner of the first partition also keeps
+// the network cursor up to date so the rest of the system can keep relying on it.
+func (u *shardBlockStateUCase) UpdateLastProcessedBlock(ctx context.Context, blockNumber uint64, network constants.NetworkType) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for index, shard := range u.shards {
+		if err := u.listenerShardUCase.UpdateShardLastProcessedBlock(ctx, shard, blockNumber); err != nil {
+			logger.GetLogger().Errorf("Failed to update last processed block of shard %s: %v", shard.Key(), err)
+			continue
+		}
+		u.shards[index].LastProcessedBlock = blockNumber
+
+		if shard.PartitionIndex == 0 {
+			if err := u.BlockStateUCase.UpdateLastProcessedBlock(ctx, blockNumber, network); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
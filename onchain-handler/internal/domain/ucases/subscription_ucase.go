@@ -0,0 +1,345 @@
+package ucases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type subscriptionUCase struct {
+	subscriptionRepository repotypes.SubscriptionRepository
+	paymentOrderUCase      ucasetypes.PaymentOrderUCase
+}
+
+func NewSubscriptionUCase(
+	subscriptionRepository repotypes.SubscriptionRepository,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+) ucasetypes.SubscriptionUCase {
+	return &subscriptionUCase{
+		subscriptionRepository: subscriptionRepository,
+		paymentOrderUCase:      paymentOrderUCase,
+	}
+}
+
+func (u *subscriptionUCase) CreateSubscriptionPlan(
+	ctx context.Context,
+	vendorID string,
+	payload dto.SubscriptionPlanPayloadDTO,
+) (dto.SubscriptionPlanDTO, error) {
+	plan := &entities.SubscriptionPlan{
+		VendorID:        vendorID,
+		Name:            payload.Name,
+		Amount:          payload.Amount,
+		Symbol:          payload.Symbol,
+		Network:         payload.Network,
+		BillingInterval: payload.BillingInterval,
+		GracePeriod:     payload.GracePeriod,
+	}
+	if err := u.subscriptionRepository.CreateSubscriptionPlan(ctx, plan); err != nil {
+		return dto.SubscriptionPlanDTO{}, err
+	}
+	return plan.ToDto(), nil
+}
+
+func (u *subscriptionUCase) GetSubscriptionPlans(ctx context.Context, vendorID string) ([]dto.SubscriptionPlanDTO, error) {
+	plans, err := u.subscriptionRepository.GetSubscriptionPlans(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	planDTOs := make([]dto.SubscriptionPlanDTO, 0, len(plans))
+	for _, plan := range plans {
+		planDTOs = append(planDTOs, plan.ToDto())
+	}
+	return planDTOs, nil
+}
+
+// CreateSubscription subscribes the customer to the plan and bills its first cycle right away.
+func (u *subscriptionUCase) CreateSubscription(
+	ctx context.Context,
+	vendorID string,
+	payload dto.SubscriptionPayloadDTO,
+) (dto.SubscriptionDTO, error) {
+	plan, err := u.subscriptionRepository.GetSubscriptionPlanByID(ctx, vendorID, payload.PlanID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.SubscriptionDTO{}, fmt.Errorf("%w: %d", ucasetypes.ErrSubscriptionPlanNotFound, payload.PlanID)
+		}
+		return dto.SubscriptionDTO{}, err
+	}
+
+	now := time.Now().UTC()
+	subscription := &entities.Subscription{
+		PlanID:        plan.ID,
+		Plan:          *plan,
+		VendorID:      vendorID,
+		CustomerID:    payload.CustomerID,
+		WebhookURL:    payload.WebhookURL,
+		Status:        constants.SubscriptionActive,
+		BillingAnchor: now,
+		NextBillingAt: now,
+	}
+	if err := u.subscriptionRepository.CreateSubscription(ctx, subscription); err != nil {
+		return dto.SubscriptionDTO{}, err
+	}
+
+	// The billing worker retries the first cycle when it cannot be billed now
+	currentOrder, err := u.billSubscription(ctx, subscription)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to bill the first cycle of subscription %d: %v", subscription.ID, err)
+	}
+	return mapSubscriptionToDTO(*subscription, currentOrder), nil
+}
+
+func (u *subscriptionUCase) GetSubscription(ctx context.Context, vendorID string, id uint64) (dto.SubscriptionDTO, error) {
+	subscription, err := u.getSubscription(ctx, vendorID, id)
+	if err != nil {
+		return dto.SubscriptionDTO{}, err
+	}
+
+	currentOrder, err := u.currentOrderOf(ctx, *subscription)
+	if err != nil {
+		return dto.SubscriptionDTO{}, err
+	}
+	return mapSubscriptionToDTO(*subscription, currentOrder), nil
+}
+
+// CancelSubscription stops billing the subscription. The order of the cycle being billed is cancelled
+// when it is still PENDING, otherwise it is left to expire.
+func (u *subscriptionUCase) CancelSubscription(ctx context.Context, vendorID string, id uint64) (dto.SubscriptionDTO, error) {
+	// Step 1: Cancel the subscription, the status may have changed in the meantime
+	subscription, err := u.getSubscription(ctx, vendorID, id)
+	if err != nil {
+		return dto.SubscriptionDTO{}, err
+	}
+	cancelledAt := time.Now().UTC()
+	cancelled, err := u.subscriptionRepository.CancelSubscription(ctx, id, cancelledAt)
+	if err != nil {
+		return dto.SubscriptionDTO{}, err
+	}
+	if !cancelled {
+		return dto.SubscriptionDTO{}, fmt.Errorf("%w: subscription %d is already cancelled", ucasetypes.ErrSubscriptionNotCancellable, id)
+	}
+	subscription.Status = constants.SubscriptionCancelled
+	subscription.CancelledAt = &cancelledAt
+
+	// Step 2: Cancel the unpaid order of the current cycle
+	currentOrder, err := u.currentOrderOf(ctx, *subscription)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to retrieve the current order of cancelled subscription %d: %v", id, err)
+	} else if currentOrder != nil && currentOrder.Status == constants.Pending {
+		cancelledOrder, err := u.paymentOrderUCase.CancelPaymentOrder(ctx, vendorID, currentOrder.RequestID)
+		if err != nil {
+			logger.GetLogger().Warnf("Failed to cancel order %s of cancelled subscription %d: %v", currentOrder.RequestID, id, err)
+		} else {
+			currentOrder = &cancelledOrder
+		}
+	}
+
+	return mapSubscriptionToDTO(*subscription, currentOrder), nil
+}
+
+// BillDueSubscriptions creates the order of the next cycle of the ACTIVE subscriptions that are due.
+func (u *subscriptionUCase) BillDueSubscriptions(ctx context.Context) error {
+	subscriptions, err := u.subscriptionRepository.GetDueSubscriptions(ctx, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	for index := range subscriptions {
+		if _, err := u.billSubscription(ctx, &subscriptions[index]); err != nil {
+			logger.GetLogger().Errorf("Failed to bill subscription %d: %v", subscriptions[index].ID, err)
+		}
+	}
+	return nil
+}
+
+// TrackBillingSubscriptions moves the subscriptions by the outcome of the order of their cycle: a paid order
+// schedules the next cycle, an expired one makes the subscription PAST_DUE and a failed or cancelled one cancels it.
+func (u *subscriptionUCase) TrackBillingSubscriptions(ctx context.Context) error {
+	subscriptions, err := u.subscriptionRepository.GetBillingSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if err := u.trackSubscription(ctx, subscription); err != nil {
+			logger.GetLogger().Errorf("Failed to track the billing of subscription %d: %v", subscription.ID, err)
+		}
+	}
+	return nil
+}
+
+// billSubscription creates the order of the next cycle of the subscription and sends its webhook.
+// The order request ID is derived from the cycle, so a cycle is never billed twice.
+func (u *subscriptionUCase) billSubscription(
+	ctx context.Context,
+	subscription *entities.Subscription,
+) (*dto.PaymentOrderDTOResponse, error) {
+	// Step 1: Create the order of the cycle, or find the one created by a previous attempt
+	cycle := subscription.Cycle + 1
+	requestID := fmt.Sprintf("subscription-%d-%d", subscription.ID, cycle)
+	var orderID uint64
+	orders, err := u.paymentOrderUCase.CreatePaymentOrders(ctx, []dto.PaymentOrderPayloadDTO{{
+		RequestID:        requestID,
+		Amount:           subscription.Plan.Amount,
+		Symbol:           subscription.Plan.Symbol,
+		Network:          subscription.Plan.Network,
+		ExpiredOrderTime: subscription.Plan.GracePeriod,
+	}}, subscription.VendorID, conf.GetExpiredOrderTime())
+	switch {
+	case errors.Is(err, ucasetypes.ErrDuplicateRequestID):
+		order, err := u.paymentOrderUCase.GetPaymentOrderByRequestID(ctx, subscription.VendorID, requestID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve order %s: %w", requestID, err)
+		}
+		orderID = order.ID
+	case err != nil:
+		return nil, fmt.Errorf("failed to create order %s: %w", requestID, err)
+	case len(orders) == 0:
+		return nil, fmt.Errorf("no order created for %s", requestID)
+	default:
+		orderID = orders[0].ID
+	}
+
+	// Step 2: Attach the order to the subscription, unless another run did it first
+	updated, err := u.subscriptionRepository.UpdateSubscription(ctx, subscription.ID, nil, map[string]any{
+		"current_order_id": orderID,
+		"cycle":            cycle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, nil
+	}
+	subscription.CurrentOrderID = &orderID
+	subscription.Cycle = cycle
+
+	// Step 3: Send the payment address of the cycle to the vendor
+	order, err := u.paymentOrderUCase.GetPaymentOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve order %s: %w", requestID, err)
+	}
+	u.sendWebhook(mapSubscriptionToDTO(*subscription, &order))
+	return &order, nil
+}
+
+// trackSubscription applies the outcome of the order of the cycle being billed.
+func (u *subscriptionUCase) trackSubscription(ctx context.Context, subscription entities.Subscription) error {
+	order, err := u.paymentOrderUCase.GetPaymentOrderByID(ctx, *subscription.CurrentOrderID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve order %d: %w", *subscription.CurrentOrderID, err)
+	}
+
+	updates := map[string]any{}
+	switch {
+	case constants.IsPaidStatus(order.Status):
+		// The cycle is paid, the next one is billed one interval after it started
+		nextBillingAt := nextBillingTime(subscription.BillingAnchor, subscription.Plan.BillingInterval, subscription.Cycle)
+		updates["status"] = constants.SubscriptionActive
+		updates["current_order_id"] = nil
+		updates["next_billing_at"] = nextBillingAt
+		subscription.Status = constants.SubscriptionActive
+		subscription.CurrentOrderID = nil
+		subscription.NextBillingAt = nextBillingAt
+	case order.Status == constants.Expired && subscription.Status == constants.SubscriptionActive:
+		// The grace period is over, the order can still be paid until it fails
+		updates["status"] = constants.SubscriptionPastDue
+		subscription.Status = constants.SubscriptionPastDue
+	case order.Status == constants.Failed || order.Status == constants.Cancelled:
+		cancelledAt := time.Now().UTC()
+		updates["status"] = constants.SubscriptionCancelled
+		updates["cancelled_at"] = cancelledAt
+		subscription.Status = constants.SubscriptionCancelled
+		subscription.CancelledAt = &cancelledAt
+	default:
+		return nil
+	}
+
+	updated, err := u.subscriptionRepository.UpdateSubscription(ctx, subscription.ID, &order.ID, updates)
+	if err != nil {
+		return err
+	}
+	if updated {
+		u.sendWebhook(mapSubscriptionToDTO(subscription, &order))
+	}
+	return nil
+}
+
+func (u *subscriptionUCase) getSubscription(ctx context.Context, vendorID string, id uint64) (*entities.Subscription, error) {
+	subscription, err := u.subscriptionRepository.GetSubscriptionByID(ctx, vendorID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %d", ucasetypes.ErrSubscriptionNotFound, id)
+		}
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (u *subscriptionUCase) currentOrderOf(
+	ctx context.Context,
+	subscription entities.Subscription,
+) (*dto.PaymentOrderDTOResponse, error) {
+	if subscription.CurrentOrderID == nil {
+		return nil, nil
+	}
+	order, err := u.paymentOrderUCase.GetPaymentOrderByID(ctx, *subscription.CurrentOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve order %d: %w", *subscription.CurrentOrderID, err)
+	}
+	return &order, nil
+}
+
+func (u *subscriptionUCase) sendWebhook(subscription dto.SubscriptionDTO) {
+	go func() {
+		if err := utils.SendWebhook(subscription, subscription.WebhookURL); err != nil {
+			logger.GetLogger().Errorf("Failed to send webhook for subscription %d: %v", subscription.ID, err)
+		}
+	}()
+}
+
+// nextBillingTime returns when the cycle after the given number of cycles is billed, counted from the anchor
+// so that months of different lengths do not shift the billing date over time.
+func nextBillingTime(anchor time.Time, interval string, cycles uint) time.Time {
+	count := int(cycles)
+	switch interval {
+	case constants.SubscriptionDaily:
+		return anchor.AddDate(0, 0, count)
+	case constants.SubscriptionWeekly:
+		return anchor.AddDate(0, 0, 7*count)
+	case constants.SubscriptionMonthly:
+		return anchor.AddDate(0, count, 0)
+	case constants.SubscriptionYearly:
+		return anchor.AddDate(count, 0, 0)
+	}
+	return anchor
+}
+
+func mapSubscriptionToDTO(subscription entities.Subscription, currentOrder *dto.PaymentOrderDTOResponse) dto.SubscriptionDTO {
+	return dto.SubscriptionDTO{
+		ID:            subscription.ID,
+		CustomerID:    subscription.CustomerID,
+		Status:        subscription.Status,
+		Cycle:         subscription.Cycle,
+		NextBillingAt: subscription.NextBillingAt,
+		WebhookURL:    subscription.WebhookURL,
+		CancelledAt:   subscription.CancelledAt,
+		CreatedAt:     subscription.CreatedAt,
+		Plan:          subscription.Plan.ToDto(),
+		CurrentOrder:  currentOrder,
+	}
+}
@@ -0,0 +1,284 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/adapters/repositories/mocks"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasemocks "github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+)
+
+type subscriptionMocks struct {
+	subscriptionRepository *mocks.MockSubscriptionRepository
+	paymentOrderUCase      *ucasemocks.MockPaymentOrderUCase
+}
+
+func newTestSubscriptionUCase(ctrl *gomock.Controller) (ucasetypes.SubscriptionUCase, subscriptionMocks) {
+	m := subscriptionMocks{
+		subscriptionRepository: mocks.NewMockSubscriptionRepository(ctrl),
+		paymentOrderUCase:      ucasemocks.NewMockPaymentOrderUCase(ctrl),
+	}
+	return NewSubscriptionUCase(m.subscriptionRepository, m.paymentOrderUCase), m
+}
+
+var testBillingAnchor = time.Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+
+// testSubscription returns a monthly subscription with the given number of billed cycles.
+func testSubscription(id uint64, status string, cycle uint, currentOrderID *uint64) entities.Subscription {
+	return entities.Subscription{
+		ID:         id,
+		PlanID:     3,
+		VendorID:   "vendor-1",
+		CustomerID: "customer-1",
+		Plan: entities.SubscriptionPlan{
+			ID:              3,
+			VendorID:        "vendor-1",
+			Amount:          "25",
+			Symbol:          constants.USDT,
+			Network:         constants.Bsc.String(),
+			BillingInterval: constants.SubscriptionMonthly,
+			GracePeriod:     60,
+		},
+		Status:         status,
+		BillingAnchor:  testBillingAnchor,
+		Cycle:          cycle,
+		NextBillingAt:  nextBillingTime(testBillingAnchor, constants.SubscriptionMonthly, cycle),
+		CurrentOrderID: currentOrderID,
+	}
+}
+
+func TestBillDueSubscriptions(t *testing.T) {
+	t.Run("CreatesOrderOfNextCycle", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestSubscriptionUCase(ctrl)
+		m.subscriptionRepository.EXPECT().GetDueSubscriptions(gomock.Any(), gomock.Any()).
+			Return([]entities.Subscription{testSubscription(1, constants.SubscriptionActive, 2, nil)}, nil)
+		m.paymentOrderUCase.EXPECT().CreatePaymentOrders(gomock.Any(), gomock.Any(), "vendor-1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, payloads []dto.PaymentOrderPayloadDTO, _ string, _ time.Duration) ([]dto.CreatedPaymentOrderDTO, error) {
+				require.Equal(t, []dto.PaymentOrderPayloadDTO{{
+					RequestID:        "subscription-1-3",
+					Amount:           "25",
+					Symbol:           constants.USDT,
+					Network:          constants.Bsc.String(),
+					ExpiredOrderTime: 60, // The order expires with the grace period of the plan
+				}}, payloads)
+				return []dto.CreatedPaymentOrderDTO{{ID: 9, RequestID: "subscription-1-3"}}, nil
+			})
+		m.subscriptionRepository.EXPECT().
+			UpdateSubscription(gomock.Any(), uint64(1), nil, map[string]any{"current_order_id": uint64(9), "cycle": uint(3)}).
+			Return(true, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(9)).
+			Return(dto.PaymentOrderDTOResponse{ID: 9, RequestID: "subscription-1-3", Status: constants.Pending}, nil)
+
+		require.NoError(t, ucase.BillDueSubscriptions(context.Background()))
+	})
+
+	t.Run("ReusesOrderOfPreviousAttempt", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestSubscriptionUCase(ctrl)
+		m.subscriptionRepository.EXPECT().GetDueSubscriptions(gomock.Any(), gomock.Any()).
+			Return([]entities.Subscription{testSubscription(1, constants.SubscriptionActive, 0, nil)}, nil)
+		m.paymentOrderUCase.EXPECT().CreatePaymentOrders(gomock.Any(), gomock.Any(), "vendor-1", gomock.Any()).
+			Return(nil, fmt.Errorf("%w: subscription-1-1", ucasetypes.ErrDuplicateRequestID))
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByRequestID(gomock.Any(), "vendor-1", "subscription-1-1").
+			Return(dto.PaymentOrderDTOResponse{ID: 9, RequestID: "subscription-1-1", Status: constants.Pending}, nil)
+
+		// The cycle is billed with the order created before, never with a second one
+		m.subscriptionRepository.EXPECT().
+			UpdateSubscription(gomock.Any(), uint64(1), nil, map[string]any{"current_order_id": uint64(9), "cycle": uint(1)}).
+			Return(true, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(9)).
+			Return(dto.PaymentOrderDTOResponse{ID: 9, RequestID: "subscription-1-1", Status: constants.Pending}, nil)
+
+		require.NoError(t, ucase.BillDueSubscriptions(context.Background()))
+	})
+
+	t.Run("CycleBilledMeanwhile", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestSubscriptionUCase(ctrl)
+		m.subscriptionRepository.EXPECT().GetDueSubscriptions(gomock.Any(), gomock.Any()).
+			Return([]entities.Subscription{testSubscription(1, constants.SubscriptionActive, 0, nil)}, nil)
+		m.paymentOrderUCase.EXPECT().CreatePaymentOrders(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]dto.CreatedPaymentOrderDTO{{ID: 9}}, nil)
+		m.subscriptionRepository.EXPECT().UpdateSubscription(gomock.Any(), uint64(1), nil, gomock.Any()).Return(false, nil)
+
+		// No webhook is sent for the cycle billed by the other run, the order is not fetched
+		require.NoError(t, ucase.BillDueSubscriptions(context.Background()))
+	})
+
+	t.Run("FailureDoesNotStopOtherSubscriptions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestSubscriptionUCase(ctrl)
+		m.subscriptionRepository.EXPECT().GetDueSubscriptions(gomock.Any(), gomock.Any()).Return([]entities.Subscription{
+			testSubscription(1, constants.SubscriptionActive, 0, nil),
+			testSubscription(2, constants.SubscriptionActive, 0, nil),
+		}, nil)
+		m.paymentOrderUCase.EXPECT().CreatePaymentOrders(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("no payment wallet available"))
+		m.paymentOrderUCase.EXPECT().CreatePaymentOrders(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]dto.CreatedPaymentOrderDTO{{ID: 9}}, nil)
+		m.subscriptionRepository.EXPECT().UpdateSubscription(gomock.Any(), uint64(2), nil, gomock.Any()).Return(true, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(9)).
+			Return(dto.PaymentOrderDTOResponse{ID: 9, Status: constants.Pending}, nil)
+
+		require.NoError(t, ucase.BillDueSubscriptions(context.Background()))
+	})
+}
+
+func TestTrackBillingSubscriptions(t *testing.T) {
+	orderID := uint64(9)
+
+	t.Run("PaidCycleSchedulesNextOne", func(t *testing.T) {
+		for _, status := range []string{constants.Success, constants.Overpaid} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				// The subscription is PAST_DUE when its expired order is paid late, it is ACTIVE again
+				ucase, m := newTestSubscriptionUCase(ctrl)
+				m.subscriptionRepository.EXPECT().GetBillingSubscriptions(gomock.Any()).
+					Return([]entities.Subscription{testSubscription(1, constants.SubscriptionPastDue, 2, &orderID)}, nil)
+				m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), orderID).
+					Return(dto.PaymentOrderDTOResponse{ID: orderID, Status: status}, nil)
+				m.subscriptionRepository.EXPECT().UpdateSubscription(gomock.Any(), uint64(1), &orderID, map[string]any{
+					"status":           constants.SubscriptionActive,
+					"current_order_id": nil,
+					"next_billing_at":  time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC),
+				}).Return(true, nil)
+
+				require.NoError(t, ucase.TrackBillingSubscriptions(context.Background()))
+			})
+		}
+	})
+
+	t.Run("ExpiredOrderMakesSubscriptionPastDue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestSubscriptionUCase(ctrl)
+		m.subscriptionRepository.EXPECT().GetBillingSubscriptions(gomock.Any()).
+			Return([]entities.Subscription{testSubscription(1, constants.SubscriptionActive, 2, &orderID)}, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), orderID).
+			Return(dto.PaymentOrderDTOResponse{ID: orderID, Status: constants.Expired}, nil)
+
+		// The order of the cycle stays attached, it can still be paid
+		m.subscriptionRepository.EXPECT().
+			UpdateSubscription(gomock.Any(), uint64(1), &orderID, map[string]any{"status": constants.SubscriptionPastDue}).
+			Return(true, nil)
+
+		require.NoError(t, ucase.TrackBillingSubscriptions(context.Background()))
+	})
+
+	t.Run("PastDueSubscriptionIsLeftAsIs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestSubscriptionUCase(ctrl)
+		m.subscriptionRepository.EXPECT().GetBillingSubscriptions(gomock.Any()).
+			Return([]entities.Subscription{testSubscription(1, constants.SubscriptionPastDue, 2, &orderID)}, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), orderID).
+			Return(dto.PaymentOrderDTOResponse{ID: orderID, Status: constants.Expired}, nil)
+
+		require.NoError(t, ucase.TrackBillingSubscriptions(context.Background()))
+	})
+
+	t.Run("UnpaidOrderCancelsSubscription", func(t *testing.T) {
+		for _, status := range []string{constants.Failed, constants.Cancelled} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestSubscriptionUCase(ctrl)
+				m.subscriptionRepository.EXPECT().GetBillingSubscriptions(gomock.Any()).
+					Return([]entities.Subscription{testSubscription(1, constants.SubscriptionPastDue, 2, &orderID)}, nil)
+				m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), orderID).
+					Return(dto.PaymentOrderDTOResponse{ID: orderID, Status: status}, nil)
+				m.subscriptionRepository.EXPECT().UpdateSubscription(gomock.Any(), uint64(1), &orderID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uint64, _ *uint64, updates map[string]any) (bool, error) {
+						require.Equal(t, constants.SubscriptionCancelled, updates["status"])
+						require.Contains(t, updates, "cancelled_at")
+						return true, nil
+					})
+
+				require.NoError(t, ucase.TrackBillingSubscriptions(context.Background()))
+			})
+		}
+	})
+
+	t.Run("OrderNotSettledYet", func(t *testing.T) {
+		for _, status := range []string{constants.Pending, constants.Processing, constants.Partial, constants.OnHold} {
+			t.Run(status, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ucase, m := newTestSubscriptionUCase(ctrl)
+				m.subscriptionRepository.EXPECT().GetBillingSubscriptions(gomock.Any()).
+					Return([]entities.Subscription{testSubscription(1, constants.SubscriptionActive, 2, &orderID)}, nil)
+				m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), orderID).
+					Return(dto.PaymentOrderDTOResponse{ID: orderID, Status: status}, nil)
+
+				require.NoError(t, ucase.TrackBillingSubscriptions(context.Background()))
+			})
+		}
+	})
+
+	t.Run("FailureDoesNotStopOtherSubscriptions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ucase, m := newTestSubscriptionUCase(ctrl)
+		otherOrderID := uint64(10)
+		m.subscriptionRepository.EXPECT().GetBillingSubscriptions(gomock.Any()).Return([]entities.Subscription{
+			testSubscription(1, constants.SubscriptionActive, 2, &orderID),
+			testSubscription(2, constants.SubscriptionActive, 2, &otherOrderID),
+		}, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), orderID).
+			Return(dto.PaymentOrderDTOResponse{}, fmt.Errorf("database unavailable"))
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), otherOrderID).
+			Return(dto.PaymentOrderDTOResponse{ID: otherOrderID, Status: constants.Expired}, nil)
+		m.subscriptionRepository.EXPECT().UpdateSubscription(gomock.Any(), uint64(2), &otherOrderID, gomock.Any()).Return(true, nil)
+
+		require.NoError(t, ucase.TrackBillingSubscriptions(context.Background()))
+	})
+}
+
+func TestNextBillingTime(t *testing.T) {
+	anchor := time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		interval string
+		cycles   uint
+		expected time.Time
+	}{
+		{"Daily", constants.SubscriptionDaily, 3, time.Date(2024, time.February, 3, 10, 0, 0, 0, time.UTC)},
+		{"Weekly", constants.SubscriptionWeekly, 2, time.Date(2024, time.February, 14, 10, 0, 0, 0, time.UTC)},
+		// Counted from the anchor, the short February does not shift the billing date of March
+		{"Monthly", constants.SubscriptionMonthly, 2, time.Date(2024, time.March, 31, 10, 0, 0, 0, time.UTC)},
+		{"Yearly", constants.SubscriptionYearly, 1, time.Date(2025, time.January, 31, 10, 0, 0, 0, time.UTC)},
+		{"UnknownInterval", "HOURLY", 1, anchor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, nextBillingTime(anchor, tt.interval, tt.cycles))
+		})
+	}
+}
@@ -76,6 +76,7 @@ func (u *tokenTransferUCase) CreateTokenTransferHistories(ctx context.Context, p
 
 	for _, payload := range payloads {
 		models = append(models, entities.TokenTransferHistory{
+			RequestID:       payload.RequestID,
 			Network:         payload.Network,
 			TransactionHash: payload.TransactionHash,
 			FromAddress:     payload.FromAddress,
@@ -0,0 +1,85 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+)
+
+type tolerancePolicyUCase struct {
+	tolerancePolicyRepository repotypes.TolerancePolicyRepository
+}
+
+func NewTolerancePolicyUCase(tolerancePolicyRepository repotypes.TolerancePolicyRepository) ucasetypes.TolerancePolicyUCase {
+	return &tolerancePolicyUCase{
+		tolerancePolicyRepository: tolerancePolicyRepository,
+	}
+}
+
+func (u *tolerancePolicyUCase) GetTolerancePolicies(ctx context.Context) ([]dto.TolerancePolicyDTO, error) {
+	policies, err := u.tolerancePolicyRepository.GetTolerancePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policiesDTO := make([]dto.TolerancePolicyDTO, 0, len(policies))
+	for _, policy := range policies {
+		policiesDTO = append(policiesDTO, policy.ToDto())
+	}
+	return policiesDTO, nil
+}
+
+func (u *tolerancePolicyUCase) UpsertTolerancePolicy(
+	ctx context.Context, payload dto.TolerancePolicyPayloadDTO,
+) (dto.TolerancePolicyDTO, error) {
+	policy := entities.TolerancePolicy{
+		VendorID:        payload.VendorID,
+		Symbol:          payload.Symbol,
+		UnderAbsolute:   payload.UnderAbsolute,
+		UnderPercentage: payload.UnderPercentage,
+		OverAbsolute:    payload.OverAbsolute,
+		OverPercentage:  payload.OverPercentage,
+	}
+	if err := u.tolerancePolicyRepository.UpsertTolerancePolicy(ctx, &policy); err != nil {
+		return dto.TolerancePolicyDTO{}, err
+	}
+	return policy.ToDto(), nil
+}
+
+func (u *tolerancePolicyUCase) DeleteTolerancePolicy(ctx context.Context, vendorID, symbol string) error {
+	deleted, err := u.tolerancePolicyRepository.DeleteTolerancePolicy(ctx, vendorID, symbol)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("vendor %q, symbol %q: %w", vendorID, symbol, ucasetypes.ErrTolerancePolicyNotFound)
+	}
+	return nil
+}
+
+// resolveTolerancePolicy returns the most specific policy matching the vendor and symbol: the vendor and symbol one,
+// then the vendor one, the symbol one and the global one. PAYMENT_COVERING is the under tolerance when none matches.
+func resolveTolerancePolicy(policies []entities.TolerancePolicy, vendorID, symbol string) payment.TolerancePolicy {
+	candidates := [][2]string{{vendorID, symbol}, {vendorID, ""}, {"", symbol}, {"", ""}}
+	for _, candidate := range candidates {
+		for _, policy := range policies {
+			if policy.VendorID == candidate[0] && policy.Symbol == candidate[1] {
+				return payment.TolerancePolicy{
+					UnderAbsolute:   policy.UnderAbsolute,
+					UnderPercentage: policy.UnderPercentage,
+					OverAbsolute:    policy.OverAbsolute,
+					OverPercentage:  policy.OverPercentage,
+				}
+			}
+		}
+	}
+
+	paymentCovering := conf.GetPaymentCovering()
+	return payment.TolerancePolicy{UnderAbsolute: &paymentCovering}
+}
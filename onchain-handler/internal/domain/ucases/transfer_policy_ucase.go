@@ -0,0 +1,288 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+)
+
+type transferPolicyUCase struct {
+	transferPolicyRepository repotypes.TransferPolicyRepository
+	tokenTransferRepository  repotypes.TokenTransferRepository
+}
+
+func NewTransferPolicyUCase(
+	transferPolicyRepository repotypes.TransferPolicyRepository,
+	tokenTransferRepository repotypes.TokenTransferRepository,
+) ucasetypes.TransferPolicyUCase {
+	return &transferPolicyUCase{
+		transferPolicyRepository: transferPolicyRepository,
+		tokenTransferRepository:  tokenTransferRepository,
+	}
+}
+
+func (u *transferPolicyUCase) GetTransferPolicies(ctx context.Context) ([]dto.TransferPolicyDTO, error) {
+	policies, err := u.transferPolicyRepository.GetTransferPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policiesDTO := make([]dto.TransferPolicyDTO, 0, len(policies))
+	for _, policy := range policies {
+		policiesDTO = append(policiesDTO, policy.ToDto())
+	}
+	return policiesDTO, nil
+}
+
+func (u *transferPolicyUCase) UpsertTransferPolicy(
+	ctx context.Context, payload dto.TransferPolicyPayloadDTO,
+) (dto.TransferPolicyDTO, error) {
+	requiredApprovals := payload.RequiredApprovals
+	if requiredApprovals == 0 {
+		requiredApprovals = 1
+	}
+
+	policy := entities.TransferPolicy{
+		Network:           payload.Network,
+		Symbol:            payload.Symbol,
+		ApprovalThreshold: payload.ApprovalThreshold,
+		RequiredApprovals: requiredApprovals,
+		DailyLimit:        payload.DailyLimit,
+		AllowListOnly:     payload.AllowListOnly,
+	}
+	if err := u.transferPolicyRepository.UpsertTransferPolicy(ctx, &policy); err != nil {
+		return dto.TransferPolicyDTO{}, err
+	}
+	return policy.ToDto(), nil
+}
+
+func (u *transferPolicyUCase) DeleteTransferPolicy(ctx context.Context, network, symbol string) error {
+	deleted, err := u.transferPolicyRepository.DeleteTransferPolicy(ctx, network, symbol)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("network %q, symbol %q: %w", network, symbol, ucasetypes.ErrTransferPolicyNotFound)
+	}
+	return nil
+}
+
+func (u *transferPolicyUCase) GetAllowedAddresses(ctx context.Context) ([]dto.TransferAllowedAddressDTO, error) {
+	addresses, err := u.transferPolicyRepository.GetAllowedAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addressesDTO := make([]dto.TransferAllowedAddressDTO, 0, len(addresses))
+	for _, address := range addresses {
+		addressesDTO = append(addressesDTO, address.ToDto())
+	}
+	return addressesDTO, nil
+}
+
+func (u *transferPolicyUCase) UpsertAllowedAddress(
+	ctx context.Context, payload dto.TransferAllowedAddressPayloadDTO,
+) (dto.TransferAllowedAddressDTO, error) {
+	allowedAddress := entities.TransferAllowedAddress{
+		Network: payload.Network,
+		Address: formatNetworkAddress(payload.Network, payload.Address),
+		Label:   payload.Label,
+	}
+	if err := u.transferPolicyRepository.UpsertAllowedAddress(ctx, &allowedAddress); err != nil {
+		return dto.TransferAllowedAddressDTO{}, err
+	}
+	return allowedAddress.ToDto(), nil
+}
+
+func (u *transferPolicyUCase) DeleteAllowedAddress(ctx context.Context, network, address string) error {
+	address = formatNetworkAddress(network, address)
+	deleted, err := u.transferPolicyRepository.DeleteAllowedAddress(ctx, network, address)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("network %q, address %s: %w", network, address, ucasetypes.ErrAllowedAddressNotFound)
+	}
+	return nil
+}
+
+// formatNetworkAddress returns the address as written on the network, the form the allow-list stores it in
+func formatNetworkAddress(network, address string) string {
+	family := chain.ForNetwork(constants.NetworkType(network))
+	parsed, err := family.ParseAddress(address)
+	if err != nil {
+		return common.HexToAddress(address).Hex()
+	}
+	return family.FormatAddress(parsed)
+}
+
+func (u *transferPolicyUCase) GetTransferDecisions(
+	ctx context.Context,
+	transferKind string,
+	transferID *uint64,
+) ([]dto.TransferDecisionDTO, error) {
+	decisions, err := u.transferPolicyRepository.GetTransferDecisions(ctx, transferKind, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	decisionsDTO := make([]dto.TransferDecisionDTO, 0, len(decisions))
+	for _, decision := range decisions {
+		decisionsDTO = append(decisionsDTO, decision.ToDto())
+	}
+	return decisionsDTO, nil
+}
+
+// EvaluateTransfer returns the decision of the transfer policy of the network and symbol on a transfer of the amount
+// to the address. The decision is not recorded, the caller records it once the transfer is created.
+func (u *transferPolicyUCase) EvaluateTransfer(
+	ctx context.Context,
+	transferKind, network, symbol, toAddress, amount string,
+) (dto.TransferEvaluationDTO, error) {
+	policy, err := u.resolveTransferPolicy(ctx, transferKind, network, symbol)
+	if err != nil {
+		return dto.TransferEvaluationDTO{}, err
+	}
+
+	allowListed := false
+	if policy.AllowListOnly {
+		allowListed, err = u.transferPolicyRepository.IsAddressAllowed(ctx, network, formatNetworkAddress(network, toAddress))
+		if err != nil {
+			return dto.TransferEvaluationDTO{}, err
+		}
+	}
+
+	decision, reason := payment.EvaluateTransfer(amount, policy, allowListed)
+	requiredApprovals := policy.RequiredApprovals
+	if requiredApprovals == 0 {
+		requiredApprovals = 1
+	}
+	return dto.TransferEvaluationDTO{
+		Decision:          decision,
+		Reason:            reason,
+		RequiredApprovals: requiredApprovals,
+	}, nil
+}
+
+// RecordTransferDecisions appends the decisions to the audit.
+func (u *transferPolicyUCase) RecordTransferDecisions(ctx context.Context, decisions []dto.TransferDecisionDTO) error {
+	records := make([]entities.TransferDecision, 0, len(decisions))
+	for _, decision := range decisions {
+		records = append(records, toTransferDecision(decision))
+	}
+	return u.transferPolicyRepository.CreateTransferDecisions(ctx, records)
+}
+
+// RecordApproval records the approval of a held transfer by its actor and returns the number of distinct approvers
+// of the transfer so far. An approver approving the same transfer twice gets ErrDuplicateApproval, and the requester
+// of the transfer approving it gets ErrSelfApproval.
+func (u *transferPolicyUCase) RecordApproval(
+	ctx context.Context,
+	decision dto.TransferDecisionDTO,
+	requester string,
+) (uint, error) {
+	if decision.Actor == requester {
+		return 0, fmt.Errorf("%w: %s", ucasetypes.ErrSelfApproval, decision.Actor)
+	}
+
+	decisions, err := u.transferPolicyRepository.GetTransferDecisions(ctx, decision.TransferKind, decision.TransferID)
+	if err != nil {
+		return 0, err
+	}
+	for _, existing := range decisions {
+		if existing.Decision == constants.TransferDecisionApproved && existing.Actor == decision.Actor {
+			return 0, fmt.Errorf("%w: %s", ucasetypes.ErrDuplicateApproval, decision.Actor)
+		}
+	}
+
+	// The unique index on the approvers of a transfer rejects a concurrent duplicate
+	decision.Decision = constants.TransferDecisionApproved
+	if err := u.transferPolicyRepository.CreateTransferDecisions(ctx, []entities.TransferDecision{toTransferDecision(decision)}); err != nil {
+		return 0, err
+	}
+
+	approvals, err := u.transferPolicyRepository.CountApprovals(ctx, decision.TransferKind, *decision.TransferID)
+	if err != nil {
+		return 0, err
+	}
+	return uint(approvals), nil
+}
+
+// GetDailyLimitRoom returns how much of the daily limit of the network and symbol is left today (UTC), in token units
+// with PaymentAmountDecimalPlaces decimals. It returns nil when the policy sets no daily limit.
+func (u *transferPolicyUCase) GetDailyLimitRoom(ctx context.Context, network, symbol string) (*big.Int, error) {
+	// The daily limit does not depend on the transfer kind
+	policy, err := u.resolveTransferPolicy(ctx, constants.OutboundWithdrawal, network, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if policy.DailyLimit == nil {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	sentToday, err := u.tokenTransferRepository.GetOutboundTokenAmount(ctx, network, symbol, dayStart)
+	if err != nil {
+		return nil, err
+	}
+	return payment.DailyLimitRoom(policy, sentToday)
+}
+
+// resolveTransferPolicy returns the most specific policy matching the network and symbol: the network and symbol one,
+// then the network one, the symbol one and the global one. When none matches payouts wait for one approval,
+// as before transfer policies, and withdrawals are sent without approval.
+func (u *transferPolicyUCase) resolveTransferPolicy(
+	ctx context.Context,
+	transferKind, network, symbol string,
+) (payment.TransferPolicy, error) {
+	policies, err := u.transferPolicyRepository.GetTransferPolicies(ctx)
+	if err != nil {
+		return payment.TransferPolicy{}, err
+	}
+
+	candidates := [][2]string{{network, symbol}, {network, ""}, {"", symbol}, {"", ""}}
+	for _, candidate := range candidates {
+		for _, policy := range policies {
+			if policy.Network == candidate[0] && policy.Symbol == candidate[1] {
+				return payment.TransferPolicy{
+					ApprovalThreshold: policy.ApprovalThreshold,
+					RequiredApprovals: policy.RequiredApprovals,
+					DailyLimit:        policy.DailyLimit,
+					AllowListOnly:     policy.AllowListOnly,
+				}, nil
+			}
+		}
+	}
+
+	if transferKind == constants.OutboundPayout {
+		holdAll := 0.0
+		return payment.TransferPolicy{ApprovalThreshold: &holdAll, RequiredApprovals: 1}, nil
+	}
+	return payment.TransferPolicy{}, nil
+}
+
+func toTransferDecision(decision dto.TransferDecisionDTO) entities.TransferDecision {
+	return entities.TransferDecision{
+		TransferKind: decision.TransferKind,
+		TransferID:   decision.TransferID,
+		Network:      decision.Network,
+		Symbol:       decision.Symbol,
+		ToAddress:    decision.ToAddress,
+		Amount:       decision.Amount,
+		Decision:     decision.Decision,
+		Actor:        decision.Actor,
+		Reason:       decision.Reason,
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrBlockAfterLatest is returned when resetting the last processed block of a network past its latest block.
+var ErrBlockAfterLatest = errors.New("block is after the latest block")
+
+// AdminUCase runs the operations of the admin API, each call is recorded in the audit log under the actor.
+type AdminUCase interface {
+	GetBlockStates(ctx context.Context, actor string) ([]dto.BlockStateDTO, error)
+	ResetLastProcessedBlock(
+		ctx context.Context,
+		actor string,
+		network constants.NetworkType,
+		blockNumber uint64,
+		reason string,
+	) (dto.BlockStateDTO, error)
+	ReleasePaymentWallet(ctx context.Context, actor string, walletID uint64, reason string) (dto.PaymentWalletDTO, error)
+	LockPaymentWallet(ctx context.Context, actor string, walletID uint64, reason string) (dto.PaymentWalletDTO, error)
+	ResolvePaymentOrder(ctx context.Context, actor, vendorID, requestID, status, reason string) (dto.PaymentOrderDTOResponse, error)
+	SyncWalletBalances(
+		ctx context.Context,
+		actor string,
+		payload dto.AdminSyncBalancesPayloadDTO,
+		tokenSymbols []string,
+	) (map[string]map[string]string, error)
+	GetOrderSet(ctx context.Context, actor string) []dto.PaymentOrderDTO
+	GetAuditLogs(ctx context.Context, action *string, page, size int) (dto.PaginationDTOResponse, error)
+	GetPaymentOrderAudits(ctx context.Context, vendorID, requestID string) ([]dto.PaymentOrderAuditDTO, error)
+}
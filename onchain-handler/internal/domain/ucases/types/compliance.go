@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrDeniedAddressNotFound is returned when deleting a deny-list entry that does not exist.
+var ErrDeniedAddressNotFound = errors.New("denied address not found")
+
+// ErrComplianceHoldNotFound is returned when there is no compliance hold with the ID.
+var ErrComplianceHoldNotFound = errors.New("compliance hold not found")
+
+// ErrComplianceHoldNotReviewable is returned when releasing or rejecting a compliance hold that is no longer HELD.
+var ErrComplianceHoldNotReviewable = errors.New("compliance hold is not held")
+
+type ComplianceUCase interface {
+	ScreenPayment(ctx context.Context, payload dto.ComplianceHoldPayloadDTO) (*dto.ComplianceHoldDTO, error)
+	RecordHeldPayment(ctx context.Context, hold dto.ComplianceHoldDTO, payload dto.PaymentEventPayloadDTO) (bool, error)
+	ResumeHold(ctx context.Context, hold dto.ComplianceHoldDTO) error
+	GetPaymentHold(ctx context.Context, orderID uint64, txHash string, logIndex uint) (*dto.ComplianceHoldDTO, error)
+	GetDeniedAddresses(ctx context.Context) ([]dto.DeniedAddressDTO, error)
+	UpsertDeniedAddress(ctx context.Context, payload dto.DeniedAddressPayloadDTO) (dto.DeniedAddressDTO, error)
+	DeleteDeniedAddress(ctx context.Context, address, network string) error
+	GetComplianceHolds(ctx context.Context, status string) ([]dto.ComplianceHoldDTO, error)
+	ReleaseComplianceHold(ctx context.Context, id uint64, reviewer, note string) (dto.ComplianceHoldDTO, error)
+	RejectComplianceHold(ctx context.Context, id uint64, reviewer, note string) (dto.ComplianceHoldDTO, error)
+}
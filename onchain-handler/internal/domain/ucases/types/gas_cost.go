@@ -0,0 +1,27 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+type GasCostUCase interface {
+	AttributeSweepFee(
+		ctx context.Context,
+		network, symbol, walletAddress, transactionHash, nativePriceUSD string,
+		fees []string,
+	) error
+	AttributeWithdrawFee(
+		ctx context.Context,
+		network, symbol, receivingWalletAddress, transactionHash, nativePriceUSD string,
+		fees []string,
+	) error
+	GetNetworkFeeStatistics(
+		ctx context.Context,
+		vendorID string,
+		startTime, endTime time.Time,
+		symbols []string,
+	) ([]dto.NetworkFeeStats, error)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrInvalidGaslessPayment is returned when a gasless payment cannot be relayed for the order as signed.
+var ErrInvalidGaslessPayment = errors.New("invalid gasless payment")
+
+type GaslessPaymentUCase interface {
+	GetRelayerAddress() (string, error)
+	SubmitGaslessPayment(ctx context.Context, vendorID string, payload dto.GaslessPaymentPayloadDTO) (dto.GaslessPaymentDTO, error)
+	GetRelayerFees(ctx context.Context, vendorID string) ([]dto.RelayerFeeDTO, error)
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrIdempotencyKeyMismatch is returned when an idempotency key is reused with a different request.
+var ErrIdempotencyKeyMismatch = errors.New("idempotency key is already used with a different request")
+
+// ErrIdempotencyKeyInProgress is returned when the first request sent with an idempotency key is not completed yet.
+var ErrIdempotencyKeyInProgress = errors.New("request with the idempotency key is still in progress")
+
+type IdempotencyUCase interface {
+	BeginRequest(ctx context.Context, vendorID, key, requestHash string) (*dto.IdempotentResponseDTO, error)
+	CompleteRequest(ctx context.Context, vendorID, key string, statusCode int, response string) error
+	ReleaseRequest(ctx context.Context, vendorID, key string) error
+}
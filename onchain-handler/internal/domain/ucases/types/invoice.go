@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrInvoiceNotFound is returned when the vendor has no invoice with the request ID.
+var ErrInvoiceNotFound = errors.New("invoice not found")
+
+type InvoiceUCase interface {
+	CreateInvoice(
+		ctx context.Context,
+		payload dto.InvoicePayloadDTO,
+		vendorID string,
+		expiredOrderTime time.Duration,
+	) (dto.CreatedInvoiceDTO, error)
+	GetInvoice(ctx context.Context, vendorID, requestID string) (dto.InvoiceDTO, error)
+	ReconcileOpenInvoices(ctx context.Context) error
+}
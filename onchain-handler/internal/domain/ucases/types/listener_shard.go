@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+type ListenerShardUCase interface {
+	Heartbeat(ctx context.Context, instanceID string) error
+	Rebalance(
+		ctx context.Context,
+		networks []constants.NetworkType,
+		partitionCount uint32,
+		instanceTimeout time.Duration,
+	) error
+	GetAssignedShards(ctx context.Context, instanceID string) ([]dto.ListenerShardDTO, error)
+	Deregister(ctx context.Context, instanceID string) error
+	UpdateShardLastProcessedBlock(ctx context.Context, shard dto.ListenerShardDTO, blockNumber uint64) error
+}
@@ -3,9 +3,18 @@ package types
 import (
 	"context"
 
+	"github.com/genefriendway/onchain-handler/constants"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 )
 
 type PaymentEventHistoryUCase interface {
-	CreatePaymentEventHistory(ctx context.Context, payloads []dto.PaymentEventPayloadDTO) error
+	CreatePaymentEventHistory(ctx context.Context, payloads []dto.PaymentEventPayloadDTO) (int, error)
+	CreditPaymentEvent(ctx context.Context, payload dto.PaymentEventPayloadDTO, vendorID string, walletID *uint64) (bool, error)
+	IsPaymentEventRecorded(
+		ctx context.Context,
+		network constants.NetworkType,
+		transactionHash string,
+		logIndex uint,
+		toAddress, contractAddress string,
+	) (bool, error)
 }
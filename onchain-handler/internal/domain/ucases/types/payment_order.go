@@ -2,12 +2,32 @@ package types
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"time"
 
 	"github.com/genefriendway/onchain-handler/constants"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 )
 
+// ErrPaymentOrderNotCancellable is returned when cancelling an order that is not PENDING.
+var ErrPaymentOrderNotCancellable = errors.New("payment order is not cancellable")
+
+// ErrPaymentOrderNotExtendable is returned when extending an order that is not PENDING or PARTIAL,
+// or beyond the maximum expiry time.
+var ErrPaymentOrderNotExtendable = errors.New("payment order is not extendable")
+
+// ErrPaymentOrderNotResolvable is returned when manually marking an order with the status it already has,
+// or an order that is already paid or cancelled.
+var ErrPaymentOrderNotResolvable = errors.New("payment order is not resolvable")
+
+// ErrUnsupportedOrderChange is returned when moving an order to a network of another chain family,
+// to a token that is not supported on its network, or when moving a multi-chain order out of its options.
+var ErrUnsupportedOrderChange = errors.New("unsupported payment order change")
+
+// ErrDuplicateRequestID is returned when creating an order with a request ID the vendor already used.
+var ErrDuplicateRequestID = errors.New("duplicate request id")
+
 type PaymentOrderUCase interface {
 	CreatePaymentOrders(
 		ctx context.Context,
@@ -15,20 +35,37 @@ type PaymentOrderUCase interface {
 		vendorID string,
 		expiredOrderTime time.Duration,
 	) ([]dto.CreatedPaymentOrderDTO, error)
+	CancelPaymentOrder(ctx context.Context, vendorID, requestID string) (dto.PaymentOrderDTOResponse, error)
+	ResolvePaymentOrder(ctx context.Context, vendorID, requestID, status string) (dto.PaymentOrderDTOResponse, error)
+	GetPaymentOrderAudits(ctx context.Context, vendorID, requestID string) ([]dto.PaymentOrderAuditDTO, error)
+	ExtendPaymentOrder(ctx context.Context, vendorID, requestID string, extendTime time.Duration) (dto.PaymentOrderDTOResponse, error)
 	UpdateExpiredOrdersToFailed(ctx context.Context) ([]uint64, error)
 	UpdateActiveOrdersToExpired(ctx context.Context) ([]uint64, error)
 	GetExpiredPaymentOrders(ctx context.Context, network constants.NetworkType) ([]dto.PaymentOrderDTO, error)
+	GetPaymentOrdersOpenBetween(
+		ctx context.Context,
+		network constants.NetworkType,
+		startTime, endTime time.Time,
+	) ([]dto.PaymentOrderDTO, error)
 	UpdatePaymentOrder(
 		ctx context.Context,
 		orderID uint64,
 		blockHeight, upcomingBlockHeight *uint64,
 		status, transferredAmount, network *string,
 	) error
-	UpdateOrderNetwork(ctx context.Context, requestID string, network constants.NetworkType) error
+	UpdateOrderNetwork(ctx context.Context, vendorID, requestID string, network constants.NetworkType) error
+	SelectPaymentOption(ctx context.Context, orderID uint64, network, symbol string) error
 	UpdateOrderToSuccessAndReleaseWallet(
 		ctx context.Context,
 		orderID uint64,
-	) error
+		status string,
+	) (bool, error)
+	EvaluatePayment(
+		ctx context.Context,
+		vendorID, symbol string,
+		amount, transferred *big.Int,
+		tokenDecimals uint8,
+	) (string, error)
 	BatchUpdateOrdersToExpired(ctx context.Context, orderIDs []uint64) error
 	BatchUpdateOrderBlockHeights(ctx context.Context, orders []dto.PaymentOrderDTO) error
 	GetActivePaymentOrders(ctx context.Context) ([]dto.PaymentOrderDTO, error)
@@ -44,12 +81,13 @@ type PaymentOrderUCase interface {
 	) (dto.PaginationDTOResponse, error)
 	GetPaymentOrderByID(ctx context.Context, id uint64) (dto.PaymentOrderDTOResponse, error)
 	GetPaymentOrdersByIDs(ctx context.Context, ids []uint64) ([]dto.PaymentOrderDTOResponse, error)
-	GetPaymentOrderByRequestID(ctx context.Context, requestID string) (dto.PaymentOrderDTOResponse, error)
+	GetPaymentOrderByRequestID(ctx context.Context, vendorID, requestID string) (dto.PaymentOrderDTOResponse, error)
+	GetPaymentURI(ctx context.Context, order dto.PaymentOrderDTOResponse) string
 	ReleaseWalletsForSuccessfulOrders(ctx context.Context) error
 	GetProcessingOrdersExpired(ctx context.Context, network constants.NetworkType) ([]dto.PaymentOrderDTOResponse, error)
 	UpdateOrderMetaByRequestID(
 		ctx context.Context,
-		requestID string,
+		vendorID, requestID string,
 		payloadf dto.UpdatePaymentOrderPayloadDTO,
 	) error
 }
@@ -35,6 +35,13 @@ type PaymentWalletUCase interface {
 	GetReceivingWalletAddressWithBalances(
 		ctx context.Context, mnemonic, passphrase, salt string,
 	) (string, map[constants.NetworkType]string, error)
+	GetGasSourceWalletAddressWithBalances(
+		ctx context.Context, mnemonic, passphrase, salt string,
+	) (string, map[constants.NetworkType]string, error)
+	GetNetworkWalletAddress(
+		network constants.NetworkType, walletType constants.WalletType, mnemonic, passphrase, salt string,
+	) (string, error)
+	AssignMissingTronAddresses(ctx context.Context) error
 	SyncWalletBalances(
 		ctx context.Context,
 		walletAddress string,
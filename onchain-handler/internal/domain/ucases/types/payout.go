@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrUserWalletNotFound is returned when no wallet is registered for the user.
+var ErrUserWalletNotFound = errors.New("user wallet not found")
+
+// ErrPayoutNotFound is returned when there is no payout with the ID or request ID.
+var ErrPayoutNotFound = errors.New("payout not found")
+
+// ErrPayoutNotReviewable is returned when approving or rejecting a payout that is no longer PENDING_APPROVAL.
+var ErrPayoutNotReviewable = errors.New("payout is not pending approval")
+
+type PayoutUCase interface {
+	RegisterUserWallet(ctx context.Context, payload dto.UserWalletPayloadDTO) (dto.UserWalletDTO, error)
+	GetUserWallet(ctx context.Context, userID uint64) (dto.UserWalletDTO, error)
+	GetPayoutWalletAddress() (string, error)
+	CreatePayouts(ctx context.Context, vendorID string, payloads []dto.PayoutPayloadDTO) ([]dto.PayoutDTO, error)
+	GetPayout(ctx context.Context, vendorID, requestID string) (dto.PayoutDTO, error)
+	GetPayouts(ctx context.Context, status string) ([]dto.PayoutDTO, error)
+	ApprovePayout(ctx context.Context, id uint64, approver, reason string) (dto.PayoutDTO, error)
+	RejectPayout(ctx context.Context, id uint64, approver, reason string) (dto.PayoutDTO, error)
+	ClaimApprovedPayouts(ctx context.Context, network string, limit int) ([]dto.PayoutDTO, error)
+	CompletePayout(ctx context.Context, id uint64, status, transactionHash, fee, errorMessage string) error
+}
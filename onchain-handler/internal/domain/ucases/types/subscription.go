@@ -0,0 +1,31 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrSubscriptionPlanNotFound is returned when the vendor has no subscription plan with the ID.
+var ErrSubscriptionPlanNotFound = errors.New("subscription plan not found")
+
+// ErrSubscriptionNotFound is returned when the vendor has no subscription with the ID.
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// ErrSubscriptionNotCancellable is returned when cancelling a subscription that is already cancelled.
+var ErrSubscriptionNotCancellable = errors.New("subscription is not cancellable")
+
+type SubscriptionUCase interface {
+	CreateSubscriptionPlan(
+		ctx context.Context,
+		vendorID string,
+		payload dto.SubscriptionPlanPayloadDTO,
+	) (dto.SubscriptionPlanDTO, error)
+	GetSubscriptionPlans(ctx context.Context, vendorID string) ([]dto.SubscriptionPlanDTO, error)
+	CreateSubscription(ctx context.Context, vendorID string, payload dto.SubscriptionPayloadDTO) (dto.SubscriptionDTO, error)
+	GetSubscription(ctx context.Context, vendorID string, id uint64) (dto.SubscriptionDTO, error)
+	CancelSubscription(ctx context.Context, vendorID string, id uint64) (dto.SubscriptionDTO, error)
+	BillDueSubscriptions(ctx context.Context) error
+	TrackBillingSubscriptions(ctx context.Context) error
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrTolerancePolicyNotFound is returned when deleting a tolerance policy that does not exist.
+var ErrTolerancePolicyNotFound = errors.New("tolerance policy not found")
+
+type TolerancePolicyUCase interface {
+	GetTolerancePolicies(ctx context.Context) ([]dto.TolerancePolicyDTO, error)
+	UpsertTolerancePolicy(ctx context.Context, payload dto.TolerancePolicyPayloadDTO) (dto.TolerancePolicyDTO, error)
+	DeleteTolerancePolicy(ctx context.Context, vendorID, symbol string) error
+}
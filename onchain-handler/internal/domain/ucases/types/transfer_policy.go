@@ -0,0 +1,38 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrTransferPolicyNotFound is returned when deleting a transfer policy that does not exist.
+var ErrTransferPolicyNotFound = errors.New("transfer policy not found")
+
+// ErrAllowedAddressNotFound is returned when removing an address that is not on the allow-list.
+var ErrAllowedAddressNotFound = errors.New("address is not on the allow-list")
+
+// ErrDuplicateApproval is returned when an approver approves the same transfer twice.
+var ErrDuplicateApproval = errors.New("transfer already approved by this approver")
+
+// ErrSelfApproval is returned when the requester of a transfer approves it.
+var ErrSelfApproval = errors.New("transfer cannot be approved by its requester")
+
+type TransferPolicyUCase interface {
+	GetTransferPolicies(ctx context.Context) ([]dto.TransferPolicyDTO, error)
+	UpsertTransferPolicy(ctx context.Context, payload dto.TransferPolicyPayloadDTO) (dto.TransferPolicyDTO, error)
+	DeleteTransferPolicy(ctx context.Context, network, symbol string) error
+	GetAllowedAddresses(ctx context.Context) ([]dto.TransferAllowedAddressDTO, error)
+	UpsertAllowedAddress(ctx context.Context, payload dto.TransferAllowedAddressPayloadDTO) (dto.TransferAllowedAddressDTO, error)
+	DeleteAllowedAddress(ctx context.Context, network, address string) error
+	GetTransferDecisions(ctx context.Context, transferKind string, transferID *uint64) ([]dto.TransferDecisionDTO, error)
+	EvaluateTransfer(
+		ctx context.Context,
+		transferKind, network, symbol, toAddress, amount string,
+	) (dto.TransferEvaluationDTO, error)
+	RecordTransferDecisions(ctx context.Context, decisions []dto.TransferDecisionDTO) error
+	RecordApproval(ctx context.Context, decision dto.TransferDecisionDTO, requester string) (uint, error)
+	GetDailyLimitRoom(ctx context.Context, network, symbol string) (*big.Int, error)
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrWithdrawScheduleNotFound is returned when no withdraw schedule matches the network and symbol.
+var ErrWithdrawScheduleNotFound = errors.New("withdraw schedule not found")
+
+// ErrWithdrawSchedulePaused is returned when requesting a sweep of withdraw schedules that are all paused.
+var ErrWithdrawSchedulePaused = errors.New("withdraw schedule is paused")
+
+// ErrInvalidWithdrawSchedule is returned when the cron expression of a withdraw schedule is invalid or never runs.
+var ErrInvalidWithdrawSchedule = errors.New("invalid withdraw schedule")
+
+type WithdrawScheduleUCase interface {
+	EnsureWithdrawSchedules(ctx context.Context, network string, symbols []string) error
+	GetWithdrawSchedules(ctx context.Context, network, symbol string) ([]dto.WithdrawScheduleDTO, error)
+	UpsertWithdrawSchedule(ctx context.Context, payload dto.WithdrawSchedulePayloadDTO) (dto.WithdrawScheduleDTO, error)
+	SetWithdrawSchedulesPaused(ctx context.Context, network, symbol string, paused bool) error
+	RequestWithdrawSweep(ctx context.Context, network, symbol string) error
+	CompleteWithdrawRun(ctx context.Context, schedule dto.WithdrawScheduleDTO, status, message string) error
+}
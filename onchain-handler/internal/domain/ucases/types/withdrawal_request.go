@@ -0,0 +1,25 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+)
+
+// ErrWithdrawalRequestNotFound is returned when there is no withdrawal request with the ID.
+var ErrWithdrawalRequestNotFound = errors.New("withdrawal request not found")
+
+// ErrWithdrawalRequestNotReviewable is returned when approving or rejecting a withdrawal request that is no longer PENDING_APPROVAL.
+var ErrWithdrawalRequestNotReviewable = errors.New("withdrawal request is not pending approval")
+
+type WithdrawalRequestUCase interface {
+	AuthorizeWithdrawal(
+		ctx context.Context,
+		network, symbol, fromAddress, toAddress, balance string,
+	) (dto.WithdrawalAuthorizationDTO, error)
+	CompleteWithdrawal(ctx context.Context, id uint64, status, transactionHash, fee, errorMessage string) error
+	GetWithdrawalRequests(ctx context.Context, status string) ([]dto.WithdrawalRequestDTO, error)
+	ApproveWithdrawalRequest(ctx context.Context, id uint64, approver, reason string) (dto.WithdrawalRequestDTO, error)
+	RejectWithdrawalRequest(ctx context.Context, id uint64, approver, reason string) (dto.WithdrawalRequestDTO, error)
+}
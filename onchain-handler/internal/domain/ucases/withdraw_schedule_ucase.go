@@ -0,0 +1,189 @@
+package ucases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/cron"
+)
+
+type withdrawScheduleUCase struct {
+	withdrawScheduleRepository repotypes.WithdrawScheduleRepository
+	defaultSchedule            string
+}
+
+// NewWithdrawScheduleUCase creates the withdraw schedule use case, defaultSchedule is the cron expression
+// of the schedules without their own.
+func NewWithdrawScheduleUCase(
+	withdrawScheduleRepository repotypes.WithdrawScheduleRepository,
+	defaultSchedule string,
+) ucasetypes.WithdrawScheduleUCase {
+	return &withdrawScheduleUCase{
+		withdrawScheduleRepository: withdrawScheduleRepository,
+		defaultSchedule:            defaultSchedule,
+	}
+}
+
+// EnsureWithdrawSchedules creates the default schedule of the tokens of the network that have none.
+func (u *withdrawScheduleUCase) EnsureWithdrawSchedules(ctx context.Context, network string, symbols []string) error {
+	nextRunAt, err := u.nextRunAt("", time.Now())
+	if err != nil {
+		return err
+	}
+
+	schedules := make([]entities.WithdrawSchedule, 0, len(symbols))
+	for _, symbol := range symbols {
+		schedules = append(schedules, entities.WithdrawSchedule{
+			Network:   network,
+			Symbol:    symbol,
+			NextRunAt: nextRunAt,
+		})
+	}
+	return u.withdrawScheduleRepository.CreateWithdrawSchedules(ctx, schedules)
+}
+
+func (u *withdrawScheduleUCase) GetWithdrawSchedules(ctx context.Context, network, symbol string) ([]dto.WithdrawScheduleDTO, error) {
+	schedules, err := u.withdrawScheduleRepository.GetWithdrawSchedules(ctx, network, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulesDTO := make([]dto.WithdrawScheduleDTO, 0, len(schedules))
+	for _, schedule := range schedules {
+		schedulesDTO = append(schedulesDTO, schedule.ToDto(u.defaultSchedule))
+	}
+	return schedulesDTO, nil
+}
+
+// UpsertWithdrawSchedule sets the schedule of a network and token, its next run is recomputed from now.
+func (u *withdrawScheduleUCase) UpsertWithdrawSchedule(
+	ctx context.Context,
+	payload dto.WithdrawSchedulePayloadDTO,
+) (dto.WithdrawScheduleDTO, error) {
+	nextRunAt, err := u.nextRunAt(payload.CronExpression, time.Now())
+	if err != nil {
+		return dto.WithdrawScheduleDTO{}, err
+	}
+
+	schedule := entities.WithdrawSchedule{
+		Network:         payload.Network,
+		Symbol:          payload.Symbol,
+		CronExpression:  payload.CronExpression,
+		MinBatchValue:   payload.MinBatchValue,
+		MaxGasPriceGwei: payload.MaxGasPriceGwei,
+		NextRunAt:       nextRunAt,
+	}
+	if err := u.withdrawScheduleRepository.UpsertWithdrawSchedule(ctx, &schedule); err != nil {
+		return dto.WithdrawScheduleDTO{}, err
+	}
+
+	// Reload the paused state and last run kept by the upsert
+	stored, err := u.withdrawScheduleRepository.GetWithdrawSchedule(ctx, payload.Network, payload.Symbol)
+	if err != nil {
+		return dto.WithdrawScheduleDTO{}, err
+	}
+	return stored.ToDto(u.defaultSchedule), nil
+}
+
+// SetWithdrawSchedulesPaused pauses or resumes the schedules of the network and symbol, an empty network or symbol
+// matches any. Resumed schedules run at their next scheduled time from now.
+func (u *withdrawScheduleUCase) SetWithdrawSchedulesPaused(ctx context.Context, network, symbol string, paused bool) error {
+	schedules, err := u.withdrawScheduleRepository.GetWithdrawSchedules(ctx, network, symbol)
+	if err != nil {
+		return err
+	}
+	if len(schedules) == 0 {
+		return fmt.Errorf("network %q, symbol %q: %w", network, symbol, ucasetypes.ErrWithdrawScheduleNotFound)
+	}
+
+	now := time.Now()
+	for _, schedule := range schedules {
+		if schedule.Paused == paused {
+			continue
+		}
+		updates := map[string]any{"paused": paused, "sweep_requested": false}
+		if !paused {
+			// Do not catch up on the runs missed while paused
+			nextRunAt, err := u.nextRunAt(schedule.CronExpression, now)
+			if err != nil {
+				return err
+			}
+			updates["next_run_at"] = nextRunAt
+		}
+		if err := u.withdrawScheduleRepository.UpdateWithdrawSchedule(ctx, schedule.ID, updates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestWithdrawSweep makes the withdraw worker sweep the tokens of the network and symbol on its next check,
+// an empty network or symbol matches any. Paused schedules are not swept.
+func (u *withdrawScheduleUCase) RequestWithdrawSweep(ctx context.Context, network, symbol string) error {
+	paused := false
+	updated, err := u.withdrawScheduleRepository.UpdateWithdrawSchedules(
+		ctx, network, symbol, &paused, map[string]any{"sweep_requested": true},
+	)
+	if err != nil {
+		return err
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	schedules, err := u.withdrawScheduleRepository.GetWithdrawSchedules(ctx, network, symbol)
+	if err != nil {
+		return err
+	}
+	if len(schedules) == 0 {
+		return fmt.Errorf("network %q, symbol %q: %w", network, symbol, ucasetypes.ErrWithdrawScheduleNotFound)
+	}
+	return fmt.Errorf("network %q, symbol %q: %w", network, symbol, ucasetypes.ErrWithdrawSchedulePaused)
+}
+
+// CompleteWithdrawRun records the outcome of a due sweep. A deferred sweep stays due, any other outcome
+// moves the schedule to its next run.
+func (u *withdrawScheduleUCase) CompleteWithdrawRun(
+	ctx context.Context,
+	schedule dto.WithdrawScheduleDTO,
+	status, message string,
+) error {
+	now := time.Now()
+	updates := map[string]any{
+		"last_run_at":  now,
+		"last_status":  status,
+		"last_message": message,
+	}
+	if status != constants.WithdrawRunDeferred {
+		nextRunAt, err := u.nextRunAt(schedule.CronExpression, now)
+		if err != nil {
+			return err
+		}
+		updates["next_run_at"] = nextRunAt
+		updates["sweep_requested"] = false
+	}
+	return u.withdrawScheduleRepository.UpdateWithdrawSchedule(ctx, schedule.ID, updates)
+}
+
+// nextRunAt returns the first time after from matched by the cron expression in UTC,
+// an empty expression uses the default schedule.
+func (u *withdrawScheduleUCase) nextRunAt(expression string, from time.Time) (time.Time, error) {
+	if expression == "" {
+		expression = u.defaultSchedule
+	}
+	schedule, err := cron.Parse(expression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ucasetypes.ErrInvalidWithdrawSchedule, err)
+	}
+	nextRunAt := schedule.Next(from.UTC())
+	if nextRunAt.IsZero() {
+		return time.Time{}, fmt.Errorf("%w: %q never runs", ucasetypes.ErrInvalidWithdrawSchedule, expression)
+	}
+	return nextRunAt, nil
+}
@@ -0,0 +1,302 @@
+package ucases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	repotypes "github.com/genefriendway/onchain-handler/internal/adapters/repositories/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/entities"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+type withdrawalRequestUCase struct {
+	withdrawalRequestRepository repotypes.WithdrawalRequestRepository
+	transferPolicyUCase         ucasetypes.TransferPolicyUCase
+}
+
+func NewWithdrawalRequestUCase(
+	withdrawalRequestRepository repotypes.WithdrawalRequestRepository,
+	transferPolicyUCase ucasetypes.TransferPolicyUCase,
+) ucasetypes.WithdrawalRequestUCase {
+	return &withdrawalRequestUCase{
+		withdrawalRequestRepository: withdrawalRequestRepository,
+		transferPolicyUCase:         transferPolicyUCase,
+	}
+}
+
+// AuthorizeWithdrawal decides whether the balance of the receiving wallet, in token units, can be withdrawn to the
+// master wallet now and how much of it:
+//   - an approved withdrawal request is claimed and up to its amount is withdrawn, once the daily limit allows it
+//   - no withdrawal is made while a withdrawal request is pending approval
+//   - otherwise the transfer policy decides: a held withdrawal creates a withdrawal request, a blocked one is
+//     skipped and an auto approved one is withdrawn up to what is left of the daily limit
+func (u *withdrawalRequestUCase) AuthorizeWithdrawal(
+	ctx context.Context,
+	network, symbol, fromAddress, toAddress, balance string,
+) (dto.WithdrawalAuthorizationDTO, error) {
+	balanceAmount, err := utils.ConvertFloatTokenToSmallestUnit(balance, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return dto.WithdrawalAuthorizationDTO{}, fmt.Errorf("failed to convert balance %s: %w", balance, err)
+	}
+	room, err := u.transferPolicyUCase.GetDailyLimitRoom(ctx, network, symbol)
+	if err != nil {
+		return dto.WithdrawalAuthorizationDTO{}, err
+	}
+
+	// Step 1: Continue with the open withdrawal request if there is one
+	request, err := u.withdrawalRequestRepository.GetOpenWithdrawalRequest(ctx, network, symbol)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return dto.WithdrawalAuthorizationDTO{}, err
+	}
+	if request != nil {
+		if request.Status != constants.OutboundApproved {
+			logger.GetLogger().Infof("Withdrawal request %d of %s on network %s is %s, skipping withdrawal", request.ID, symbol, network, request.Status)
+			return dto.WithdrawalAuthorizationDTO{}, nil
+		}
+
+		amount, err := utils.ConvertFloatTokenToSmallestUnit(request.Amount, constants.PaymentAmountDecimalPlaces)
+		if err != nil {
+			return dto.WithdrawalAuthorizationDTO{}, fmt.Errorf("failed to convert amount of withdrawal request %d: %w", request.ID, err)
+		}
+		if balanceAmount.Cmp(amount) < 0 {
+			amount = balanceAmount
+		}
+		if room != nil && amount.Cmp(room) > 0 {
+			logger.GetLogger().Infof("Withdrawal request %d is above the daily limit left of %s on network %s, deferring it", request.ID, symbol, network)
+			return dto.WithdrawalAuthorizationDTO{}, nil
+		}
+
+		amountStr, err := utils.ConvertSmallestUnitToFloatToken(amount.String(), constants.PaymentAmountDecimalPlaces)
+		if err != nil {
+			return dto.WithdrawalAuthorizationDTO{}, err
+		}
+
+		updated, err := u.withdrawalRequestRepository.UpdateWithdrawalRequestStatus(ctx, request.ID, constants.OutboundApproved, map[string]any{
+			"status": constants.OutboundProcessing,
+		})
+		if err != nil {
+			return dto.WithdrawalAuthorizationDTO{}, err
+		}
+		if !updated {
+			return dto.WithdrawalAuthorizationDTO{}, nil
+		}
+		return dto.WithdrawalAuthorizationDTO{
+			Authorized: true,
+			Amount:     amountStr,
+			RequestID:  &request.ID,
+		}, nil
+	}
+
+	// Step 2: Let the transfer policy decide
+	evaluation, err := u.transferPolicyUCase.EvaluateTransfer(ctx, constants.OutboundWithdrawal, network, symbol, toAddress, balance)
+	if err != nil {
+		return dto.WithdrawalAuthorizationDTO{}, err
+	}
+	decision := dto.TransferDecisionDTO{
+		TransferKind: constants.OutboundWithdrawal,
+		Network:      network,
+		Symbol:       symbol,
+		ToAddress:    toAddress,
+		Amount:       balance,
+		Decision:     evaluation.Decision,
+		Actor:        constants.TransferPolicyActor,
+		Reason:       evaluation.Reason,
+	}
+
+	switch evaluation.Decision {
+	case constants.TransferDecisionBlocked:
+		logger.GetLogger().Warnf("Withdrawal of %s on network %s to %s is blocked: %s", symbol, network, toAddress, evaluation.Reason)
+		return dto.WithdrawalAuthorizationDTO{}, u.transferPolicyUCase.RecordTransferDecisions(ctx, []dto.TransferDecisionDTO{decision})
+
+	case constants.TransferDecisionHeld:
+		request := entities.WithdrawalRequest{
+			Network:           network,
+			Symbol:            symbol,
+			FromAddress:       fromAddress,
+			ToAddress:         toAddress,
+			Amount:            balance,
+			Status:            constants.OutboundPendingApproval,
+			RequiredApprovals: evaluation.RequiredApprovals,
+		}
+		if err := u.withdrawalRequestRepository.CreateWithdrawalRequest(ctx, &request); err != nil {
+			return dto.WithdrawalAuthorizationDTO{}, err
+		}
+		logger.GetLogger().Infof("Withdrawal of %s %s on network %s is held for approval as request %d", balance, symbol, network, request.ID)
+		decision.TransferID = &request.ID
+		return dto.WithdrawalAuthorizationDTO{}, u.transferPolicyUCase.RecordTransferDecisions(ctx, []dto.TransferDecisionDTO{decision})
+	}
+
+	amount := balanceAmount
+	if room != nil && amount.Cmp(room) > 0 {
+		amount = room
+	}
+	if amount.Sign() <= 0 {
+		logger.GetLogger().Infof("Daily limit of %s on network %s is reached, skipping withdrawal", symbol, network)
+		return dto.WithdrawalAuthorizationDTO{}, nil
+	}
+	decision.Amount, err = utils.ConvertSmallestUnitToFloatToken(amount.String(), constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return dto.WithdrawalAuthorizationDTO{}, err
+	}
+	if err := u.transferPolicyUCase.RecordTransferDecisions(ctx, []dto.TransferDecisionDTO{decision}); err != nil {
+		return dto.WithdrawalAuthorizationDTO{}, err
+	}
+	return dto.WithdrawalAuthorizationDTO{
+		Authorized: true,
+		Amount:     decision.Amount,
+	}, nil
+}
+
+// CompleteWithdrawal records the outcome of the transfer of a PROCESSING withdrawal request.
+func (u *withdrawalRequestUCase) CompleteWithdrawal(
+	ctx context.Context,
+	id uint64,
+	status, transactionHash, fee, errorMessage string,
+) error {
+	updates := map[string]any{
+		"status":           status,
+		"transaction_hash": transactionHash,
+		"error_message":    errorMessage,
+		"executed_at":      time.Now().UTC(),
+	}
+	if fee != "" {
+		updates["fee"] = fee
+	}
+
+	updated, err := u.withdrawalRequestRepository.UpdateWithdrawalRequestStatus(ctx, id, constants.OutboundProcessing, updates)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return fmt.Errorf("withdrawal request %d is no longer processing", id)
+	}
+	return nil
+}
+
+func (u *withdrawalRequestUCase) GetWithdrawalRequests(ctx context.Context, status string) ([]dto.WithdrawalRequestDTO, error) {
+	requests, err := u.withdrawalRequestRepository.GetWithdrawalRequests(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	requestDTOs := make([]dto.WithdrawalRequestDTO, 0, len(requests))
+	for _, request := range requests {
+		requestDTOs = append(requestDTOs, request.ToDto())
+	}
+	return requestDTOs, nil
+}
+
+// ApproveWithdrawalRequest records the approval of a PENDING_APPROVAL withdrawal request by the approver, the request
+// is APPROVED once it has the approvals required by its transfer policy and the next withdrawal then sends it.
+func (u *withdrawalRequestUCase) ApproveWithdrawalRequest(
+	ctx context.Context,
+	id uint64,
+	approver, reason string,
+) (dto.WithdrawalRequestDTO, error) {
+	request, err := u.getReviewableWithdrawalRequest(ctx, id)
+	if err != nil {
+		return dto.WithdrawalRequestDTO{}, err
+	}
+
+	approvals, err := u.transferPolicyUCase.RecordApproval(
+		// Withdrawal requests are requested by the transfer policy
+		ctx, withdrawalDecision(*request, constants.TransferDecisionApproved, approver, reason), constants.TransferPolicyActor,
+	)
+	if err != nil {
+		return dto.WithdrawalRequestDTO{}, err
+	}
+
+	updates := map[string]any{"approvals": approvals}
+	if approvals >= request.RequiredApprovals {
+		updates["status"] = constants.OutboundApproved
+		updates["approved_at"] = time.Now().UTC()
+	}
+	return u.reviewWithdrawalRequest(ctx, id, updates)
+}
+
+// RejectWithdrawalRequest rejects a PENDING_APPROVAL withdrawal request with the reason, the balance stays in the
+// receiving wallet and the next withdrawal is evaluated again.
+func (u *withdrawalRequestUCase) RejectWithdrawalRequest(
+	ctx context.Context,
+	id uint64,
+	approver, reason string,
+) (dto.WithdrawalRequestDTO, error) {
+	request, err := u.getReviewableWithdrawalRequest(ctx, id)
+	if err != nil {
+		return dto.WithdrawalRequestDTO{}, err
+	}
+
+	rejected, err := u.reviewWithdrawalRequest(ctx, id, map[string]any{
+		"status":        constants.OutboundRejected,
+		"error_message": reason,
+	})
+	if err != nil {
+		return dto.WithdrawalRequestDTO{}, err
+	}
+
+	decision := withdrawalDecision(*request, constants.TransferDecisionRejected, approver, reason)
+	if err := u.transferPolicyUCase.RecordTransferDecisions(ctx, []dto.TransferDecisionDTO{decision}); err != nil {
+		logger.GetLogger().Errorf("Failed to record rejection of withdrawal request %d: %v", id, err)
+	}
+	return rejected, nil
+}
+
+// getReviewableWithdrawalRequest returns the withdrawal request when it is PENDING_APPROVAL.
+func (u *withdrawalRequestUCase) getReviewableWithdrawalRequest(ctx context.Context, id uint64) (*entities.WithdrawalRequest, error) {
+	request, err := u.withdrawalRequestRepository.GetWithdrawalRequestByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %d", ucasetypes.ErrWithdrawalRequestNotFound, id)
+		}
+		return nil, err
+	}
+	if request.Status != constants.OutboundPendingApproval {
+		return nil, fmt.Errorf("%w: withdrawal request %d is %s", ucasetypes.ErrWithdrawalRequestNotReviewable, id, request.Status)
+	}
+	return request, nil
+}
+
+// reviewWithdrawalRequest applies the updates to a PENDING_APPROVAL withdrawal request and returns it.
+func (u *withdrawalRequestUCase) reviewWithdrawalRequest(
+	ctx context.Context,
+	id uint64,
+	updates map[string]any,
+) (dto.WithdrawalRequestDTO, error) {
+	updated, err := u.withdrawalRequestRepository.UpdateWithdrawalRequestStatus(ctx, id, constants.OutboundPendingApproval, updates)
+	if err != nil {
+		return dto.WithdrawalRequestDTO{}, err
+	}
+
+	request, err := u.withdrawalRequestRepository.GetWithdrawalRequestByID(ctx, id)
+	if err != nil {
+		return dto.WithdrawalRequestDTO{}, err
+	}
+	if !updated {
+		return dto.WithdrawalRequestDTO{}, fmt.Errorf(
+			"%w: withdrawal request %d is %s", ucasetypes.ErrWithdrawalRequestNotReviewable, id, request.Status,
+		)
+	}
+	return request.ToDto(), nil
+}
+
+func withdrawalDecision(request entities.WithdrawalRequest, decision, actor, reason string) dto.TransferDecisionDTO {
+	return dto.TransferDecisionDTO{
+		TransferKind: constants.OutboundWithdrawal,
+		TransferID:   &request.ID,
+		Network:      request.Network,
+		Symbol:       request.Symbol,
+		ToAddress:    request.ToAddress,
+		Amount:       request.Amount,
+		Decision:     decision,
+		Actor:        actor,
+		Reason:       reason,
+	}
+}
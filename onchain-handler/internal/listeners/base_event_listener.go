@@ -2,9 +2,11 @@ package listeners
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -21,18 +23,22 @@ import (
 // baseEventListener represents the shared behavior of any blockchain event listener.
 type baseEventListener struct {
 	ethClient              clienttypes.Client
+	subscriber             clienttypes.Subscriber // Optional, realtime events are polled when nil
 	network                constants.NetworkType
 	eventChan              chan any
 	blockStateUCase        ucasetypes.BlockStateUCase
 	currentBlock           uint64
 	confirmationDepth      uint64
-	confirmedEventHandlers map[common.Address]listenertypes.EventHandler
-	realtimeEventHandlers  map[common.Address]listenertypes.EventHandler
+	confirmedEventHandlers *eventRegistry
+	realtimeEventHandlers  *eventRegistry
+	subscriptionRetryDelay time.Duration // First delay of the resubscribe backoff
 }
 
 // NewBaseEventListener initializes a base listener.
+// When a subscriber is given, realtime events are pushed over websocket instead of being polled.
 func NewBaseEventListener(
 	client clienttypes.Client,
+	subscriber clienttypes.Subscriber,
 	network constants.NetworkType,
 	blockStateUCase ucasetypes.BlockStateUCase,
 	startBlockListener *uint64,
@@ -63,48 +69,61 @@ func NewBaseEventListener(
 
 	return &baseEventListener{
 		ethClient:              client,
+		subscriber:             subscriber,
 		network:                network,
 		eventChan:              eventChan,
 		blockStateUCase:        blockStateUCase,
 		currentBlock:           currentBlock, // Store the final determined current block
 		confirmationDepth:      confirmationDepth,
-		confirmedEventHandlers: make(map[common.Address]listenertypes.EventHandler),
-		realtimeEventHandlers:  make(map[common.Address]listenertypes.EventHandler),
+		confirmedEventHandlers: newEventRegistry(),
+		realtimeEventHandlers:  newEventRegistry(),
+		subscriptionRetryDelay: constants.SubscriptionRetryDelay,
 	}
 }
 
-// RegisterConfirmedEventListener registers a confirmed event listener for a specific contract
-func (listener *baseEventListener) RegisterConfirmedEventListener(contractAddress string, handler listenertypes.EventHandler) {
-	address := common.HexToAddress(contractAddress)
-	listener.confirmedEventHandlers[address] = handler
-}
+// RegisterEventHandler registers the handlers of a contract event, logs are routed to them by address and event signature.
+// It must be called before RunListener.
+func (listener *baseEventListener) RegisterEventHandler(registration listenertypes.EventHandlerRegistration) error {
+	if registration.Confirmed == nil {
+		return fmt.Errorf("missing confirmed handler for event %s of contract %s", registration.EventName, registration.ContractAddress)
+	}
+	topic, err := eventTopic(registration.ABI, registration.EventName)
+	if err != nil {
+		return fmt.Errorf("failed to register handler for contract %s: %w", registration.ContractAddress, err)
+	}
 
-// RegisterRealtimeEventListener registers a realtime event listener for a specific contract
-func (listener *baseEventListener) RegisterRealtimeEventListener(contractAddress string, handler listenertypes.EventHandler) {
-	address := common.HexToAddress(contractAddress)
-	listener.realtimeEventHandlers[address] = handler
+	address := common.HexToAddress(registration.ContractAddress)
+	listener.confirmedEventHandlers.add(address, topic, registration.Confirmed)
+	if registration.Realtime != nil {
+		listener.realtimeEventHandlers.add(address, topic, registration.Realtime)
+	}
+	return nil
 }
 
 // RunListener starts the listener and processes incoming events.
 func (listener *baseEventListener) RunListener(ctx context.Context) error {
-	// Extract contract addresses from EventHandlers map
-	var contractAddresses []common.Address
-	for address := range listener.confirmedEventHandlers {
-		contractAddresses = append(contractAddresses, address)
+	// Only the contracts with registered handlers are polled, an empty filter would match every log
+	confirmedAddresses := listener.confirmedEventHandlers.contractAddresses()
+	if len(confirmedAddresses) == 0 {
+		return fmt.Errorf("no event handlers registered on network %s", listener.network.String())
 	}
+	realtimeAddresses := listener.realtimeEventHandlers.contractAddresses()
 
 	var wg sync.WaitGroup
-	wg.Add(3)
+	wg.Add(2)
 
 	go func() {
 		defer wg.Done()
-		listener.listenConfirmedEvents(ctx, contractAddresses)
+		listener.listenConfirmedEvents(ctx, confirmedAddresses)
 	}()
 
-	go func() {
-		defer wg.Done()
-		listener.listenRealtimeEvents(ctx, contractAddresses)
-	}()
+	if len(realtimeAddresses) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			listener.listenRealtimeEvents(ctx, realtimeAddresses)
+		}()
+	}
 
 	go func() {
 		defer wg.Done()
@@ -122,8 +141,139 @@ func (listener *baseEventListener) RunListener(ctx context.Context) error {
 	return nil
 }
 
-// listenRealtimeEvents polls the blockchain for listening events from effectiveLatestBlock to latest block
+// listenRealtimeEvents listens for realtime events through the subscriber when available, falling back to polling.
 func (listener *baseEventListener) listenRealtimeEvents(ctx context.Context, contractAddresses []common.Address) {
+	if listener.subscriber != nil {
+		err := listener.subscribeRealtimeEvents(ctx, contractAddresses)
+		if err == nil {
+			return
+		}
+		logger.GetLogger().Warnf("Failed to subscribe to realtime events on network %s, falling back to polling: %v", listener.network.String(), err)
+	}
+	listener.pollRealtimeEvents(ctx, contractAddresses)
+}
+
+// subscribeRealtimeEvents receives realtime events over a log subscription.
+// Dropped subscriptions are re-established with backoff and the missed blocks are back-filled with FilterLogs.
+// It only returns an error if the initial subscription could not be established.
+func (listener *baseEventListener) subscribeRealtimeEvents(ctx context.Context, contractAddresses []common.Address) error {
+	logs := make(chan types.Log, constants.DefaultSubscriptionBuffer)
+	sub, err := listener.subscriber.SubscribeLogs(ctx, contractAddresses, logs)
+	if err != nil {
+		return err
+	}
+	logger.GetLogger().Infof("Subscribed to realtime events on network %s", listener.network.String())
+
+	// Track the highest block seen so a reconnect knows where the gap starts
+	lastSeenBlock, err := listener.blockStateUCase.GetLatestBlock(ctx, listener.network)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to retrieve the latest block number from %s: %v", listener.network.String(), err)
+	}
+
+	for {
+		select {
+		case vLog := <-logs:
+			// Logs removed by a reorg are handled by the confirmed listener
+			if vLog.Removed {
+				continue
+			}
+			listener.dispatchRealtimeLogs([]types.Log{vLog})
+			lastSeenBlock = max(lastSeenBlock, vLog.BlockNumber)
+
+		case err := <-sub.Err():
+			logger.GetLogger().Warnf("Realtime subscription on network %s dropped: %v", listener.network.String(), err)
+			sub.Unsubscribe()
+
+			sub = listener.resubscribeLogs(ctx, contractAddresses, logs)
+			if sub == nil {
+				return nil // Context cancelled
+			}
+			if lastSeenBlock > 0 {
+				lastSeenBlock = listener.backfillRealtimeEvents(ctx, contractAddresses, lastSeenBlock+1)
+			}
+
+		case <-ctx.Done():
+			sub.Unsubscribe()
+			logger.GetLogger().Infof("Stopping realtime subscription on network %s...", listener.network.String())
+			return nil
+		}
+	}
+}
+
+// resubscribeLogs retries the log subscription with exponential backoff until it succeeds or ctx is cancelled.
+func (listener *baseEventListener) resubscribeLogs(
+	ctx context.Context,
+	contractAddresses []common.Address,
+	logs chan<- types.Log,
+) ethereum.Subscription {
+	delay := listener.subscriptionRetryDelay
+	for {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil
+		}
+
+		sub, err := listener.subscriber.SubscribeLogs(ctx, contractAddresses, logs)
+		if err == nil {
+			logger.GetLogger().Infof("Resubscribed to realtime events on network %s", listener.network.String())
+			return sub
+		}
+
+		logger.GetLogger().Warnf("Failed to resubscribe to realtime events on network %s: %v", listener.network.String(), err)
+		delay = min(delay*2, constants.MaxSubscriptionRetryDelay)
+	}
+}
+
+// backfillRealtimeEvents replays the logs emitted while the subscription was down and returns the last back-filled block.
+func (listener *baseEventListener) backfillRealtimeEvents(
+	ctx context.Context,
+	contractAddresses []common.Address,
+	fromBlock uint64,
+) uint64 {
+	latestBlock, err := listener.ethClient.GetLatestBlockNumber(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get latest block for back-filling on network %s: %v", listener.network.String(), err)
+		return fromBlock - 1
+	}
+
+	toBlock := latestBlock.Uint64()
+	if fromBlock > toBlock {
+		return toBlock
+	}
+	logger.GetLogger().Infof("Back-filling realtime events on network %s from block %d to %d", listener.network.String(), fromBlock, toBlock)
+
+	for chunkStart := fromBlock; chunkStart <= toBlock; {
+		logs, chunkEnd, err := listener.ethClient.PollForLogsUpTo(ctx, contractAddresses, chunkStart, toBlock)
+		if err != nil {
+			logger.GetLogger().Errorf("Failed to back-fill realtime logs on network %s from block %d to %d: %v", listener.network.String(), chunkStart, toBlock, err)
+			return chunkStart - 1
+		}
+		listener.dispatchRealtimeLogs(logs)
+		chunkStart = chunkEnd + 1
+	}
+
+	return toBlock
+}
+
+// dispatchRealtimeLogs hands each log to the realtime handlers registered for its contract and event.
+func (listener *baseEventListener) dispatchRealtimeLogs(logs []types.Log) {
+	for _, logEntry := range logs {
+		eventHandlers := listener.realtimeEventHandlers.handlersOf(logEntry)
+		if len(eventHandlers) == 0 {
+			logger.GetLogger().Debugf("No realtime event handler for log on network %s: address %s, tx %s", listener.network.String(), logEntry.Address.Hex(), logEntry.TxHash.Hex())
+			continue
+		}
+		for _, eventHandler := range eventHandlers {
+			if _, err := eventHandler(logEntry); err != nil {
+				logger.GetLogger().Warnf("Failed to process realtime log entry on network %s: %v", listener.network.String(), err)
+			}
+		}
+	}
+}
+
+// pollRealtimeEvents polls the blockchain for listening events from effectiveLatestBlock to latest block
+func (listener *baseEventListener) pollRealtimeEvents(ctx context.Context, contractAddresses []common.Address) {
 	logger.GetLogger().Infof("Starting to realtime events on network %s...", listener.network.String())
 
 	currentBlock := uint64(0)
@@ -162,42 +312,31 @@ func (listener *baseEventListener) listenRealtimeEvents(ctx context.Context, con
 			continue
 		}
 
-		// Process the blocks in chunks.
+		// Process the blocks in chunks sized by the client.
 		currentBlock = effectiveLatestBlock + 1
-		for chunkStart := currentBlock; chunkStart <= latestBlock; chunkStart += constants.DefaultBlockOffset {
-			chunkEnd := min(chunkStart+constants.DefaultBlockOffset-1, latestBlock)
-
-			logger.GetLogger().Debugf("Base Event Listener: Processing block chunk on network %s: %d to %d", listener.network.String(), chunkStart, chunkEnd)
-
+		for chunkStart := currentBlock; chunkStart <= latestBlock; chunkStart = currentBlock {
 			var logs []types.Log
+			var chunkEnd uint64
 			// Poll logs from the blockchain with retries in case of failure.
 			for range constants.MaxRetries {
-				// Poll logs from the chunk of blocks.
-				logs, err = listener.ethClient.PollForLogsFromBlock(ctx, contractAddresses, chunkStart, chunkEnd)
+				// Poll logs from as many blocks as the RPC endpoint currently allows.
+				logs, chunkEnd, err = listener.ethClient.PollForLogsUpTo(ctx, contractAddresses, chunkStart, latestBlock)
 				if err != nil {
-					logger.GetLogger().Warnf("Failed to poll realtime logs on network %s from block %d to %d: %v. Retrying...", listener.network.String(), chunkStart, chunkEnd, err)
+					logger.GetLogger().Warnf("Failed to poll realtime logs on network %s from block %d to %d: %v. Retrying...", listener.network.String(), chunkStart, latestBlock, err)
 					time.Sleep(constants.RetryDelay)
 					continue
 				}
 				break
 			}
 			if err != nil {
-				logger.GetLogger().Errorf("Max retries reached on network %s. Skipping block chunk %d to %d due to error: %v", listener.network.String(), chunkStart, chunkEnd, err)
+				logger.GetLogger().Errorf("Max retries reached on network %s. Skipping blocks %d to %d due to error: %v", listener.network.String(), chunkStart, latestBlock, err)
 				break // Exit the loop if we cannot fetch logs
 			}
 
+			logger.GetLogger().Debugf("Base Event Listener: Processed block chunk on network %s: %d to %d", listener.network.String(), chunkStart, chunkEnd)
+
 			// Apply each parseAndProcessFunc to the logs
-			for _, logEntry := range logs {
-				if eventHandler, exists := listener.realtimeEventHandlers[logEntry.Address]; exists {
-					_, err := eventHandler(logEntry)
-					if err != nil {
-						logger.GetLogger().Warnf("Failed to process realtime log entry on network %s: %v", listener.network.String(), err)
-						continue
-					}
-				} else {
-					logger.GetLogger().Warnf("No realtime event handler for log address on network %s: %s", listener.network.String(), logEntry.Address.Hex())
-				}
-			}
+			listener.dispatchRealtimeLogs(logs)
 
 			// Update the current block for the next iteration.
 			currentBlock = chunkEnd + 1
@@ -235,8 +374,19 @@ func (listener *baseEventListener) listenConfirmedEvents(ctx context.Context, co
 		currentBlock = lastProcessedBlock + 1
 	}
 
+	// The stored cursor only moves with this loop, unless an operator resets it through the admin API
+	storedBlock := lastProcessedBlock
+
 	// Continuously listen for new confirmed events.
 	for {
+		if resetBlock, err := listener.blockStateUCase.GetLastProcessedBlock(ctx, listener.network); err == nil &&
+			resetBlock != 0 && resetBlock != storedBlock {
+			logger.GetLogger().Infof("Last processed block on network %s was reset from %d to %d, resuming from block %d",
+				listener.network.String(), storedBlock, resetBlock, resetBlock+1)
+			storedBlock = resetBlock
+			currentBlock = resetBlock + 1
+		}
+
 		// Retrieve the latest block number from cache or blockchain to stay up-to-date.
 		latestBlock, err := listener.blockStateUCase.GetLatestBlock(ctx, listener.network)
 		if err != nil {
@@ -254,55 +404,53 @@ func (listener *baseEventListener) listenConfirmedEvents(ctx context.Context, co
 
 		logger.GetLogger().Debugf("Listening for confirmed events starting at block on network %s: %d", listener.network.String(), currentBlock)
 
-		// Determine the end block while respecting APIMaxBlocksPerRequest and the effective latest block.
-		endBlock := min(currentBlock+constants.APIMaxBlocksPerRequest/8, effectiveLatestBlock)
-
-		// Process the blocks in chunks.
-		for chunkStart := currentBlock; chunkStart <= endBlock; chunkStart += constants.DefaultBlockOffset {
-			chunkEnd := min(chunkStart+constants.DefaultBlockOffset-1, endBlock)
+		var logs []types.Log
+		var chunkEnd uint64
+		// Poll logs from the blockchain with retries in case of failure.
+		for range constants.MaxRetries {
+			// Poll logs from as many blocks as the RPC endpoint currently allows, the range adapts to its responses.
+			logs, chunkEnd, err = listener.ethClient.PollForLogsUpTo(ctx, contractAddresses, currentBlock, effectiveLatestBlock)
+			if err != nil {
+				logger.GetLogger().Warnf("Failed to poll confirmed logs on network %s from block %d to %d: %v. Retrying...", listener.network.String(), currentBlock, effectiveLatestBlock, err)
+				time.Sleep(constants.RetryDelay)
+				continue
+			}
+			break
+		}
+		if err != nil {
+			logger.GetLogger().Errorf("Max retries reached on network %s. Retrying block %d on the next iteration due to error: %v", listener.network.String(), currentBlock, err)
+			continue
+		}
 
-			logger.GetLogger().Debugf("Base Event Listener: Processing block chunk on network %s: %d to %d", listener.network.String(), chunkStart, chunkEnd)
+		logger.GetLogger().Debugf("Base Event Listener: Processed block chunk on network %s: %d to %d", listener.network.String(), currentBlock, chunkEnd)
 
-			var logs []types.Log
-			// Poll logs from the blockchain with retries in case of failure.
-			for range constants.MaxRetries {
-				// Poll logs from the chunk of blocks.
-				logs, err = listener.ethClient.PollForLogsFromBlock(ctx, contractAddresses, chunkStart, chunkEnd)
+		// Apply each parseAndProcessFunc to the logs
+		for _, logEntry := range logs {
+			eventHandlers := listener.confirmedEventHandlers.handlersOf(logEntry)
+			if len(eventHandlers) == 0 {
+				logger.GetLogger().Debugf("No confirmed event handler for log on network %s: address %s, tx %s", listener.network.String(), logEntry.Address.Hex(), logEntry.TxHash.Hex())
+				continue
+			}
+			for _, eventHandler := range eventHandlers {
+				processedEvent, err := eventHandler(logEntry)
 				if err != nil {
-					logger.GetLogger().Warnf("Failed to poll confirmed logs on network %s from block %d to %d: %v. Retrying...", listener.network.String(), chunkStart, chunkEnd, err)
-					time.Sleep(constants.RetryDelay)
+					logger.GetLogger().Warnf("Failed to process confirmed log entry on network %s: %v", listener.network.String(), err)
 					continue
 				}
-				break
-			}
-			if err != nil {
-				logger.GetLogger().Errorf("Max retries reached on network %s. Skipping block chunk %d to %d due to error: %v", listener.network.String(), chunkStart, chunkEnd, err)
-				break // Exit the loop if we cannot fetch logs
-			}
-
-			// Apply each parseAndProcessFunc to the logs
-			for _, logEntry := range logs {
-				if eventHandler, exists := listener.confirmedEventHandlers[logEntry.Address]; exists {
-					processedEvent, err := eventHandler(logEntry)
-					if err != nil {
-						logger.GetLogger().Warnf("Failed to process confirmed log entry on network %s: %v", listener.network.String(), err)
-						continue
-					}
 
-					// Send the processed event to the channel
-					listener.eventChan <- processedEvent
-				} else {
-					logger.GetLogger().Warnf("No confirmed event handler for log address on network %s: %s", listener.network.String(), logEntry.Address.Hex())
-				}
+				// Send the processed event to the channel
+				listener.eventChan <- processedEvent
 			}
-
-			// Update the current block for the next iteration.
-			currentBlock = chunkEnd + 1
 		}
 
+		// Update the current block for the next iteration.
+		currentBlock = chunkEnd + 1
+
 		// Update the last processed block in the repository.
 		if err := listener.blockStateUCase.UpdateLastProcessedBlock(ctx, currentBlock, listener.network); err != nil {
 			logger.GetLogger().Errorf("Failed to update last processed block on network %s in repository: %v", listener.network.String(), err)
+		} else {
+			storedBlock = currentBlock
 		}
 	}
 }
@@ -0,0 +1,235 @@
+package listeners
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"go.uber.org/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+	clientmocks "github.com/genefriendway/onchain-handler/pkg/blockchain/client/mocks"
+)
+
+var testEventTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+type baseListenerMocks struct {
+	ethClient       *clientmocks.MockClient
+	subscriber      *clientmocks.MockSubscriber
+	blockStateUCase *mocks.MockBlockStateUCase
+}
+
+// newTestBaseListener returns a listener whose realtime handler sends the block number of each handled log to handled.
+func newTestBaseListener(ctrl *gomock.Controller, handled chan<- uint64) (*baseEventListener, baseListenerMocks) {
+	m := baseListenerMocks{
+		ethClient:       clientmocks.NewMockClient(ctrl),
+		subscriber:      clientmocks.NewMockSubscriber(ctrl),
+		blockStateUCase: mocks.NewMockBlockStateUCase(ctrl),
+	}
+	listener := &baseEventListener{
+		ethClient:              m.ethClient,
+		subscriber:             m.subscriber,
+		network:                constants.Bsc,
+		blockStateUCase:        m.blockStateUCase,
+		confirmedEventHandlers: newEventRegistry(),
+		realtimeEventHandlers:  newEventRegistry(),
+		subscriptionRetryDelay: time.Millisecond,
+	}
+	listener.realtimeEventHandlers.add(common.HexToAddress(testTokenAddress), testEventTopic, func(vLog types.Log) (any, error) {
+		handled <- vLog.BlockNumber
+		return nil, nil
+	})
+	return listener, m
+}
+
+func testBlockLog(blockNumber uint64) types.Log {
+	return types.Log{
+		Address:     common.HexToAddress(testTokenAddress),
+		Topics:      []common.Hash{testEventTopic},
+		BlockNumber: blockNumber,
+	}
+}
+
+// receiveBlocks returns the block numbers of the next n handled logs.
+func receiveBlocks(t *testing.T, handled <-chan uint64, n int) []uint64 {
+	var blocks []uint64
+	for range n {
+		select {
+		case blockNumber := <-handled:
+			blocks = append(blocks, blockNumber)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for realtime logs", "handled %v", blocks)
+		}
+	}
+	return blocks
+}
+
+func TestSubscribeRealtimeEvents(t *testing.T) {
+	contractAddresses := []common.Address{common.HexToAddress(testTokenAddress)}
+
+	t.Run("ResubscribesAndBackfillsGap", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handled := make(chan uint64, 10)
+		listener, m := newTestBaseListener(ctrl, handled)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		m.blockStateUCase.EXPECT().GetLatestBlock(gomock.Any(), constants.Bsc).Return(uint64(100), nil)
+		drop := make(chan struct{})
+		gomock.InOrder(
+			// The first connection delivers block 101 and then drops
+			m.subscriber.EXPECT().SubscribeLogs(gomock.Any(), contractAddresses, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ []common.Address, logs chan<- types.Log) (ethereum.Subscription, error) {
+					return event.NewSubscription(func(<-chan struct{}) error {
+						logs <- testBlockLog(101)
+						<-drop
+						return errors.New("websocket: close 1006 (abnormal closure)")
+					}), nil
+				}),
+			// The endpoint is still down on the first retry
+			m.subscriber.EXPECT().SubscribeLogs(gomock.Any(), contractAddresses, gomock.Any()).
+				Return(nil, errors.New("all websocket endpoints failed")),
+			m.subscriber.EXPECT().SubscribeLogs(gomock.Any(), contractAddresses, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ []common.Address, logs chan<- types.Log) (ethereum.Subscription, error) {
+					return event.NewSubscription(func(quit <-chan struct{}) error {
+						<-quit
+						return nil
+					}), nil
+				}),
+		)
+
+		// The blocks 102 to 105 mined while the subscription was down are polled in the chunks the client allows
+		m.ethClient.EXPECT().GetLatestBlockNumber(gomock.Any()).Return(big.NewInt(105), nil)
+		gomock.InOrder(
+			m.ethClient.EXPECT().PollForLogsUpTo(gomock.Any(), contractAddresses, uint64(102), uint64(105)).
+				Return([]types.Log{testBlockLog(103)}, uint64(103), nil),
+			m.ethClient.EXPECT().PollForLogsUpTo(gomock.Any(), contractAddresses, uint64(104), uint64(105)).
+				Return([]types.Log{testBlockLog(105)}, uint64(105), nil),
+		)
+
+		done := make(chan error)
+		go func() {
+			done <- listener.subscribeRealtimeEvents(ctx, contractAddresses)
+		}()
+
+		require.Equal(t, []uint64{101}, receiveBlocks(t, handled, 1))
+		close(drop)
+		require.Equal(t, []uint64{103, 105}, receiveBlocks(t, handled, 2))
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("NoBackfillWithoutSeenBlock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handled := make(chan uint64, 10)
+		listener, m := newTestBaseListener(ctrl, handled)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// Without a known block the gap cannot be located, the confirmed listener catches the missed payments
+		m.blockStateUCase.EXPECT().GetLatestBlock(gomock.Any(), constants.Bsc).Return(uint64(0), errors.New("cache miss"))
+		resubscribed := make(chan struct{})
+		gomock.InOrder(
+			m.subscriber.EXPECT().SubscribeLogs(gomock.Any(), contractAddresses, gomock.Any()).
+				Return(event.NewSubscription(func(<-chan struct{}) error {
+					return errors.New("websocket: close 1006 (abnormal closure)")
+				}), nil),
+			m.subscriber.EXPECT().SubscribeLogs(gomock.Any(), contractAddresses, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ []common.Address, _ chan<- types.Log) (ethereum.Subscription, error) {
+					close(resubscribed)
+					return event.NewSubscription(func(quit <-chan struct{}) error {
+						<-quit
+						return nil
+					}), nil
+				}),
+		)
+
+		done := make(chan error)
+		go func() {
+			done <- listener.subscribeRealtimeEvents(ctx, contractAddresses)
+		}()
+
+		<-resubscribed
+		cancel()
+		require.NoError(t, <-done)
+		require.Empty(t, handled)
+	})
+
+	t.Run("InitialSubscriptionFails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		listener, m := newTestBaseListener(ctrl, make(chan uint64))
+		m.subscriber.EXPECT().SubscribeLogs(gomock.Any(), contractAddresses, gomock.Any()).
+			Return(nil, errors.New("all websocket endpoints failed"))
+
+		// The error makes the listener fall back to polling
+		require.Error(t, listener.subscribeRealtimeEvents(context.Background(), contractAddresses))
+	})
+
+	t.Run("StopsRetryingOnceCancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		listener, _ := newTestBaseListener(ctrl, make(chan uint64))
+		listener.subscriptionRetryDelay = time.Hour
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.Nil(t, listener.resubscribeLogs(ctx, contractAddresses, make(chan types.Log)))
+	})
+}
+
+func TestBackfillRealtimeEvents(t *testing.T) {
+	contractAddresses := []common.Address{common.HexToAddress(testTokenAddress)}
+
+	t.Run("NothingMissed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		listener, m := newTestBaseListener(ctrl, make(chan uint64))
+		m.ethClient.EXPECT().GetLatestBlockNumber(gomock.Any()).Return(big.NewInt(100), nil)
+
+		require.Equal(t, uint64(100), listener.backfillRealtimeEvents(context.Background(), contractAddresses, 101))
+	})
+
+	t.Run("PollFailureKeepsBackfilledBlocks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handled := make(chan uint64, 10)
+		listener, m := newTestBaseListener(ctrl, handled)
+		m.ethClient.EXPECT().GetLatestBlockNumber(gomock.Any()).Return(big.NewInt(110), nil)
+		m.ethClient.EXPECT().PollForLogsUpTo(gomock.Any(), contractAddresses, uint64(101), uint64(110)).
+			Return([]types.Log{testBlockLog(102)}, uint64(105), nil)
+		m.ethClient.EXPECT().PollForLogsUpTo(gomock.Any(), contractAddresses, uint64(106), uint64(110)).
+			Return(nil, uint64(0), errors.New("429 too many requests"))
+
+		// The next reconnect back-fills again from block 106
+		require.Equal(t, uint64(105), listener.backfillRealtimeEvents(context.Background(), contractAddresses, 101))
+		require.Equal(t, []uint64{102}, receiveBlocks(t, handled, 1))
+	})
+
+	t.Run("LatestBlockUnavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		listener, m := newTestBaseListener(ctrl, make(chan uint64))
+		m.ethClient.EXPECT().GetLatestBlockNumber(gomock.Any()).Return(nil, errors.New("connection refused"))
+
+		require.Equal(t, uint64(100), listener.backfillRealtimeEvents(context.Background(), contractAddresses, 101))
+	})
+}
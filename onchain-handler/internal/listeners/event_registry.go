@@ -0,0 +1,96 @@
+package listeners
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	listenertypes "github.com/genefriendway/onchain-handler/internal/listeners/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+)
+
+// eventRoute identifies the logs of one event emitted by one contract.
+type eventRoute struct {
+	address common.Address
+	topic   common.Hash
+}
+
+// eventRegistry routes logs to the handlers registered for their contract address and event signature.
+type eventRegistry struct {
+	handlers  map[eventRoute][]listenertypes.EventHandler
+	addresses []common.Address
+	mu        sync.RWMutex
+}
+
+func newEventRegistry() *eventRegistry {
+	return &eventRegistry{
+		handlers: make(map[eventRoute][]listenertypes.EventHandler),
+	}
+}
+
+// add registers handler for the event topic emitted by the contract at address.
+func (r *eventRegistry) add(address common.Address, topic common.Hash, handler listenertypes.EventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.hasAddress(address) {
+		r.addresses = append(r.addresses, address)
+	}
+	route := eventRoute{address: address, topic: topic}
+	r.handlers[route] = append(r.handlers[route], handler)
+}
+
+// handlersOf returns the handlers registered for the contract and event of vLog.
+func (r *eventRegistry) handlersOf(vLog types.Log) []listenertypes.EventHandler {
+	if len(vLog.Topics) == 0 {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.handlers[eventRoute{address: vLog.Address, topic: vLog.Topics[0]}]
+}
+
+// contractAddresses returns the addresses of the contracts with at least one registered handler.
+func (r *eventRegistry) contractAddresses() []common.Address {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]common.Address(nil), r.addresses...)
+}
+
+func (r *eventRegistry) hasAddress(address common.Address) bool {
+	for _, existing := range r.addresses {
+		if existing == address {
+			return true
+		}
+	}
+	return false
+}
+
+// eventTopic returns the signature topic of the named event of the ABI.
+func eventTopic(parsedABI abi.ABI, eventName string) (common.Hash, error) {
+	event, ok := parsedABI.Events[eventName]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("event %s not found in ABI", eventName)
+	}
+	return event.ID, nil
+}
+
+// NewTypedEventHandler returns an event handler that decodes the log into T before calling handle.
+// T is a struct whose fields are named after the event arguments, e.g. blockchain.ApprovalEvent.
+func NewTypedEventHandler[T any](
+	parsedABI abi.ABI,
+	eventName string,
+	handle func(vLog types.Log, event T) (any, error),
+) listenertypes.EventHandler {
+	return func(vLog types.Log) (any, error) {
+		var event T
+		if err := blockchain.UnpackEvent(vLog, parsedABI, eventName, &event); err != nil {
+			return nil, err
+		}
+		return handle(vLog, event)
+	}
+}
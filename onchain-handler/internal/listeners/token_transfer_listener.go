@@ -4,23 +4,26 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/genefriendway/onchain-handler/conf"
 	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/contracts/abigen/paymentrouter"
 	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
 	settypes "github.com/genefriendway/onchain-handler/internal/adapters/orderset/types"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
 	listenertypes "github.com/genefriendway/onchain-handler/internal/listeners/types"
 	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
-	"github.com/genefriendway/onchain-handler/pkg/payment"
 	"github.com/genefriendway/onchain-handler/pkg/utils"
 )
 
@@ -31,12 +34,14 @@ type tokenTransferListener struct {
 	baseEventListener        listenertypes.BaseEventListener
 	paymentOrderUCase        ucasetypes.PaymentOrderUCase
 	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase
-	paymentStatisticsUCase   ucasetypes.PaymentStatisticsUCase
-	paymentWalletUCase       ucasetypes.PaymentWalletUCase
+	complianceUCase          ucasetypes.ComplianceUCase
 	network                  constants.NetworkType
 	tokenContractAddresses   []string
 	tokenDecimalsMap         map[string]uint8
+	tokenSymbolsMap          map[string]string
 	parsedABI                abi.ABI
+	paymentRouterAddress     string
+	paymentRouterABI         abi.ABI
 	orderSet                 settypes.Set[dto.PaymentOrderDTO]
 	mu                       sync.Mutex // Mutex for ticker synchronization
 }
@@ -48,10 +53,10 @@ func NewTokenTransferListener(
 	baseEventListener listenertypes.BaseEventListener,
 	paymentOrderUCase ucasetypes.PaymentOrderUCase,
 	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
-	paymentStatisticsUCase ucasetypes.PaymentStatisticsUCase,
-	paymentWalletUCase ucasetypes.PaymentWalletUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
 	network constants.NetworkType,
 	tokenContractAddresses []string,
+	paymentRouterAddress string,
 	orderSet settypes.Set[dto.PaymentOrderDTO],
 ) (listenertypes.EventListener, error) {
 	parsedABI, err := abi.JSON(strings.NewReader(constants.Erc20TransferEventABI))
@@ -59,6 +64,11 @@ func NewTokenTransferListener(
 		return nil, fmt.Errorf("failed to parse ERC20 ABI: %w", err)
 	}
 
+	paymentRouterABI, err := paymentrouter.PaymentrouterMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payment router ABI: %w", err)
+	}
+
 	tokenDecimalsMap := make(map[string]uint8)
 	for _, addr := range tokenContractAddresses {
 		decimals, err := blockchain.GetTokenDecimalsFromCache(addr, network.String(), cacheRepo)
@@ -67,20 +77,26 @@ func NewTokenTransferListener(
 		}
 		tokenDecimalsMap[addr] = decimals
 	}
+	tokenSymbolsMap, err := tokenSymbolsOf(tokenContractAddresses)
+	if err != nil {
+		return nil, err
+	}
 
 	listener := &tokenTransferListener{
-		ctx:                      ctx,
+		ctx:                      utils.WithAuditActor(ctx, utils.AuditActor(constants.AuditActorListener, network.String())),
 		cacheRepo:                cacheRepo,
 		baseEventListener:        baseEventListener,
 		paymentOrderUCase:        paymentOrderUCase,
 		paymentEventHistoryUCase: paymentEventHistoryUCase,
-		paymentStatisticsUCase:   paymentStatisticsUCase,
-		paymentWalletUCase:       paymentWalletUCase,
+		complianceUCase:          complianceUCase,
 		network:                  network,
 		tokenContractAddresses:   tokenContractAddresses,
 		tokenDecimalsMap:         tokenDecimalsMap,
+		tokenSymbolsMap:          tokenSymbolsMap,
 		orderSet:                 orderSet,
 		parsedABI:                parsedABI,
+		paymentRouterAddress:     paymentRouterAddress,
+		paymentRouterABI:         *paymentRouterABI,
 	}
 
 	// Init the order set
@@ -119,8 +135,11 @@ func (listener *tokenTransferListener) startCleanSetTicker(interval time.Duratio
 func (listener *tokenTransferListener) fetchOrderDetailsFromSet(
 	key string, transferEvent blockchain.TransferEvent, tokenSymbol string,
 ) (*dto.PaymentOrderDTO, error) {
-	// Fetch order from the set
+	// Fetch order from the set, multi-chain orders are stored per option
 	order, exists := listener.orderSet.GetItem(key)
+	if !exists {
+		order, exists = listener.orderSet.GetItem(dto.OptionSetKey(key, listener.network.String()))
+	}
 	if !exists {
 		logger.GetLogger().Infof("No matching order found in set for address %s and token %s", transferEvent.To.Hex(), tokenSymbol)
 		return nil, nil // Return nil instead of an error if no order is found
@@ -158,20 +177,37 @@ func (listener *tokenTransferListener) parseAndProcessRealtimeTransferEvent(vLog
 	// Create a unique key for the order
 	key := transferEvent.To.Hex() + "_" + tokenSymbol
 
+	if err := listener.markOrderProcessing(listener.auditContext(vLog), key, transferEvent, tokenSymbol, vLog.BlockNumber); err != nil {
+		return nil, err
+	}
+
+	return transferEvent, nil
+}
+
+// auditContext returns the context of the changes made to the orders for the log, recorded under the actor
+// of the listener with the block and transaction of the log.
+func (listener *tokenTransferListener) auditContext(vLog types.Log) context.Context {
+	source, _ := utils.AuditSourceOf(listener.ctx)
+	source.BlockNumber = vLog.BlockNumber
+	source.TxHash = vLog.TxHash.Hex()
+	return utils.WithAuditSource(listener.ctx, source)
+}
+
+// markOrderProcessing marks the order stored under key as processing once a payment for it is seen in an unconfirmed block.
+func (listener *tokenTransferListener) markOrderProcessing(
+	ctx context.Context, key string, transferEvent blockchain.TransferEvent, tokenSymbol string, upcomingBlockHeight uint64,
+) error {
 	// Fetch the order details from the set
 	order, err := listener.fetchOrderDetailsFromSet(key, transferEvent, tokenSymbol)
 	if err != nil || order == nil {
-		return nil, err
+		return err
 	}
 	logger.GetLogger().Infof("Found order ID %d in set: %v", order.ID, order)
 
-	// Get block number from the event
-	upcomingBlockHeight := vLog.BlockNumber
-
-	// Prevent unnecessary status update
-	if order.Status == constants.Success {
-		logger.GetLogger().Infof("Skipping order ID %d as it is already in SUCCESS status", order.ID)
-		return nil, nil
+	// Prevent unnecessary status update, orders on hold stay on hold until the compliance review
+	if constants.IsPaidStatus(order.Status) || order.Status == constants.OnHold {
+		logger.GetLogger().Infof("Skipping order ID %d as it is already in %s status", order.ID, order.Status)
+		return nil
 	}
 	if order.BlockHeight >= upcomingBlockHeight || order.UpcomingBlockHeight >= upcomingBlockHeight {
 		logger.GetLogger().Infof(
@@ -181,15 +217,20 @@ func (listener *tokenTransferListener) parseAndProcessRealtimeTransferEvent(vLog
 			order.BlockHeight,
 			order.UpcomingBlockHeight,
 		)
-		return nil, nil
+		return nil
+	}
+
+	// A multi-chain order moves to the option it is being paid with
+	if err := listener.selectPaymentOption(ctx, *order); err != nil {
+		return err
 	}
 
 	// Update the order status to 'Processing'
 	status := constants.Processing
-	err = listener.paymentOrderUCase.UpdatePaymentOrder(listener.ctx, order.ID, nil, &upcomingBlockHeight, &status, nil, nil)
+	err = listener.paymentOrderUCase.UpdatePaymentOrder(ctx, order.ID, nil, &upcomingBlockHeight, &status, nil, nil)
 	if err != nil {
 		logger.GetLogger().Errorf("Failed to update order status to processing on network %s for order ID %d, error: %v", listener.network.String(), order.ID, err)
-		return nil, err
+		return err
 	}
 	logger.GetLogger().Infof(
 		"Updated order ID %d to status 'Processing' on network %s, block height: %d",
@@ -202,12 +243,45 @@ func (listener *tokenTransferListener) parseAndProcessRealtimeTransferEvent(vLog
 	order.Status = status
 	order.UpcomingBlockHeight = upcomingBlockHeight
 
-	if err := listener.orderSet.UpdateItem(key, *order); err != nil {
+	if err := listener.orderSet.UpdateItem(order.SetKey(), *order); err != nil {
 		logger.GetLogger().Errorf("Failed to update order in set: %v", err)
-		return nil, err
+		return err
 	}
+	listener.syncOptionItems(*order)
 
-	return transferEvent, nil
+	return nil
+}
+
+// selectPaymentOption moves a multi-chain order to the network of the listener and the token of its item.
+func (listener *tokenTransferListener) selectPaymentOption(ctx context.Context, order dto.PaymentOrderDTO) error {
+	if !order.IsMultiChain() {
+		return nil
+	}
+	if err := listener.paymentOrderUCase.SelectPaymentOption(ctx, order.ID, order.Network, order.Symbol); err != nil {
+		logger.GetLogger().Errorf("Failed to select %s on network %s for order ID %d, error: %v", order.Symbol, order.Network, order.ID, err)
+		return err
+	}
+	return nil
+}
+
+// syncOptionItems copies the status and transferred amount of the order to the items of its other options in the set,
+// so that they are not expired or credited on their own once the order is paid.
+func (listener *tokenTransferListener) syncOptionItems(order dto.PaymentOrderDTO) {
+	key := order.SetKey()
+	for _, optionKey := range order.SetKeys() {
+		if optionKey == key {
+			continue
+		}
+		item, exists := listener.orderSet.GetItem(optionKey)
+		if !exists {
+			continue
+		}
+		item.Status = order.Status
+		item.Transferred = order.Transferred
+		if err := listener.orderSet.UpdateItem(optionKey, item); err != nil {
+			logger.GetLogger().Errorf("Failed to update option %s of order ID %d in set: %v", optionKey, order.ID, err)
+		}
+	}
 }
 
 // parseAndProcessConfirmedTransferEvent parses and processes a confirmed transfer event, checking if it matches any payment order in the set.
@@ -235,6 +309,17 @@ func (listener *tokenTransferListener) parseAndProcessConfirmedTransferEvent(vLo
 	// Create a unique key for the order
 	key := transferEvent.To.Hex() + "_" + tokenSymbol
 
+	return listener.creditOrderPayment(vLog, key, transferEvent, vLog.Address.Hex(), tokenSymbol)
+}
+
+// creditOrderPayment records a confirmed payment of the given token for the order stored under key
+// and updates the order status, returning the processed order for the webhook.
+func (listener *tokenTransferListener) creditOrderPayment(
+	vLog types.Log,
+	key string,
+	transferEvent blockchain.TransferEvent,
+	tokenContractAddress, tokenSymbol string,
+) (any, error) {
 	// Fetch the order details from the set
 	order, err := listener.fetchOrderDetailsFromSet(key, transferEvent, tokenSymbol)
 	if err != nil || order == nil {
@@ -242,7 +327,7 @@ func (listener *tokenTransferListener) parseAndProcessConfirmedTransferEvent(vLo
 	}
 
 	// Get decimals for the token
-	tokenDecimals := listener.tokenDecimalsMap[vLog.Address.Hex()]
+	tokenDecimals := listener.tokenDecimalsMap[tokenContractAddress]
 
 	// Convert transfer amount to token units
 	transferEventValueInEth, err := utils.ConvertSmallestUnitToFloatToken(transferEvent.Value.String(), tokenDecimals)
@@ -254,103 +339,262 @@ func (listener *tokenTransferListener) parseAndProcessConfirmedTransferEvent(vLo
 		return nil, err
 	}
 
+	// Nothing to credit for zero value transfers
+	if transferEvent.Value.Sign() == 0 {
+		logger.GetLogger().Infof("Skipping zero value transfer %s on network %s for order ID %d",
+			vLog.TxHash.Hex(), listener.network.String(), order.ID)
+		return nil, nil
+	}
+
 	// Prepare payment event history payload
 	payload := dto.PaymentEventPayloadDTO{
 		PaymentOrderID:  order.ID,
 		TransactionHash: vLog.TxHash.Hex(),
-		FromAddress:     transferEvent.From.Hex(),
-		ToAddress:       transferEvent.To.Hex(),
-		ContractAddress: vLog.Address.Hex(),
+		LogIndex:        vLog.Index,
+		FromAddress:     chain.ForNetwork(listener.network).FormatAddress(transferEvent.From),
+		ToAddress:       chain.ForNetwork(listener.network).FormatAddress(transferEvent.To),
+		ContractAddress: tokenContractAddress,
 		TokenSymbol:     tokenSymbol,
 		Amount:          transferEventValueInEth,
 		Network:         listener.network.String(),
 	}
 
-	// Process Order Payment
-	isUpdated, err := listener.processOrderPayment(*order, transferEvent, vLog.BlockNumber, tokenDecimals)
+	// A log processed again (restart, catch-up overlap, rescan) is not screened nor credited twice,
+	// its order is only settled again in case it could not be updated when the log was recorded
+	ctx := listener.auditContext(vLog)
+	recorded, err := listener.paymentEventHistoryUCase.IsPaymentEventRecorded(
+		ctx, listener.network, payload.TransactionHash, payload.LogIndex, payload.ToAddress, payload.ContractAddress,
+	)
 	if err != nil {
-		logger.GetLogger().Errorf(
-			"Failed to process payment on network %s for order ID %d, error: %v",
-			listener.network.String(), order.ID, err,
-		)
 		return nil, err
 	}
-	if isUpdated {
-		// Store payment event history
-		if err := listener.paymentEventHistoryUCase.CreatePaymentEventHistory(
-			listener.ctx, []dto.PaymentEventPayloadDTO{payload},
-		); err != nil {
-			logger.GetLogger().Errorf("Failed to store payment event history on network %s for order ID %d, error: %v",
-				listener.network.String(), order.ID, err)
-			return nil, err
-		}
+	if recorded {
+		return listener.resumeOrderPayment(ctx, order, payload, transferEvent, vLog, tokenDecimals)
+	}
 
-		// Update Payment Statistics & Wallet Balance
-		granularity := constants.Daily
-		periodStart := utils.GetPeriodStart(granularity, time.Now())
+	// Screen the payer before recording the payment, a held payment is credited only once it is released.
+	// A payment that could not be screened nor held is left unrecorded, to be screened again when the log is.
+	hold, err := listener.screenPayment(ctx, order, payload)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := listener.paymentStatisticsUCase.IncrementStatistics(
-			listener.ctx, granularity, periodStart, nil, &payload.Amount, payload.TokenSymbol, order.VendorID,
-		); err != nil {
-			logger.GetLogger().Errorf("Failed to increment payment statistics on network %s for order ID %d, error: %v",
-				listener.network.String(), order.ID, err)
+	// Record the event, it is stored only once per transaction hash and log index. A held payment is recorded with
+	// its compliance hold, any other payment is credited to the statistics and, unless it went through the router,
+	// to its payment wallet with the record
+	var created bool
+	if hold != nil {
+		created, err = listener.complianceUCase.RecordHeldPayment(ctx, *hold, payload)
+	} else {
+		var walletID *uint64
+		if !order.IsRouterPayment() {
+			// Router payments go straight to the router treasury, no payment wallet holds them
+			walletID = &order.Wallet.ID
 		}
+		created, err = listener.paymentEventHistoryUCase.CreditPaymentEvent(ctx, payload, order.VendorID, walletID)
+	}
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to store payment event history on network %s for order ID %d, error: %v",
+			listener.network.String(), order.ID, err)
+		return nil, err
+	}
+	if !created {
+		logger.GetLogger().Infof("Skipping transfer %s (log index %d) on network %s for order ID %d, recorded concurrently",
+			payload.TransactionHash, payload.LogIndex, listener.network.String(), order.ID)
+		return nil, nil
+	}
+	if hold != nil {
+		return listener.heldOrderOf(order)
+	}
+
+	processedOrder, err := listener.settleOrderPayment(ctx, order, transferEvent, vLog, tokenDecimals)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return nil if no order was processed
+	if processedOrder.ID == 0 {
+		return nil, nil
+	}
 
-		if err := listener.paymentWalletUCase.AddPaymentWalletBalance(
-			listener.ctx, order.Wallet.ID, payload.Amount, listener.network, payload.TokenSymbol,
-		); err != nil {
-			logger.GetLogger().Errorf("Failed to add payment wallet balance on network %s for order ID %d, error: %v",
-				listener.network.String(), order.ID, err)
+	// Ready to send webhook for the processed order
+	return processedOrder, nil
+}
+
+// resumeOrderPayment settles the order of a payment recorded before. The order status and transferred amount
+// are computed again from the recorded payments, the statistics and wallet balance were credited in the transaction
+// recording the payment.
+// Held payments are left to the compliance review. The order is returned for the webhook only when it changed.
+func (listener *tokenTransferListener) resumeOrderPayment(
+	ctx context.Context,
+	order *dto.PaymentOrderDTO,
+	payload dto.PaymentEventPayloadDTO,
+	transferEvent blockchain.TransferEvent,
+	vLog types.Log,
+	tokenDecimals uint8,
+) (any, error) {
+	currentOrder, err := listener.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get order by ID %d, error: %v", order.ID, err)
+		return nil, err
+	}
+	if !slices.ContainsFunc(currentOrder.EventHistories, func(event dto.PaymentHistoryDTO) bool {
+		return event.IsLog(payload.TransactionHash, payload.LogIndex)
+	}) {
+		logger.GetLogger().Infof("Skipping transfer %s (log index %d) on network %s recorded for another order than order ID %d",
+			payload.TransactionHash, payload.LogIndex, listener.network.String(), order.ID)
+		return nil, nil
+	}
+	hold, err := listener.complianceUCase.GetPaymentHold(ctx, order.ID, payload.TransactionHash, payload.LogIndex)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get the compliance hold of payment %s for order ID %d, error: %v",
+			payload.TransactionHash, order.ID, err)
+		return nil, err
+	}
+	if hold != nil && hold.Status == constants.ComplianceHoldHeld && currentOrder.Status != constants.OnHold {
+		// The order could not be put on hold when the payment was recorded
+		if err := listener.complianceUCase.ResumeHold(ctx, *hold); err != nil {
+			logger.GetLogger().Errorf("Failed to put order ID %d on hold for payment %s, error: %v",
+				order.ID, payload.TransactionHash, err)
+			return nil, err
 		}
+		return listener.heldOrderOf(order)
+	}
+	if hold != nil || currentOrder.Status == constants.OnHold {
+		logger.GetLogger().Infof("Skipping already processed transfer %s (log index %d) on network %s for order ID %d, held for compliance review",
+			payload.TransactionHash, payload.LogIndex, listener.network.String(), order.ID)
+		return nil, nil
+	}
+
+	processedOrder, err := listener.settleOrderPayment(ctx, order, transferEvent, vLog, tokenDecimals)
+	if err != nil {
+		return nil, err
+	}
+	if processedOrder.ID == 0 ||
+		(processedOrder.Status == currentOrder.Status && processedOrder.Transferred == currentOrder.Transferred) {
+		logger.GetLogger().Infof("Skipping already processed transfer %s (log index %d) on network %s for order ID %d",
+			payload.TransactionHash, payload.LogIndex, listener.network.String(), order.ID)
+		return nil, nil
+	}
+
+	logger.GetLogger().Infof("Settled order ID %d on network %s from already recorded transfer %s (log index %d)",
+		order.ID, listener.network.String(), payload.TransactionHash, payload.LogIndex)
+	return processedOrder, nil
+}
+
+// settleOrderPayment updates the status and transferred amount of the order from its recorded payments
+// and returns the processed order.
+func (listener *tokenTransferListener) settleOrderPayment(
+	ctx context.Context,
+	order *dto.PaymentOrderDTO,
+	transferEvent blockchain.TransferEvent,
+	vLog types.Log,
+	tokenDecimals uint8,
+) (dto.PaymentOrderDTOResponse, error) {
+	// Process Order Payment
+	if _, err := listener.processOrderPayment(ctx, *order, transferEvent, vLog, tokenDecimals); err != nil {
+		logger.GetLogger().Errorf(
+			"Failed to process payment on network %s for order ID %d, error: %v",
+			listener.network.String(), order.ID, err,
+		)
+		return dto.PaymentOrderDTOResponse{}, err
 	}
 
 	// Retrieve updated order from DB
 	processedOrder, err := listener.paymentOrderUCase.GetPaymentOrderByID(listener.ctx, order.ID)
 	if err != nil {
 		logger.GetLogger().Errorf("Failed to get the processed order by ID %d, error: %v", order.ID, err)
-		return nil, err
+		return dto.PaymentOrderDTOResponse{}, err
 	}
-
-	// Return nil if no order was processed
 	if processedOrder.ID == 0 {
-		return nil, nil
+		return processedOrder, nil
 	}
 
 	// Recheck if the processed order is already SUCCESS
-	if err := listener.recheckOrder(&processedOrder, tokenDecimals); err != nil {
+	if err := listener.recheckOrder(ctx, &processedOrder, tokenDecimals); err != nil {
 		logger.GetLogger().Errorf("Recheck and release wallet failed for order ID %d: %v", processedOrder.ID, err)
 	}
-
-	// Ready to send webhook for the processed order
 	return processedOrder, nil
 }
 
+// screenPayment screens the payer of the payment and returns its hold for the compliance review,
+// nil when the payment is not held.
+func (listener *tokenTransferListener) screenPayment(
+	ctx context.Context, order *dto.PaymentOrderDTO, payload dto.PaymentEventPayloadDTO,
+) (*dto.ComplianceHoldDTO, error) {
+	holdPayload := dto.ComplianceHoldPayloadDTO{
+		PaymentOrderID:  order.ID,
+		VendorID:        order.VendorID,
+		Network:         listener.network.String(),
+		Symbol:          payload.TokenSymbol,
+		TransactionHash: payload.TransactionHash,
+		LogIndex:        payload.LogIndex,
+		FromAddress:     payload.FromAddress,
+		ToAddress:       payload.ToAddress,
+		Amount:          payload.Amount,
+	}
+	if !order.IsRouterPayment() {
+		holdPayload.WalletID = &order.Wallet.ID
+	}
+
+	hold, err := listener.complianceUCase.ScreenPayment(ctx, holdPayload)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to screen payment %s on network %s for order ID %d, error: %v",
+			payload.TransactionHash, listener.network.String(), order.ID, err)
+		return nil, err
+	}
+	return hold, nil
+}
+
+// heldOrderOf returns the order whose payment was held so that its webhook is sent once it is put on hold.
+func (listener *tokenTransferListener) heldOrderOf(order *dto.PaymentOrderDTO) (any, error) {
+	heldOrder, err := listener.paymentOrderUCase.GetPaymentOrderByID(listener.ctx, order.ID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get the held order by ID %d, error: %v", order.ID, err)
+		return nil, err
+	}
+	if heldOrder.Status != constants.OnHold || order.Status == constants.OnHold {
+		// Paid orders keep their status and orders already on hold were notified before
+		return nil, nil
+	}
+
+	order.Status = constants.OnHold
+	if err := listener.orderSet.UpdateItem(order.SetKey(), *order); err != nil {
+		logger.GetLogger().Errorf("Failed to update held order in set: %v", err)
+	}
+	listener.syncOptionItems(*order)
+
+	return heldOrder, nil
+}
+
 // processOrderPayment handles the payment for an order based on the transfer event details.
 // It updates the order status and wallet usage based on the payment amount.
 func (listener *tokenTransferListener) processOrderPayment(
+	ctx context.Context,
 	order dto.PaymentOrderDTO,
 	transferEvent blockchain.TransferEvent,
-	blockHeight uint64,
+	vLog types.Log,
 	tokenDecimals uint8,
 ) (bool, error) {
+	blockHeight := vLog.BlockNumber
 	orderAmount, err := utils.ConvertFloatTokenToSmallestUnit(order.Amount, tokenDecimals)
 	if err != nil {
 		return false, fmt.Errorf("failed to convert order amount: %v", err)
 	}
-	minimumAcceptedAmount := payment.CalculatePaymentCoveringAsDiscount(
-		orderAmount, conf.GetPaymentCovering(), tokenDecimals,
-	)
 
 	// Get newest order state in cache or DB
-	orderDTO, err := listener.paymentOrderUCase.GetPaymentOrderByID(listener.ctx, order.ID)
+	orderDTO, err := listener.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
 	if err != nil {
 		logger.GetLogger().Errorf("Failed to get order by ID %d, error: %v", order.ID, err)
 		return false, err
 	}
 
-	// Calculate total transferred amount from EventHistories
+	// Calculate total transferred amount from EventHistories, the transfer itself is added below
 	totalTransferred := big.NewInt(0)
-	for _, event := range orderDTO.EventHistories {
+	for _, event := range orderDTO.CountedEventHistories(order.Network, order.Symbol, conf.GetSplitPaymentPolicy()) {
+		if event.IsLog(vLog.TxHash.Hex(), vLog.Index) {
+			continue
+		}
 		amountWei, err := utils.ConvertFloatTokenToSmallestUnit(event.Amount, tokenDecimals)
 		if err != nil {
 			logger.GetLogger().Warnf("Failed to convert event amount to Wei, tx: %s, err: %v", event.TransactionHash, err)
@@ -362,11 +606,20 @@ func (listener *tokenTransferListener) processOrderPayment(
 	// Calculate the total transferred amount by adding the new transfer event value.
 	totalTransferred = new(big.Int).Add(totalTransferred, transferEvent.Value)
 
-	// Check if the total transferred amount is greater than or equal to the minimum accepted amount (full payment).
-	if totalTransferred.Cmp(minimumAcceptedAmount) >= 0 {
-		logger.GetLogger().Infof("Processed full payment on network %s for order ID: %d", listener.network.String(), order.ID)
+	// Evaluate the total transferred amount under the tolerance policy of the vendor and token
+	outcome, err := listener.paymentOrderUCase.EvaluatePayment(
+		ctx, order.VendorID, order.Symbol, orderAmount, totalTransferred, tokenDecimals,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate payment: %w", err)
+	}
+
+	switch outcome {
+	case constants.Success, constants.Overpaid:
+		// Full payment, flagged OVERPAID above the over tolerance
+		logger.GetLogger().Infof("Processed full payment (%s) on network %s for order ID: %d", outcome, listener.network.String(), order.ID)
 
-		status := constants.Success
+		status := outcome
 		// Check if order is still 'Processing' or needs to be marked as 'Success'.
 		/*
 			if blockHeight < order.UpcomingBlockHeight {
@@ -375,25 +628,31 @@ func (listener *tokenTransferListener) processOrderPayment(
 		*/
 
 		// Update the order status to 'Success' and mark the wallet as no longer in use.
-		return true, listener.updatePaymentOrderStatus(order, status, totalTransferred.String(), blockHeight, tokenDecimals)
-	} else if totalTransferred.Cmp(big.NewInt(0)) > 0 {
+		return true, listener.updatePaymentOrderStatus(ctx, order, status, totalTransferred.String(), blockHeight, tokenDecimals)
+	case constants.Partial:
 		// If the total transferred amount is greater than 0 but less than the minimum accepted amount (partial payment).
 		logger.GetLogger().Infof("Processed partial payment on network %s for order ID: %d", listener.network.String(), order.ID)
 
 		// Check if the order is still 'Processing' or needs to be marked as 'Partial'.
+		// The block heights of the other options of a multi-chain order do not compare, its item has the one of this option.
 		status := constants.Partial
-		if blockHeight < orderDTO.UpcomingBlockHeight {
+		upcomingBlockHeight := orderDTO.UpcomingBlockHeight
+		if order.IsMultiChain() {
+			upcomingBlockHeight = order.UpcomingBlockHeight
+		}
+		if blockHeight < upcomingBlockHeight {
 			status = constants.Processing
 		}
 
 		// Update the order status and keep the wallet associated with the order.
-		return true, listener.updatePaymentOrderStatus(order, status, totalTransferred.String(), blockHeight, tokenDecimals)
+		return true, listener.updatePaymentOrderStatus(ctx, order, status, totalTransferred.String(), blockHeight, tokenDecimals)
 	}
 
 	return false, nil
 }
 
 func (listener *tokenTransferListener) updatePaymentOrderStatus(
+	ctx context.Context,
 	order dto.PaymentOrderDTO,
 	status, transferredAmount string,
 	blockHeight uint64,
@@ -409,9 +668,14 @@ func (listener *tokenTransferListener) updatePaymentOrderStatus(
 	order.Transferred = transferredAmountInEth
 	order.Status = status
 
+	// A multi-chain order settles on the option it is paid with
+	if err := listener.selectPaymentOption(ctx, order); err != nil {
+		return fmt.Errorf("failed to select payment option: %w", err)
+	}
+
 	// Update the payment order in database
 	err = listener.paymentOrderUCase.UpdatePaymentOrder(
-		listener.ctx,
+		ctx,
 		order.ID,
 		&blockHeight,
 		nil,
@@ -424,10 +688,11 @@ func (listener *tokenTransferListener) updatePaymentOrderStatus(
 	}
 
 	// Update item in set
-	key := order.PaymentAddress + "_" + order.Symbol
+	key := order.SetKey()
 	if err := listener.orderSet.UpdateItem(key, order); err != nil {
 		return fmt.Errorf("failed to update order in set: %w", err)
 	}
+	listener.syncOptionItems(order)
 
 	logger.GetLogger().Infof("Successfully updated order ID %d to status '%s' with transferred amount: %s on block %d",
 		order.ID, status, transferredAmountInEth, blockHeight)
@@ -449,15 +714,17 @@ func (listener *tokenTransferListener) removeOrders() {
 		}
 		// Check if the order needs to be cleaned
 		if listener.shouldCleanOrder(order) {
-			// Update the order status to 'Expired' if it has expired
-			if order.Status != constants.Success {
+			// Update the order status to 'Expired' if it has expired, orders on hold are settled by the compliance review.
+			// A multi-chain order is expired once, by the item of the option it was created with.
+			if !constants.IsPaidStatus(order.Status) && order.Status != constants.OnHold && order.IsPrimaryOption() {
 				orders[index].Status = constants.Expired
 				cleanedExpiredOrders = append(cleanedExpiredOrders, orders[index])
 			}
 
 			// Remove the order from the set
+			key := order.SetKey()
 			if !listener.orderSet.Remove(func(o dto.PaymentOrderDTO) bool {
-				return o.ID == order.ID
+				return o.ID == order.ID && o.SetKey() == key
 			}) {
 				logger.GetLogger().Errorf("Failed to remove order ID from the order set: %d", order.ID)
 				return
@@ -514,7 +781,7 @@ func (listener *tokenTransferListener) isOrderExpired(order dto.PaymentOrderDTO)
 }
 
 func (listener *tokenTransferListener) isOrderSucceeded(order dto.PaymentOrderDTO) bool {
-	return order.Status == constants.Success
+	return constants.IsPaidStatus(order.Status)
 }
 
 // sendWebhookForOrders sends webhooks for the given orders with the specified status.
@@ -537,6 +804,7 @@ func (listener *tokenTransferListener) sendWebhookForOrders(orders []dto.Payment
 			WebhookURL:     order.WebhookURL,
 			Symbol:         order.Symbol,
 			PaymentAddress: order.PaymentAddress,
+			PaymentMode:    order.PaymentMode,
 			Expired:        uint64(order.ExpiredTime.Unix()),
 		}
 		orderDTOs = append(orderDTOs, paymentOrderDTO)
@@ -557,10 +825,10 @@ func (listener *tokenTransferListener) sendWebhookForOrders(orders []dto.Payment
 	}
 }
 
-func (listener *tokenTransferListener) recheckOrder(processedOrder *dto.PaymentOrderDTOResponse, tokenDecimals uint8) error {
-	// Already success, no further processing required.
-	if processedOrder.Status == constants.Success {
-		logger.GetLogger().Infof("Order ID %d already SUCCESS and wallet released.", processedOrder.ID)
+func (listener *tokenTransferListener) recheckOrder(ctx context.Context, processedOrder *dto.PaymentOrderDTOResponse, tokenDecimals uint8) error {
+	// Already paid, no further processing required.
+	if constants.IsPaidStatus(processedOrder.Status) {
+		logger.GetLogger().Infof("Order ID %d already %s and wallet released.", processedOrder.ID, processedOrder.Status)
 		return nil
 	}
 
@@ -572,7 +840,7 @@ func (listener *tokenTransferListener) recheckOrder(processedOrder *dto.PaymentO
 
 	// Calculate total transferred amount from PaymentHistory
 	totalTransferredWei := big.NewInt(0)
-	for _, event := range processedOrder.EventHistories {
+	for _, event := range processedOrder.CountedEventHistories(processedOrder.Network, processedOrder.Symbol, conf.GetSplitPaymentPolicy()) {
 		eventAmountWei, err := utils.ConvertFloatTokenToSmallestUnit(event.Amount, tokenDecimals)
 		if err != nil {
 			return fmt.Errorf("failed to convert event amount (tx: %s): %w", event.TransactionHash, err)
@@ -580,62 +848,149 @@ func (listener *tokenTransferListener) recheckOrder(processedOrder *dto.PaymentO
 		totalTransferredWei.Add(totalTransferredWei, eventAmountWei)
 	}
 
-	minimumAcceptedAmount := payment.CalculatePaymentCoveringAsDiscount(
-		orderAmountWei, conf.GetPaymentCovering(), tokenDecimals,
-	)
-
 	// Check if total transferred amount is sufficient
-	if totalTransferredWei.Cmp(minimumAcceptedAmount) < 0 {
+	outcome, err := listener.paymentOrderUCase.EvaluatePayment(
+		ctx, processedOrder.VendorID, processedOrder.Symbol, orderAmountWei, totalTransferredWei, tokenDecimals,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate payment: %w", err)
+	}
+	if !constants.IsPaidStatus(outcome) {
 		logger.GetLogger().Infof(
-			"Order ID %d has insufficient amount transferred (%s Wei) for SUCCESS status (minimum required: %s Wei).",
+			"Order ID %d has insufficient amount transferred (%s Wei) for SUCCESS status.",
 			processedOrder.ID,
 			totalTransferredWei.String(),
-			minimumAcceptedAmount.String(),
 		)
 		return nil
 	}
 
 	// Update DB status and release wallet
-	if err := listener.paymentOrderUCase.UpdateOrderToSuccessAndReleaseWallet(listener.ctx, processedOrder.ID); err != nil {
-		return fmt.Errorf("failed to update order status to SUCCESS and release wallet: %w", err)
+	if _, err := listener.paymentOrderUCase.UpdateOrderToSuccessAndReleaseWallet(ctx, processedOrder.ID, outcome); err != nil {
+		return fmt.Errorf("failed to update order status to %s and release wallet: %w", outcome, err)
 	}
 
-	logger.GetLogger().Infof("Successfully updated order ID %d to SUCCESS status independently.", processedOrder.ID)
+	logger.GetLogger().Infof("Successfully updated order ID %d to %s status independently.", processedOrder.ID, outcome)
 
 	// Update processed order status
-	processedOrder.Status = constants.Success
+	processedOrder.Status = outcome
 	processedOrder.Transferred, err = utils.ConvertSmallestUnitToFloatToken(totalTransferredWei.String(), tokenDecimals)
 	if err != nil {
 		return fmt.Errorf("failed to convert total transferred amount back to float: %w", err)
 	}
 
-	// Update the order in the set
-	key := processedOrder.PaymentAddress + "_" + processedOrder.Symbol
-	orderInSet, exists := listener.orderSet.GetItem(key)
-	if !exists {
-		logger.GetLogger().Warnf("Order ID %d not found in set for key %s during recheck.", processedOrder.ID, key)
-		return nil
-	}
+	// Update the order in the set, on every option of a multi-chain order
+	for _, key := range processedOrder.SetKeys() {
+		orderInSet, exists := listener.orderSet.GetItem(key)
+		if !exists {
+			logger.GetLogger().Warnf("Order ID %d not found in set for key %s during recheck.", processedOrder.ID, key)
+			continue
+		}
 
-	orderInSet.Status = constants.Success
-	orderInSet.Transferred = processedOrder.Transferred
-	if err := listener.orderSet.UpdateItem(key, orderInSet); err != nil {
-		return fmt.Errorf("failed to update order in set: %w", err)
+		orderInSet.Status = outcome
+		orderInSet.Transferred = processedOrder.Transferred
+		if err := listener.orderSet.UpdateItem(key, orderInSet); err != nil {
+			return fmt.Errorf("failed to update order in set: %w", err)
+		}
 	}
 
 	return nil
 }
 
-// Register registers the token transfer listener for confirmed and real-time events.
+// routerPaymentOf maps a payment router event to the order set key and an equivalent transfer event.
+// It returns false when the paid token is not one of the tokens of the network.
+func (listener *tokenTransferListener) routerPaymentOf(
+	event paymentrouter.PaymentrouterPaymentReceived,
+) (string, blockchain.TransferEvent, string, string, bool) {
+	for _, contractAddress := range listener.tokenContractAddresses {
+		if !strings.EqualFold(contractAddress, event.Token.Hex()) {
+			continue
+		}
+		tokenSymbol := listener.tokenSymbolsMap[contractAddress]
+		transferEvent := blockchain.TransferEvent{
+			From:  event.Payer,
+			To:    common.HexToAddress(listener.paymentRouterAddress),
+			Value: event.Amount,
+		}
+		// The order ID is tagged as in dto.RouterOrderID, so order IDs out of the uint64 range never match
+		key := common.Hash(event.OrderId).Hex() + "_" + tokenSymbol
+		return key, transferEvent, contractAddress, tokenSymbol, true
+	}
+	return "", blockchain.TransferEvent{}, "", "", false
+}
+
+// tokenSymbolsOf returns the symbols of the token contracts keyed by their address.
+func tokenSymbolsOf(tokenContractAddresses []string) (map[string]string, error) {
+	tokenSymbolsMap := make(map[string]string, len(tokenContractAddresses))
+	for _, addr := range tokenContractAddresses {
+		symbol, err := conf.GetTokenSymbol(addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token symbol for %s: %w", addr, err)
+		}
+		tokenSymbolsMap[addr] = symbol
+	}
+	return tokenSymbolsMap, nil
+}
+
+// processRealtimeRouterPayment marks the order paid through the payment router as processing.
+func (listener *tokenTransferListener) processRealtimeRouterPayment(
+	vLog types.Log, event paymentrouter.PaymentrouterPaymentReceived,
+) (any, error) {
+	key, transferEvent, _, tokenSymbol, ok := listener.routerPaymentOf(event)
+	if !ok {
+		logger.GetLogger().Infof("Skipping router payment of unsupported token %s on network %s", event.Token.Hex(), listener.network.String())
+		return nil, nil
+	}
+
+	if err := listener.markOrderProcessing(listener.auditContext(vLog), key, transferEvent, tokenSymbol, vLog.BlockNumber); err != nil {
+		return nil, err
+	}
+
+	return transferEvent, nil
+}
+
+// processConfirmedRouterPayment credits the order paid through the payment router.
+func (listener *tokenTransferListener) processConfirmedRouterPayment(
+	vLog types.Log, event paymentrouter.PaymentrouterPaymentReceived,
+) (any, error) {
+	key, transferEvent, tokenContractAddress, tokenSymbol, ok := listener.routerPaymentOf(event)
+	if !ok {
+		logger.GetLogger().Infof("Skipping router payment of unsupported token %s on network %s", event.Token.Hex(), listener.network.String())
+		return nil, nil
+	}
+
+	return listener.creditOrderPayment(vLog, key, transferEvent, tokenContractAddress, tokenSymbol)
+}
+
+// Register registers the token transfer listener for confirmed and real-time Transfer events,
+// and for PaymentReceived events when a payment router is deployed on the network.
 func (listener *tokenTransferListener) Register(ctx context.Context) {
 	for _, contractAddress := range listener.tokenContractAddresses {
-		listener.baseEventListener.RegisterConfirmedEventListener(
-			contractAddress,
-			listener.parseAndProcessConfirmedTransferEvent,
-		)
-		listener.baseEventListener.RegisterRealtimeEventListener(
-			contractAddress,
-			listener.parseAndProcessRealtimeTransferEvent,
-		)
+		if err := listener.baseEventListener.RegisterEventHandler(listenertypes.EventHandlerRegistration{
+			ContractAddress: contractAddress,
+			ABI:             listener.parsedABI,
+			EventName:       constants.TransferEventName,
+			Confirmed:       listener.parseAndProcessConfirmedTransferEvent,
+			Realtime:        listener.parseAndProcessRealtimeTransferEvent,
+		}); err != nil {
+			logger.GetLogger().Errorf("Failed to register transfer handler for contract %s on network %s: %v", contractAddress, listener.network.String(), err)
+		}
+	}
+
+	if listener.paymentRouterAddress == "" {
+		return
+	}
+	if err := listener.baseEventListener.RegisterEventHandler(listenertypes.EventHandlerRegistration{
+		ContractAddress: listener.paymentRouterAddress,
+		ABI:             listener.paymentRouterABI,
+		EventName:       constants.PaymentReceivedEventName,
+		Confirmed: NewTypedEventHandler(
+			listener.paymentRouterABI, constants.PaymentReceivedEventName, listener.processConfirmedRouterPayment,
+		),
+		Realtime: NewTypedEventHandler(
+			listener.paymentRouterABI, constants.PaymentReceivedEventName, listener.processRealtimeRouterPayment,
+		),
+	}); err != nil {
+		logger.GetLogger().Errorf("Failed to register payment router handler for contract %s on network %s: %v",
+			listener.paymentRouterAddress, listener.network.String(), err)
 	}
 }
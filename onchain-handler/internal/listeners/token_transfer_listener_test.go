@@ -0,0 +1,550 @@
+package listeners
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/contracts/abigen/paymentrouter"
+	"github.com/genefriendway/onchain-handler/internal/adapters/cache"
+	"github.com/genefriendway/onchain-handler/internal/adapters/orderset"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+)
+
+const (
+	testTokenAddress  = "0x55d398326f99059fF775485246999027B3197955"
+	testWalletAddress = "0x1111111111111111111111111111111111111111"
+	testPayerAddress  = "0x2222222222222222222222222222222222222222"
+	testTxHash        = "0x3333333333333333333333333333333333333333333333333333333333333333"
+	testRouterAddress = "0x4444444444444444444444444444444444444444"
+)
+
+type listenerMocks struct {
+	paymentOrderUCase        *mocks.MockPaymentOrderUCase
+	paymentEventHistoryUCase *mocks.MockPaymentEventHistoryUCase
+	complianceUCase          *mocks.MockComplianceUCase
+}
+
+func newTestListener(t *testing.T, ctrl *gomock.Controller, orders ...dto.PaymentOrderDTO) (*tokenTransferListener, listenerMocks) {
+	ctx := context.Background()
+	orderSet, err := orderset.NewSet(
+		ctx,
+		func(order dto.PaymentOrderDTO) string { return order.SetKey() },
+		cache.NewCachingRepository(ctx, cache.NewGoCacheClient()),
+	)
+	require.NoError(t, err)
+	for _, order := range orders {
+		require.NoError(t, orderSet.Add(order))
+	}
+
+	m := listenerMocks{
+		paymentOrderUCase:        mocks.NewMockPaymentOrderUCase(ctrl),
+		paymentEventHistoryUCase: mocks.NewMockPaymentEventHistoryUCase(ctrl),
+		complianceUCase:          mocks.NewMockComplianceUCase(ctrl),
+	}
+	return &tokenTransferListener{
+		ctx:                      ctx,
+		paymentOrderUCase:        m.paymentOrderUCase,
+		paymentEventHistoryUCase: m.paymentEventHistoryUCase,
+		complianceUCase:          m.complianceUCase,
+		network:                  constants.Bsc,
+		tokenContractAddresses:   []string{testTokenAddress},
+		tokenDecimalsMap:         map[string]uint8{testTokenAddress: 18},
+		tokenSymbolsMap:          map[string]string{testTokenAddress: constants.USDT},
+		orderSet:                 orderSet,
+	}, m
+}
+
+func testOrder() dto.PaymentOrderDTO {
+	return dto.PaymentOrderDTO{
+		ID:             1,
+		RequestID:      "request-1",
+		VendorID:       "vendor-1",
+		PaymentAddress: testWalletAddress,
+		Wallet:         dto.PaymentWalletDTO{ID: 7, Address: testWalletAddress},
+		Amount:         "10",
+		Transferred:    "0",
+		Symbol:         constants.USDT,
+		Network:        constants.Bsc.String(),
+		Status:         constants.Pending,
+		ExpiredTime:    time.Now().Add(time.Hour),
+	}
+}
+
+// testOrderState returns the order as stored, with the payment of the log recorded.
+func testOrderState(status, transferred string, vLog types.Log) dto.PaymentOrderDTOResponse {
+	logIndex := vLog.Index
+	return dto.PaymentOrderDTOResponse{
+		ID:             1,
+		RequestID:      "request-1",
+		VendorID:       "vendor-1",
+		Network:        constants.Bsc.String(),
+		Amount:         "10",
+		Transferred:    transferred,
+		Status:         status,
+		Symbol:         constants.USDT,
+		PaymentAddress: testWalletAddress,
+		EventHistories: []dto.PaymentHistoryDTO{{
+			TransactionHash: vLog.TxHash.Hex(),
+			LogIndex:        &logIndex,
+			FromAddress:     testPayerAddress,
+			ToAddress:       testWalletAddress,
+			Amount:          "10",
+			TokenSymbol:     constants.USDT,
+			Network:         constants.Bsc.String(),
+		}},
+	}
+}
+
+func testTransfer() (types.Log, blockchain.TransferEvent) {
+	vLog := types.Log{
+		Address:     common.HexToAddress(testTokenAddress),
+		BlockNumber: 100,
+		TxHash:      common.HexToHash(testTxHash),
+		Index:       2,
+	}
+	value, _ := new(big.Int).SetString("10000000000000000000", 10) // 10 tokens of 18 decimals
+	return vLog, blockchain.TransferEvent{
+		From:  common.HexToAddress(testPayerAddress),
+		To:    common.HexToAddress(testWalletAddress),
+		Value: value,
+	}
+}
+
+func TestCreditOrderPayment(t *testing.T) {
+	t.Run("RedeliverySettlesOrderOfFailedCredit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testOrder()
+		listener, m := newTestListener(t, ctrl, order)
+		vLog, transferEvent := testTransfer()
+
+		// First delivery: the payment is recorded, then the order update fails
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+			Return(false, nil)
+		m.complianceUCase.EXPECT().ScreenPayment(gomock.Any(), gomock.Any()).Return(nil, nil)
+		walletID := uint64(7)
+		m.paymentEventHistoryUCase.EXPECT().
+			CreditPaymentEvent(gomock.Any(), gomock.Any(), "vendor-1", &walletID).
+			Return(true, nil).Times(1)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.Pending, "0", vLog), nil)
+		m.paymentOrderUCase.EXPECT().EvaluatePayment(gomock.Any(), "vendor-1", constants.USDT, gomock.Any(), gomock.Any(), uint8(18)).
+			Return(constants.Success, nil)
+		m.paymentOrderUCase.EXPECT().UpdatePaymentOrder(gomock.Any(), uint64(1), gomock.Any(), nil, gomock.Any(), gomock.Any(), nil).
+			Return(errors.New("connection reset"))
+
+		processed, err := listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+		require.Error(t, err)
+		require.Nil(t, processed)
+
+		// Redelivery: the payment is not recorded nor counted again, the order is settled from its payments
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+			Return(true, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.Pending, "0", vLog), nil).Times(2)
+		m.complianceUCase.EXPECT().GetPaymentHold(gomock.Any(), uint64(1), vLog.TxHash.Hex(), vLog.Index).Return(nil, nil)
+		m.paymentOrderUCase.EXPECT().EvaluatePayment(gomock.Any(), "vendor-1", constants.USDT, gomock.Any(), gomock.Any(), uint8(18)).
+			DoAndReturn(func(_ context.Context, _, _ string, orderAmount, transferred *big.Int, _ uint8) (string, error) {
+				require.Zero(t, orderAmount.Cmp(transferred), "the recorded payment is counted once")
+				return constants.Success, nil
+			})
+		status := constants.Success
+		transferred := "10.000000000000000000"
+		m.paymentOrderUCase.EXPECT().UpdatePaymentOrder(gomock.Any(), uint64(1), gomock.Any(), nil, &status, &transferred, nil).
+			Return(nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.Success, "10", vLog), nil)
+
+		processed, err = listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+		require.NoError(t, err)
+		processedOrder, ok := processed.(dto.PaymentOrderDTOResponse)
+		require.True(t, ok)
+		require.Equal(t, constants.Success, processedOrder.Status)
+
+		orderInSet, exists := listener.orderSet.GetItem(order.SetKey())
+		require.True(t, exists)
+		require.Equal(t, constants.Success, orderInSet.Status)
+		require.Equal(t, transferred, orderInSet.Transferred)
+	})
+
+	t.Run("RedeliveryOfSettledPayment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testOrder()
+		listener, m := newTestListener(t, ctrl, order)
+		vLog, transferEvent := testTransfer()
+
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+			Return(true, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.Success, "10", vLog), nil).AnyTimes()
+		m.complianceUCase.EXPECT().GetPaymentHold(gomock.Any(), uint64(1), vLog.TxHash.Hex(), vLog.Index).Return(nil, nil)
+		m.paymentOrderUCase.EXPECT().EvaluatePayment(gomock.Any(), "vendor-1", constants.USDT, gomock.Any(), gomock.Any(), uint8(18)).
+			Return(constants.Success, nil)
+		m.paymentOrderUCase.EXPECT().UpdatePaymentOrder(gomock.Any(), uint64(1), gomock.Any(), nil, gomock.Any(), gomock.Any(), nil).
+			Return(nil)
+
+		// The order is unchanged, no webhook is sent again
+		processed, err := listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+		require.NoError(t, err)
+		require.Nil(t, processed)
+	})
+
+	t.Run("RedeliveryOfHeldPayment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testOrder()
+		listener, m := newTestListener(t, ctrl, order)
+		vLog, transferEvent := testTransfer()
+
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+			Return(true, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.OnHold, "0", vLog), nil)
+		m.complianceUCase.EXPECT().GetPaymentHold(gomock.Any(), uint64(1), vLog.TxHash.Hex(), vLog.Index).
+			Return(&dto.ComplianceHoldDTO{ID: 3, Status: constants.ComplianceHoldHeld}, nil)
+
+		// Held payments are credited by the compliance review only
+		processed, err := listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+		require.NoError(t, err)
+		require.Nil(t, processed)
+	})
+
+	t.Run("HeldPaymentIsRecordedWithItsHold", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testOrder()
+		listener, m := newTestListener(t, ctrl, order)
+		vLog, transferEvent := testTransfer()
+
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+			Return(false, nil)
+		hold := &dto.ComplianceHoldDTO{PaymentOrderID: 1, TransactionHash: vLog.TxHash.Hex(), LogIndex: vLog.Index, Status: constants.ComplianceHoldHeld}
+		m.complianceUCase.EXPECT().ScreenPayment(gomock.Any(), gomock.Any()).Return(hold, nil)
+		m.complianceUCase.EXPECT().RecordHeldPayment(gomock.Any(), *hold, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ dto.ComplianceHoldDTO, payload dto.PaymentEventPayloadDTO) (bool, error) {
+				require.Equal(t, vLog.TxHash.Hex(), payload.TransactionHash)
+				require.Equal(t, vLog.Index, payload.LogIndex)
+				return true, nil
+			})
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.OnHold, "0", vLog), nil)
+
+		// The payment is not credited, the order put on hold is returned for its webhook
+		processed, err := listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+		require.NoError(t, err)
+		heldOrder, ok := processed.(dto.PaymentOrderDTOResponse)
+		require.True(t, ok)
+		require.Equal(t, constants.OnHold, heldOrder.Status)
+
+		orderInSet, exists := listener.orderSet.GetItem(order.SetKey())
+		require.True(t, exists)
+		require.Equal(t, constants.OnHold, orderInSet.Status)
+	})
+
+	t.Run("RedeliveryHoldsOrderOfHeldPayment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testOrder()
+		listener, m := newTestListener(t, ctrl, order)
+		vLog, transferEvent := testTransfer()
+
+		// The payment and its hold were recorded but the order could not be put on hold
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+			Return(true, nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.Pending, "0", vLog), nil)
+		hold := &dto.ComplianceHoldDTO{ID: 3, PaymentOrderID: 1, Status: constants.ComplianceHoldHeld}
+		m.complianceUCase.EXPECT().GetPaymentHold(gomock.Any(), uint64(1), vLog.TxHash.Hex(), vLog.Index).Return(hold, nil)
+		m.complianceUCase.EXPECT().ResumeHold(gomock.Any(), *hold).Return(nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).
+			Return(testOrderState(constants.OnHold, "0", vLog), nil)
+
+		processed, err := listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+		require.NoError(t, err)
+		heldOrder, ok := processed.(dto.PaymentOrderDTOResponse)
+		require.True(t, ok)
+		require.Equal(t, constants.OnHold, heldOrder.Status)
+	})
+
+	t.Run("ScreeningFailureRecordsNothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testOrder()
+		listener, m := newTestListener(t, ctrl, order)
+		vLog, transferEvent := testTransfer()
+
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+			Return(false, nil)
+		m.complianceUCase.EXPECT().ScreenPayment(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection reset"))
+
+		// The payment is left unrecorded, to be screened again when the log is processed again
+		processed, err := listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+		require.Error(t, err)
+		require.Nil(t, processed)
+	})
+}
+
+func TestCreditMultiChainOrderPayment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The order was created on TRON and also accepts USDT on BSC, the network of the listener
+	options := []dto.PaymentOptionDTO{
+		{Network: constants.Tron.String(), Symbol: constants.USDT},
+		{Network: constants.Bsc.String(), Symbol: constants.USDT},
+	}
+	order := testOrder()
+	order.Options = dto.EncodePaymentOptions(options)
+	tronItem := order
+	tronItem.Network = constants.Tron.String()
+	listener, m := newTestListener(t, ctrl, order, tronItem)
+	vLog, transferEvent := testTransfer()
+
+	pendingState := testOrderState(constants.Pending, "0", vLog)
+	pendingState.Network = constants.Tron.String()
+	pendingState.Options = options
+	paidState := testOrderState(constants.Success, "10", vLog)
+	paidState.Options = options
+
+	m.paymentEventHistoryUCase.EXPECT().
+		IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testWalletAddress, testTokenAddress).
+		Return(false, nil)
+	m.complianceUCase.EXPECT().ScreenPayment(gomock.Any(), gomock.Any()).Return(nil, nil)
+	walletID := uint64(7)
+	m.paymentEventHistoryUCase.EXPECT().CreditPaymentEvent(gomock.Any(), gomock.Any(), "vendor-1", &walletID).Return(true, nil)
+	gomock.InOrder(
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).Return(pendingState, nil),
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), uint64(1)).Return(paidState, nil),
+	)
+	m.paymentOrderUCase.EXPECT().EvaluatePayment(gomock.Any(), "vendor-1", constants.USDT, gomock.Any(), gomock.Any(), uint8(18)).
+		Return(constants.Success, nil)
+
+	// The order settles on the option paid before its status is updated
+	status := constants.Success
+	transferred := "10.000000000000000000"
+	gomock.InOrder(
+		m.paymentOrderUCase.EXPECT().SelectPaymentOption(gomock.Any(), uint64(1), constants.Bsc.String(), constants.USDT).Return(nil),
+		m.paymentOrderUCase.EXPECT().UpdatePaymentOrder(gomock.Any(), uint64(1), gomock.Any(), nil, &status, &transferred, nil).
+			Return(nil),
+	)
+
+	processed, err := listener.creditOrderPayment(vLog, order.SetKey(), transferEvent, testTokenAddress, constants.USDT)
+	require.NoError(t, err)
+	processedOrder, ok := processed.(dto.PaymentOrderDTOResponse)
+	require.True(t, ok)
+	require.Equal(t, constants.Success, processedOrder.Status)
+	require.Equal(t, constants.Bsc.String(), processedOrder.Network)
+
+	// The item of the other option is paid too, so it is neither credited nor expired on its own
+	for _, item := range []dto.PaymentOrderDTO{order, tronItem} {
+		itemInSet, exists := listener.orderSet.GetItem(item.SetKey())
+		require.True(t, exists)
+		require.Equal(t, constants.Success, itemInSet.Status, item.Network)
+		require.Equal(t, transferred, itemInSet.Transferred, item.Network)
+	}
+}
+
+// newTestRouterListener returns a listener registered on a base listener, with the payment router deployed
+// at routerAddress, or without a router when it is empty.
+func newTestRouterListener(
+	t *testing.T, ctrl *gomock.Controller, routerAddress string, orders ...dto.PaymentOrderDTO,
+) (*tokenTransferListener, *baseEventListener, listenerMocks) {
+	listener, m := newTestListener(t, ctrl, orders...)
+	parsedABI, err := abi.JSON(strings.NewReader(constants.Erc20TransferEventABI))
+	require.NoError(t, err)
+	paymentRouterABI, err := paymentrouter.PaymentrouterMetaData.GetAbi()
+	require.NoError(t, err)
+
+	base := &baseEventListener{
+		network:                constants.Bsc,
+		confirmedEventHandlers: newEventRegistry(),
+		realtimeEventHandlers:  newEventRegistry(),
+	}
+	listener.baseEventListener = base
+	listener.parsedABI = parsedABI
+	listener.paymentRouterABI = *paymentRouterABI
+	listener.paymentRouterAddress = routerAddress
+	listener.Register(context.Background())
+	return listener, base, m
+}
+
+func testRouterOrder() dto.PaymentOrderDTO {
+	order := testOrder()
+	order.PaymentMode = constants.PaymentModeRouter
+	order.PaymentAddress = testRouterAddress
+	order.Wallet = dto.PaymentWalletDTO{}
+	return order
+}
+
+// testRouterPayment returns the PaymentReceived log of 10 tokens paid for the order through the router at routerAddress.
+func testRouterPayment(t *testing.T, routerAddress string, orderID uint64, tokenAddress string) types.Log {
+	paymentRouterABI, err := paymentrouter.PaymentrouterMetaData.GetAbi()
+	require.NoError(t, err)
+	value, _ := new(big.Int).SetString("10000000000000000000", 10)
+	return types.Log{
+		Address: common.HexToAddress(routerAddress),
+		Topics: []common.Hash{
+			paymentRouterABI.Events[constants.PaymentReceivedEventName].ID,
+			common.HexToHash(dto.RouterOrderID(orderID)),
+			common.BytesToHash(common.HexToAddress(testPayerAddress).Bytes()),
+			common.BytesToHash(common.HexToAddress(tokenAddress).Bytes()),
+		},
+		Data:        common.LeftPadBytes(value.Bytes(), 32),
+		BlockNumber: 100,
+		TxHash:      common.HexToHash(testTxHash),
+		Index:       2,
+	}
+}
+
+func TestRouterPayment(t *testing.T) {
+	t.Run("OnlyLogsOfRouterAreHandled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, base, _ := newTestRouterListener(t, ctrl, testRouterAddress)
+		require.ElementsMatch(t,
+			[]common.Address{common.HexToAddress(testTokenAddress), common.HexToAddress(testRouterAddress)},
+			base.confirmedEventHandlers.contractAddresses(),
+		)
+		require.Len(t, base.confirmedEventHandlers.handlersOf(testRouterPayment(t, testRouterAddress, 1, testTokenAddress)), 1)
+		require.Len(t, base.realtimeEventHandlers.handlersOf(testRouterPayment(t, testRouterAddress, 1, testTokenAddress)), 1)
+
+		// The same event emitted by another contract is never handled
+		otherContract := "0x5555555555555555555555555555555555555555"
+		require.Empty(t, base.confirmedEventHandlers.handlersOf(testRouterPayment(t, otherContract, 1, testTokenAddress)))
+		require.Empty(t, base.realtimeEventHandlers.handlersOf(testRouterPayment(t, otherContract, 1, testTokenAddress)))
+	})
+
+	t.Run("NoRouterDeployed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, base, _ := newTestRouterListener(t, ctrl, "")
+		require.Equal(t, []common.Address{common.HexToAddress(testTokenAddress)}, base.confirmedEventHandlers.contractAddresses())
+		require.Equal(t, []common.Address{common.HexToAddress(testTokenAddress)}, base.realtimeEventHandlers.contractAddresses())
+	})
+
+	t.Run("CreditsOrderOfReference", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testRouterOrder()
+		_, base, m := newTestRouterListener(t, ctrl, testRouterAddress, order)
+		vLog := testRouterPayment(t, testRouterAddress, order.ID, testTokenAddress)
+
+		m.paymentEventHistoryUCase.EXPECT().
+			IsPaymentEventRecorded(gomock.Any(), constants.Bsc, vLog.TxHash.Hex(), vLog.Index, testRouterAddress, testTokenAddress).
+			Return(false, nil)
+		m.complianceUCase.EXPECT().ScreenPayment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, payload dto.ComplianceHoldPayloadDTO) (*dto.ComplianceHoldDTO, error) {
+				require.Nil(t, payload.WalletID, "no payment wallet holds router payments")
+				return nil, nil
+			})
+
+		// The payment is credited to the statistics only, the router treasury receives it
+		m.paymentEventHistoryUCase.EXPECT().CreditPaymentEvent(gomock.Any(), gomock.Any(), "vendor-1", nil).
+			DoAndReturn(func(_ context.Context, payload dto.PaymentEventPayloadDTO, _ string, _ *uint64) (bool, error) {
+				require.Equal(t, order.ID, payload.PaymentOrderID)
+				require.Equal(t, testPayerAddress, payload.FromAddress)
+				require.Equal(t, testRouterAddress, payload.ToAddress)
+				require.Equal(t, constants.USDT, payload.TokenSymbol)
+				require.Equal(t, "10.000000000000000000", payload.Amount)
+				return true, nil
+			})
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), order.ID).
+			Return(testOrderState(constants.Pending, "0", vLog), nil)
+		m.paymentOrderUCase.EXPECT().EvaluatePayment(gomock.Any(), "vendor-1", constants.USDT, gomock.Any(), gomock.Any(), uint8(18)).
+			Return(constants.Success, nil)
+		m.paymentOrderUCase.EXPECT().UpdatePaymentOrder(gomock.Any(), order.ID, gomock.Any(), nil, gomock.Any(), gomock.Any(), nil).
+			Return(nil)
+		m.paymentOrderUCase.EXPECT().GetPaymentOrderByID(gomock.Any(), order.ID).
+			Return(testOrderState(constants.Success, "10", vLog), nil)
+
+		processed, err := base.confirmedEventHandlers.handlersOf(vLog)[0](vLog)
+		require.NoError(t, err)
+		processedOrder, ok := processed.(dto.PaymentOrderDTOResponse)
+		require.True(t, ok)
+		require.Equal(t, constants.Success, processedOrder.Status)
+	})
+
+	t.Run("MarksOrderOfReferenceProcessing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testRouterOrder()
+		listener, base, m := newTestRouterListener(t, ctrl, testRouterAddress, order)
+		vLog := testRouterPayment(t, testRouterAddress, order.ID, testTokenAddress)
+
+		status := constants.Processing
+		blockHeight := vLog.BlockNumber
+		m.paymentOrderUCase.EXPECT().UpdatePaymentOrder(gomock.Any(), order.ID, nil, &blockHeight, &status, nil, nil).Return(nil)
+
+		_, err := base.realtimeEventHandlers.handlersOf(vLog)[0](vLog)
+		require.NoError(t, err)
+		orderInSet, exists := listener.orderSet.GetItem(order.SetKey())
+		require.True(t, exists)
+		require.Equal(t, constants.Processing, orderInSet.Status)
+	})
+
+	t.Run("OtherReferenceIsSkipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// The ADDRESS order 2 is paid at its payment address, a router payment tagged with its ID does not match it
+		addressOrder := testOrder()
+		addressOrder.ID = 2
+		_, base, _ := newTestRouterListener(t, ctrl, testRouterAddress, testRouterOrder(), addressOrder)
+
+		for _, orderID := range []uint64{2, 3} {
+			vLog := testRouterPayment(t, testRouterAddress, orderID, testTokenAddress)
+			processed, err := base.confirmedEventHandlers.handlersOf(vLog)[0](vLog)
+			require.NoError(t, err)
+			require.Nil(t, processed)
+
+			// No order is marked processing
+			_, err = base.realtimeEventHandlers.handlersOf(vLog)[0](vLog)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("UnsupportedTokenIsSkipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		order := testRouterOrder()
+		_, base, _ := newTestRouterListener(t, ctrl, testRouterAddress, order)
+		vLog := testRouterPayment(t, testRouterAddress, order.ID, "0x6666666666666666666666666666666666666666")
+
+		processed, err := base.confirmedEventHandlers.handlersOf(vLog)[0](vLog)
+		require.NoError(t, err)
+		require.Nil(t, processed)
+	})
+}
@@ -0,0 +1,418 @@
+package listeners
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
+	"github.com/genefriendway/onchain-handler/internal/adapters/orderset"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	listenertypes "github.com/genefriendway/onchain-handler/internal/listeners/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// tokenTransferRescanner re-scans a block range of one network and credits the transfers missed by the listener.
+// Applied transfers go through the same confirmed event handler as the live listener, using a private order set
+// holding only the order being credited. The block state is never touched.
+type tokenTransferRescanner struct {
+	ethClient                clienttypes.Client
+	network                  constants.NetworkType
+	tokenContractAddresses   []common.Address
+	paymentOrderUCase        ucasetypes.PaymentOrderUCase
+	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase
+	listener                 *tokenTransferListener
+	mu                       sync.Mutex // Allows a single rescan per network at a time
+}
+
+// NewTokenTransferRescanner creates a rescanner for the token transfers of a network.
+func NewTokenTransferRescanner(
+	ctx context.Context,
+	cacheRepo cachetypes.CacheRepository,
+	ethClient clienttypes.Client,
+	paymentOrderUCase ucasetypes.PaymentOrderUCase,
+	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
+	network constants.NetworkType,
+	tokenContractAddresses []string,
+) (listenertypes.TransferRescanner, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(constants.Erc20TransferEventABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ERC20 ABI: %w", err)
+	}
+
+	tokenDecimalsMap := make(map[string]uint8)
+	var contractAddresses []common.Address
+	for _, addr := range tokenContractAddresses {
+		decimals, err := blockchain.FetchTokenDecimals(ctx, ethClient, addr, network.String(), cacheRepo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token decimals for %s: %w", addr, err)
+		}
+		tokenDecimalsMap[addr] = decimals
+		contractAddresses = append(contractAddresses, common.HexToAddress(addr))
+	}
+	tokenSymbolsMap, err := tokenSymbolsOf(tokenContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the orders being credited apart from the live order set
+	orderSet, err := orderset.NewNamespacedSet(ctx, "rescan_"+network.String(), func(order dto.PaymentOrderDTO) string {
+		return order.SetKey()
+	}, cacheRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rescan order set: %w", err)
+	}
+
+	return &tokenTransferRescanner{
+		ethClient:                ethClient,
+		network:                  network,
+		tokenContractAddresses:   contractAddresses,
+		paymentOrderUCase:        paymentOrderUCase,
+		paymentEventHistoryUCase: paymentEventHistoryUCase,
+		listener: &tokenTransferListener{
+			ctx:                      utils.WithAuditActor(ctx, utils.AuditActor(constants.AuditActorRescan, network.String())),
+			cacheRepo:                cacheRepo,
+			paymentOrderUCase:        paymentOrderUCase,
+			paymentEventHistoryUCase: paymentEventHistoryUCase,
+			complianceUCase:          complianceUCase,
+			network:                  network,
+			tokenContractAddresses:   tokenContractAddresses,
+			tokenDecimalsMap:         tokenDecimalsMap,
+			tokenSymbolsMap:          tokenSymbolsMap,
+			parsedABI:                parsedABI,
+			orderSet:                 orderSet,
+		},
+	}, nil
+}
+
+// rescanState carries what one rescan run has seen so far.
+type rescanState struct {
+	ordersByWallet map[string][]dto.PaymentOrderDTO // Candidate orders keyed by lowercase payment address and symbol
+	blockTimes     map[uint64]time.Time
+	pending        map[uint64]*big.Int // Dry-run credits per order, so later transfers project on top of earlier ones
+	seen           map[string]struct{} // Transaction hash and log index of the processed logs
+}
+
+// Rescan scans fromBlock to toBlock and reports every transfer paid to an order of the network.
+// In dry-run mode nothing is written, with apply the transfers not yet recorded are credited.
+func (r *tokenTransferRescanner) Rescan(ctx context.Context, fromBlock, toBlock uint64, apply bool) (dto.RescanResultDTO, error) {
+	result := dto.RescanResultDTO{
+		Network:   r.network.String(),
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Apply:     apply,
+		Events:    []dto.RescanEventDTO{},
+	}
+
+	if fromBlock == 0 || fromBlock > toBlock {
+		return result, fmt.Errorf("invalid block range %d to %d", fromBlock, toBlock)
+	}
+	if !r.mu.TryLock() {
+		return result, fmt.Errorf("a rescan is already running on network %s", r.network.String())
+	}
+	defer r.mu.Unlock()
+
+	latestBlock, err := r.ethClient.GetLatestBlockNumber(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to get latest block on network %s: %w", r.network.String(), err)
+	}
+	if toBlock > latestBlock.Uint64() {
+		return result, fmt.Errorf("to block %d is beyond the latest block %d on network %s", toBlock, latestBlock.Uint64(), r.network.String())
+	}
+
+	// Step 1: Load the orders that could have been paid within the time span of the range
+	startTime, err := r.ethClient.GetBlockTime(ctx, fromBlock)
+	if err != nil {
+		return result, fmt.Errorf("failed to get time of block %d: %w", fromBlock, err)
+	}
+	endTime, err := r.ethClient.GetBlockTime(ctx, toBlock)
+	if err != nil {
+		return result, fmt.Errorf("failed to get time of block %d: %w", toBlock, err)
+	}
+	orders, err := r.paymentOrderUCase.GetPaymentOrdersOpenBetween(ctx, r.network, startTime, endTime)
+	if err != nil {
+		return result, fmt.Errorf("failed to get payment orders for rescan: %w", err)
+	}
+
+	state := &rescanState{
+		ordersByWallet: make(map[string][]dto.PaymentOrderDTO),
+		blockTimes:     make(map[uint64]time.Time),
+		pending:        make(map[uint64]*big.Int),
+		seen:           make(map[string]struct{}),
+	}
+	for _, order := range orders {
+		key := strings.ToLower(chain.NormalizeAddress(order.PaymentAddress)) + "_" + order.Symbol
+		state.ordersByWallet[key] = append(state.ordersByWallet[key], order)
+	}
+	logger.GetLogger().Infof(
+		"Rescanning network %s from block %d to %d (apply: %t) against %d orders",
+		r.network.String(), fromBlock, toBlock, apply, len(orders),
+	)
+
+	// Step 2: Walk the range and process every transfer to a payment address
+	defer r.listener.orderSet.Remove(func(dto.PaymentOrderDTO) bool { return true })
+	for chunkStart := fromBlock; chunkStart <= toBlock; {
+		logs, chunkEnd, err := r.ethClient.PollForLogsUpTo(ctx, r.tokenContractAddresses, chunkStart, toBlock)
+		if err != nil {
+			return result, fmt.Errorf("failed to poll logs on network %s from block %d: %w", r.network.String(), chunkStart, err)
+		}
+
+		for _, vLog := range logs {
+			result.ScannedLogs++
+			event, matched := r.processLog(ctx, vLog, state, apply)
+			if !matched {
+				continue
+			}
+			result.MatchedLogs++
+			if event.Action == constants.RescanCredited {
+				result.CreditedLogs++
+			}
+			result.Events = append(result.Events, event)
+		}
+		chunkStart = chunkEnd + 1
+	}
+
+	logger.GetLogger().Infof(
+		"Rescan on network %s from block %d to %d done: %d logs scanned, %d matched, %d credited",
+		r.network.String(), fromBlock, toBlock, result.ScannedLogs, result.MatchedLogs, result.CreditedLogs,
+	)
+	return result, nil
+}
+
+// processLog matches a transfer log to an order and credits or projects it. It returns false when the log
+// does not pay any order of the network.
+func (r *tokenTransferRescanner) processLog(
+	ctx context.Context,
+	vLog types.Log,
+	state *rescanState,
+	apply bool,
+) (dto.RescanEventDTO, bool) {
+	// Step 1: Decode the transfer and skip the ones that cannot be payments
+	if vLog.Removed {
+		return dto.RescanEventDTO{}, false
+	}
+	tokenSymbol, err := conf.GetTokenSymbol(vLog.Address.Hex())
+	if err != nil {
+		return dto.RescanEventDTO{}, false
+	}
+	transferEvent, err := blockchain.UnpackTransferEvent(vLog, r.listener.parsedABI)
+	if err != nil || transferEvent.Value.Sign() <= 0 {
+		return dto.RescanEventDTO{}, false
+	}
+	candidates := state.ordersByWallet[strings.ToLower(transferEvent.To.Hex())+"_"+tokenSymbol]
+	if len(candidates) == 0 {
+		return dto.RescanEventDTO{}, false
+	}
+
+	logKey := fmt.Sprintf("%s_%d", vLog.TxHash.Hex(), vLog.Index)
+	if _, exists := state.seen[logKey]; exists {
+		return dto.RescanEventDTO{}, false
+	}
+	state.seen[logKey] = struct{}{}
+
+	tokenDecimals := r.listener.tokenDecimalsMap[vLog.Address.Hex()]
+	amount, err := utils.ConvertSmallestUnitToFloatToken(transferEvent.Value.String(), tokenDecimals)
+	if err != nil {
+		logger.GetLogger().Warnf("Failed to convert rescanned transfer value on network %s, tx %s: %v", r.network.String(), vLog.TxHash.Hex(), err)
+		return dto.RescanEventDTO{}, false
+	}
+
+	event := dto.RescanEventDTO{
+		TransactionHash: vLog.TxHash.Hex(),
+		LogIndex:        vLog.Index,
+		BlockNumber:     vLog.BlockNumber,
+		FromAddress:     chain.ForNetwork(r.network).FormatAddress(transferEvent.From),
+		ToAddress:       chain.ForNetwork(r.network).FormatAddress(transferEvent.To),
+		TokenSymbol:     tokenSymbol,
+		Amount:          amount,
+	}
+
+	// Step 2: Pick the order that owned the payment address when the transfer was mined
+	blockTime, exists := state.blockTimes[vLog.BlockNumber]
+	if !exists {
+		blockTime, err = r.ethClient.GetBlockTime(ctx, vLog.BlockNumber)
+		if err != nil {
+			event.Action = constants.RescanFailed
+			event.Error = fmt.Sprintf("failed to get block time: %v", err)
+			return event, true
+		}
+		state.blockTimes[vLog.BlockNumber] = blockTime
+	}
+	order, found := selectRescanOrder(candidates, blockTime, conf.GetOrderCutoffTime())
+	if !found {
+		return dto.RescanEventDTO{}, false
+	}
+	event.PaymentOrderID = order.ID
+	event.RequestID = order.RequestID
+
+	// Step 3: Skip transfers already recorded by the listener or the catch-up worker
+	recorded, err := r.paymentEventHistoryUCase.IsPaymentEventRecorded(
+		ctx, r.network, vLog.TxHash.Hex(), vLog.Index, event.ToAddress, vLog.Address.Hex(),
+	)
+	if err != nil {
+		event.Action = constants.RescanFailed
+		event.Error = err.Error()
+		return event, true
+	}
+
+	currentOrder, err := r.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
+	if err != nil {
+		event.Action = constants.RescanFailed
+		event.Error = err.Error()
+		return event, true
+	}
+	event.PreviousStatus = currentOrder.Status
+	event.Status = currentOrder.Status
+
+	if recorded {
+		event.Action = constants.RescanAlreadyRecorded
+		if !apply {
+			return event, true
+		}
+
+		// The order is settled again in case it could not be updated when the transfer was recorded
+		processedOrder, settled, err := r.applyCredit(order, currentOrder, vLog)
+		if err != nil {
+			event.Action = constants.RescanFailed
+			event.Error = err.Error()
+			return event, true
+		}
+		if settled {
+			event.Status = processedOrder.Status
+			event.Action = constants.RescanSettled
+			r.sendWebhook(processedOrder)
+		}
+		return event, true
+	}
+	if currentOrder.Status == constants.Cancelled {
+		event.Action = constants.RescanOrderCancelled
+		return event, true
+	}
+
+	// Step 4: Project or apply the credit
+	if !apply {
+		status, err := r.projectStatus(ctx, currentOrder, order.Symbol, transferEvent.Value, tokenDecimals, state.pending)
+		if err != nil {
+			event.Action = constants.RescanFailed
+			event.Error = err.Error()
+			return event, true
+		}
+		event.Status = status
+		event.Action = constants.RescanWouldCredit
+		return event, true
+	}
+
+	processedOrder, credited, err := r.applyCredit(order, currentOrder, vLog)
+	if err == nil && !credited {
+		err = fmt.Errorf("order %d was not credited", order.ID)
+	}
+	if err != nil {
+		event.Action = constants.RescanFailed
+		event.Error = err.Error()
+		return event, true
+	}
+	event.Status = processedOrder.Status
+	event.Action = constants.RescanCredited
+	r.sendWebhook(processedOrder)
+	return event, true
+}
+
+// applyCredit runs the confirmed event handler of the listener with only the given order in its set.
+// It returns false when the handler did not change the order.
+func (r *tokenTransferRescanner) applyCredit(
+	order dto.PaymentOrderDTO,
+	currentOrder dto.PaymentOrderDTOResponse,
+	vLog types.Log,
+) (dto.PaymentOrderDTOResponse, bool, error) {
+	order.Status = currentOrder.Status
+	order.Transferred = currentOrder.Transferred
+
+	r.listener.orderSet.Remove(func(dto.PaymentOrderDTO) bool { return true })
+	if err := r.listener.orderSet.Add(order); err != nil {
+		return dto.PaymentOrderDTOResponse{}, false, fmt.Errorf("failed to add order %d to the rescan set: %w", order.ID, err)
+	}
+
+	processed, err := r.listener.parseAndProcessConfirmedTransferEvent(vLog)
+	if err != nil {
+		return dto.PaymentOrderDTOResponse{}, false, err
+	}
+	processedOrder, ok := processed.(dto.PaymentOrderDTOResponse)
+	return processedOrder, ok, nil
+}
+
+// sendWebhook sends the webhook of an order changed by the rescan.
+func (r *tokenTransferRescanner) sendWebhook(processedOrder dto.PaymentOrderDTOResponse) {
+	if processedOrder.WebhookURL == "" {
+		return
+	}
+	go func() {
+		if err := utils.SendWebhook(processedOrder, processedOrder.WebhookURL); err != nil {
+			logger.GetLogger().Errorf("Failed to send webhook for rescanned order ID %d on network %s: %v", processedOrder.ID, r.network.String(), err)
+		}
+	}()
+}
+
+// projectStatus returns the status the order would get once value of the token and the earlier dry-run credits are added.
+func (r *tokenTransferRescanner) projectStatus(
+	ctx context.Context,
+	currentOrder dto.PaymentOrderDTOResponse,
+	symbol string,
+	value *big.Int,
+	tokenDecimals uint8,
+	pending map[uint64]*big.Int,
+) (string, error) {
+	orderAmount, err := utils.ConvertFloatTokenToSmallestUnit(currentOrder.Amount, tokenDecimals)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert order amount: %w", err)
+	}
+
+	totalTransferred := big.NewInt(0)
+	for _, event := range currentOrder.CountedEventHistories(r.network.String(), symbol, conf.GetSplitPaymentPolicy()) {
+		amountWei, err := utils.ConvertFloatTokenToSmallestUnit(event.Amount, tokenDecimals)
+		if err != nil {
+			return "", fmt.Errorf("failed to convert event amount (tx: %s): %w", event.TransactionHash, err)
+		}
+		totalTransferred.Add(totalTransferred, amountWei)
+	}
+
+	if pending[currentOrder.ID] == nil {
+		pending[currentOrder.ID] = big.NewInt(0)
+	}
+	pending[currentOrder.ID].Add(pending[currentOrder.ID], value)
+	totalTransferred.Add(totalTransferred, pending[currentOrder.ID])
+
+	return r.paymentOrderUCase.EvaluatePayment(
+		ctx, currentOrder.VendorID, symbol, orderAmount, totalTransferred, tokenDecimals,
+	)
+}
+
+// selectRescanOrder picks the latest order created before blockTime that was still accepting payments at blockTime.
+// Orders are expected in creation order.
+func selectRescanOrder(orders []dto.PaymentOrderDTO, blockTime time.Time, orderCutoffTime time.Duration) (dto.PaymentOrderDTO, bool) {
+	for i := len(orders) - 1; i >= 0; i-- {
+		order := orders[i]
+		if order.CreatedAt.After(blockTime) {
+			continue
+		}
+		if blockTime.After(order.ExpiredTime.Add(orderCutoffTime)) {
+			continue
+		}
+		return order, true
+	}
+	return dto.PaymentOrderDTO{}, false
+}
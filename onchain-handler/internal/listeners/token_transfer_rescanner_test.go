@@ -0,0 +1,122 @@
+package listeners
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+)
+
+func TestSelectRescanOrder(t *testing.T) {
+	blockTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cutoff := 30 * time.Minute
+	order := func(id uint64, createdAt, expiredTime time.Time) dto.PaymentOrderDTO {
+		return dto.PaymentOrderDTO{ID: id, CreatedAt: createdAt, ExpiredTime: expiredTime}
+	}
+
+	tests := []struct {
+		name       string
+		orders     []dto.PaymentOrderDTO
+		expectedID uint64
+		found      bool
+	}{
+		{
+			name: "LatestOrderAcceptingPayments",
+			orders: []dto.PaymentOrderDTO{
+				order(1, blockTime.Add(-3*time.Hour), blockTime.Add(time.Hour)),
+				order(2, blockTime.Add(-time.Hour), blockTime.Add(time.Hour)),
+			},
+			expectedID: 2,
+			found:      true,
+		},
+		{
+			name: "OrderCreatedAfterTheBlock",
+			orders: []dto.PaymentOrderDTO{
+				order(1, blockTime.Add(-time.Hour), blockTime.Add(time.Hour)),
+				order(2, blockTime.Add(time.Minute), blockTime.Add(time.Hour)),
+			},
+			expectedID: 1,
+			found:      true,
+		},
+		{
+			name: "OrderExpiredWithinTheCutoff",
+			orders: []dto.PaymentOrderDTO{
+				order(1, blockTime.Add(-time.Hour), blockTime.Add(-10*time.Minute)),
+			},
+			expectedID: 1,
+			found:      true,
+		},
+		{
+			name: "OrderExpiredBeyondTheCutoff",
+			orders: []dto.PaymentOrderDTO{
+				order(1, blockTime.Add(-2*time.Hour), blockTime.Add(-time.Hour)),
+			},
+			found: false,
+		},
+		{
+			name:  "NoOrders",
+			found: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, found := selectRescanOrder(tt.orders, blockTime, cutoff)
+			require.Equal(t, tt.found, found)
+			require.Equal(t, tt.expectedID, selected.ID)
+		})
+	}
+}
+
+func TestProjectStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	paymentOrderUCase := mocks.NewMockPaymentOrderUCase(ctrl)
+	rescanner := &tokenTransferRescanner{network: constants.Bsc, paymentOrderUCase: paymentOrderUCase}
+
+	// The order already has 4 of its 10 tokens recorded
+	currentOrder := dto.PaymentOrderDTOResponse{
+		ID:       1,
+		VendorID: "vendor-1",
+		Amount:   "10",
+		Symbol:   constants.USDT,
+		Network:  constants.Bsc.String(),
+		EventHistories: []dto.PaymentHistoryDTO{{
+			Amount:      "4",
+			TokenSymbol: constants.USDT,
+			Network:     constants.Bsc.String(),
+		}},
+	}
+	tokens := func(amount int64) *big.Int {
+		return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	}
+	pending := make(map[uint64]*big.Int)
+
+	// Each dry-run transfer is projected on top of the earlier ones of the run
+	gomock.InOrder(
+		paymentOrderUCase.EXPECT().
+			EvaluatePayment(gomock.Any(), "vendor-1", constants.USDT, tokens(10), tokens(7), uint8(18)).
+			Return(constants.Partial, nil),
+		paymentOrderUCase.EXPECT().
+			EvaluatePayment(gomock.Any(), "vendor-1", constants.USDT, tokens(10), tokens(10), uint8(18)).
+			Return(constants.Success, nil),
+	)
+
+	status, err := rescanner.projectStatus(context.Background(), currentOrder, constants.USDT, tokens(3), 18, pending)
+	require.NoError(t, err)
+	require.Equal(t, constants.Partial, status)
+
+	status, err = rescanner.projectStatus(context.Background(), currentOrder, constants.USDT, tokens(3), 18, pending)
+	require.NoError(t, err)
+	require.Equal(t, constants.Success, status)
+	require.Equal(t, tokens(6), pending[1], "the dry-run credits of the order are totalled")
+}
@@ -3,18 +3,35 @@ package types
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
 )
 
 // EventHandler is a type for event handler functions.
 type EventHandler func(log types.Log) (any, error)
 
+// EventHandlerRegistration declares which logs a handler processes: the contract and the event of its ABI.
+// Logs are routed by contract address and event signature (topic0), several registrations may share a contract.
+type EventHandlerRegistration struct {
+	ContractAddress string
+	ABI             abi.ABI
+	EventName       string
+	Confirmed       EventHandler // Called once the log is past the confirmation depth, its result goes to the event channel
+	Realtime        EventHandler // Optional, called as soon as the log is seen
+}
+
 type BaseEventListener interface {
 	RunListener(ctx context.Context) error
-	RegisterConfirmedEventListener(contractAddress string, handler EventHandler)
-	RegisterRealtimeEventListener(contractAddress string, handler EventHandler)
+	RegisterEventHandler(registration EventHandlerRegistration) error
 }
 
 type EventListener interface {
 	Register(ctx context.Context)
 }
+
+// TransferRescanner re-processes the transfers of a block range without moving the listener cursor.
+type TransferRescanner interface {
+	Rescan(ctx context.Context, fromBlock, toBlock uint64, apply bool) (dto.RescanResultDTO, error)
+}
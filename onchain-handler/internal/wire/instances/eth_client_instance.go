@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/genefriendway/onchain-handler/conf"
 	"github.com/genefriendway/onchain-handler/constants"
 	"github.com/genefriendway/onchain-handler/pkg/blockchain/client"
 	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
@@ -27,13 +28,60 @@ func ETHClientInstance(network constants.NetworkType, rpcUrls []string) (clientt
 	}
 
 	// Create a new Ethereum client if not already initialized
-	client, err := client.NewRoundRobinClient(rpcUrls)
+	minRange, maxRange, endpointMaxRanges := conf.GetLogRangeLimits(network)
+	logRangeLimits := client.LogRangeLimits{
+		MinRange:          minRange,
+		MaxRange:          maxRange,
+		EndpointMaxRanges: endpointMaxRanges,
+	}
+	var (
+		ethClient clienttypes.Client
+		err       error
+	)
+	if network == constants.Tron {
+		// Tron reads through its JSON-RPC endpoints and sends transactions through its HTTP API
+		apiURL, apiKey, feeLimit := conf.GetTronAPI()
+		ethClient, err = client.NewTronClient(rpcUrls, logRangeLimits, apiURL, apiKey, feeLimit)
+	} else {
+		ethClient, err = client.NewRoundRobinClient(rpcUrls, logRangeLimits)
+	}
 	if err != nil {
 		logger.GetLogger().Errorf("Failed to initialize Ethereum client for network %s: %v", network, err)
 		return nil, fmt.Errorf("failed to initialize Ethereum client for network %s: %w", network, err)
 	}
 
 	// Store the client in the map for future use
-	clientMap[network] = client
-	return client, nil
+	clientMap[network] = ethClient
+	return ethClient, nil
+}
+
+var (
+	subscriberMap = make(map[constants.NetworkType]clienttypes.Subscriber)
+	subscriberMux sync.Mutex
+)
+
+// ETHSubscriberInstance provides a singleton websocket subscriber for a specific network.
+// It returns nil when no websocket URLs are configured, in which case callers fall back to polling.
+func ETHSubscriberInstance(network constants.NetworkType, wsUrls []string) (clienttypes.Subscriber, error) {
+	if len(wsUrls) == 0 {
+		return nil, nil
+	}
+
+	subscriberMux.Lock()
+	defer subscriberMux.Unlock()
+
+	// Check if the subscriber for the network already exists
+	if subscriber, exists := subscriberMap[network]; exists {
+		return subscriber, nil
+	}
+
+	subscriber, err := client.NewWSSubscriber(wsUrls)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to initialize websocket subscriber for network %s: %v", network, err)
+		return nil, fmt.Errorf("failed to initialize websocket subscriber for network %s: %w", network, err)
+	}
+
+	// Store the subscriber in the map for future use
+	subscriberMap[network] = subscriber
+	return subscriber, nil
 }
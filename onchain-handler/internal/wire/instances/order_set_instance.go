@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/genefriendway/onchain-handler/conf"
 	"github.com/genefriendway/onchain-handler/internal/adapters/orderset"
 	settypes "github.com/genefriendway/onchain-handler/internal/adapters/orderset/types"
 	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
@@ -19,12 +20,18 @@ var (
 func PaymentOrderSetInstance(ctx context.Context) settypes.Set[dto.PaymentOrderDTO] {
 	paymentOrderSetOnce.Do(func() {
 		keyFunc := func(order dto.PaymentOrderDTO) string {
-			return order.PaymentAddress + "_" + order.Symbol
+			return order.SetKey()
+		}
+
+		// Sharded instances keep their own view of the set so a shared cache does not mix shards
+		namespace := ""
+		if conf.IsListenerShardingEnabled() {
+			namespace = conf.GetListenerInstanceID()
 		}
 
 		// Initialize the order set
 		var err error
-		paymentOrderSet, err = orderset.NewSet(ctx, keyFunc, CacheRepositoryInstance(ctx))
+		paymentOrderSet, err = orderset.NewNamespacedSet(ctx, namespace, keyFunc, CacheRepositoryInstance(ctx))
 		if err != nil {
 			logger.GetLogger().Fatalf("Create payment order set error: %v", err)
 		}
@@ -3,6 +3,7 @@ package wire
 import (
 	"gorm.io/gorm"
 
+	"github.com/genefriendway/onchain-handler/conf"
 	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
 	settypes "github.com/genefriendway/onchain-handler/internal/adapters/orderset/types"
 	"github.com/genefriendway/onchain-handler/internal/adapters/repositories"
@@ -23,6 +24,21 @@ type repos struct {
 	NetworkMetadataRepo      repotypes.NetworkMetadataRepository
 	TokenMetadataRepo        repotypes.TokenMetadataRepository
 	PaymentStatisticsRepo    repotypes.PaymentStatisticsRepository
+	ListenerShardRepo        repotypes.ListenerShardRepository
+	RelayerTransactionRepo   repotypes.RelayerTransactionRepository
+	TolerancePolicyRepo      repotypes.TolerancePolicyRepository
+	IdempotencyKeyRepo       repotypes.IdempotencyKeyRepository
+	InvoiceRepo              repotypes.InvoiceRepository
+	SubscriptionRepo         repotypes.SubscriptionRepository
+	PayoutRepo               repotypes.PayoutRepository
+	UserWalletRepo           repotypes.UserWalletRepository
+	TransferPolicyRepo       repotypes.TransferPolicyRepository
+	WithdrawalRequestRepo    repotypes.WithdrawalRequestRepository
+	WithdrawScheduleRepo     repotypes.WithdrawScheduleRepository
+	GasCostRepo              repotypes.GasCostRepository
+	ComplianceRepo           repotypes.ComplianceRepository
+	AdminAuditLogRepo        repotypes.AdminAuditLogRepository
+	PaymentOrderAuditRepo    repotypes.PaymentOrderAuditRepository
 }
 
 // Initialize repositories (only using cache where needed)
@@ -38,6 +54,21 @@ func initializeRepos(db *gorm.DB, cacheRepo cachetypes.CacheRepository) *repos {
 		NetworkMetadataRepo:      repositories.NewNetworkMetadataCacheRepository(repositories.NewNetworkMetadataRepository(db), cacheRepo),
 		PaymentStatisticsRepo:    repositories.NewPaymentStatisticsRepository(db),
 		TokenMetadataRepo:        repositories.NewTokenMetadataCacheRepository(repositories.NewTokenMetadataRepository(db), cacheRepo),
+		ListenerShardRepo:        repositories.NewListenerShardRepository(db),
+		RelayerTransactionRepo:   repositories.NewRelayerTransactionRepository(db),
+		TolerancePolicyRepo:      repositories.NewTolerancePolicyCacheRepository(repositories.NewTolerancePolicyRepository(db), cacheRepo),
+		IdempotencyKeyRepo:       repositories.NewIdempotencyKeyRepository(db),
+		InvoiceRepo:              repositories.NewInvoiceRepository(db),
+		SubscriptionRepo:         repositories.NewSubscriptionRepository(db),
+		PayoutRepo:               repositories.NewPayoutRepository(db),
+		UserWalletRepo:           repositories.NewUserWalletRepository(db),
+		TransferPolicyRepo:       repositories.NewTransferPolicyRepository(db),
+		WithdrawalRequestRepo:    repositories.NewWithdrawalRequestRepository(db),
+		WithdrawScheduleRepo:     repositories.NewWithdrawScheduleRepository(db),
+		GasCostRepo:              repositories.NewGasCostRepository(db),
+		ComplianceRepo:           repositories.NewComplianceRepository(db),
+		AdminAuditLogRepo:        repositories.NewAdminAuditLogRepository(db),
+		PaymentOrderAuditRepo:    repositories.NewPaymentOrderAuditRepository(db),
 	}
 }
 
@@ -50,6 +81,19 @@ type UseCases struct {
 	PaymentWalletUCase       ucasetypes.PaymentWalletUCase
 	MetadataUCase            ucasetypes.MetadataUCase
 	PaymentStatisticsUCase   ucasetypes.PaymentStatisticsUCase
+	ListenerShardUCase       ucasetypes.ListenerShardUCase
+	GaslessPaymentUCase      ucasetypes.GaslessPaymentUCase
+	TolerancePolicyUCase     ucasetypes.TolerancePolicyUCase
+	IdempotencyUCase         ucasetypes.IdempotencyUCase
+	InvoiceUCase             ucasetypes.InvoiceUCase
+	SubscriptionUCase        ucasetypes.SubscriptionUCase
+	PayoutUCase              ucasetypes.PayoutUCase
+	TransferPolicyUCase      ucasetypes.TransferPolicyUCase
+	WithdrawalRequestUCase   ucasetypes.WithdrawalRequestUCase
+	WithdrawScheduleUCase    ucasetypes.WithdrawScheduleUCase
+	GasCostUCase             ucasetypes.GasCostUCase
+	ComplianceUCase          ucasetypes.ComplianceUCase
+	AdminUCase               ucasetypes.AdminUCase
 }
 
 // Initialize use cases
@@ -57,22 +101,84 @@ func InitializeUseCases(
 	db *gorm.DB, cacheRepo cachetypes.CacheRepository, paymentOrderSet settypes.Set[dto.PaymentOrderDTO],
 ) *UseCases {
 	repos := initializeRepos(db, cacheRepo)
+	walletConfig := conf.GetWalletConfiguration()
+
+	// Invoices and subscriptions create and read their orders through the payment order use case
+	paymentOrderUCase := ucases.NewPaymentOrderUCase(
+		db,
+		repos.PaymentOrderRepo,
+		repos.PaymentWalletRepo,
+		repos.BlockStateRepo,
+		repos.PaymentStatisticsRepo,
+		paymentOrderSet,
+		cacheRepo,
+		repos.TolerancePolicyRepo,
+		repos.PaymentOrderAuditRepo,
+	)
+
+	// Held payments are credited through the wallet and statistics use cases once released
+	paymentWalletUCase := ucases.NewPaymentWalletUCase(db, repos.PaymentWalletRepo, repos.PaymentWalletBalanceRepo, repos.ComplianceRepo)
+	paymentStatisticsUCase := ucases.NewPaymentStatisticsCase(repos.PaymentStatisticsRepo)
+
+	// Payments are recorded together with their credit to the statistics and the payment wallet balance
+	paymentEventHistoryUCase := ucases.NewPaymentEventHistoryUCase(
+		repos.PaymentEventHistoryRepo,
+		repos.PaymentStatisticsRepo,
+		repos.PaymentWalletBalanceRepo,
+	)
+
+	// Payouts and withdrawals are evaluated against the transfer policies
+	transferPolicyUCase := ucases.NewTransferPolicyUCase(repos.TransferPolicyRepo, repos.TokenTransferRepo)
 
 	// Return all use cases
 	return &UseCases{
-		BlockStateUCase: ucases.NewBlockStateUCase(repos.BlockStateRepo),
-		PaymentOrderUCase: ucases.NewPaymentOrderUCase(
-			db,
+		BlockStateUCase:          ucases.NewBlockStateUCase(repos.BlockStateRepo),
+		PaymentOrderUCase:        paymentOrderUCase,
+		TokenTransferUCase:       ucases.NewTokenTransferUCase(repos.TokenTransferRepo),
+		PaymentEventHistoryUCase: paymentEventHistoryUCase,
+		PaymentWalletUCase:       paymentWalletUCase,
+		MetadataUCase:            ucases.NewMetadataUCase(repos.NetworkMetadataRepo, repos.TokenMetadataRepo),
+		PaymentStatisticsUCase:   paymentStatisticsUCase,
+		ListenerShardUCase:       ucases.NewListenerShardUCase(db, repos.ListenerShardRepo),
+		GaslessPaymentUCase: ucases.NewGaslessPaymentUCase(
 			repos.PaymentOrderRepo,
-			repos.PaymentWalletRepo,
-			repos.BlockStateRepo,
+			repos.RelayerTransactionRepo,
+			walletConfig.Mnemonic,
+			walletConfig.Passphrase,
+			walletConfig.Salt,
+		),
+		TolerancePolicyUCase: ucases.NewTolerancePolicyUCase(repos.TolerancePolicyRepo),
+		IdempotencyUCase:     ucases.NewIdempotencyUCase(repos.IdempotencyKeyRepo, conf.GetIdempotencyKeyTTL()),
+		InvoiceUCase:         ucases.NewInvoiceUCase(repos.InvoiceRepo, paymentOrderUCase),
+		SubscriptionUCase:    ucases.NewSubscriptionUCase(repos.SubscriptionRepo, paymentOrderUCase),
+		PayoutUCase: ucases.NewPayoutUCase(
+			repos.PayoutRepo,
+			repos.UserWalletRepo,
+			transferPolicyUCase,
+			walletConfig.Mnemonic,
+			walletConfig.Passphrase,
+			walletConfig.Salt,
+		),
+		TransferPolicyUCase:    transferPolicyUCase,
+		WithdrawalRequestUCase: ucases.NewWithdrawalRequestUCase(repos.WithdrawalRequestRepo, transferPolicyUCase),
+		WithdrawScheduleUCase:  ucases.NewWithdrawScheduleUCase(repos.WithdrawScheduleRepo, conf.GetWithdrawSchedule()),
+		GasCostUCase:           ucases.NewGasCostUCase(repos.GasCostRepo, repos.TokenTransferRepo, repos.PaymentStatisticsRepo),
+		ComplianceUCase: ucases.NewComplianceUCase(
+			repos.ComplianceRepo,
+			repos.PaymentEventHistoryRepo,
 			repos.PaymentStatisticsRepo,
+			repos.PaymentWalletBalanceRepo,
+			paymentOrderUCase,
+			conf.GetScreeningProvider(),
+			conf.GetComplianceConfiguration(),
+		),
+		AdminUCase: ucases.NewAdminUCase(
+			repos.AdminAuditLogRepo,
+			repos.BlockStateRepo,
+			repos.PaymentWalletRepo,
+			paymentOrderUCase,
+			paymentWalletUCase,
 			paymentOrderSet,
 		),
-		TokenTransferUCase:       ucases.NewTokenTransferUCase(repos.TokenTransferRepo),
-		PaymentEventHistoryUCase: ucases.NewPaymentEventHistoryUCase(repos.PaymentEventHistoryRepo),
-		PaymentWalletUCase:       ucases.NewPaymentWalletUCase(db, repos.PaymentWalletRepo, repos.PaymentWalletBalanceRepo),
-		MetadataUCase:            ucases.NewMetadataUCase(repos.NetworkMetadataRepo, repos.TokenMetadataRepo),
-		PaymentStatisticsUCase:   ucases.NewPaymentStatisticsCase(repos.PaymentStatisticsRepo),
 	}
 }
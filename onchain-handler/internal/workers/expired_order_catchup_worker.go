@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -19,9 +20,9 @@ import (
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
 	workertypes "github.com/genefriendway/onchain-handler/internal/workers/types"
 	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
-	"github.com/genefriendway/onchain-handler/pkg/payment"
 	"github.com/genefriendway/onchain-handler/pkg/utils"
 )
 
@@ -29,8 +30,7 @@ import (
 type expiredOrderCatchupWorker struct {
 	paymentOrderUCase        ucasetypes.PaymentOrderUCase
 	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase
-	paymentStatisticsUCase   ucasetypes.PaymentStatisticsUCase
-	paymentWalletUCase       ucasetypes.PaymentWalletUCase
+	complianceUCase          ucasetypes.ComplianceUCase
 	blockStateUCase          ucasetypes.BlockStateUCase
 	cacheRepo                cachetypes.CacheRepository
 	tokenContractAddresses   []string
@@ -47,8 +47,7 @@ type expiredOrderCatchupWorker struct {
 func NewExpiredOrderCatchupWorker(
 	paymentOrderUCase ucasetypes.PaymentOrderUCase,
 	paymentEventHistoryUCase ucasetypes.PaymentEventHistoryUCase,
-	paymentStatisticsUCase ucasetypes.PaymentStatisticsUCase,
-	paymentWalletUCase ucasetypes.PaymentWalletUCase,
+	complianceUCase ucasetypes.ComplianceUCase,
 	blockStateUCase ucasetypes.BlockStateUCase,
 	cacheRepo cachetypes.CacheRepository,
 	tokenContractAddresses []string,
@@ -80,8 +79,7 @@ func NewExpiredOrderCatchupWorker(
 	return &expiredOrderCatchupWorker{
 		paymentOrderUCase:        paymentOrderUCase,
 		paymentEventHistoryUCase: paymentEventHistoryUCase,
-		paymentStatisticsUCase:   paymentStatisticsUCase,
-		paymentWalletUCase:       paymentWalletUCase,
+		complianceUCase:          complianceUCase,
 		blockStateUCase:          blockStateUCase,
 		cacheRepo:                cacheRepo,
 		tokenContractAddresses:   tokenContractAddresses,
@@ -145,8 +143,11 @@ func (w *expiredOrderCatchupWorker) catchupExpiredOrders(ctx context.Context) {
 		return
 	}
 
-	// Find the smallest block height from the expired orders
+	// Find the smallest block height from the expired orders, the options of multi-chain orders are not in block order
 	minBlockHeight := expiredOrders[0].BlockHeight
+	for _, expiredOrder := range expiredOrders[1:] {
+		minBlockHeight = min(minBlockHeight, expiredOrder.BlockHeight)
+	}
 
 	// Define the maximum block we should query up to
 	latestBlock, err := w.blockStateUCase.GetLatestBlock(ctx, w.network)
@@ -159,13 +160,16 @@ func (w *expiredOrderCatchupWorker) catchupExpiredOrders(ctx context.Context) {
 	effectiveLatestBlock := latestBlock - w.confirmationDepth
 
 	// Start processing logs from the smallest block height to the effective latest block
-	w.processExpiredOrders(ctx, minBlockHeight, effectiveLatestBlock, expiredOrders)
+	lastScannedBlock := w.processExpiredOrders(ctx, minBlockHeight, effectiveLatestBlock, expiredOrders)
+	if lastScannedBlock <= minBlockHeight {
+		return
+	}
 
-	// Update processed block height for non processed orders
+	// Update processed block height for non processed orders, only up to the blocks actually scanned
 	var nonProcessedOrders []dto.PaymentOrderDTO
 	for index, expiredOrder := range expiredOrders {
 		if _, exists := w.processedOrderIDs[expiredOrder.ID]; !exists {
-			expiredOrders[index].BlockHeight = effectiveLatestBlock
+			expiredOrders[index].BlockHeight = max(expiredOrder.BlockHeight, lastScannedBlock)
 			nonProcessedOrders = append(nonProcessedOrders, expiredOrders[index])
 		}
 	}
@@ -192,39 +196,41 @@ func (w *expiredOrderCatchupWorker) catchupExpiredOrders(ctx context.Context) {
 	}
 }
 
-// processExpiredOrders processes logs from the blockchain starting from the given block height
-func (w *expiredOrderCatchupWorker) processExpiredOrders(ctx context.Context, startBlock, endBlock uint64, expiredOrders []dto.PaymentOrderDTO) {
+// processExpiredOrders processes logs from the blockchain starting from the given block height.
+// It returns the last block scanned, which is below endBlock when polling failed midway.
+func (w *expiredOrderCatchupWorker) processExpiredOrders(ctx context.Context, startBlock, endBlock uint64, expiredOrders []dto.PaymentOrderDTO) uint64 {
 	logger.GetLogger().Infof("Processing expired orders on network %s starting from block %d to block %d", w.network.String(), startBlock, endBlock)
 
 	// Check for invalid end block height
 	if endBlock <= 0 {
 		logger.GetLogger().Warnf("End block (%d) is non-positive. Skipping processing for expired orders on network %s", endBlock, w.network.String())
-		return
+		return 0
 	}
 
 	// Safeguard if start block is beyond the end block
 	if startBlock > endBlock {
 		logger.GetLogger().Warnf("Start block %d is beyond the end block %d. No logs to process on network %s", startBlock, endBlock, w.network.String())
-		return
+		return 0
 	}
 
-	// Process logs in chunks of DefaultBlockOffset
+	// Process logs in chunks sized by the client
 	var addresses []common.Address
 	for _, tokenAddress := range w.tokenContractAddresses {
 		addresses = append(addresses, common.HexToAddress(tokenAddress))
 	}
-	for chunkStart := startBlock; chunkStart <= endBlock; chunkStart += constants.DefaultBlockOffset {
-		chunkEnd := min(chunkStart+constants.DefaultBlockOffset-1, endBlock)
-
-		logger.GetLogger().Debugf("Expired Order Catchup Worker: Processing block chunk from %d to %d on network %s", chunkStart, chunkEnd, w.network.String())
-
-		// Poll logs from blockchain for this block range
-		logs, err := w.ethClient.PollForLogsFromBlock(ctx, addresses, chunkStart, chunkEnd)
+	startBlock = max(startBlock, 1) // The genesis block holds no transfers
+	lastScannedBlock := startBlock - 1
+	for chunkStart := startBlock; chunkStart <= endBlock; chunkStart = lastScannedBlock + 1 {
+		// Poll logs from as many blocks as the RPC endpoint currently allows
+		logs, chunkEnd, err := w.ethClient.PollForLogsUpTo(ctx, addresses, chunkStart, endBlock)
 		if err != nil {
-			logger.GetLogger().Errorf("Failed to poll logs on network %s from block range %d-%d: %v", w.network.String(), chunkStart, chunkEnd, err)
-			continue
+			logger.GetLogger().Errorf("Failed to poll logs on network %s from block %d to %d: %v", w.network.String(), chunkStart, endBlock, err)
+			break
 		}
 
+		logger.GetLogger().Debugf("Expired Order Catchup Worker: Processed block chunk from %d to %d on network %s", chunkStart, chunkEnd, w.network.String())
+		lastScannedBlock = chunkEnd
+
 		// Process each log entry and match with expired orders
 		for _, logEntry := range logs {
 			err := w.processLog(ctx, logEntry, expiredOrders, logEntry.BlockNumber)
@@ -234,6 +240,8 @@ func (w *expiredOrderCatchupWorker) processExpiredOrders(ctx context.Context, st
 			}
 		}
 	}
+
+	return lastScannedBlock
 }
 
 // processLog processes a single log entry from the blockchain
@@ -258,6 +266,13 @@ func (w *expiredOrderCatchupWorker) processLog(
 		return fmt.Errorf("failed to unpack transfer event on network %s: %w", w.network.String(), err)
 	}
 
+	// The orders credited by the transfer are audited with its block and transaction
+	ctx = utils.WithAuditSource(ctx, utils.AuditSource{
+		Actor:       utils.AuditActor(constants.AuditActorWorker, "expired_order_catchup"),
+		BlockNumber: vLog.BlockNumber,
+		TxHash:      vLog.TxHash.Hex(),
+	})
+
 	// Iterate over all expired orders to find a matching wallet address
 	for index, order := range orders {
 		// Check if the order matches the transfer event based on the wallet address and token symbol
@@ -267,13 +282,14 @@ func (w *expiredOrderCatchupWorker) processLog(
 		// Found a matching order, now process the payment for that order
 		logger.GetLogger().Infof("Matched transfer to wallet %s for order ID on network %s: %d", transferEvent.To.Hex(), w.network.String(), order.ID)
 
-		// Call processOrderPayment to handle the order update logic based on the transfer event
-		isUpdated, err := w.processOrderPayment(ctx, &orders[index], transferEvent, blockHeight, tokenDecimals)
-		if err != nil {
-			return fmt.Errorf("failed to process order payment for order ID %d on network %s: %w", order.ID, w.network.String(), err)
+		// Logs of blocks already scanned for the order are ignored
+		if blockHeight <= order.BlockHeight {
+			logger.GetLogger().Infof("Processed order: %d on network %s. Ignore this turn.", order.ID, w.network.String())
+			continue
 		}
 
-		if !isUpdated {
+		// Nothing to credit for zero value transfers
+		if transferEvent.Value.Sign() == 0 {
 			continue
 		}
 
@@ -284,48 +300,78 @@ func (w *expiredOrderCatchupWorker) processLog(
 			return err
 		}
 
-		// Create payment event history for the order
-		if err := w.createPaymentEventHistory(ctx, order, transferEventValueInEth, transferEvent, tokenSymbol, vLog.Address.Hex(), vLog.TxHash.Hex()); err != nil {
-			return fmt.Errorf("failed to create payment event history for order ID %d on network %s: %w", order.ID, w.network.String(), err)
+		// A log already processed by the listener is not screened nor credited again,
+		// its order is only settled again in case it could not be updated when the log was recorded
+		recorded, err := w.paymentEventHistoryUCase.IsPaymentEventRecorded(
+			ctx, w.network, vLog.TxHash.Hex(), vLog.Index, chain.ForNetwork(w.network).FormatAddress(transferEvent.To), vLog.Address.Hex(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to check payment event history for order ID %d on network %s: %w", order.ID, w.network.String(), err)
+		}
+		if recorded {
+			resumed, err := w.resumeOrderPayment(ctx, &orders[index], transferEvent, vLog, tokenDecimals)
+			if err != nil {
+				return fmt.Errorf("failed to resume order payment for order ID %d on network %s: %w", order.ID, w.network.String(), err)
+			}
+			if !resumed {
+				logger.GetLogger().Infof("Skipping transfer %s (log index %d) recorded for another order than order ID %d on network %s", vLog.TxHash.Hex(), vLog.Index, order.ID, w.network.String())
+				continue
+			}
+			return nil
 		}
-		logger.GetLogger().Infof("Successfully processed order ID: %d on network %s with transferred amount: %s", order.ID, w.network.String(), transferEvent.Value.String())
 
-		// Get the payment order by ID to send the webhook
-		paymentOrderDTO, err := w.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
+		// Screen the payer before recording the payment so that a late payment does not bypass the compliance review,
+		// a payment that could not be screened nor held is left unrecorded and screened again on the next run
+		hold, err := w.complianceUCase.ScreenPayment(ctx, dto.ComplianceHoldPayloadDTO{
+			PaymentOrderID:  order.ID,
+			VendorID:        order.VendorID,
+			WalletID:        &order.Wallet.ID,
+			Network:         w.network.String(),
+			Symbol:          tokenSymbol,
+			TransactionHash: vLog.TxHash.Hex(),
+			LogIndex:        vLog.Index,
+			FromAddress:     chain.ForNetwork(w.network).FormatAddress(transferEvent.From),
+			ToAddress:       chain.ForNetwork(w.network).FormatAddress(transferEvent.To),
+			Amount:          transferEventValueInEth,
+		})
 		if err != nil {
-			return fmt.Errorf("failed to get payment order by ID %d on network %s: %w", order.ID, w.network.String(), err)
+			return fmt.Errorf("failed to screen payment for order ID %d on network %s: %w", order.ID, w.network.String(), err)
 		}
 
-		// Increment payment statistics
-		granularity := constants.Daily
-		if err = w.paymentStatisticsUCase.IncrementStatistics(
-			ctx,
-			granularity,
-			utils.GetPeriodStart(granularity, time.Now()),
-			nil,
-			&transferEventValueInEth,
-			order.Symbol,
-			order.VendorID,
-		); err != nil {
-			logger.GetLogger().Errorf("Failed to increment payment statistics for order ID %d on network %s: %v", order.ID, w.network.String(), err)
+		// Record the event, it is stored only once per transaction hash and log index. A held payment is recorded
+		// with its compliance hold, any other payment is credited to the statistics and the payment wallet with the record
+		payload := w.paymentEventPayload(order, transferEventValueInEth, transferEvent, tokenSymbol, vLog.Address.Hex(), vLog.TxHash.Hex(), vLog.Index)
+		var created bool
+		if hold != nil {
+			created, err = w.complianceUCase.RecordHeldPayment(ctx, *hold, payload)
+		} else {
+			created, err = w.paymentEventHistoryUCase.CreditPaymentEvent(ctx, payload, order.VendorID, &order.Wallet.ID)
 		}
-
-		// Add the transferred amount to the payment wallet balance
-		if err = w.paymentWalletUCase.AddPaymentWalletBalance(ctx, order.Wallet.ID, transferEventValueInEth, w.network, order.Symbol); err != nil {
-			logger.GetLogger().Errorf("Failed to add payment wallet balance on network %s for order ID %d, error: %v", w.network.String(), order.ID, err)
+		if err != nil {
+			return fmt.Errorf("failed to create payment event history for order ID %d on network %s: %w", order.ID, w.network.String(), err)
+		}
+		if !created {
+			logger.GetLogger().Infof("Skipping transfer %s (log index %d) for order ID %d on network %s, recorded concurrently", vLog.TxHash.Hex(), vLog.Index, order.ID, w.network.String())
 			continue
 		}
+		if hold != nil {
+			w.processedOrderIDs[order.ID] = struct{}{}
+			w.sendHeldOrderWebhook(ctx, order.ID)
+			return nil
+		}
 
-		// Send webhook if webhook URL is present
-		if paymentOrderDTO.WebhookURL != "" {
-			// Use a separate goroutine for webhook sending
-			go func() {
-				if err := utils.SendWebhook(paymentOrderDTO, paymentOrderDTO.WebhookURL); err != nil {
-					logger.GetLogger().Errorf("Failed to send webhook for order ID %d on network %s: %v", order.ID, w.network.String(), err)
-				}
-			}()
+		// Call processOrderPayment to handle the order update logic based on the transfer event
+		if _, err := w.processOrderPayment(ctx, &orders[index], transferEvent, vLog, tokenDecimals); err != nil {
+			return fmt.Errorf("failed to process order payment for order ID %d on network %s: %w", order.ID, w.network.String(), err)
 		}
 
+		// Get the payment order by ID to send the webhook
+		paymentOrderDTO, err := w.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get payment order by ID %d on network %s: %w", order.ID, w.network.String(), err)
+		}
+		w.sendOrderWebhook(paymentOrderDTO)
+
 		logger.GetLogger().Infof("Successfully processed order ID: %d on network %s with transferred amount: %s", order.ID, w.network.String(), transferEvent.Value.String())
 		return nil // Stop once we've processed the matching order
 	}
@@ -335,35 +381,121 @@ func (w *expiredOrderCatchupWorker) processLog(
 	return nil
 }
 
+// resumeOrderPayment settles the order of a transfer recorded before, in case the order could not be updated when
+// the transfer was recorded. It returns false when the transfer was recorded for another order. The order is computed
+// again from its recorded payments, held payments are left to the compliance review.
+func (w *expiredOrderCatchupWorker) resumeOrderPayment(
+	ctx context.Context,
+	order *dto.PaymentOrderDTO,
+	transferEvent blockchain.TransferEvent,
+	vLog types.Log,
+	tokenDecimals uint8,
+) (bool, error) {
+	currentOrder, err := w.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
+	if err != nil {
+		return false, err
+	}
+	if !slices.ContainsFunc(currentOrder.EventHistories, func(event dto.PaymentHistoryDTO) bool {
+		return event.IsLog(vLog.TxHash.Hex(), vLog.Index)
+	}) {
+		return false, nil
+	}
+
+	hold, err := w.complianceUCase.GetPaymentHold(ctx, order.ID, vLog.TxHash.Hex(), vLog.Index)
+	if err != nil {
+		return false, err
+	}
+	if hold != nil && hold.Status == constants.ComplianceHoldHeld && currentOrder.Status != constants.OnHold {
+		// The order could not be put on hold when the payment was recorded
+		if err := w.complianceUCase.ResumeHold(ctx, *hold); err != nil {
+			return false, err
+		}
+		w.sendHeldOrderWebhook(ctx, order.ID)
+		return true, nil
+	}
+	if hold != nil || currentOrder.Status == constants.OnHold {
+		logger.GetLogger().Infof("Skipping already processed transfer %s (log index %d) for order ID %d on network %s, held for compliance review",
+			vLog.TxHash.Hex(), vLog.Index, order.ID, w.network.String())
+		return true, nil
+	}
+
+	if _, err := w.processOrderPayment(ctx, order, transferEvent, vLog, tokenDecimals); err != nil {
+		return false, err
+	}
+	processedOrder, err := w.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
+	if err != nil {
+		return false, err
+	}
+	if processedOrder.Status == currentOrder.Status && processedOrder.Transferred == currentOrder.Transferred {
+		logger.GetLogger().Infof("Skipping already processed transfer %s (log index %d) for order ID %d on network %s",
+			vLog.TxHash.Hex(), vLog.Index, order.ID, w.network.String())
+		return true, nil
+	}
+
+	logger.GetLogger().Infof("Settled order ID %d on network %s from already recorded transfer %s (log index %d)",
+		order.ID, w.network.String(), vLog.TxHash.Hex(), vLog.Index)
+	w.sendOrderWebhook(processedOrder)
+	return true, nil
+}
+
+// sendOrderWebhook sends the webhook of the processed order if its webhook URL is present.
+func (w *expiredOrderCatchupWorker) sendOrderWebhook(order dto.PaymentOrderDTOResponse) {
+	if order.WebhookURL == "" {
+		return
+	}
+
+	// Use a separate goroutine for webhook sending
+	go func() {
+		if err := utils.SendWebhook(order, order.WebhookURL); err != nil {
+			logger.GetLogger().Errorf("Failed to send webhook for order ID %d on network %s: %v", order.ID, w.network.String(), err)
+		}
+	}()
+}
+
+// sendHeldOrderWebhook notifies the order put on hold by the compliance screening.
+func (w *expiredOrderCatchupWorker) sendHeldOrderWebhook(ctx context.Context, orderID uint64) {
+	heldOrder, err := w.paymentOrderUCase.GetPaymentOrderByID(ctx, orderID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get held order by ID %d on network %s: %v", orderID, w.network.String(), err)
+		return
+	}
+	if heldOrder.Status != constants.OnHold || heldOrder.WebhookURL == "" {
+		return
+	}
+
+	go func() {
+		if err := utils.SendWebhook(heldOrder, heldOrder.WebhookURL); err != nil {
+			logger.GetLogger().Errorf("Failed to send webhook for held order ID %d on network %s: %v", orderID, w.network.String(), err)
+		}
+	}()
+}
+
 // isMatchingOrder checks if the order matches the transfer event based on the wallet address and token symbol
 func (w *expiredOrderCatchupWorker) isMatchingOrder(order dto.PaymentOrderDTO, transferEvent blockchain.TransferEvent, tokenSymbol string) bool {
-	return order.Status != constants.Success &&
-		strings.EqualFold(transferEvent.To.Hex(), order.Wallet.Address) &&
+	return !constants.IsPaidStatus(order.Status) &&
+		strings.EqualFold(transferEvent.To.Hex(), chain.NormalizeAddress(order.PaymentAddress)) &&
 		strings.EqualFold(order.Symbol, tokenSymbol)
 }
 
-// createPaymentEventHistory constructs and stores the payment event history
-func (w *expiredOrderCatchupWorker) createPaymentEventHistory(
-	ctx context.Context,
+// paymentEventPayload constructs the payment event history of the transfer to the order.
+func (w *expiredOrderCatchupWorker) paymentEventPayload(
 	order dto.PaymentOrderDTO,
 	transferEventValueInEth string,
 	transferEvent blockchain.TransferEvent,
 	tokenSymbol, contractAddress, txHash string,
-) error {
-	payloads := []dto.PaymentEventPayloadDTO{
-		{
-			PaymentOrderID:  order.ID,
-			TransactionHash: txHash,
-			FromAddress:     transferEvent.From.Hex(),
-			ToAddress:       transferEvent.To.Hex(),
-			ContractAddress: contractAddress,
-			TokenSymbol:     tokenSymbol,
-			Amount:          transferEventValueInEth,
-			Network:         w.network.String(),
-		},
+	logIndex uint,
+) dto.PaymentEventPayloadDTO {
+	return dto.PaymentEventPayloadDTO{
+		PaymentOrderID:  order.ID,
+		TransactionHash: txHash,
+		LogIndex:        logIndex,
+		FromAddress:     chain.ForNetwork(w.network).FormatAddress(transferEvent.From),
+		ToAddress:       chain.ForNetwork(w.network).FormatAddress(transferEvent.To),
+		ContractAddress: contractAddress,
+		TokenSymbol:     tokenSymbol,
+		Amount:          transferEventValueInEth,
+		Network:         w.network.String(),
 	}
-
-	return w.paymentEventHistoryUCase.CreatePaymentEventHistory(ctx, payloads)
 }
 
 // processOrderPayment handles the payment for an order based on the transfer event details.
@@ -372,20 +504,16 @@ func (w *expiredOrderCatchupWorker) processOrderPayment(
 	ctx context.Context,
 	order *dto.PaymentOrderDTO,
 	transferEvent blockchain.TransferEvent,
-	blockHeight uint64,
+	vLog types.Log,
 	tokenDecimals uint8,
 ) (bool, error) {
-	if blockHeight <= order.BlockHeight {
-		logger.GetLogger().Infof("Processed order: %d on network %s. Ignore this turn.", order.ID, w.network.String())
-		return false, nil
-	}
+	blockHeight := vLog.BlockNumber
 
 	// Convert order amount into the appropriate unit (e.g., wei)
 	orderAmount, err := utils.ConvertFloatTokenToSmallestUnit(order.Amount, tokenDecimals)
 	if err != nil {
 		return false, fmt.Errorf("failed to convert order amount: %v", err)
 	}
-	minimumAcceptedAmount := payment.CalculatePaymentCoveringAsDiscount(orderAmount, conf.GetPaymentCovering(), tokenDecimals)
 
 	// Get newest order state in cache or DB
 	orderDTO, err := w.paymentOrderUCase.GetPaymentOrderByID(ctx, order.ID)
@@ -394,9 +522,12 @@ func (w *expiredOrderCatchupWorker) processOrderPayment(
 		return false, err
 	}
 
-	// Calculate total transferred amount from EventHistories
+	// Calculate total transferred amount from EventHistories, the transfer itself is added below
 	totalTransferred := big.NewInt(0)
-	for _, event := range orderDTO.EventHistories {
+	for _, event := range orderDTO.CountedEventHistories(order.Network, order.Symbol, conf.GetSplitPaymentPolicy()) {
+		if event.IsLog(vLog.TxHash.Hex(), vLog.Index) {
+			continue
+		}
 		amountWei, err := utils.ConvertFloatTokenToSmallestUnit(event.Amount, tokenDecimals)
 		if err != nil {
 			logger.GetLogger().Warnf("Failed to convert event amount to Wei, tx: %s, err: %v", event.TransactionHash, err)
@@ -408,9 +539,16 @@ func (w *expiredOrderCatchupWorker) processOrderPayment(
 	// Calculate the total transferred amount by adding the new transfer event value.
 	totalTransferred = new(big.Int).Add(totalTransferred, transferEvent.Value)
 
-	// Check if the total transferred amount is greater than or equal to the minimum accepted amount (full payment).
-	if totalTransferred.Cmp(minimumAcceptedAmount) >= 0 {
-		logger.GetLogger().Infof("Processed full payment on network %s for order ID: %d", w.network.String(), order.ID)
+	// Evaluate the total transferred amount under the tolerance policy of the vendor and token
+	outcome, err := w.paymentOrderUCase.EvaluatePayment(ctx, order.VendorID, order.Symbol, orderAmount, totalTransferred, tokenDecimals)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate payment: %w", err)
+	}
+
+	switch outcome {
+	case constants.Success, constants.Overpaid:
+		// Full payment, flagged OVERPAID above the over tolerance
+		logger.GetLogger().Infof("Processed full payment (%s) on network %s for order ID: %d", outcome, w.network.String(), order.ID)
 
 		if order.BlockHeight < order.UpcomingBlockHeight {
 			// Update the order tranferred and keep the wallet associated with the order.
@@ -418,8 +556,8 @@ func (w *expiredOrderCatchupWorker) processOrderPayment(
 		}
 
 		// Update the order status to 'Success' and mark the wallet as no longer in use.
-		return w.updatePaymentOrderStatus(ctx, order, constants.Success, totalTransferred.String(), blockHeight, tokenDecimals)
-	} else if totalTransferred.Cmp(big.NewInt(0)) > 0 {
+		return w.updatePaymentOrderStatus(ctx, order, outcome, totalTransferred.String(), blockHeight, tokenDecimals)
+	case constants.Partial:
 		// If the total transferred amount is greater than 0 but less than the minimum accepted amount (partial payment).
 		logger.GetLogger().Infof("Processed partial payment on network %s for order ID: %d", w.network.String(), order.ID)
 
@@ -448,6 +586,13 @@ func (w *expiredOrderCatchupWorker) updatePaymentOrderStatus(
 	order.Transferred = transferredAmountInEth
 	order.Status = status
 
+	// A multi-chain order settles on the option it is paid with
+	if order.IsMultiChain() {
+		if err := w.paymentOrderUCase.SelectPaymentOption(ctx, order.ID, order.Network, order.Symbol); err != nil {
+			return false, fmt.Errorf("failed to select payment option for order ID %d: %w", order.ID, err)
+		}
+	}
+
 	// Save the updated order to the repository
 	err = w.paymentOrderUCase.UpdatePaymentOrder(ctx, order.ID, &blockHeight, nil, &status, &transferredAmountInEth, nil)
 	if err != nil {
@@ -0,0 +1,63 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	workertypes "github.com/genefriendway/onchain-handler/internal/workers/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// invoiceReconcileWorker updates the open invoices from the payments of their orders and sends their webhooks.
+type invoiceReconcileWorker struct {
+	invoiceUCase ucasetypes.InvoiceUCase
+	isRunning    bool
+	mu           sync.Mutex
+}
+
+func NewInvoiceReconcileWorker(invoiceUCase ucasetypes.InvoiceUCase) workertypes.Worker {
+	return &invoiceReconcileWorker{
+		invoiceUCase: invoiceUCase,
+	}
+}
+
+func (w *invoiceReconcileWorker) Start(ctx context.Context) {
+	// The orders changed by the worker are audited under its name
+	ctx = utils.WithAuditActor(ctx, utils.AuditActor(constants.AuditActorWorker, "invoice_reconcile"))
+
+	ticker := time.NewTicker(constants.InvoiceReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			go w.run(ctx)
+		case <-ctx.Done():
+			logger.GetLogger().Info("Shutting down invoiceReconcileWorker")
+			return
+		}
+	}
+}
+
+func (w *invoiceReconcileWorker) run(ctx context.Context) {
+	w.mu.Lock()
+	if w.isRunning {
+		logger.GetLogger().Warn("Previous invoiceReconcileWorker run still in progress, skipping this cycle")
+		w.mu.Unlock()
+		return
+	}
+	w.isRunning = true
+	w.mu.Unlock()
+
+	if err := w.invoiceUCase.ReconcileOpenInvoices(ctx); err != nil {
+		logger.GetLogger().Errorf("Failed to reconcile open invoices: %v", err)
+	}
+
+	w.mu.Lock()
+	w.isRunning = false
+	w.mu.Unlock()
+}
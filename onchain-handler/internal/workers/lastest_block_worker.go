@@ -5,6 +5,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
 	"github.com/genefriendway/onchain-handler/constants"
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
 	workertypes "github.com/genefriendway/onchain-handler/internal/workers/types"
@@ -15,6 +18,7 @@ import (
 type latestBlockWorker struct {
 	blockStateUCase ucasetypes.BlockStateUCase
 	ethClient       clienttypes.Client
+	subscriber      clienttypes.Subscriber // Optional, the worker polls on a ticker when nil
 	network         constants.NetworkType
 	isRunning       bool       // Tracks if catchup is running
 	mu              sync.Mutex // Mutex to protect the isRunning flag
@@ -23,25 +27,68 @@ type latestBlockWorker struct {
 func NewLatestBlockWorker(
 	blockStateUCase ucasetypes.BlockStateUCase,
 	ethClient clienttypes.Client,
+	subscriber clienttypes.Subscriber,
 	network constants.NetworkType,
 ) workertypes.Worker {
 	return &latestBlockWorker{
 		blockStateUCase: blockStateUCase,
 		ethClient:       ethClient,
+		subscriber:      subscriber,
 		network:         network,
 	}
 }
 
-// Start starts the periodic task of fetching the latest block and storing it in cache and DB
+// Start keeps the latest block in cache and DB up to date.
+// With a subscriber the worker is driven by newHeads and only polls while the subscription is down.
 func (w *latestBlockWorker) Start(ctx context.Context) {
 	ticker := time.NewTicker(constants.LatestBlockFetchInterval)
 	defer ticker.Stop()
 
+	heads := make(chan *types.Header, constants.DefaultSubscriptionBuffer)
+	var (
+		sub         ethereum.Subscription
+		subErr      <-chan error
+		resubscribe <-chan time.Time
+		retryDelay  = constants.SubscriptionRetryDelay
+	)
+
+	subscribe := func() {
+		if w.subscriber == nil {
+			return
+		}
+		newSub, err := w.subscriber.SubscribeNewHeads(ctx, heads)
+		if err != nil {
+			logger.GetLogger().Warnf("Failed to subscribe to new heads on network %s, polling instead: %v", w.network.String(), err)
+			resubscribe = time.After(retryDelay)
+			retryDelay = min(retryDelay*2, constants.MaxSubscriptionRetryDelay)
+			return
+		}
+		logger.GetLogger().Infof("Subscribed to new heads on network %s", w.network.String())
+		sub, subErr, resubscribe = newSub, newSub.Err(), nil
+		retryDelay = constants.SubscriptionRetryDelay
+	}
+	subscribe()
+
 	for {
 		select {
 		case <-ticker.C:
-			go w.run(ctx)
+			// Only poll while there is no live subscription
+			if sub == nil {
+				go w.run(ctx)
+			}
+		case head := <-heads:
+			w.storeLatestBlock(ctx, head.Number.Uint64())
+		case err := <-subErr:
+			logger.GetLogger().Warnf("New heads subscription on network %s dropped: %v", w.network.String(), err)
+			sub.Unsubscribe()
+			sub, subErr = nil, nil
+			resubscribe = time.After(retryDelay)
+		case <-resubscribe:
+			subscribe()
 		case <-ctx.Done():
+			if sub != nil {
+				sub.Unsubscribe()
+			}
 			logger.GetLogger().Infof("Shutting down latestBlockWorker on network %s", w.network.String())
 			return
 		}
@@ -71,13 +118,6 @@ func (w *latestBlockWorker) run(ctx context.Context) {
 
 // fetchAndStoreLatestBlock fetches the latest block and stores it in cache and DB
 func (w *latestBlockWorker) fetchAndStoreLatestBlock(ctx context.Context) {
-	// Get the current latest block in DB/cache
-	existingBlock, err := w.blockStateUCase.GetLatestBlock(ctx, w.network)
-	if err != nil {
-		logger.GetLogger().Infof("Failed to get latest block for network %s: %v", w.network.String(), err)
-		return
-	}
-
 	// Get the current head of the chain
 	blockNumber, err := w.ethClient.GetLatestBlockNumber(ctx)
 	if err != nil {
@@ -85,9 +125,19 @@ func (w *latestBlockWorker) fetchAndStoreLatestBlock(ctx context.Context) {
 		return
 	}
 
-	if blockNumber.Uint64() > existingBlock {
-		updatedBlock := blockNumber.Uint64()
+	w.storeLatestBlock(ctx, blockNumber.Uint64())
+}
+
+// storeLatestBlock stores the block number in cache and DB if it is ahead of the stored one
+func (w *latestBlockWorker) storeLatestBlock(ctx context.Context, updatedBlock uint64) {
+	// Get the current latest block in DB/cache
+	existingBlock, err := w.blockStateUCase.GetLatestBlock(ctx, w.network)
+	if err != nil {
+		logger.GetLogger().Infof("Failed to get latest block for network %s: %v", w.network.String(), err)
+		return
+	}
 
+	if updatedBlock > existingBlock {
 		if err := w.blockStateUCase.UpdateLatestBlock(ctx, updatedBlock, w.network); err != nil {
 			logger.GetLogger().Infof("Failed to update latest block in DB: %v", err)
 			return
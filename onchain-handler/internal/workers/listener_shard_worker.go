@@ -0,0 +1,120 @@
+package workers
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	workertypes "github.com/genefriendway/onchain-handler/internal/workers/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+// ShardAssignmentHandler is called with the full list of shards owned by the instance whenever it changes.
+type ShardAssignmentHandler func(ctx context.Context, shards []dto.ListenerShardDTO)
+
+type listenerShardWorker struct {
+	listenerShardUCase ucasetypes.ListenerShardUCase
+	instanceID         string
+	networks           []constants.NetworkType
+	partitionCount     uint32
+	onAssignment       ShardAssignmentHandler
+	assignedShardKeys  []string
+	isRunning          bool
+	mu                 sync.Mutex
+}
+
+func NewListenerShardWorker(
+	listenerShardUCase ucasetypes.ListenerShardUCase,
+	instanceID string,
+	networks []constants.NetworkType,
+	partitionCount uint32,
+	onAssignment ShardAssignmentHandler,
+) workertypes.Worker {
+	return &listenerShardWorker{
+		listenerShardUCase: listenerShardUCase,
+		instanceID:         instanceID,
+		networks:           networks,
+		partitionCount:     partitionCount,
+		onAssignment:       onAssignment,
+	}
+}
+
+func (w *listenerShardWorker) Start(ctx context.Context) {
+	// Join the cluster right away instead of waiting for the first tick
+	w.run(ctx)
+
+	ticker := time.NewTicker(constants.ListenerShardHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			go w.run(ctx)
+		case <-ctx.Done():
+			logger.GetLogger().Infof("Shutting down listenerShardWorker, releasing shards of instance %s", w.instanceID)
+			// Use a fresh context so the shards are released even though ctx is cancelled
+			releaseCtx, cancel := context.WithTimeout(context.Background(), constants.RetryDelay)
+			if err := w.listenerShardUCase.Deregister(releaseCtx, w.instanceID); err != nil {
+				logger.GetLogger().Errorf("Failed to deregister listener instance %s: %v", w.instanceID, err)
+			}
+			cancel()
+			return
+		}
+	}
+}
+
+func (w *listenerShardWorker) run(ctx context.Context) {
+	w.mu.Lock()
+	if w.isRunning {
+		logger.GetLogger().Warn("Previous listenerShardWorker run still in progress, skipping this cycle")
+		w.mu.Unlock()
+		return
+	}
+
+	// Mark as running
+	w.isRunning = true
+	w.mu.Unlock()
+
+	w.syncAssignments(ctx)
+
+	// Mark as not running
+	w.mu.Lock()
+	w.isRunning = false
+	w.mu.Unlock()
+}
+
+// syncAssignments sends a heartbeat, rebalances the shards and notifies the handler when the owned shards changed.
+func (w *listenerShardWorker) syncAssignments(ctx context.Context) {
+	if err := w.listenerShardUCase.Heartbeat(ctx, w.instanceID); err != nil {
+		logger.GetLogger().Errorf("Failed to send heartbeat for listener instance %s: %v", w.instanceID, err)
+		return
+	}
+
+	if err := w.listenerShardUCase.Rebalance(
+		ctx, w.networks, w.partitionCount, constants.ListenerInstanceTimeout,
+	); err != nil {
+		logger.GetLogger().Errorf("Failed to rebalance listener shards: %v", err)
+	}
+
+	shards, err := w.listenerShardUCase.GetAssignedShards(ctx, w.instanceID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get shards assigned to instance %s: %v", w.instanceID, err)
+		return
+	}
+
+	shardKeys := make([]string, 0, len(shards))
+	for _, shard := range shards {
+		shardKeys = append(shardKeys, shard.Key())
+	}
+	if slices.Equal(shardKeys, w.assignedShardKeys) {
+		return
+	}
+
+	logger.GetLogger().Infof("Listener instance %s now owns shards: %v", w.instanceID, shardKeys)
+	w.assignedShardKeys = shardKeys
+	w.onAssignment(ctx, shards)
+}
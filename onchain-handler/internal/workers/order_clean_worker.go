@@ -34,6 +34,9 @@ func NewOrderCleanWorker(
 }
 
 func (w *orderCleanWorker) Start(ctx context.Context) {
+	// The orders changed by the worker are audited under its name
+	ctx = utils.WithAuditActor(ctx, utils.AuditActor(constants.AuditActorWorker, "order_clean"))
+
 	ticker := time.NewTicker(constants.OrderCleanInterval)
 	defer ticker.Stop()
 
@@ -71,12 +74,7 @@ func (w *orderCleanWorker) run(ctx context.Context) {
 }
 
 func (w *orderCleanWorker) resolveProcessingOrders(ctx context.Context) {
-	networks := []constants.NetworkType{
-		constants.Bsc,
-		constants.AvaxCChain,
-	}
-
-	for _, network := range networks {
+	for _, network := range conf.GetNetworks() {
 		orderDTOs, err := w.paymentOrderUCase.GetProcessingOrdersExpired(ctx, network)
 		if err != nil {
 			logger.GetLogger().Errorf("Failed to get processing orders for network %s: %v", network, err)
@@ -90,35 +88,46 @@ func (w *orderCleanWorker) resolveProcessingOrders(ctx context.Context) {
 }
 
 func (w *orderCleanWorker) tryResolveProcessingOrder(ctx context.Context, orderDTO dto.PaymentOrderDTOResponse, network constants.NetworkType) {
-	if orderDTO.Status == constants.Success {
+	if constants.IsPaidStatus(orderDTO.Status) {
 		return
 	}
 
-	totalTransferred := big.NewFloat(0)
-	for _, event := range orderDTO.EventHistories {
-		eventAmount, ok := new(big.Float).SetString(event.Amount)
-		if !ok {
+	// Amounts are compared in the scale they are stored with, the token decimals are not needed
+	totalTransferred := big.NewInt(0)
+	for _, event := range orderDTO.CountedEventHistories(orderDTO.Network, orderDTO.Symbol, conf.GetSplitPaymentPolicy()) {
+		eventAmount, err := utils.ConvertFloatTokenToSmallestUnit(event.Amount, constants.PaymentAmountDecimalPlaces)
+		if err != nil {
 			logger.GetLogger().Warnf("Invalid amount in event history for order %d: %s", orderDTO.ID, event.Amount)
 			continue
 		}
 		totalTransferred.Add(totalTransferred, eventAmount)
 	}
 
-	orderAmount, ok := new(big.Float).SetString(orderDTO.Amount)
-	if !ok {
+	orderAmount, err := utils.ConvertFloatTokenToSmallestUnit(orderDTO.Amount, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
 		logger.GetLogger().Warnf("Invalid order amount for order %d: %s", orderDTO.ID, orderDTO.Amount)
 		return
 	}
 
-	paymentCovering := conf.GetPaymentCovering()
-	minimumAcceptedAmount := new(big.Float).Sub(orderAmount, big.NewFloat(paymentCovering))
-	if totalTransferred.Cmp(minimumAcceptedAmount) < 0 {
+	outcome, err := w.paymentOrderUCase.EvaluatePayment(
+		ctx, orderDTO.VendorID, orderDTO.Symbol, orderAmount, totalTransferred, constants.PaymentAmountDecimalPlaces,
+	)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to evaluate payment of order %d: %v", orderDTO.ID, err)
+		return
+	}
+	if !constants.IsPaidStatus(outcome) {
 		return
 	}
 
-	// Mark order as SUCCESS
-	if err := w.paymentOrderUCase.UpdateOrderToSuccessAndReleaseWallet(ctx, orderDTO.ID); err != nil {
-		logger.GetLogger().Errorf("Failed to update order %d to SUCCESS: %v", orderDTO.ID, err)
+	// Mark order as SUCCESS or OVERPAID
+	updated, err := w.paymentOrderUCase.UpdateOrderToSuccessAndReleaseWallet(ctx, orderDTO.ID, outcome)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to update order %d to %s: %v", orderDTO.ID, outcome, err)
+		return
+	}
+	if !updated {
+		// Already resolved by another credit path which sent the webhook
 		return
 	}
 
@@ -129,17 +138,18 @@ func (w *orderCleanWorker) tryResolveProcessingOrder(ctx context.Context, orderD
 		return
 	}
 
-	if updatedOrder.Status != constants.Success {
+	if !constants.IsPaidStatus(updatedOrder.Status) {
 		return
 	}
 
-	// Update the order in the set
-	key := updatedOrder.PaymentAddress + "_" + updatedOrder.Symbol
-	orderInSet, exists := w.orderSet.GetItem(key)
-	if !exists {
-		logger.GetLogger().Warnf("Order not found in set for key: %s", key)
-	} else {
-		orderInSet.Status = constants.Success
+	// Update the order in the set, on every option of a multi-chain order
+	for _, key := range updatedOrder.SetKeys() {
+		orderInSet, exists := w.orderSet.GetItem(key)
+		if !exists {
+			logger.GetLogger().Warnf("Order not found in set for key: %s", key)
+			continue
+		}
+		orderInSet.Status = updatedOrder.Status
 		if err := w.orderSet.UpdateItem(key, orderInSet); err != nil {
 			logger.GetLogger().Warnf("Failed to update order in set for key %s: %v", key, err)
 		}
@@ -157,7 +167,7 @@ func (w *orderCleanWorker) tryResolveProcessingOrder(ctx context.Context, orderD
 	if len(webhookErrors) > 0 {
 		logger.GetLogger().Errorf("Failed to send webhook for order %d: %v", orderDTO.ID, webhookErrors)
 	} else {
-		logger.GetLogger().Infof("Order %d (%s) marked as SUCCESS and webhook sent.", orderDTO.ID, network)
+		logger.GetLogger().Infof("Order %d (%s) marked as %s and webhook sent.", orderDTO.ID, network, updatedOrder.Status)
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strconv"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
 	workertypes "github.com/genefriendway/onchain-handler/internal/workers/types"
 	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
 	"github.com/genefriendway/onchain-handler/pkg/crypto"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
@@ -33,17 +35,23 @@ type paymentWalletWithdrawWorker struct {
 	ctx                    context.Context
 	ethClient              clienttypes.Client
 	network                constants.NetworkType
+	family                 chain.Family // Writes the addresses of the network
 	chainID                uint64
 	cacheRepo              cachetypes.CacheRepository
 	tokenTransferUCase     ucasetypes.TokenTransferUCase
 	paymentWalletUCase     ucasetypes.PaymentWalletUCase
+	withdrawalRequestUCase ucasetypes.WithdrawalRequestUCase
+	withdrawScheduleUCase  ucasetypes.WithdrawScheduleUCase
+	gasCostUCase           ucasetypes.GasCostUCase
 	tokenContractAddresses []string
 	masterWalletAddress    string
 	mnemonic               string
 	passphrase             string
 	salt                   string
 	gasBufferMultiplier    float64
-	withdrawInterval       string
+	hotWalletTargets       map[string]string // Token units by symbol
+	receivingGasFloor      string            // Empty when the receiving wallet is not refilled
+	receivingGasTarget     string
 	isRunning              bool
 	mu                     sync.Mutex
 }
@@ -56,56 +64,48 @@ func NewPaymentWalletWithdrawWorker(
 	cacheRepo cachetypes.CacheRepository,
 	tokenTransferUCase ucasetypes.TokenTransferUCase,
 	paymentWalletUCase ucasetypes.PaymentWalletUCase,
+	withdrawalRequestUCase ucasetypes.WithdrawalRequestUCase,
+	withdrawScheduleUCase ucasetypes.WithdrawScheduleUCase,
+	gasCostUCase ucasetypes.GasCostUCase,
 	tokenContractAddresses []string,
 	masterWalletAddress string,
 	mnemonic, passphrase, salt string,
 	gasBufferMultiplier float64,
-	withdrawInterval string,
+	hotWalletTargets map[string]string,
+	receivingGasFloor, receivingGasTarget string,
 ) workertypes.Worker {
 	return &paymentWalletWithdrawWorker{
 		ctx:                    ctx,
 		ethClient:              ethClient,
 		network:                network,
+		family:                 chain.ForNetwork(network),
 		chainID:                chainID,
 		cacheRepo:              cacheRepo,
 		tokenTransferUCase:     tokenTransferUCase,
 		paymentWalletUCase:     paymentWalletUCase,
+		withdrawalRequestUCase: withdrawalRequestUCase,
+		withdrawScheduleUCase:  withdrawScheduleUCase,
+		gasCostUCase:           gasCostUCase,
 		tokenContractAddresses: tokenContractAddresses,
 		masterWalletAddress:    masterWalletAddress,
 		mnemonic:               mnemonic,
 		passphrase:             passphrase,
 		salt:                   salt,
 		gasBufferMultiplier:    gasBufferMultiplier,
-		withdrawInterval:       withdrawInterval,
+		hotWalletTargets:       hotWalletTargets,
+		receivingGasFloor:      receivingGasFloor,
+		receivingGasTarget:     receivingGasTarget,
 	}
 }
 
+// Start checks the withdraw schedules of the network every minute and sweeps the tokens whose schedule is due.
 func (w *paymentWalletWithdrawWorker) Start(ctx context.Context) {
-	for {
-		// Calculate the duration until the next scheduled time (e.g., midnight)
-		var sleepDuration time.Duration
-
-		switch w.withdrawInterval {
-		case constants.WithdrawIntervalHourly:
-			sleepDuration = time.Hour
-		case constants.WithdrawIntervalDaily:
-			now := time.Now()
-			nextRun := time.Date(
-				now.Year(), now.Month(), now.Day()+1, // Next day
-				0, 0, 0, 0, // 00:00:00
-				now.Location(),
-			)
-			sleepDuration = time.Until(nextRun)
-		default:
-			logger.GetLogger().Errorf("Invalid RunInterval: %s. Defaulting to hourly.", w.withdrawInterval)
-			sleepDuration = time.Hour
-		}
-
-		logger.GetLogger().Infof("Next payment wallet withdrawal on network %s scheduled in: %s", w.network, sleepDuration)
+	ticker := time.NewTicker(constants.WithdrawScheduleCheckInterval)
+	defer ticker.Stop()
 
-		// Sleep until the next scheduled time or exit early if the context is canceled
+	for {
 		select {
-		case <-time.After(sleepDuration):
+		case <-ticker.C:
 			go w.run(ctx)
 		case <-ctx.Done():
 			logger.GetLogger().Infof("Shutting down paymentWalletWithdrawWorker on network %s", w.network)
@@ -131,9 +131,18 @@ func (w *paymentWalletWithdrawWorker) run(ctx context.Context) {
 		w.mu.Unlock()
 	}()
 
+	schedules, err := w.getDueSchedules(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get withdraw schedules on network %s: %v", w.network, err)
+		return
+	}
+	if len(schedules) == 0 {
+		return
+	}
+
 	var lastErr error
 	for attempt := 1; attempt <= constants.MaxRetries; attempt++ {
-		lastErr = w.withdraw(ctx)
+		lastErr = w.withdraw(ctx, schedules)
 		if lastErr == nil {
 			logger.GetLogger().Infof("Withdrawal process on network %s succeeded on attempt %d", w.network, attempt)
 			return
@@ -151,9 +160,45 @@ func (w *paymentWalletWithdrawWorker) run(ctx context.Context) {
 	}
 
 	logger.GetLogger().Errorf("Withdrawal process on network %s failed after %d attempts: %v", w.network, constants.MaxRetries, lastErr)
+	for _, schedule := range schedules {
+		w.completeWithdrawRun(ctx, schedule, constants.WithdrawRunFailed, lastErr.Error())
+	}
 }
 
-func (w *paymentWalletWithdrawWorker) withdraw(ctx context.Context) error {
+// getDueSchedules returns the schedules, by token symbol, of the tokens of the network to sweep now:
+// the ones past their next run or with a requested sweep, unless paused.
+func (w *paymentWalletWithdrawWorker) getDueSchedules(ctx context.Context) (map[string]dto.WithdrawScheduleDTO, error) {
+	symbols := make([]string, 0, len(w.tokenContractAddresses))
+	for _, tokenAddr := range w.tokenContractAddresses {
+		tokenSymbol, err := conf.GetTokenSymbol(tokenAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token symbol from token contract address %s: %w", tokenAddr, err)
+		}
+		symbols = append(symbols, tokenSymbol)
+	}
+	if err := w.withdrawScheduleUCase.EnsureWithdrawSchedules(ctx, w.network.String(), symbols); err != nil {
+		return nil, err
+	}
+
+	schedules, err := w.withdrawScheduleUCase.GetWithdrawSchedules(ctx, w.network.String(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dueSchedules := make(map[string]dto.WithdrawScheduleDTO)
+	for _, schedule := range schedules {
+		if schedule.Paused {
+			continue
+		}
+		if schedule.SweepRequested || !now.Before(schedule.NextRunAt) {
+			dueSchedules[schedule.Symbol] = schedule
+		}
+	}
+	return dueSchedules, nil
+}
+
+func (w *paymentWalletWithdrawWorker) withdraw(ctx context.Context, schedules map[string]dto.WithdrawScheduleDTO) error {
 	// Step 1: Get native token symbol
 	nativeTokenSymbol, err := blockchain.GetNativeTokenSymbol(w.network)
 	if err != nil {
@@ -169,39 +214,78 @@ func (w *paymentWalletWithdrawWorker) withdraw(ctx context.Context) error {
 	}
 
 	// Step 3: Get receiving wallet (address and private key)
-	account, privKey, err := payment.GetReceivingWallet(w.mnemonic, w.passphrase, w.salt)
+	account, privKey, err := payment.GetNetworkWallet(w.network, constants.ReceivingWallet, w.mnemonic, w.passphrase, w.salt)
 	if err != nil {
 		return fmt.Errorf("failed to get receiving wallet on network %s: %w", w.network, err)
 	}
 
-	receivingAddr := account.Address.Hex()
+	receivingAddr := w.family.FormatAddress(account.Address)
 	receivingPrivKey, err := crypto.PrivateKeyToHex(privKey)
 	if err != nil {
 		return fmt.Errorf("failed to convert private key to hex: %w", err)
 	}
 
-	// Loop through each token contract
+	// Refill the receiving wallet with gas before it tops up the payment wallets
+	if err := w.refillReceivingWalletGas(ctx, receivingAddr, nativeTokenSymbol); err != nil {
+		logger.GetLogger().Errorf("Failed to refill receiving wallet gas on network %s: %v", w.network, err)
+	}
+
+	// Price the gas costs of the sweeps attributed to the orders, they are attributed without USD value when unavailable
+	nativePriceUSD, err := w.getNativePriceUSD(ctx)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to get %s USD price on network %s: %v", nativeTokenSymbol, w.network, err)
+	}
+
+	// Loop through each token contract due for a sweep
 	for _, tokenAddr := range w.tokenContractAddresses {
+		// Get token symbol
+		tokenSymbol, err := conf.GetTokenSymbol(tokenAddr)
+		if err != nil {
+			return fmt.Errorf("failed to get token symbol from token contract address %s: %w", tokenAddr, err)
+		}
+		schedule, due := schedules[tokenSymbol]
+		if !due {
+			continue
+		}
+
 		decimals, err := blockchain.GetTokenDecimalsFromCache(tokenAddr, w.network.String(), w.cacheRepo)
 		if err != nil {
 			logger.GetLogger().Errorf("Skipping token %s: failed to get decimals: %v", tokenAddr, err)
 			continue
 		}
 
-		// Get token symbol
-		tokenSymbol, err := conf.GetTokenSymbol(tokenAddr)
-		if err != nil {
-			return fmt.Errorf("failed to get token symbol from token contract address %s: %w", tokenAddr, err)
+		// A requested sweep runs regardless of the gas price ceiling and the minimum batch value
+		if !schedule.SweepRequested {
+			if reason, err := w.checkGasPriceCeiling(ctx, schedule); err != nil {
+				logger.GetLogger().Errorf("Failed to check gas price ceiling of %s on network %s: %v", tokenSymbol, w.network, err)
+				continue
+			} else if reason != "" {
+				logger.GetLogger().Infof("Deferring %s sweep on network %s: %s", tokenSymbol, w.network, reason)
+				w.completeWithdrawRun(ctx, schedule, constants.WithdrawRunDeferred, reason)
+				continue
+			}
 		}
 
 		addressWalletMap := w.mapWallets(wallets, w.network.String(), tokenSymbol, decimals)
 
+		if !schedule.SweepRequested {
+			if reason, err := w.checkMinBatchValue(schedule, addressWalletMap, decimals); err != nil {
+				logger.GetLogger().Errorf("Failed to check minimum batch value of %s on network %s: %v", tokenSymbol, w.network, err)
+				continue
+			} else if reason != "" {
+				logger.GetLogger().Infof("Skipping %s sweep on network %s: %s", tokenSymbol, w.network, reason)
+				w.completeWithdrawRun(ctx, schedule, constants.WithdrawRunSkipped, reason)
+				continue
+			}
+		}
+
 		for address, walletInfo := range addressWalletMap {
 			if walletInfo.TokenAmount == nil {
 				continue
 			}
 			err := w.processWallet(
 				ctx, address, nativeTokenSymbol, receivingAddr, receivingPrivKey, walletInfo, decimals, tokenAddr, tokenSymbol,
+				nativePriceUSD,
 			)
 			if err != nil {
 				logger.GetLogger().Errorf(
@@ -211,10 +295,15 @@ func (w *paymentWalletWithdrawWorker) withdraw(ctx context.Context) error {
 			time.Sleep(constants.DefaultNetworkDelay)
 		}
 
-		if err := w.transferFromReceivingToMasterWallet(ctx, receivingAddr, receivingPrivKey, decimals, tokenAddr, tokenSymbol); err != nil {
+		if err := w.transferFromReceivingToMasterWallet(
+			ctx, receivingAddr, receivingPrivKey, decimals, tokenAddr, tokenSymbol, nativePriceUSD,
+		); err != nil {
 			logger.GetLogger().Errorf(
 				"Failed to transfer from receiving to master for token %s on network %s: %v", tokenSymbol, w.network, err,
 			)
+			w.completeWithdrawRun(ctx, schedule, constants.WithdrawRunFailed, err.Error())
+		} else {
+			w.completeWithdrawRun(ctx, schedule, constants.WithdrawRunSuccess, "")
 		}
 		time.Sleep(constants.DefaultNetworkDelay)
 	}
@@ -222,11 +311,85 @@ func (w *paymentWalletWithdrawWorker) withdraw(ctx context.Context) error {
 	return nil
 }
 
+// checkGasPriceCeiling returns why the sweep of the schedule is deferred when the gas price is above its ceiling,
+// or an empty string when the sweep can run.
+func (w *paymentWalletWithdrawWorker) checkGasPriceCeiling(ctx context.Context, schedule dto.WithdrawScheduleDTO) (string, error) {
+	if schedule.MaxGasPriceGwei == nil {
+		return "", nil
+	}
+	ceiling, err := utils.ConvertFloatTokenToSmallestUnit(
+		strconv.FormatFloat(*schedule.MaxGasPriceGwei, 'f', -1, 64), constants.GweiDecimalPlaces,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert gas price ceiling %v: %w", *schedule.MaxGasPriceGwei, err)
+	}
+
+	gasPrice, err := w.ethClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get gas price: %w", err)
+	}
+	if gasPrice.Cmp(ceiling) <= 0 {
+		return "", nil
+	}
+
+	gasPriceGwei, err := utils.ConvertSmallestUnitToFloatToken(gasPrice.String(), constants.GweiDecimalPlaces)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert gas price %s: %w", gasPrice.String(), err)
+	}
+	return fmt.Sprintf("gas price %s gwei is above the ceiling of %v gwei", gasPriceGwei, *schedule.MaxGasPriceGwei), nil
+}
+
+// checkMinBatchValue returns why the sweep of the schedule is skipped when the payment wallets hold less than
+// its minimum batch value together, or an empty string when the sweep can run.
+func (w *paymentWalletWithdrawWorker) checkMinBatchValue(
+	schedule dto.WithdrawScheduleDTO,
+	addressWalletMap map[string]walletInfo,
+	decimals uint8,
+) (string, error) {
+	if schedule.MinBatchValue == nil {
+		return "", nil
+	}
+	minBatchValue, err := utils.ConvertFloatTokenToSmallestUnit(strconv.FormatFloat(*schedule.MinBatchValue, 'f', -1, 64), decimals)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert minimum batch value %v: %w", *schedule.MinBatchValue, err)
+	}
+
+	total := big.NewInt(0)
+	for _, walletInfo := range addressWalletMap {
+		if walletInfo.TokenAmount != nil {
+			total.Add(total, walletInfo.TokenAmount)
+		}
+	}
+	if total.Cmp(minBatchValue) >= 0 {
+		return "", nil
+	}
+
+	totalValue, err := utils.ConvertSmallestUnitToFloatToken(total.String(), decimals)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert payment wallets balance %s: %w", total.String(), err)
+	}
+	return fmt.Sprintf(
+		"payment wallets hold %s %s, below the minimum batch value of %v", totalValue, schedule.Symbol, *schedule.MinBatchValue,
+	), nil
+}
+
+func (w *paymentWalletWithdrawWorker) completeWithdrawRun(
+	ctx context.Context,
+	schedule dto.WithdrawScheduleDTO,
+	status, message string,
+) {
+	if err := w.withdrawScheduleUCase.CompleteWithdrawRun(ctx, schedule, status, message); err != nil {
+		logger.GetLogger().Errorf("Failed to record %s sweep on network %s: %v", schedule.Symbol, w.network, err)
+	}
+}
+
+// transferFromReceivingToMasterWallet tops up the hot wallet to its target from the receiving wallet
+// and sends the rest of the receiving wallet balance to the master wallet.
 func (w *paymentWalletWithdrawWorker) transferFromReceivingToMasterWallet(
 	ctx context.Context,
 	receivingWalletAddress, receivingWalletPrivateKey string,
 	decimals uint8,
-	tokenAddress, tokenSymbol string,
+	tokenAddress, tokenSymbol, nativePriceUSD string,
 ) error {
 	// Step 1: Get the balance of the token in the receiving wallet
 	tokenBalance, err := w.ethClient.GetTokenBalance(ctx, tokenAddress, receivingWalletAddress)
@@ -242,6 +405,14 @@ func (w *paymentWalletWithdrawWorker) transferFromReceivingToMasterWallet(
 		return nil
 	}
 
+	// Step 3: Keep the hot wallet at its target, only the excess goes to the master wallet
+	tokenBalance, err = w.topUpHotWallet(
+		ctx, receivingWalletAddress, receivingWalletPrivateKey, decimals, tokenAddress, tokenSymbol, tokenBalance,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to top up hot wallet with %s on network %s: %w", tokenSymbol, w.network, err)
+	}
+
 	// Enforce minimum withdrawal threshold
 	minThreshold := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil) // 10^decimals
 	minThreshold.Mul(minThreshold, big.NewInt(constants.MinimumWithdrawThreshold))
@@ -255,16 +426,41 @@ func (w *paymentWalletWithdrawWorker) transferFromReceivingToMasterWallet(
 		return nil
 	}
 
-	// Step 3: Perform the transfer to the master wallet
+	// Step 4: Let the transfer policy authorize the withdrawal
+	balance, err := utils.ConvertSmallestUnitToFloatToken(tokenBalance.String(), decimals)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s balance of receiving wallet on network %s: %w", tokenSymbol, w.network, err)
+	}
+	authorization, err := w.withdrawalRequestUCase.AuthorizeWithdrawal(
+		ctx, w.network.String(), tokenSymbol, receivingWalletAddress, w.masterWalletAddress, balance,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to authorize %s withdrawal to master wallet on network %s: %w", tokenSymbol, w.network, err)
+	}
+	if !authorization.Authorized {
+		return nil
+	}
+	withdrawAmount, err := utils.ConvertFloatTokenToSmallestUnit(authorization.Amount, decimals)
+	if err != nil {
+		return fmt.Errorf("failed to convert authorized amount %s on network %s: %w", authorization.Amount, w.network, err)
+	}
+	if withdrawAmount.Cmp(tokenBalance) > 0 {
+		withdrawAmount = tokenBalance
+	}
+
+	// Step 5: Perform the transfer to the master wallet
 	txHash, gasUsed, gasPrice, receiptStatus, err := w.ethClient.TransferToken(
 		ctx,
 		w.chainID,
 		tokenAddress,
 		receivingWalletPrivateKey,
 		w.masterWalletAddress,
-		tokenBalance,
+		withdrawAmount,
 	)
 	if err != nil {
+		if authorization.RequestID != nil {
+			w.completeWithdrawalRequest(ctx, *authorization.RequestID, constants.OutboundFailed, "", "", err.Error())
+		}
 		return fmt.Errorf(
 			"failed to transfer %s from receiving wallet to master wallet on network %s: %w",
 			tokenSymbol,
@@ -273,9 +469,9 @@ func (w *paymentWalletWithdrawWorker) transferFromReceivingToMasterWallet(
 		)
 	}
 
-	// Step 4: Calculate fee and create the payload
+	// Step 6: Calculate fee and create the payload
 	fee := utils.CalculateFee(gasUsed, gasPrice)
-	tokenAmount, err := utils.ConvertSmallestUnitToFloatToken(tokenBalance.String(), decimals)
+	tokenAmount, err := utils.ConvertSmallestUnitToFloatToken(withdrawAmount.String(), decimals)
 	if err != nil {
 		logger.GetLogger().Errorf(
 			"Failed to convert token amount for transfer %s on network %s: %v", tokenSymbol, w.network, err,
@@ -290,6 +486,13 @@ func (w *paymentWalletWithdrawWorker) transferFromReceivingToMasterWallet(
 		status = false
 		errorMessage = "execution reverted"
 	}
+	if authorization.RequestID != nil {
+		requestStatus := constants.OutboundSuccess
+		if !status {
+			requestStatus = constants.OutboundFailed
+		}
+		w.completeWithdrawalRequest(ctx, *authorization.RequestID, requestStatus, txHash.Hex(), fee, errorMessage)
+	}
 
 	payload := dto.TokenTransferHistoryDTO{
 		Network:         w.network.String(),
@@ -304,19 +507,24 @@ func (w *paymentWalletWithdrawWorker) transferFromReceivingToMasterWallet(
 		Type:            constants.Withdraw,
 	}
 
-	// Step 5: Persist transfer history
+	// Step 7: Persist transfer history
 	err = w.tokenTransferUCase.CreateTokenTransferHistories(ctx, []dto.TokenTransferHistoryDTO{payload})
 	if err != nil {
 		logger.GetLogger().Errorf("Failed to create token transfer history for receiving wallet transfer on network %s: %v", w.network, err)
 		return err
 	}
 
-	// Step 6: Log successful transfer
+	// Step 8: Log successful transfer and attribute its fee to the withdrawn orders
 	if receiptStatus == 1 {
 		logger.GetLogger().Infof(
 			"Transferred %s %s from receiving wallet to master wallet  on network %s. Transaction hash: %s. Fee: %s",
-			tokenBalance.String(), tokenSymbol, w.network, txHash.Hex(), fee,
+			withdrawAmount.String(), tokenSymbol, w.network, txHash.Hex(), fee,
 		)
+		if err := w.gasCostUCase.AttributeWithdrawFee(
+			ctx, w.network.String(), tokenSymbol, receivingWalletAddress, txHash.Hex(), nativePriceUSD, []string{fee},
+		); err != nil {
+			logger.GetLogger().Errorf("Failed to attribute fee of withdrawal %s on network %s: %v", txHash.Hex(), w.network, err)
+		}
 	} else {
 		logger.GetLogger().Errorf(
 			"%s transfer failed from receiving wallet to master wallet on network %s. Transaction hash: %s",
@@ -327,6 +535,183 @@ func (w *paymentWalletWithdrawWorker) transferFromReceivingToMasterWallet(
 	return nil
 }
 
+// topUpHotWallet sends the receiving wallet balance missing from the hot wallet target of the token to the hot wallet
+// and returns the balance left in the receiving wallet. Without a target the whole balance is left.
+func (w *paymentWalletWithdrawWorker) topUpHotWallet(
+	ctx context.Context,
+	receivingWalletAddress, receivingWalletPrivateKey string,
+	decimals uint8,
+	tokenAddress, tokenSymbol string,
+	tokenBalance *big.Int,
+) (*big.Int, error) {
+	target, exists := w.hotWalletTargets[tokenSymbol]
+	if !exists {
+		return tokenBalance, nil
+	}
+	targetAmount, err := utils.ConvertFloatTokenToSmallestUnit(target, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert hot wallet target %s: %w", target, err)
+	}
+
+	// Step 1: Check how much the hot wallet is missing
+	account, _, err := payment.GetNetworkWallet(w.network, constants.PayoutWallet, w.mnemonic, w.passphrase, w.salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hot wallet: %w", err)
+	}
+	hotWalletAddress := w.family.FormatAddress(account.Address)
+	hotBalance, err := w.ethClient.GetTokenBalance(ctx, tokenAddress, hotWalletAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s balance of hot wallet: %w", tokenSymbol, err)
+	}
+	if hotBalance.Cmp(targetAmount) >= 0 {
+		return tokenBalance, nil
+	}
+	topUpAmount := new(big.Int).Sub(targetAmount, hotBalance)
+	if topUpAmount.Cmp(tokenBalance) > 0 {
+		topUpAmount.Set(tokenBalance)
+	}
+
+	// Step 2: Transfer the missing balance to the hot wallet
+	txHash, gasUsed, gasPrice, receiptStatus, err := w.ethClient.TransferToken(
+		ctx, w.chainID, tokenAddress, receivingWalletPrivateKey, hotWalletAddress, topUpAmount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer %s to hot wallet: %w", tokenSymbol, err)
+	}
+
+	// Step 3: Persist the rebalancing transfer
+	tokenAmount, err := utils.ConvertSmallestUnitToFloatToken(topUpAmount.String(), decimals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert top up amount: %w", err)
+	}
+	payload := dto.TokenTransferHistoryDTO{
+		Network:         w.network.String(),
+		TransactionHash: txHash.Hex(),
+		FromAddress:     receivingWalletAddress,
+		ToAddress:       hotWalletAddress,
+		TokenAmount:     tokenAmount,
+		Status:          receiptStatus == 1,
+		Symbol:          tokenSymbol,
+		Fee:             utils.CalculateFee(gasUsed, gasPrice),
+		Type:            constants.Rebalance,
+	}
+	if receiptStatus != 1 {
+		payload.ErrorMessage = "execution reverted"
+	}
+	if err := w.tokenTransferUCase.CreateTokenTransferHistories(ctx, []dto.TokenTransferHistoryDTO{payload}); err != nil {
+		logger.GetLogger().Errorf("Failed to create token transfer history for hot wallet top up on network %s: %v", w.network, err)
+	}
+
+	// Nothing is sent to the master wallet until the hot wallet is topped up
+	if receiptStatus != 1 {
+		return nil, fmt.Errorf("hot wallet top up failed. Transaction hash: %s", txHash.Hex())
+	}
+	logger.GetLogger().Infof(
+		"Topped up hot wallet with %s %s on network %s. Transaction hash: %s", tokenAmount, tokenSymbol, w.network, txHash.Hex(),
+	)
+	return new(big.Int).Sub(tokenBalance, topUpAmount), nil
+}
+
+// refillReceivingWalletGas refills the receiving wallet from the gas source wallet up to its gas target
+// once its native balance is below its gas floor. The refill is recorded with the status of its receipt.
+func (w *paymentWalletWithdrawWorker) refillReceivingWalletGas(
+	ctx context.Context,
+	receivingWalletAddress, nativeTokenSymbol string,
+) error {
+	floor, target := w.receivingGasFloor, w.receivingGasTarget
+	if floor == "" {
+		return nil
+	}
+	floorAmount, err := utils.ConvertFloatTokenToSmallestUnit(floor, constants.NativeTokenDecimalPlaces)
+	if err != nil {
+		return fmt.Errorf("failed to convert receiving gas floor %s: %w", floor, err)
+	}
+	targetAmount, err := utils.ConvertFloatTokenToSmallestUnit(target, constants.NativeTokenDecimalPlaces)
+	if err != nil {
+		return fmt.Errorf("failed to convert receiving gas target %s: %w", target, err)
+	}
+	if targetAmount.Cmp(floorAmount) <= 0 {
+		return fmt.Errorf("receiving gas target %s is not above the floor %s", target, floor)
+	}
+
+	// Step 1: Check the native balance of the receiving wallet against its floor
+	nativeBalance, err := w.ethClient.GetNativeTokenBalance(ctx, receivingWalletAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get native balance of receiving wallet: %w", err)
+	}
+	if nativeBalance.Cmp(floorAmount) >= 0 {
+		return nil
+	}
+	refillAmount := new(big.Int).Sub(targetAmount, nativeBalance)
+
+	// Step 2: Transfer the refill from the gas source wallet
+	account, privateKey, err := payment.GetNetworkWallet(w.network, constants.GasSourceWallet, w.mnemonic, w.passphrase, w.salt)
+	if err != nil {
+		return fmt.Errorf("failed to get gas source wallet: %w", err)
+	}
+	gasSourceAddress := w.family.FormatAddress(account.Address)
+	privateKeyHex, err := crypto.PrivateKeyToHex(privateKey)
+	if err != nil {
+		return fmt.Errorf("failed to convert private key to hex: %w", err)
+	}
+	txHash, gasUsed, gasPrice, err := w.ethClient.TransferNativeToken(
+		ctx, w.chainID, privateKeyHex, receivingWalletAddress, refillAmount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to transfer native token from gas source wallet %s: %w", gasSourceAddress, err)
+	}
+	receiptStatus, receiptErr := w.ethClient.WaitForReceipt(ctx, txHash)
+	if receiptErr != nil {
+		logger.GetLogger().Errorf("Failed to get the receipt of receiving wallet gas refill %s on network %s: %v", txHash.Hex(), w.network, receiptErr)
+	}
+
+	// Step 3: Persist the rebalancing transfer
+	nativeAmount, err := utils.ConvertSmallestUnitToFloatToken(refillAmount.String(), constants.NativeTokenDecimalPlaces)
+	if err != nil {
+		return fmt.Errorf("failed to convert refill amount: %w", err)
+	}
+	payload := dto.TokenTransferHistoryDTO{
+		Network:         w.network.String(),
+		TransactionHash: txHash.Hex(),
+		FromAddress:     gasSourceAddress,
+		ToAddress:       receivingWalletAddress,
+		TokenAmount:     nativeAmount,
+		Status:          receiptStatus == 1,
+		Symbol:          nativeTokenSymbol,
+		Fee:             utils.CalculateFee(gasUsed, gasPrice),
+		Type:            constants.Rebalance,
+	}
+	if receiptStatus != 1 {
+		payload.ErrorMessage = "execution reverted"
+		if receiptErr != nil {
+			payload.ErrorMessage = receiptErr.Error()
+		}
+	}
+	if err := w.tokenTransferUCase.CreateTokenTransferHistories(ctx, []dto.TokenTransferHistoryDTO{payload}); err != nil {
+		return fmt.Errorf("failed to create token transfer history for receiving wallet gas refill: %w", err)
+	}
+
+	if receiptStatus != 1 {
+		return fmt.Errorf("receiving wallet gas refill failed. Transaction hash: %s", txHash.Hex())
+	}
+
+	logger.GetLogger().Infof(
+		"Refilled receiving wallet with %s %s on network %s. Transaction hash: %s", nativeAmount, nativeTokenSymbol, w.network, txHash.Hex(),
+	)
+	return nil
+}
+
+// completeWithdrawalRequest records the outcome of the transfer of an approved withdrawal request.
+func (w *paymentWalletWithdrawWorker) completeWithdrawalRequest(
+	ctx context.Context,
+	id uint64,
+	status, transactionHash, fee, errorMessage string,
+) {
+	if err := w.withdrawalRequestUCase.CompleteWithdrawal(ctx, id, status, transactionHash, fee, errorMessage); err != nil {
+		logger.GetLogger().Errorf("Failed to complete withdrawal request %d on network %s: %v", id, w.network, err)
+	}
+}
+
 func (w *paymentWalletWithdrawWorker) mapWallets(
 	wallets []dto.PaymentWalletBalanceDTO,
 	network string,
@@ -335,6 +720,10 @@ func (w *paymentWalletWithdrawWorker) mapWallets(
 ) map[string]walletInfo {
 	addressWalletMap := make(map[string]walletInfo)
 	for _, wallet := range wallets {
+		address := wallet.AddressOn(network)
+		if address == "" {
+			continue
+		}
 		for _, networkBalance := range wallet.NetworkBalances {
 			if networkBalance.Network != network {
 				continue
@@ -345,10 +734,10 @@ func (w *paymentWalletWithdrawWorker) mapWallets(
 				}
 				amount, err := utils.ConvertFloatTokenToSmallestUnit(tokenBalance.Amount, decimals)
 				if err != nil {
-					logger.GetLogger().Errorf("Failed to convert amount for wallet %s on network %s: %v", wallet.Address, network, err)
+					logger.GetLogger().Errorf("Failed to convert amount for wallet %s on network %s: %v", address, network, err)
 					continue
 				}
-				addressWalletMap[wallet.Address] = walletInfo{
+				addressWalletMap[address] = walletInfo{
 					ID:          wallet.ID,
 					TokenAmount: amount,
 				}
@@ -362,11 +751,13 @@ func (w *paymentWalletWithdrawWorker) processWallet(
 	ctx context.Context,
 	address, nativeTokenSymbol, receivingWalletAddress, receivingWalletPrivateKey string,
 	walletInfo walletInfo, decimals uint8,
-	tokenAddress, tokenSymbol string,
+	tokenAddress, tokenSymbol, nativePriceUSD string,
 ) error {
 	// Step 1: Generate account and validate
-	account, privateKey, err := crypto.GenerateAccount(w.mnemonic, w.passphrase, w.salt, constants.PaymentWallet, walletInfo.ID)
-	if err != nil || account.Address.Hex() != address {
+	account, privateKey, err := crypto.GenerateAccountForCoinType(
+		w.family.CoinType(), w.mnemonic, w.passphrase, w.salt, constants.PaymentWallet, walletInfo.ID,
+	)
+	if err != nil || w.family.FormatAddress(account.Address) != address {
 		return fmt.Errorf("account generation or address mismatch: %v", err)
 	}
 
@@ -416,6 +807,7 @@ func (w *paymentWalletWithdrawWorker) processWallet(
 	}
 
 	var payloads []dto.TokenTransferHistoryDTO
+	var fees []string
 
 	// Step 4: Transfer native token for gas if required
 	if requiredGas.Cmp(big.NewInt(0)) > 0 {
@@ -427,6 +819,7 @@ func (w *paymentWalletWithdrawWorker) processWallet(
 		}
 
 		fee := utils.CalculateFee(gasUsed, gasPrice)
+		fees = append(fees, fee)
 		nativeAmount, err := utils.ConvertSmallestUnitToFloatToken(requiredGas.String(), constants.NativeTokenDecimalPlaces)
 		if err != nil {
 			return fmt.Errorf(
@@ -514,21 +907,56 @@ func (w *paymentWalletWithdrawWorker) processWallet(
 		return err
 	}
 
+	// Step 7: Attribute the gas and transfer fees to the orders paid into the wallet
+	if status {
+		fees = append(fees, fee)
+		if err := w.gasCostUCase.AttributeSweepFee(
+			ctx, w.network.String(), tokenSymbol, address, txHash.Hex(), nativePriceUSD, fees,
+		); err != nil {
+			logger.GetLogger().Errorf("Failed to attribute fee of sweep %s on network %s: %v", txHash.Hex(), w.network, err)
+		}
+	}
+
 	return nil
 }
 
+// getNativePriceUSD returns the USD price of the native token from the price feed of the network, or its fixed price.
+// It returns an empty price when the network has neither.
+func (w *paymentWalletWithdrawWorker) getNativePriceUSD(ctx context.Context) (string, error) {
+	feedAddress, fixedPrice := conf.GetNativePriceSource(w.network)
+	if feedAddress == "" {
+		return fixedPrice, nil
+	}
+
+	price, err := blockchain.GetPriceFromFeed(ctx, w.ethClient, feedAddress)
+	if err != nil {
+		// Fall back to the fixed price
+		return fixedPrice, err
+	}
+	return price, nil
+}
+
+// parseAddress parses an address written on the network, invalid addresses are parsed as hex as before.
+func (w *paymentWalletWithdrawWorker) parseAddress(address string) common.Address {
+	parsed, err := w.family.ParseAddress(address)
+	if err != nil {
+		return common.HexToAddress(address)
+	}
+	return parsed
+}
+
 // calculateRequiredGas calculates the required gas for a wallet withdrawal.
 func (w *paymentWalletWithdrawWorker) calculateRequiredGas(
 	ctx context.Context, address, receivingWalletAddress string, tokenAmount *big.Int, tokenAddress string,
 ) (*big.Int, error) {
 	// Step 1: Estimate the gas required for ERC20 token transfer
 	estimatedGas, err := w.ethClient.EstimateGasGeneric(
-		common.HexToAddress(tokenAddress),           // Contract address
-		common.HexToAddress(address),                // From address
-		erc20token.Erc20tokenMetaData.ABI,           // ERC-20 ABI (adjust if using a different standard)
-		"transfer",                                  // Method name (e.g., "transfer" for ERC-20)
-		common.HexToAddress(receivingWalletAddress), // To address
-		tokenAmount,                                 // Amount
+		w.parseAddress(tokenAddress),           // Contract address
+		w.parseAddress(address),                // From address
+		erc20token.Erc20tokenMetaData.ABI,      // ERC-20 ABI (adjust if using a different standard)
+		"transfer",                             // Method name (e.g., "transfer" for ERC-20)
+		w.parseAddress(receivingWalletAddress), // To address
+		tokenAmount,                            // Amount
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to estimate gas on network %s: %w", w.network, err)
@@ -0,0 +1,261 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+	clientmocks "github.com/genefriendway/onchain-handler/pkg/blockchain/client/mocks"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+)
+
+const (
+	testMnemonic               = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testReceivingWalletAddress = "0x4444444444444444444444444444444444444444"
+	testReceivingPrivateKey    = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testWithdrawTokenAddress   = "0x55d398326f99059fF775485246999027B3197955"
+	testRebalanceTxHash        = "0x5555555555555555555555555555555555555555555555555555555555555555"
+)
+
+func newTestWithdrawWorker(ctrl *gomock.Controller) (
+	*paymentWalletWithdrawWorker, *clientmocks.MockClient, *mocks.MockTokenTransferUCase,
+) {
+	ethClient := clientmocks.NewMockClient(ctrl)
+	tokenTransferUCase := mocks.NewMockTokenTransferUCase(ctrl)
+	return &paymentWalletWithdrawWorker{
+		ctx:                context.Background(),
+		ethClient:          ethClient,
+		network:            constants.Bsc,
+		family:             chain.ForNetwork(constants.Bsc),
+		chainID:            56,
+		tokenTransferUCase: tokenTransferUCase,
+		mnemonic:           testMnemonic,
+	}, ethClient, tokenTransferUCase
+}
+
+// tokens returns the amount of whole tokens of 18 decimals in the smallest unit.
+func tokens(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+}
+
+func walletAddressOf(t *testing.T, walletType constants.WalletType) string {
+	account, _, err := payment.GetNetworkWallet(constants.Bsc, walletType, testMnemonic, "", "")
+	require.NoError(t, err)
+	return chain.ForNetwork(constants.Bsc).FormatAddress(account.Address)
+}
+
+func TestTopUpHotWallet(t *testing.T) {
+	hotWalletAddress := walletAddressOf(t, constants.PayoutWallet)
+	txHash := common.HexToHash(testRebalanceTxHash)
+
+	t.Run("NoTargetLeavesBalance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, _, _ := newTestWithdrawWorker(ctrl)
+
+		left, err := w.topUpHotWallet(context.Background(), testReceivingWalletAddress, testReceivingPrivateKey,
+			18, testWithdrawTokenAddress, constants.USDT, tokens(50))
+		require.NoError(t, err)
+		require.Zero(t, left.Cmp(tokens(50)))
+	})
+
+	t.Run("HotWalletAtTarget", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, _ := newTestWithdrawWorker(ctrl)
+		w.hotWalletTargets = map[string]string{constants.USDT: "100"}
+		ethClient.EXPECT().GetTokenBalance(gomock.Any(), testWithdrawTokenAddress, hotWalletAddress).Return(tokens(100), nil)
+
+		left, err := w.topUpHotWallet(context.Background(), testReceivingWalletAddress, testReceivingPrivateKey,
+			18, testWithdrawTokenAddress, constants.USDT, tokens(50))
+		require.NoError(t, err)
+		require.Zero(t, left.Cmp(tokens(50)))
+	})
+
+	t.Run("TopsUpMissingBalance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, tokenTransferUCase := newTestWithdrawWorker(ctrl)
+		w.hotWalletTargets = map[string]string{constants.USDT: "100"}
+		ethClient.EXPECT().GetTokenBalance(gomock.Any(), testWithdrawTokenAddress, hotWalletAddress).Return(tokens(80), nil)
+		ethClient.EXPECT().
+			TransferToken(gomock.Any(), uint64(56), testWithdrawTokenAddress, testReceivingPrivateKey, hotWalletAddress, tokens(20)).
+			Return(txHash, uint64(21000), big.NewInt(1_000_000_000), uint64(1), nil)
+		tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), []dto.TokenTransferHistoryDTO{{
+			Network:         constants.Bsc.String(),
+			TransactionHash: txHash.Hex(),
+			FromAddress:     testReceivingWalletAddress,
+			ToAddress:       hotWalletAddress,
+			TokenAmount:     "20.000000000000000000",
+			Status:          true,
+			Symbol:          constants.USDT,
+			Fee:             "0.000021",
+			Type:            constants.Rebalance,
+		}}).Return(nil)
+
+		left, err := w.topUpHotWallet(context.Background(), testReceivingWalletAddress, testReceivingPrivateKey,
+			18, testWithdrawTokenAddress, constants.USDT, tokens(50))
+		require.NoError(t, err)
+		require.Zero(t, left.Cmp(tokens(30)), "the top up is not swept to the master wallet")
+	})
+
+	t.Run("TopUpLimitedToReceivingBalance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, tokenTransferUCase := newTestWithdrawWorker(ctrl)
+		w.hotWalletTargets = map[string]string{constants.USDT: "100"}
+		ethClient.EXPECT().GetTokenBalance(gomock.Any(), testWithdrawTokenAddress, hotWalletAddress).Return(tokens(10), nil)
+		ethClient.EXPECT().
+			TransferToken(gomock.Any(), uint64(56), testWithdrawTokenAddress, testReceivingPrivateKey, hotWalletAddress, tokens(50)).
+			Return(txHash, uint64(21000), big.NewInt(1_000_000_000), uint64(1), nil)
+		tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), gomock.Any()).Return(nil)
+
+		left, err := w.topUpHotWallet(context.Background(), testReceivingWalletAddress, testReceivingPrivateKey,
+			18, testWithdrawTokenAddress, constants.USDT, tokens(50))
+		require.NoError(t, err)
+		require.Zero(t, left.Sign())
+	})
+
+	t.Run("RevertedTopUpStopsSweep", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, tokenTransferUCase := newTestWithdrawWorker(ctrl)
+		w.hotWalletTargets = map[string]string{constants.USDT: "100"}
+		ethClient.EXPECT().GetTokenBalance(gomock.Any(), testWithdrawTokenAddress, hotWalletAddress).Return(tokens(80), nil)
+		ethClient.EXPECT().
+			TransferToken(gomock.Any(), uint64(56), testWithdrawTokenAddress, testReceivingPrivateKey, hotWalletAddress, tokens(20)).
+			Return(txHash, uint64(21000), big.NewInt(1_000_000_000), uint64(0), nil)
+		tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, payloads []dto.TokenTransferHistoryDTO) error {
+				require.Len(t, payloads, 1)
+				require.Equal(t, constants.Rebalance, payloads[0].Type)
+				require.False(t, payloads[0].Status)
+				require.Equal(t, "execution reverted", payloads[0].ErrorMessage)
+				return nil
+			})
+
+		left, err := w.topUpHotWallet(context.Background(), testReceivingWalletAddress, testReceivingPrivateKey,
+			18, testWithdrawTokenAddress, constants.USDT, tokens(50))
+		require.Error(t, err)
+		require.Nil(t, left)
+	})
+}
+
+func TestRefillReceivingWalletGas(t *testing.T) {
+	gasSourceAddress := walletAddressOf(t, constants.GasSourceWallet)
+	txHash := common.HexToHash(testRebalanceTxHash)
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, _, _ := newTestWithdrawWorker(ctrl)
+
+		require.NoError(t, w.refillReceivingWalletGas(context.Background(), testReceivingWalletAddress, "BNB"))
+	})
+
+	t.Run("TargetNotAboveFloor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, _, _ := newTestWithdrawWorker(ctrl)
+		w.receivingGasFloor, w.receivingGasTarget = "1", "1"
+
+		require.Error(t, w.refillReceivingWalletGas(context.Background(), testReceivingWalletAddress, "BNB"))
+	})
+
+	t.Run("BalanceAtFloor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, _ := newTestWithdrawWorker(ctrl)
+		w.receivingGasFloor, w.receivingGasTarget = "1", "3"
+		ethClient.EXPECT().GetNativeTokenBalance(gomock.Any(), testReceivingWalletAddress).Return(tokens(1), nil)
+
+		require.NoError(t, w.refillReceivingWalletGas(context.Background(), testReceivingWalletAddress, "BNB"))
+	})
+
+	t.Run("RefillsUpToTarget", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, tokenTransferUCase := newTestWithdrawWorker(ctrl)
+		w.receivingGasFloor, w.receivingGasTarget = "2", "3"
+		ethClient.EXPECT().GetNativeTokenBalance(gomock.Any(), testReceivingWalletAddress).Return(tokens(1), nil)
+		ethClient.EXPECT().
+			TransferNativeToken(gomock.Any(), uint64(56), gomock.Any(), testReceivingWalletAddress, tokens(2)).
+			Return(txHash, uint64(21000), big.NewInt(1_000_000_000), nil)
+		ethClient.EXPECT().WaitForReceipt(gomock.Any(), txHash).Return(uint64(1), nil)
+		tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), []dto.TokenTransferHistoryDTO{{
+			Network:         constants.Bsc.String(),
+			TransactionHash: txHash.Hex(),
+			FromAddress:     gasSourceAddress,
+			ToAddress:       testReceivingWalletAddress,
+			TokenAmount:     "2.000000000000000000",
+			Status:          true,
+			Symbol:          "BNB",
+			Fee:             "0.000021",
+			Type:            constants.Rebalance,
+		}}).Return(nil)
+
+		require.NoError(t, w.refillReceivingWalletGas(context.Background(), testReceivingWalletAddress, "BNB"))
+	})
+
+	t.Run("RevertedRefillIsRecordedAsFailed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, tokenTransferUCase := newTestWithdrawWorker(ctrl)
+		w.receivingGasFloor, w.receivingGasTarget = "2", "3"
+		ethClient.EXPECT().GetNativeTokenBalance(gomock.Any(), testReceivingWalletAddress).Return(tokens(1), nil)
+		ethClient.EXPECT().
+			TransferNativeToken(gomock.Any(), uint64(56), gomock.Any(), testReceivingWalletAddress, tokens(2)).
+			Return(txHash, uint64(21000), big.NewInt(1_000_000_000), nil)
+		ethClient.EXPECT().WaitForReceipt(gomock.Any(), txHash).Return(uint64(0), nil)
+		tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, payloads []dto.TokenTransferHistoryDTO) error {
+				require.Len(t, payloads, 1)
+				require.Equal(t, constants.Rebalance, payloads[0].Type)
+				require.False(t, payloads[0].Status)
+				require.Equal(t, "execution reverted", payloads[0].ErrorMessage)
+				return nil
+			})
+
+		require.Error(t, w.refillReceivingWalletGas(context.Background(), testReceivingWalletAddress, "BNB"))
+	})
+
+	t.Run("UnknownReceiptIsRecordedAsFailed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, ethClient, tokenTransferUCase := newTestWithdrawWorker(ctrl)
+		w.receivingGasFloor, w.receivingGasTarget = "2", "3"
+		ethClient.EXPECT().GetNativeTokenBalance(gomock.Any(), testReceivingWalletAddress).Return(tokens(1), nil)
+		ethClient.EXPECT().
+			TransferNativeToken(gomock.Any(), uint64(56), gomock.Any(), testReceivingWalletAddress, tokens(2)).
+			Return(txHash, uint64(21000), big.NewInt(1_000_000_000), nil)
+		ethClient.EXPECT().WaitForReceipt(gomock.Any(), txHash).Return(uint64(0), errors.New("context deadline exceeded"))
+		tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, payloads []dto.TokenTransferHistoryDTO) error {
+				require.False(t, payloads[0].Status)
+				require.Equal(t, "context deadline exceeded", payloads[0].ErrorMessage)
+				return nil
+			})
+
+		require.Error(t, w.refillReceivingWalletGas(context.Background(), testReceivingWalletAddress, "BNB"))
+	})
+}
@@ -0,0 +1,186 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	workertypes "github.com/genefriendway/onchain-handler/internal/workers/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain"
+	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
+	"github.com/genefriendway/onchain-handler/pkg/crypto"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// payoutWorker sends the approved payouts of a network from the payout wallet, one transfer per payout.
+type payoutWorker struct {
+	ethClient          clienttypes.Client
+	network            constants.NetworkType
+	chainID            uint64
+	cacheRepo          cachetypes.CacheRepository
+	payoutUCase        ucasetypes.PayoutUCase
+	tokenTransferUCase ucasetypes.TokenTransferUCase
+	mnemonic           string
+	passphrase         string
+	salt               string
+	isRunning          bool
+	mu                 sync.Mutex
+}
+
+func NewPayoutWorker(
+	ethClient clienttypes.Client,
+	network constants.NetworkType,
+	chainID uint64,
+	cacheRepo cachetypes.CacheRepository,
+	payoutUCase ucasetypes.PayoutUCase,
+	tokenTransferUCase ucasetypes.TokenTransferUCase,
+	mnemonic, passphrase, salt string,
+) workertypes.Worker {
+	return &payoutWorker{
+		ethClient:          ethClient,
+		network:            network,
+		chainID:            chainID,
+		cacheRepo:          cacheRepo,
+		payoutUCase:        payoutUCase,
+		tokenTransferUCase: tokenTransferUCase,
+		mnemonic:           mnemonic,
+		passphrase:         passphrase,
+		salt:               salt,
+	}
+}
+
+func (w *payoutWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(constants.PayoutExecutionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			go w.run(ctx)
+		case <-ctx.Done():
+			logger.GetLogger().Infof("Shutting down payoutWorker on network %s", w.network)
+			return
+		}
+	}
+}
+
+func (w *payoutWorker) run(ctx context.Context) {
+	w.mu.Lock()
+	if w.isRunning {
+		logger.GetLogger().Warnf("Previous payoutWorker on network %s run still in progress, skipping this cycle", w.network)
+		w.mu.Unlock()
+		return
+	}
+	w.isRunning = true
+	w.mu.Unlock()
+
+	defer func() {
+		w.mu.Lock()
+		w.isRunning = false
+		w.mu.Unlock()
+	}()
+
+	if err := w.sendApprovedPayouts(ctx); err != nil {
+		logger.GetLogger().Errorf("Failed to send approved payouts on network %s: %v", w.network, err)
+	}
+}
+
+func (w *payoutWorker) sendApprovedPayouts(ctx context.Context) error {
+	// Step 1: Get the payout wallet (address and private key)
+	account, privKey, err := payment.GetPayoutWallet(w.mnemonic, w.passphrase, w.salt)
+	if err != nil {
+		return err
+	}
+	payoutAddr := account.Address.Hex()
+	payoutPrivKey, err := crypto.PrivateKeyToHex(privKey)
+	if err != nil {
+		return fmt.Errorf("failed to convert private key to hex: %w", err)
+	}
+
+	// Step 2: Claim the approved payouts, a claimed payout is never sent twice
+	payouts, err := w.payoutUCase.ClaimApprovedPayouts(ctx, w.network.String(), constants.PayoutBatchSize)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Send them one by one, the transfer waits for its receipt so the nonces do not collide
+	for _, payout := range payouts {
+		status, txHash, fee, errorMessage := w.sendPayout(ctx, payout, payoutAddr, payoutPrivKey)
+		if err := w.payoutUCase.CompletePayout(ctx, payout.ID, status, txHash, fee, errorMessage); err != nil {
+			logger.GetLogger().Errorf(
+				"Failed to complete payout %s with status %s, transaction hash %q: %v", payout.RequestID, status, txHash, err,
+			)
+		}
+	}
+	return nil
+}
+
+// sendPayout transfers the payout amount and returns its outcome. The status is PROCESSING when the transaction
+// is sent but its receipt cannot be retrieved, the payout is then left for an operator to check.
+func (w *payoutWorker) sendPayout(
+	ctx context.Context,
+	payout dto.PayoutDTO,
+	payoutAddr, payoutPrivKey string,
+) (status, txHash, fee, errorMessage string) {
+	tokenAddr, err := conf.GetTokenAddress(payout.Symbol, payout.Network)
+	if err != nil {
+		return constants.OutboundFailed, "", "", err.Error()
+	}
+	decimals, err := blockchain.GetTokenDecimalsFromCache(tokenAddr, payout.Network, w.cacheRepo)
+	if err != nil {
+		return constants.OutboundFailed, "", "", fmt.Sprintf("failed to get token decimals: %v", err)
+	}
+	amount, err := utils.ConvertFloatTokenToSmallestUnit(payout.Amount, decimals)
+	if err != nil {
+		return constants.OutboundFailed, "", "", fmt.Sprintf("invalid amount %s: %v", payout.Amount, err)
+	}
+
+	hash, gasUsed, gasPrice, receiptStatus, err := w.ethClient.TransferToken(
+		ctx, w.chainID, tokenAddr, payoutPrivKey, payout.ToAddress, amount,
+	)
+	if err != nil {
+		return constants.OutboundFailed, "", "", err.Error()
+	}
+	txHash = hash.Hex()
+
+	// The receipt could not be retrieved, the transaction may still be mined
+	if gasUsed == 0 {
+		logger.GetLogger().Errorf("Payout %s sent in transaction %s on network %s, but its receipt is unavailable", payout.RequestID, txHash, w.network)
+		return constants.OutboundProcessing, txHash, "", "transaction receipt unavailable"
+	}
+
+	fee = utils.CalculateFee(gasUsed, gasPrice)
+	status = constants.OutboundSuccess
+	if receiptStatus != 1 {
+		status = constants.OutboundFailed
+		errorMessage = "execution reverted"
+	}
+
+	// Persist the transfer history
+	history := dto.TokenTransferHistoryDTO{
+		RequestID:       payout.RequestID,
+		Network:         payout.Network,
+		TransactionHash: txHash,
+		FromAddress:     payoutAddr,
+		ToAddress:       payout.ToAddress,
+		TokenAmount:     payout.Amount,
+		Fee:             fee,
+		Symbol:          payout.Symbol,
+		Status:          status == constants.OutboundSuccess,
+		Type:            constants.Transfer,
+		ErrorMessage:    errorMessage,
+	}
+	if err := w.tokenTransferUCase.CreateTokenTransferHistories(ctx, []dto.TokenTransferHistoryDTO{history}); err != nil {
+		logger.GetLogger().Errorf("Failed to create token transfer history for payout %s: %v", payout.RequestID, err)
+	}
+
+	return status, txHash, fee, errorMessage
+}
@@ -0,0 +1,209 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/genefriendway/onchain-handler/conf"
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/internal/adapters/cache"
+	cachetypes "github.com/genefriendway/onchain-handler/internal/adapters/cache/types"
+	"github.com/genefriendway/onchain-handler/internal/delivery/dto"
+	"github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+	clientmocks "github.com/genefriendway/onchain-handler/pkg/blockchain/client/mocks"
+	"github.com/genefriendway/onchain-handler/pkg/payment"
+)
+
+const (
+	testPayoutTxHash    = "0x6666666666666666666666666666666666666666666666666666666666666666"
+	testPayoutToAddress = "0x7777777777777777777777777777777777777777"
+)
+
+type payoutWorkerMocks struct {
+	ethClient          *clientmocks.MockClient
+	payoutUCase        *mocks.MockPayoutUCase
+	tokenTransferUCase *mocks.MockTokenTransferUCase
+	cacheRepo          cachetypes.CacheRepository
+}
+
+func newTestPayoutWorker(ctrl *gomock.Controller) (*payoutWorker, payoutWorkerMocks) {
+	m := payoutWorkerMocks{
+		ethClient:          clientmocks.NewMockClient(ctrl),
+		payoutUCase:        mocks.NewMockPayoutUCase(ctrl),
+		tokenTransferUCase: mocks.NewMockTokenTransferUCase(ctrl),
+		cacheRepo:          cache.NewCachingRepository(context.Background(), cache.NewGoCacheClient()),
+	}
+	return NewPayoutWorker(
+		m.ethClient, constants.Bsc, 56, m.cacheRepo, m.payoutUCase, m.tokenTransferUCase, testMnemonic, "", "",
+	).(*payoutWorker), m
+}
+
+// cacheTokenDecimals caches the decimals of the USDT token of the network, as the token metadata worker does.
+func cacheTokenDecimals(t *testing.T, cacheRepo cachetypes.CacheRepository) string {
+	tokenAddr, err := conf.GetTokenAddress(constants.USDT, constants.Bsc.String())
+	require.NoError(t, err)
+	key := &cachetypes.Keyer{Raw: constants.TokenDecimals + constants.Bsc.String() + tokenAddr}
+	require.NoError(t, cacheRepo.SaveItem(key, uint8(18), time.Hour))
+	return tokenAddr
+}
+
+func testPayoutDTO(id uint64) dto.PayoutDTO {
+	return dto.PayoutDTO{
+		ID:        id,
+		RequestID: "payout-1",
+		ToAddress: testPayoutToAddress,
+		Amount:    "10",
+		Symbol:    constants.USDT,
+		Network:   constants.Bsc.String(),
+		Status:    constants.OutboundProcessing,
+	}
+}
+
+func TestSendApprovedPayouts(t *testing.T) {
+	account, _, err := payment.GetPayoutWallet(testMnemonic, "", "")
+	require.NoError(t, err)
+	payoutAddress := account.Address.Hex()
+	txHash := common.HexToHash(testPayoutTxHash)
+	gasPrice := big.NewInt(1_000_000_000)
+
+	t.Run("SuccessfulPayout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, m := newTestPayoutWorker(ctrl)
+		tokenAddr := cacheTokenDecimals(t, m.cacheRepo)
+		m.payoutUCase.EXPECT().ClaimApprovedPayouts(gomock.Any(), constants.Bsc.String(), constants.PayoutBatchSize).
+			Return([]dto.PayoutDTO{testPayoutDTO(1)}, nil)
+		m.ethClient.EXPECT().TransferToken(gomock.Any(), uint64(56), tokenAddr, gomock.Any(), testPayoutToAddress, tokens(10)).
+			Return(txHash, uint64(50_000), gasPrice, uint64(1), nil)
+		m.tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, histories []dto.TokenTransferHistoryDTO) error {
+				require.Len(t, histories, 1)
+				require.Equal(t, payoutAddress, histories[0].FromAddress)
+				require.Equal(t, testPayoutToAddress, histories[0].ToAddress)
+				require.Equal(t, "payout-1", histories[0].RequestID)
+				require.True(t, histories[0].Status)
+				return nil
+			})
+		m.payoutUCase.EXPECT().CompletePayout(gomock.Any(), uint64(1), constants.OutboundSuccess, txHash.Hex(), "0.000050", "").
+			Return(nil)
+
+		require.NoError(t, w.sendApprovedPayouts(context.Background()))
+	})
+
+	t.Run("RevertedPayout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, m := newTestPayoutWorker(ctrl)
+		cacheTokenDecimals(t, m.cacheRepo)
+		m.payoutUCase.EXPECT().ClaimApprovedPayouts(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]dto.PayoutDTO{testPayoutDTO(1)}, nil)
+		m.ethClient.EXPECT().TransferToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(txHash, uint64(50_000), gasPrice, uint64(0), nil)
+		m.tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, histories []dto.TokenTransferHistoryDTO) error {
+				require.False(t, histories[0].Status)
+				require.Equal(t, "execution reverted", histories[0].ErrorMessage)
+				return nil
+			})
+
+		// The gas of the reverted transaction is still paid
+		m.payoutUCase.EXPECT().
+			CompletePayout(gomock.Any(), uint64(1), constants.OutboundFailed, txHash.Hex(), "0.000050", "execution reverted").
+			Return(nil)
+
+		require.NoError(t, w.sendApprovedPayouts(context.Background()))
+	})
+
+	t.Run("ReceiptUnavailableKeepsPayoutProcessing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, m := newTestPayoutWorker(ctrl)
+		cacheTokenDecimals(t, m.cacheRepo)
+		m.payoutUCase.EXPECT().ClaimApprovedPayouts(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]dto.PayoutDTO{testPayoutDTO(1)}, nil)
+		m.ethClient.EXPECT().TransferToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(txHash, uint64(0), nil, uint64(0), nil)
+
+		// No transfer history is written until the outcome is known
+		m.payoutUCase.EXPECT().
+			CompletePayout(gomock.Any(), uint64(1), constants.OutboundProcessing, txHash.Hex(), "", "transaction receipt unavailable").
+			Return(nil)
+
+		require.NoError(t, w.sendApprovedPayouts(context.Background()))
+	})
+
+	t.Run("TransferNotSent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, m := newTestPayoutWorker(ctrl)
+		cacheTokenDecimals(t, m.cacheRepo)
+		m.payoutUCase.EXPECT().ClaimApprovedPayouts(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]dto.PayoutDTO{testPayoutDTO(1)}, nil)
+		m.ethClient.EXPECT().TransferToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(common.Hash{}, uint64(0), nil, uint64(0), errors.New("insufficient funds for gas"))
+		m.payoutUCase.EXPECT().
+			CompletePayout(gomock.Any(), uint64(1), constants.OutboundFailed, "", "", "insufficient funds for gas").
+			Return(nil)
+
+		require.NoError(t, w.sendApprovedPayouts(context.Background()))
+	})
+
+	t.Run("UnknownTokenDecimals", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, m := newTestPayoutWorker(ctrl)
+		m.payoutUCase.EXPECT().ClaimApprovedPayouts(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]dto.PayoutDTO{testPayoutDTO(1)}, nil)
+
+		// The payout fails without sending anything
+		m.payoutUCase.EXPECT().CompletePayout(gomock.Any(), uint64(1), constants.OutboundFailed, "", "", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint64, _, _, _, errorMessage string) error {
+				require.Contains(t, errorMessage, "failed to get token decimals")
+				return nil
+			})
+
+		require.NoError(t, w.sendApprovedPayouts(context.Background()))
+	})
+
+	t.Run("CompletionFailureDoesNotStopBatch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, m := newTestPayoutWorker(ctrl)
+		cacheTokenDecimals(t, m.cacheRepo)
+		m.payoutUCase.EXPECT().ClaimApprovedPayouts(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]dto.PayoutDTO{testPayoutDTO(1), testPayoutDTO(2)}, nil)
+		m.ethClient.EXPECT().TransferToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(txHash, uint64(50_000), gasPrice, uint64(1), nil).Times(2)
+		m.tokenTransferUCase.EXPECT().CreateTokenTransferHistories(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		m.payoutUCase.EXPECT().CompletePayout(gomock.Any(), uint64(1), constants.OutboundSuccess, gomock.Any(), gomock.Any(), "").
+			Return(errors.New("payout 1 is no longer processing"))
+		m.payoutUCase.EXPECT().CompletePayout(gomock.Any(), uint64(2), constants.OutboundSuccess, gomock.Any(), gomock.Any(), "").
+			Return(nil)
+
+		require.NoError(t, w.sendApprovedPayouts(context.Background()))
+	})
+
+	t.Run("ClaimFails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		w, m := newTestPayoutWorker(ctrl)
+		m.payoutUCase.EXPECT().ClaimApprovedPayouts(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("database unavailable"))
+
+		require.ErrorContains(t, w.sendApprovedPayouts(context.Background()), "database unavailable")
+	})
+}
@@ -0,0 +1,67 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	workertypes "github.com/genefriendway/onchain-handler/internal/workers/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// subscriptionBillingWorker tracks the orders of the billed cycles and creates the orders of the due ones.
+type subscriptionBillingWorker struct {
+	subscriptionUCase ucasetypes.SubscriptionUCase
+	isRunning         bool
+	mu                sync.Mutex
+}
+
+func NewSubscriptionBillingWorker(subscriptionUCase ucasetypes.SubscriptionUCase) workertypes.Worker {
+	return &subscriptionBillingWorker{
+		subscriptionUCase: subscriptionUCase,
+	}
+}
+
+func (w *subscriptionBillingWorker) Start(ctx context.Context) {
+	// The orders changed by the worker are audited under its name
+	ctx = utils.WithAuditActor(ctx, utils.AuditActor(constants.AuditActorWorker, "subscription_billing"))
+
+	ticker := time.NewTicker(constants.SubscriptionBillingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			go w.run(ctx)
+		case <-ctx.Done():
+			logger.GetLogger().Info("Shutting down subscriptionBillingWorker")
+			return
+		}
+	}
+}
+
+func (w *subscriptionBillingWorker) run(ctx context.Context) {
+	w.mu.Lock()
+	if w.isRunning {
+		logger.GetLogger().Warn("Previous subscriptionBillingWorker run still in progress, skipping this cycle")
+		w.mu.Unlock()
+		return
+	}
+	w.isRunning = true
+	w.mu.Unlock()
+
+	// Track first so that a cycle paid in this run schedules the next one before billing
+	if err := w.subscriptionUCase.TrackBillingSubscriptions(ctx); err != nil {
+		logger.GetLogger().Errorf("Failed to track billing subscriptions: %v", err)
+	}
+	if err := w.subscriptionUCase.BillDueSubscriptions(ctx); err != nil {
+		logger.GetLogger().Errorf("Failed to bill due subscriptions: %v", err)
+	}
+
+	w.mu.Lock()
+	w.isRunning = false
+	w.mu.Unlock()
+}
@@ -0,0 +1,48 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/genefriendway/onchain-handler/internal/domain/ucases/mocks"
+)
+
+func TestSubscriptionBillingWorkerRun(t *testing.T) {
+	t.Run("TracksBeforeBilling", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// A cycle paid since the last run schedules the next one before the due subscriptions are billed
+		subscriptionUCase := mocks.NewMockSubscriptionUCase(ctrl)
+		gomock.InOrder(
+			subscriptionUCase.EXPECT().TrackBillingSubscriptions(gomock.Any()).Return(nil),
+			subscriptionUCase.EXPECT().BillDueSubscriptions(gomock.Any()).Return(nil),
+		)
+
+		NewSubscriptionBillingWorker(subscriptionUCase).(*subscriptionBillingWorker).run(context.Background())
+	})
+
+	t.Run("BillsWhenTrackingFails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptionUCase := mocks.NewMockSubscriptionUCase(ctrl)
+		subscriptionUCase.EXPECT().TrackBillingSubscriptions(gomock.Any()).Return(errors.New("database unavailable"))
+		subscriptionUCase.EXPECT().BillDueSubscriptions(gomock.Any()).Return(nil)
+
+		NewSubscriptionBillingWorker(subscriptionUCase).(*subscriptionBillingWorker).run(context.Background())
+	})
+
+	t.Run("SkipsWhileRunning", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// The subscriptions are neither tracked nor billed twice at the same time
+		w := NewSubscriptionBillingWorker(mocks.NewMockSubscriptionUCase(ctrl)).(*subscriptionBillingWorker)
+		w.isRunning = true
+		w.run(context.Background())
+	})
+}
@@ -13,6 +13,8 @@ func GetConfirmationDepth(network constants.NetworkType) (uint64, error) {
 		return constants.ConfirmationDepthBSC, nil
 	case constants.AvaxCChain.String():
 		return constants.ConfirmationDepthAVAX, nil
+	case constants.Tron.String():
+		return constants.ConfirmationDepthTron, nil
 	default:
 		return 0, fmt.Errorf("unsupported network: %s", network)
 	}
@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+// Family describes how the accounts of a chain family are derived and how their addresses are written.
+// Every family shares the secp256k1 keys and the 20 bytes account of the EVM, so the services handle
+// addresses as common.Address and only format them for the family at the edges.
+type Family interface {
+	// Name returns the name of the chain family
+	Name() string
+	// CoinType returns the BIP-44 coin type the accounts of the family are derived with
+	CoinType() uint32
+	// FormatAddress returns the address as written on the chain
+	FormatAddress(address common.Address) string
+	// ParseAddress parses an address written on the chain or as hex
+	ParseAddress(address string) (common.Address, error)
+	// IsValidAddress reports whether the address can be parsed
+	IsValidAddress(address string) bool
+}
+
+var (
+	// EVM is the family of the EVM compatible networks
+	EVM Family = evmFamily{}
+	// Tron is the family of the Tron network
+	Tron Family = tronFamily{}
+)
+
+// ForNetwork returns the chain family of the network, networks are EVM compatible unless stated otherwise.
+func ForNetwork(network constants.NetworkType) Family {
+	if network == constants.Tron {
+		return Tron
+	}
+	return EVM
+}
+
+// NormalizeAddress returns the checksummed hex form of a Tron address, and any other address as is.
+// It gives the addresses of every family a single form to be matched with the logs.
+func NormalizeAddress(address string) string {
+	if !isTronBase58Address(address) {
+		return address
+	}
+	parsed, err := Tron.ParseAddress(address)
+	if err != nil {
+		return address
+	}
+	return parsed.Hex()
+}
+
+type evmFamily struct{}
+
+func (evmFamily) Name() string {
+	return "EVM"
+}
+
+func (evmFamily) CoinType() uint32 {
+	return constants.EVMCoinType
+}
+
+func (evmFamily) FormatAddress(address common.Address) string {
+	return address.Hex()
+}
+
+func (evmFamily) ParseAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, fmt.Errorf("invalid address: %s", address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+func (evmFamily) IsValidAddress(address string) bool {
+	return common.IsHexAddress(address)
+}
@@ -0,0 +1,65 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+// USDT contract on the Tron mainnet
+const (
+	tronUSDTBase58 = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	tronUSDTHex    = "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c"
+)
+
+func TestTronAddress(t *testing.T) {
+	t.Run("FormatsBase58", func(t *testing.T) {
+		require.Equal(t, tronUSDTBase58, Tron.FormatAddress(common.HexToAddress(tronUSDTHex)))
+	})
+
+	t.Run("ParsesEveryForm", func(t *testing.T) {
+		for _, address := range []string{tronUSDTBase58, "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", tronUSDTHex} {
+			parsed, err := Tron.ParseAddress(address)
+			require.NoError(t, err, address)
+			require.Equal(t, common.HexToAddress(tronUSDTHex), parsed)
+		}
+	})
+
+	t.Run("RejectsBadChecksum", func(t *testing.T) {
+		_, err := Tron.ParseAddress("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u")
+		require.Error(t, err)
+		require.False(t, Tron.IsValidAddress("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u"))
+	})
+
+	t.Run("RejectsInvalidCharacters", func(t *testing.T) {
+		require.False(t, Tron.IsValidAddress("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj0l"))
+	})
+
+	t.Run("RoundTrips", func(t *testing.T) {
+		for _, address := range []common.Address{{}, common.HexToAddress("0x00000000000000000000000000000000000000ff")} {
+			parsed, err := Tron.ParseAddress(Tron.FormatAddress(address))
+			require.NoError(t, err)
+			require.Equal(t, address, parsed)
+		}
+	})
+
+	t.Run("HexAddress", func(t *testing.T) {
+		require.Equal(t, "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", HexAddress(common.HexToAddress(tronUSDTHex)))
+	})
+}
+
+func TestForNetwork(t *testing.T) {
+	require.Equal(t, Tron, ForNetwork(constants.Tron))
+	require.Equal(t, EVM, ForNetwork(constants.Bsc))
+	require.Equal(t, uint32(195), ForNetwork(constants.Tron).CoinType())
+	require.Equal(t, uint32(60), ForNetwork(constants.AvaxCChain).CoinType())
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	require.Equal(t, common.HexToAddress(tronUSDTHex).Hex(), NormalizeAddress(tronUSDTBase58))
+	require.Equal(t, tronUSDTHex, NormalizeAddress(tronUSDTHex))
+	require.Equal(t, "temp-abc", NormalizeAddress("temp-abc"))
+}
@@ -0,0 +1,140 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+const (
+	tronAddressPrefix      = 0x41 // Version byte of the Tron mainnet addresses
+	tronBase58AddressSize  = 34
+	base58Alphabet         = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base58CheckSumByteSize = 4
+)
+
+type tronFamily struct{}
+
+func (tronFamily) Name() string {
+	return "TRON"
+}
+
+func (tronFamily) CoinType() uint32 {
+	return constants.TronCoinType
+}
+
+// FormatAddress returns the base58check address, e.g. TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t
+func (tronFamily) FormatAddress(address common.Address) string {
+	payload := append([]byte{tronAddressPrefix}, address.Bytes()...)
+	return base58Encode(append(payload, checksum(payload)...))
+}
+
+// ParseAddress parses a base58check address, a hex address with the 41 version byte or an EVM hex address
+func (tronFamily) ParseAddress(address string) (common.Address, error) {
+	address = strings.TrimSpace(address)
+
+	if isTronBase58Address(address) {
+		decoded, err := base58Decode(address)
+		if err != nil {
+			return common.Address{}, fmt.Errorf("invalid Tron address %s: %w", address, err)
+		}
+		payload, sum := decoded[:len(decoded)-base58CheckSumByteSize], decoded[len(decoded)-base58CheckSumByteSize:]
+		if len(payload) != common.AddressLength+1 || payload[0] != tronAddressPrefix {
+			return common.Address{}, fmt.Errorf("invalid Tron address %s: unexpected version or length", address)
+		}
+		if !bytes.Equal(sum, checksum(payload)) {
+			return common.Address{}, fmt.Errorf("invalid Tron address %s: checksum mismatch", address)
+		}
+		return common.BytesToAddress(payload[1:]), nil
+	}
+
+	if len(address) == 2*(common.AddressLength+1) && strings.HasPrefix(address, "41") {
+		decoded, err := hex.DecodeString(address)
+		if err != nil {
+			return common.Address{}, fmt.Errorf("invalid Tron address %s: %w", address, err)
+		}
+		return common.BytesToAddress(decoded[1:]), nil
+	}
+
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address), nil
+	}
+	return common.Address{}, fmt.Errorf("invalid Tron address: %s", address)
+}
+
+func (f tronFamily) IsValidAddress(address string) bool {
+	_, err := f.ParseAddress(address)
+	return err == nil
+}
+
+// HexAddress returns the hex address with the 41 version byte the Tron HTTP API expects.
+func HexAddress(address common.Address) string {
+	return hex.EncodeToString(append([]byte{tronAddressPrefix}, address.Bytes()...))
+}
+
+func isTronBase58Address(address string) bool {
+	return len(address) == tronBase58AddressSize && address[0] == 'T'
+}
+
+func checksum(payload []byte) []byte {
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return second[:base58CheckSumByteSize]
+}
+
+func base58Encode(input []byte) string {
+	number := new(big.Int).SetBytes(input)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+	mod := new(big.Int)
+
+	var encoded []byte
+	for number.Sign() > 0 {
+		number.DivMod(number, radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	// Leading zero bytes are written as the first character of the alphabet
+	for _, b := range input {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
+
+func base58Decode(input string) ([]byte, error) {
+	number := new(big.Int)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+
+	for _, r := range input {
+		index := strings.IndexRune(base58Alphabet, r)
+		if index < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", r)
+		}
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(index)))
+	}
+
+	decoded := number.Bytes()
+	for _, r := range input {
+		if r != rune(base58Alphabet[0]) {
+			break
+		}
+		decoded = append([]byte{0}, decoded...)
+	}
+	if len(decoded) <= base58CheckSumByteSize {
+		return nil, fmt.Errorf("base58 input is too short")
+	}
+	return decoded, nil
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -32,21 +33,29 @@ type roundRobinClient struct {
 	mu             sync.Mutex
 	failureTracker map[int]time.Time // Tracks failed clients and their cooldown periods
 	cooldown       time.Duration     // Cooldown period for retrying a failed client
+	logRanges      []*logRange       // Adaptive eth_getLogs block range per client
 }
 
 // NewRoundRobinClient creates a new RoundRobinClient
-func NewRoundRobinClient(rpcEndpoints []string) (clienttypes.Client, error) {
+func NewRoundRobinClient(rpcEndpoints []string, logRangeLimits LogRangeLimits) (clienttypes.Client, error) {
 	if len(rpcEndpoints) == 0 {
 		return nil, fmt.Errorf("no RPC endpoints provided")
 	}
 
 	clients := make([]*ethclient.Client, len(rpcEndpoints))
+	logRanges := make([]*logRange, len(rpcEndpoints))
 	for i, endpoint := range rpcEndpoints {
 		client, err := ethclient.Dial(endpoint)
 		if err != nil {
 			return nil, err
 		}
 		clients[i] = client
+
+		maxRange := logRangeLimits.MaxRange
+		if i < len(logRangeLimits.EndpointMaxRanges) && logRangeLimits.EndpointMaxRanges[i] > 0 {
+			maxRange = logRangeLimits.EndpointMaxRanges[i]
+		}
+		logRanges[i] = newLogRange(min(logRangeLimits.MinRange, maxRange), maxRange)
 	}
 
 	return &roundRobinClient{
@@ -55,6 +64,7 @@ func NewRoundRobinClient(rpcEndpoints []string) (clienttypes.Client, error) {
 		counter:        0,
 		failureTracker: make(map[int]time.Time),
 		cooldown:       constants.EthClientCooldown,
+		logRanges:      logRanges,
 	}, nil
 }
 
@@ -231,6 +241,68 @@ func (c *roundRobinClient) PollForLogsFromBlock(
 	return result.([]types.Log), nil
 }
 
+// logRangeOf returns the adaptive block range of the given client
+func (c *roundRobinClient) logRangeOf(client *ethclient.Client) *logRange {
+	for i := range c.clients {
+		if c.clients[i] == client {
+			return c.logRanges[i]
+		}
+	}
+	return c.logRanges[len(c.logRanges)-1]
+}
+
+// PollForLogsUpTo polls logs from fromBlock onwards, covering as many blocks up to maxEndBlock as the
+// adaptive range of the selected endpoint allows. It returns the logs and the last block covered.
+func (c *roundRobinClient) PollForLogsUpTo(
+	ctx context.Context,
+	contractAddresses []common.Address,
+	fromBlock uint64,
+	maxEndBlock uint64,
+) ([]types.Log, uint64, error) {
+	if fromBlock > maxEndBlock {
+		return nil, 0, fmt.Errorf("invalid block range: from block %d is beyond end block %d", fromBlock, maxEndBlock)
+	}
+
+	type logsResult struct {
+		logs     []types.Log
+		endBlock uint64
+	}
+
+	result, err := c.executeWithRetry(func(client *ethclient.Client) (any, error) {
+		blockRange := c.logRangeOf(client)
+
+		// Shrink and retry right away on range errors, they are not failures of the endpoint
+		for {
+			endBlock := min(fromBlock+blockRange.current()-1, maxEndBlock)
+			requested := endBlock - fromBlock + 1
+			query := ethereum.FilterQuery{
+				Addresses: contractAddresses,
+				FromBlock: new(big.Int).SetUint64(fromBlock),
+				ToBlock:   new(big.Int).SetUint64(endBlock),
+			}
+
+			start := time.Now()
+			logs, err := client.FilterLogs(ctx, query)
+			if err == nil {
+				blockRange.observe(requested, len(logs), time.Since(start))
+				return logsResult{logs: logs, endBlock: endBlock}, nil
+			}
+			if requested == 1 || !isLogRangeError(err) {
+				return nil, fmt.Errorf("failed to poll logs from block %d to %d: %w", fromBlock, endBlock, err)
+			}
+
+			blockRange.shrink(requested, parseSuggestedLogRange(err.Error()))
+			logger.GetLogger().Debugf("Block range of %d rejected by RPC endpoint, retrying with %d blocks: %v", requested, blockRange.current(), err)
+		}
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs := result.(logsResult)
+	return logs.logs, logs.endBlock, nil
+}
+
 // GetLatestBlockNumber retrieves the latest block number using round-robin
 func (c *roundRobinClient) GetLatestBlockNumber(ctx context.Context) (*big.Int, error) {
 	// Use executeWithRetry to perform the operation
@@ -249,6 +321,21 @@ func (c *roundRobinClient) GetLatestBlockNumber(ctx context.Context) (*big.Int,
 	return result.(*big.Int), nil
 }
 
+// GetBlockTime retrieves the timestamp of the given block using round-robin
+func (c *roundRobinClient) GetBlockTime(ctx context.Context, blockNumber uint64) (time.Time, error) {
+	result, err := c.executeWithRetry(func(client *ethclient.Client) (any, error) {
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch the header of block %d: %w", blockNumber, err)
+		}
+		return time.Unix(int64(header.Time), 0).UTC(), nil
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return result.(time.Time), nil
+}
+
 // GetTokenDecimals retrieves the decimal precision of an ERC20 token by its contract address using round-robin
 func (c *roundRobinClient) GetTokenDecimals(ctx context.Context, tokenContractAddress string) (uint8, error) {
 	// Use executeWithRetry to perform the operation
@@ -316,6 +403,111 @@ func (c *roundRobinClient) EstimateGasGeneric(
 	return result.(uint64), nil
 }
 
+// CallContract calls a read-only contract method with a custom ABI and returns its unpacked outputs
+func (c *roundRobinClient) CallContract(
+	ctx context.Context,
+	contractAddress common.Address,
+	abiDef string, // Raw ABI string
+	method string, // Method name (e.g., "nonces", "DOMAIN_SEPARATOR")
+	args ...any, // Variable arguments for the method
+) ([]any, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiDef))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	result, err := c.executeWithRetry(func(client *ethclient.Client) (any, error) {
+		contract := bind.NewBoundContract(contractAddress, parsedABI, client, client, client)
+
+		var outputs []any
+		if err := contract.Call(&bind.CallOpts{Context: ctx}, &outputs, method, args...); err != nil {
+			return nil, fmt.Errorf("failed to call %s on contract %s: %w", method, contractAddress.Hex(), err)
+		}
+		return outputs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]any), nil
+}
+
+// SendContractTransaction sends a transaction calling a contract method with a custom ABI and waits for it to be mined.
+// It returns the transaction hash, the gas used, the gas price and the receipt status.
+func (c *roundRobinClient) SendContractTransaction(
+	ctx context.Context,
+	chainID uint64,
+	contractAddress common.Address,
+	fromPrivateKeyHex string,
+	abiDef string, // Raw ABI string
+	method string, // Method name (e.g., "transferWithAuthorization", "permit")
+	args ...any, // Variable arguments for the method
+) (common.Hash, uint64, *big.Int, uint64, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiDef))
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	fromPrivateKey, err := crypto.HexToECDSA(fromPrivateKeyHex)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	// Set a timeout context for the operation
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	type txResult struct {
+		Hash          common.Hash
+		GasUsed       uint64
+		GasPrice      *big.Int
+		ReceiptStatus uint64
+	}
+
+	result, err := c.executeWithRetry(func(client *ethclient.Client) (any, error) {
+		// Get an authorized transactor
+		auth, err := c.getAuth(ctx, fromPrivateKey, new(big.Int).SetUint64(chainID), client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get authorized transactor: %w", err)
+		}
+
+		// Send the transaction, gas is estimated so a call that would revert is not sent
+		contract := bind.NewBoundContract(contractAddress, parsedABI, client, client, client)
+		tx, err := contract.Transact(auth, method, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send %s transaction: %w", method, err)
+		}
+
+		// Wait for the transaction to be mined
+		receipt, receiptErr := bind.WaitMined(ctx, client, tx)
+		if receiptErr != nil {
+			logger.GetLogger().Errorf("Failed to wait for transaction %s to be mined: %v", tx.Hash().Hex(), receiptErr)
+			// Return the transaction hash even if receipt retrieval fails
+			return txResult{Hash: tx.Hash(), GasPrice: auth.GasPrice}, nil
+		}
+
+		return txResult{
+			Hash:          tx.Hash(),
+			GasUsed:       receipt.GasUsed,
+			GasPrice:      auth.GasPrice,
+			ReceiptStatus: receipt.Status,
+		}, nil
+	})
+	if err != nil {
+		logger.GetLogger().Errorf("Contract transaction %s failed: %v", method, err)
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to send %s transaction after retries: %w", method, err)
+	}
+
+	res := result.(txResult)
+
+	logger.GetLogger().Infof(
+		"Contract transaction %s executed: txHash=%s, gasUsed=%d, gasPrice=%s, receiptStatus=%d",
+		method, res.Hash.Hex(), res.GasUsed, res.GasPrice.String(), res.ReceiptStatus,
+	)
+
+	return res.Hash, res.GasUsed, res.GasPrice, res.ReceiptStatus, nil
+}
+
 // TransferToken transfers ERC-20 tokens from one address to another with round-robin retry logic.
 func (c *roundRobinClient) TransferToken(
 	ctx context.Context,
@@ -529,6 +721,31 @@ func (c *roundRobinClient) TransferNativeToken(
 	return res.Hash, res.EstimatedGas, res.GasPrice, nil
 }
 
+// WaitForReceipt polls the receipt of the transaction until it is mined and returns its receipt status.
+func (c *roundRobinClient) WaitForReceipt(ctx context.Context, txHash common.Hash) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.ReceiptTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(constants.ReceiptInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := c.getClient().TransactionReceipt(ctx, txHash)
+		if err == nil {
+			return receipt.Status, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			logger.GetLogger().Warnf("Failed to get the receipt of transaction %s: %v", txHash.Hex(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("failed to wait for transaction %s to be mined: %w", txHash.Hex(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 // SuggestGasPrice retrieves the suggested gas price using round-robin retry logic.
 func (c *roundRobinClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
 	// Use `executeWithRetry` to simplify retry logic
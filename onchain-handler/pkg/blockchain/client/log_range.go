@@ -0,0 +1,137 @@
+package client
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+// LogRangeLimits bounds the eth_getLogs block range of a client.
+type LogRangeLimits struct {
+	MinRange          uint64   // Range used at start-up and lower bound when shrinking on slow responses
+	MaxRange          uint64   // Upper bound when growing on fast responses
+	EndpointMaxRanges []uint64 // Optional per endpoint upper bound, matched by position with the RPC endpoints, 0 means MaxRange
+}
+
+// logRange tracks the eth_getLogs block range of one RPC endpoint.
+// It grows while responses are fast and small and shrinks when the provider rejects or struggles with the range.
+type logRange struct {
+	size     uint64
+	minRange uint64
+	maxRange uint64
+	mu       sync.Mutex
+}
+
+func newLogRange(minRange, maxRange uint64) *logRange {
+	minRange = max(minRange, 1)
+	maxRange = max(maxRange, minRange)
+	return &logRange{
+		size:     minRange,
+		minRange: minRange,
+		maxRange: maxRange,
+	}
+}
+
+// current returns the number of blocks to request in the next call
+func (r *logRange) current() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.size
+}
+
+// observe adapts the range to a successful response covering requested blocks
+func (r *logRange) observe(requested uint64, logCount int, elapsed time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case elapsed >= constants.LogRangeSlowResponse:
+		r.size = max(r.size/2, r.minRange)
+	case requested >= r.size && elapsed < constants.LogRangeFastResponse && logCount < constants.LogRangeGrowMaxLogs:
+		// Only grow when the full range was used, a short tail says nothing about the provider
+		r.size = min(r.size*2, r.maxRange)
+	}
+}
+
+// shrink reduces the range after the provider rejected requested blocks.
+// The suggested range of the provider is used when given, otherwise the range is halved.
+// It may go below minRange since the provider cannot serve more.
+func (r *logRange) shrink(requested, suggested uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if suggested > 0 && suggested < requested {
+		r.size = suggested
+		return
+	}
+	r.size = max(requested/2, 1)
+}
+
+var (
+	// e.g. "query returned more than 10000 results. Try with this block range [0x1A2B, 0x1C3D]."
+	suggestedHexRangePattern = regexp.MustCompile(`\[\s*(0x[0-9a-fA-F]+)\s*,\s*(0x[0-9a-fA-F]+)\s*\]`)
+	// e.g. "exceed maximum block range: 5000", "eth_getLogs is limited to a 1000 blocks range",
+	// "block range is limited to 2000", "up to a 2K block range"
+	suggestedSizePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)maximum block range:?\s*(\d+)`),
+		regexp.MustCompile(`(?i)limited to (?:a )?(\d+) blocks?`),
+		regexp.MustCompile(`(?i)block range (?:is )?(?:limited to|limit(?: is)?:?|of)\s*(\d+)`),
+		regexp.MustCompile(`(?i)up to an? (\d+)(k?) block range`),
+	}
+	logRangeErrorMessages = []string{
+		"query returned more than",
+		"block range",
+		"blocks range",
+		"range too large",
+		"range is too large",
+		"too many blocks",
+		"too many results",
+		"response size exceeded",
+		"response size should not",
+	}
+)
+
+// isLogRangeError reports whether the provider rejected an eth_getLogs call because of its range or result size
+func isLogRangeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	for _, pattern := range logRangeErrorMessages {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSuggestedLogRange extracts the number of blocks suggested by the provider, 0 when there is none
+func parseSuggestedLogRange(message string) uint64 {
+	if match := suggestedHexRangePattern.FindStringSubmatch(message); match != nil {
+		from, errFrom := strconv.ParseUint(strings.TrimPrefix(match[1], "0x"), 16, 64)
+		to, errTo := strconv.ParseUint(strings.TrimPrefix(match[2], "0x"), 16, 64)
+		if errFrom == nil && errTo == nil && to >= from {
+			return to - from + 1
+		}
+	}
+
+	for _, pattern := range suggestedSizePatterns {
+		match := pattern.FindStringSubmatch(message)
+		if match == nil {
+			continue
+		}
+		size, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if len(match) > 2 && strings.EqualFold(match[2], "k") {
+			size *= 1000
+		}
+		return size
+	}
+	return 0
+}
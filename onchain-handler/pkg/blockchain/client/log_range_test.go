@@ -0,0 +1,81 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+func TestLogRange(t *testing.T) {
+	t.Run("GrowsOnFastSmallResponses", func(t *testing.T) {
+		r := newLogRange(10, 50)
+		r.observe(10, 0, time.Millisecond)
+		require.Equal(t, uint64(20), r.current())
+		r.observe(20, 0, time.Millisecond)
+		r.observe(40, 0, time.Millisecond)
+		require.Equal(t, uint64(50), r.current())
+	})
+
+	t.Run("DoesNotGrowOnShortTail", func(t *testing.T) {
+		r := newLogRange(10, 50)
+		r.observe(3, 0, time.Millisecond)
+		require.Equal(t, uint64(10), r.current())
+	})
+
+	t.Run("DoesNotGrowOnLargeResponses", func(t *testing.T) {
+		r := newLogRange(10, 50)
+		r.observe(10, constants.LogRangeGrowMaxLogs, time.Millisecond)
+		require.Equal(t, uint64(10), r.current())
+	})
+
+	t.Run("ShrinksOnSlowResponsesDownToMin", func(t *testing.T) {
+		r := newLogRange(10, 50)
+		r.observe(10, 0, time.Millisecond)
+		r.observe(20, 0, constants.LogRangeSlowResponse)
+		require.Equal(t, uint64(10), r.current())
+		r.observe(10, 0, constants.LogRangeSlowResponse)
+		require.Equal(t, uint64(10), r.current())
+	})
+
+	t.Run("ShrinksOnRangeErrors", func(t *testing.T) {
+		r := newLogRange(10, 50)
+		r.shrink(10, 0)
+		require.Equal(t, uint64(5), r.current())
+		r.shrink(5, 3)
+		require.Equal(t, uint64(3), r.current())
+		r.shrink(1, 0)
+		require.Equal(t, uint64(1), r.current())
+	})
+}
+
+func TestIsLogRangeError(t *testing.T) {
+	require.False(t, isLogRangeError(nil))
+	require.False(t, isLogRangeError(errors.New("429 Too Many Requests: rate limit exceeded")))
+	require.True(t, isLogRangeError(errors.New("query returned more than 10000 results")))
+	require.True(t, isLogRangeError(errors.New("exceed maximum block range: 5000")))
+	require.True(t, isLogRangeError(errors.New("Log response size exceeded.")))
+}
+
+func TestParseSuggestedLogRange(t *testing.T) {
+	tests := []struct {
+		message  string
+		expected uint64
+	}{
+		{"query returned more than 10000 results. Try with this block range [0x10, 0x1F].", 16},
+		{"exceed maximum block range: 5000", 5000},
+		{"eth_getLogs is limited to a 1000 blocks range", 1000},
+		{"block range is limited to 2000", 2000},
+		{"You can make eth_getLogs requests with up to a 2K block range", 2000},
+		{"block range is too wide", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			require.Equal(t, tt.expected, parseSuggestedLogRange(tt.message))
+		})
+	}
+}
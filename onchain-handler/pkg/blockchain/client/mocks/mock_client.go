@@ -0,0 +1,321 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/blockchain/client/types/client.go
+//
+// Generated by this command:
+//
+//	mockgen -source=pkg/blockchain/client/types/client.go -destination=pkg/blockchain/client/mocks/mock_client.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+	time "time"
+
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+	isgomock struct{}
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// CallContract mocks base method.
+func (m *MockClient) CallContract(ctx context.Context, contractAddress common.Address, abiDef, method string, args ...any) ([]any, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, contractAddress, abiDef, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CallContract", varargs...)
+	ret0, _ := ret[0].([]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract.
+func (mr *MockClientMockRecorder) CallContract(ctx, contractAddress, abiDef, method any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, contractAddress, abiDef, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockClient)(nil).CallContract), varargs...)
+}
+
+// Close mocks base method.
+func (m *MockClient) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
+}
+
+// EstimateGasGeneric mocks base method.
+func (m *MockClient) EstimateGasGeneric(contractAddress, fromAddress common.Address, abiDef, method string, args ...any) (uint64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{contractAddress, fromAddress, abiDef, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EstimateGasGeneric", varargs...)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGasGeneric indicates an expected call of EstimateGasGeneric.
+func (mr *MockClientMockRecorder) EstimateGasGeneric(contractAddress, fromAddress, abiDef, method any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{contractAddress, fromAddress, abiDef, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasGeneric", reflect.TypeOf((*MockClient)(nil).EstimateGasGeneric), varargs...)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockClient) GetBaseFee(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockClientMockRecorder) GetBaseFee(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockClient)(nil).GetBaseFee), ctx)
+}
+
+// GetBlockTime mocks base method.
+func (m *MockClient) GetBlockTime(ctx context.Context, blockNumber uint64) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockTime", ctx, blockNumber)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockTime indicates an expected call of GetBlockTime.
+func (mr *MockClientMockRecorder) GetBlockTime(ctx, blockNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTime", reflect.TypeOf((*MockClient)(nil).GetBlockTime), ctx, blockNumber)
+}
+
+// GetLatestBlockNumber mocks base method.
+func (m *MockClient) GetLatestBlockNumber(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestBlockNumber", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestBlockNumber indicates an expected call of GetLatestBlockNumber.
+func (mr *MockClientMockRecorder) GetLatestBlockNumber(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestBlockNumber", reflect.TypeOf((*MockClient)(nil).GetLatestBlockNumber), ctx)
+}
+
+// GetNativeTokenBalance mocks base method.
+func (m *MockClient) GetNativeTokenBalance(ctx context.Context, walletAddress string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNativeTokenBalance", ctx, walletAddress)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNativeTokenBalance indicates an expected call of GetNativeTokenBalance.
+func (mr *MockClientMockRecorder) GetNativeTokenBalance(ctx, walletAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNativeTokenBalance", reflect.TypeOf((*MockClient)(nil).GetNativeTokenBalance), ctx, walletAddress)
+}
+
+// GetTokenBalance mocks base method.
+func (m *MockClient) GetTokenBalance(ctx context.Context, tokenContractAddress, walletAddress string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenBalance", ctx, tokenContractAddress, walletAddress)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenBalance indicates an expected call of GetTokenBalance.
+func (mr *MockClientMockRecorder) GetTokenBalance(ctx, tokenContractAddress, walletAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenBalance", reflect.TypeOf((*MockClient)(nil).GetTokenBalance), ctx, tokenContractAddress, walletAddress)
+}
+
+// GetTokenDecimals mocks base method.
+func (m *MockClient) GetTokenDecimals(ctx context.Context, tokenContractAddress string) (uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenDecimals", ctx, tokenContractAddress)
+	ret0, _ := ret[0].(uint8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenDecimals indicates an expected call of GetTokenDecimals.
+func (mr *MockClientMockRecorder) GetTokenDecimals(ctx, tokenContractAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenDecimals", reflect.TypeOf((*MockClient)(nil).GetTokenDecimals), ctx, tokenContractAddress)
+}
+
+// PollForLogsFromBlock mocks base method.
+func (m *MockClient) PollForLogsFromBlock(ctx context.Context, contractAddresses []common.Address, fromBlock, endBlock uint64) ([]types.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PollForLogsFromBlock", ctx, contractAddresses, fromBlock, endBlock)
+	ret0, _ := ret[0].([]types.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PollForLogsFromBlock indicates an expected call of PollForLogsFromBlock.
+func (mr *MockClientMockRecorder) PollForLogsFromBlock(ctx, contractAddresses, fromBlock, endBlock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollForLogsFromBlock", reflect.TypeOf((*MockClient)(nil).PollForLogsFromBlock), ctx, contractAddresses, fromBlock, endBlock)
+}
+
+// PollForLogsUpTo mocks base method.
+func (m *MockClient) PollForLogsUpTo(ctx context.Context, contractAddresses []common.Address, fromBlock, maxEndBlock uint64) ([]types.Log, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PollForLogsUpTo", ctx, contractAddresses, fromBlock, maxEndBlock)
+	ret0, _ := ret[0].([]types.Log)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PollForLogsUpTo indicates an expected call of PollForLogsUpTo.
+func (mr *MockClientMockRecorder) PollForLogsUpTo(ctx, contractAddresses, fromBlock, maxEndBlock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollForLogsUpTo", reflect.TypeOf((*MockClient)(nil).PollForLogsUpTo), ctx, contractAddresses, fromBlock, maxEndBlock)
+}
+
+// SendContractTransaction mocks base method.
+func (m *MockClient) SendContractTransaction(ctx context.Context, chainID uint64, contractAddress common.Address, fromPrivateKeyHex, abiDef, method string, args ...any) (common.Hash, uint64, *big.Int, uint64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, chainID, contractAddress, fromPrivateKeyHex, abiDef, method}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendContractTransaction", varargs...)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(*big.Int)
+	ret3, _ := ret[3].(uint64)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// SendContractTransaction indicates an expected call of SendContractTransaction.
+func (mr *MockClientMockRecorder) SendContractTransaction(ctx, chainID, contractAddress, fromPrivateKeyHex, abiDef, method any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, chainID, contractAddress, fromPrivateKeyHex, abiDef, method}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransaction", reflect.TypeOf((*MockClient)(nil).SendContractTransaction), varargs...)
+}
+
+// SuggestGasPrice mocks base method.
+func (m *MockClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasPrice", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasPrice indicates an expected call of SuggestGasPrice.
+func (mr *MockClientMockRecorder) SuggestGasPrice(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockClient)(nil).SuggestGasPrice), ctx)
+}
+
+// SuggestGasTipCap mocks base method.
+func (m *MockClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasTipCap", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasTipCap indicates an expected call of SuggestGasTipCap.
+func (mr *MockClientMockRecorder) SuggestGasTipCap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasTipCap", reflect.TypeOf((*MockClient)(nil).SuggestGasTipCap), ctx)
+}
+
+// TransferNativeToken mocks base method.
+func (m *MockClient) TransferNativeToken(ctx context.Context, chainID uint64, fromPrivateKeyHex, toAddressHex string, amount *big.Int) (common.Hash, uint64, *big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferNativeToken", ctx, chainID, fromPrivateKeyHex, toAddressHex, amount)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(*big.Int)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// TransferNativeToken indicates an expected call of TransferNativeToken.
+func (mr *MockClientMockRecorder) TransferNativeToken(ctx, chainID, fromPrivateKeyHex, toAddressHex, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferNativeToken", reflect.TypeOf((*MockClient)(nil).TransferNativeToken), ctx, chainID, fromPrivateKeyHex, toAddressHex, amount)
+}
+
+// TransferToken mocks base method.
+func (m *MockClient) TransferToken(ctx context.Context, chainID uint64, tokenContractAddress, fromPrivateKeyHex, toAddressHex string, amount *big.Int) (common.Hash, uint64, *big.Int, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferToken", ctx, chainID, tokenContractAddress, fromPrivateKeyHex, toAddressHex, amount)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(*big.Int)
+	ret3, _ := ret[3].(uint64)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// TransferToken indicates an expected call of TransferToken.
+func (mr *MockClientMockRecorder) TransferToken(ctx, chainID, tokenContractAddress, fromPrivateKeyHex, toAddressHex, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferToken", reflect.TypeOf((*MockClient)(nil).TransferToken), ctx, chainID, tokenContractAddress, fromPrivateKeyHex, toAddressHex, amount)
+}
+
+// WaitForReceipt mocks base method.
+func (m *MockClient) WaitForReceipt(ctx context.Context, txHash common.Hash) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForReceipt", ctx, txHash)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForReceipt indicates an expected call of WaitForReceipt.
+func (mr *MockClientMockRecorder) WaitForReceipt(ctx, txHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForReceipt", reflect.TypeOf((*MockClient)(nil).WaitForReceipt), ctx, txHash)
+}
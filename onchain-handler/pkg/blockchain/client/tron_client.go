@@ -0,0 +1,458 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/contracts/abigen/erc20token"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+// sunToWei scales the sun amounts of Tron to the 18 decimals the native amounts of the client are handled with
+var sunToWei = new(big.Int).Exp(big.NewInt(10), big.NewInt(constants.NativeTokenDecimalPlaces-constants.TronSunDecimalPlaces), nil)
+
+// tronClient reads the Tron network through its Ethereum compatible JSON-RPC and sends the transactions
+// through its HTTP API, which builds them. Addresses may be given in base58 or hex, and the native amounts
+// are in TRX scaled to 18 decimals so that callers handle TRX as any other native token. The energy of a
+// transaction is its gas and the energy fee its gas price, the gas used of a receipt is the TRX burnt.
+type tronClient struct {
+	clienttypes.Client // Reads through the JSON-RPC
+	apiURL             string
+	apiKey             string
+	feeLimit           int64
+	httpClient         *http.Client
+}
+
+// tronTransaction is an unsigned or signed transaction as returned and accepted by the HTTP API
+type tronTransaction struct {
+	TxID       string          `json:"txID"`
+	RawData    json.RawMessage `json:"raw_data"`
+	RawDataHex string          `json:"raw_data_hex"`
+	Visible    bool            `json:"visible"`
+	Signature  []string        `json:"signature,omitempty"`
+}
+
+// tronTransactionInfo is the receipt of a transaction, empty until the transaction is in a block
+type tronTransactionInfo struct {
+	ID          string `json:"id"`
+	Fee         int64  `json:"fee"`
+	BlockNumber int64  `json:"blockNumber"`
+	Result      string `json:"result"`
+	Receipt     struct {
+		Result           string `json:"result"`
+		EnergyUsageTotal int64  `json:"energy_usage_total"`
+	} `json:"receipt"`
+}
+
+// NewTronClient creates a client of the Tron network. The RPC endpoints are the JSON-RPC endpoints of the nodes,
+// e.g. https://api.trongrid.io/jsonrpc, and the API URL the base URL of the HTTP API, e.g. https://api.trongrid.io.
+// The fee limit is the max TRX, in sun, a contract call may burn.
+func NewTronClient(
+	rpcEndpoints []string,
+	logRangeLimits LogRangeLimits,
+	apiURL, apiKey string,
+	feeLimit int64,
+) (clienttypes.Client, error) {
+	if apiURL == "" {
+		return nil, fmt.Errorf("no Tron API URL provided")
+	}
+
+	rpcClient, err := NewRoundRobinClient(rpcEndpoints, logRangeLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	if feeLimit <= 0 {
+		feeLimit = constants.TronDefaultFeeLimit
+	}
+
+	return &tronClient{
+		Client:     rpcClient,
+		apiURL:     strings.TrimRight(apiURL, "/"),
+		apiKey:     apiKey,
+		feeLimit:   feeLimit,
+		httpClient: &http.Client{Timeout: constants.TronAPITimeout},
+	}, nil
+}
+
+// EstimateGasGeneric estimates the energy of a contract call, with the bandwidth of the transaction converted to energy
+func (c *tronClient) EstimateGasGeneric(
+	contractAddress common.Address,
+	fromAddress common.Address,
+	abiDef string,
+	method string,
+	args ...any,
+) (uint64, error) {
+	energy, err := c.Client.EstimateGasGeneric(contractAddress, fromAddress, abiDef, method, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	energyFee, err := c.Client.SuggestGasPrice(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get energy fee: %w", err)
+	}
+	if energyFee.Sign() <= 0 {
+		return energy, nil
+	}
+
+	bandwidthFee := new(big.Int).SetUint64(constants.TronTransferSize * constants.TronBandwidthPrice)
+	bandwidthEnergy := new(big.Int).Div(new(big.Int).Add(bandwidthFee, new(big.Int).Sub(energyFee, big.NewInt(1))), energyFee)
+	return energy + bandwidthEnergy.Uint64(), nil
+}
+
+// SuggestGasPrice returns the energy fee
+func (c *tronClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	energyFee, err := c.Client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Mul(energyFee, sunToWei), nil
+}
+
+// GetBaseFee returns the energy fee, Tron has no fee market
+func (c *tronClient) GetBaseFee(ctx context.Context) (*big.Int, error) {
+	return c.SuggestGasPrice(ctx)
+}
+
+// SuggestGasTipCap returns zero, Tron has no priority fee
+func (c *tronClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// GetTokenDecimals retrieves the decimals of a TRC-20 token
+func (c *tronClient) GetTokenDecimals(ctx context.Context, tokenContractAddress string) (uint8, error) {
+	tokenAddress, err := chain.Tron.ParseAddress(tokenContractAddress)
+	if err != nil {
+		return 0, err
+	}
+	return c.Client.GetTokenDecimals(ctx, tokenAddress.Hex())
+}
+
+// GetTokenBalance retrieves the TRC-20 token balance of a wallet
+func (c *tronClient) GetTokenBalance(ctx context.Context, tokenContractAddress string, walletAddress string) (*big.Int, error) {
+	tokenAddress, err := chain.Tron.ParseAddress(tokenContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	accountAddress, err := chain.Tron.ParseAddress(walletAddress)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.GetTokenBalance(ctx, tokenAddress.Hex(), accountAddress.Hex())
+}
+
+// GetNativeTokenBalance retrieves the TRX balance of a wallet
+func (c *tronClient) GetNativeTokenBalance(ctx context.Context, walletAddress string) (*big.Int, error) {
+	accountAddress, err := chain.Tron.ParseAddress(walletAddress)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := c.Client.GetNativeTokenBalance(ctx, accountAddress.Hex())
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Mul(balance, sunToWei), nil
+}
+
+// SendContractTransaction sends a transaction calling a contract method and waits for its receipt
+func (c *tronClient) SendContractTransaction(
+	ctx context.Context,
+	chainID uint64,
+	contractAddress common.Address,
+	fromPrivateKeyHex string,
+	abiDef string,
+	method string,
+	args ...any,
+) (common.Hash, uint64, *big.Int, uint64, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiDef))
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+	data, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to pack method data for %s: %w", method, err)
+	}
+
+	return c.sendContractCall(ctx, contractAddress, fromPrivateKeyHex, data)
+}
+
+// TransferToken transfers TRC-20 tokens and waits for the receipt of the transfer
+func (c *tronClient) TransferToken(
+	ctx context.Context,
+	chainID uint64,
+	tokenContractAddress, fromPrivateKeyHex, toAddressHex string,
+	amount *big.Int,
+) (common.Hash, uint64, *big.Int, uint64, error) {
+	if amount == nil || amount.Cmp(big.NewInt(0)) <= 0 {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("invalid amount: must be greater than 0")
+	}
+
+	tokenAddress, err := chain.Tron.ParseAddress(tokenContractAddress)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, err
+	}
+	toAddress, err := chain.Tron.ParseAddress(toAddressHex)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, err
+	}
+
+	parsedABI, err := erc20token.Erc20tokenMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+	data, err := parsedABI.Pack("transfer", toAddress, amount)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to pack transfer data: %w", err)
+	}
+
+	return c.sendContractCall(ctx, tokenAddress, fromPrivateKeyHex, data)
+}
+
+// TransferNativeToken transfers TRX without waiting for its receipt. The amount is in TRX scaled to 18 decimals,
+// it returns the bandwidth the transfer is expected to burn as its gas.
+func (c *tronClient) TransferNativeToken(
+	ctx context.Context,
+	chainID uint64,
+	fromPrivateKeyHex, toAddressHex string,
+	amount *big.Int,
+) (common.Hash, uint64, *big.Int, error) {
+	fromPrivateKey, err := crypto.HexToECDSA(fromPrivateKeyHex)
+	if err != nil {
+		return common.Hash{}, 0, nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	toAddress, err := chain.Tron.ParseAddress(toAddressHex)
+	if err != nil {
+		return common.Hash{}, 0, nil, err
+	}
+
+	sunAmount := new(big.Int).Div(amount, sunToWei)
+	if sunAmount.Sign() <= 0 || !sunAmount.IsInt64() {
+		return common.Hash{}, 0, nil, fmt.Errorf("invalid amount: %s", amount.String())
+	}
+
+	var tx tronTransaction
+	err = c.post(ctx, "/wallet/createtransaction", map[string]any{
+		"owner_address": chain.HexAddress(crypto.PubkeyToAddress(fromPrivateKey.PublicKey)),
+		"to_address":    chain.HexAddress(toAddress),
+		"amount":        sunAmount.Int64(),
+		"visible":       false,
+	}, &tx)
+	if err != nil {
+		return common.Hash{}, 0, nil, fmt.Errorf("failed to create TRX transfer: %w", err)
+	}
+
+	hash, err := c.signAndBroadcast(ctx, &tx, fromPrivateKey)
+	if err != nil {
+		return common.Hash{}, 0, nil, fmt.Errorf("failed to transfer native token: %w", err)
+	}
+
+	return hash, constants.TronTransferSize * constants.TronBandwidthPrice, new(big.Int).Set(sunToWei), nil
+}
+
+// WaitForReceipt polls the receipt of the transaction until it is in a block and returns its receipt status
+func (c *tronClient) WaitForReceipt(ctx context.Context, txHash common.Hash) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.TronReceiptTimeout)
+	defer cancel()
+
+	txID := strings.TrimPrefix(txHash.Hex(), "0x")
+	info, err := c.waitForTransactionInfo(ctx, txID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to wait for transaction %s to be in a block: %w", txID, err)
+	}
+	return receiptStatusOf(info), nil
+}
+
+// receiptStatusOf returns 1 when the transaction succeeded, like the status of an EVM receipt, and 0 otherwise
+func receiptStatusOf(info *tronTransactionInfo) uint64 {
+	if info.Result != "FAILED" && (info.Receipt.Result == "" || info.Receipt.Result == "SUCCESS") {
+		return 1
+	}
+	return 0
+}
+
+// sendContractCall builds, signs and broadcasts a contract call and waits for its receipt. It returns the
+// transaction hash, the TRX burnt in sun as the gas used at a gas price of one sun, and the receipt status.
+func (c *tronClient) sendContractCall(
+	ctx context.Context,
+	contractAddress common.Address,
+	fromPrivateKeyHex string,
+	data []byte,
+) (common.Hash, uint64, *big.Int, uint64, error) {
+	fromPrivateKey, err := crypto.HexToECDSA(fromPrivateKeyHex)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	var response struct {
+		Result struct {
+			Result  bool   `json:"result"`
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"result"`
+		Transaction tronTransaction `json:"transaction"`
+	}
+	err = c.post(ctx, "/wallet/triggersmartcontract", map[string]any{
+		"owner_address":    chain.HexAddress(crypto.PubkeyToAddress(fromPrivateKey.PublicKey)),
+		"contract_address": chain.HexAddress(contractAddress),
+		"data":             hex.EncodeToString(data),
+		"fee_limit":        c.feeLimit,
+		"call_value":       0,
+		"visible":          false,
+	}, &response)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to build contract call: %w", err)
+	}
+	if !response.Result.Result {
+		return common.Hash{}, 0, nil, 0, fmt.Errorf("failed to build contract call: %s %s", response.Result.Code, decodeTronMessage(response.Result.Message))
+	}
+
+	// Set a timeout context for the operation
+	ctx, cancel := context.WithTimeout(ctx, constants.TronReceiptTimeout)
+	defer cancel()
+
+	hash, err := c.signAndBroadcast(ctx, &response.Transaction, fromPrivateKey)
+	if err != nil {
+		return common.Hash{}, 0, nil, 0, err
+	}
+
+	info, err := c.waitForTransactionInfo(ctx, response.Transaction.TxID)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to wait for transaction %s to be in a block: %v", response.Transaction.TxID, err)
+		// Return the transaction hash even if receipt retrieval fails
+		return hash, 0, new(big.Int).Set(sunToWei), 0, nil
+	}
+
+	status := receiptStatusOf(info)
+
+	logger.GetLogger().Infof(
+		"Tron contract call executed: txHash=%s, energyUsed=%d, fee=%d sun, status=%d",
+		response.Transaction.TxID, info.Receipt.EnergyUsageTotal, info.Fee, status,
+	)
+
+	return hash, uint64(info.Fee), new(big.Int).Set(sunToWei), status, nil
+}
+
+// signAndBroadcast signs the transaction ID, the SHA-256 hash of the raw data, and broadcasts the signed transaction
+func (c *tronClient) signAndBroadcast(ctx context.Context, tx *tronTransaction, privateKey *ecdsa.PrivateKey) (common.Hash, error) {
+	rawData, err := hex.DecodeString(tx.RawDataHex)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid raw data of transaction %s: %w", tx.TxID, err)
+	}
+	txID := sha256.Sum256(rawData)
+	if hex.EncodeToString(txID[:]) != strings.ToLower(tx.TxID) {
+		return common.Hash{}, fmt.Errorf("transaction ID %s does not match its raw data", tx.TxID)
+	}
+
+	signature, err := crypto.Sign(txID[:], privateKey)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to sign transaction %s: %w", tx.TxID, err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	tx.Signature = []string{hex.EncodeToString(signature)}
+
+	var response struct {
+		Result  bool   `json:"result"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := c.post(ctx, "/wallet/broadcasttransaction", tx, &response); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to broadcast transaction %s: %w", tx.TxID, err)
+	}
+	if !response.Result {
+		return common.Hash{}, fmt.Errorf("transaction %s rejected: %s %s", tx.TxID, response.Code, decodeTronMessage(response.Message))
+	}
+
+	return common.BytesToHash(txID[:]), nil
+}
+
+// waitForTransactionInfo polls the receipt of the transaction until it is in a block or the context is done
+func (c *tronClient) waitForTransactionInfo(ctx context.Context, txID string) (*tronTransactionInfo, error) {
+	ticker := time.NewTicker(constants.TronReceiptInterval)
+	defer ticker.Stop()
+
+	for {
+		var info tronTransactionInfo
+		if err := c.post(ctx, "/wallet/gettransactioninfobyid", map[string]any{"value": txID}, &info); err != nil {
+			logger.GetLogger().Warnf("Failed to get the receipt of transaction %s: %v", txID, err)
+		} else if info.ID != "" {
+			return &info, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// post sends a request to the HTTP API and decodes its JSON response
+func (c *tronClient) post(ctx context.Context, path string, payload, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set(constants.TronAPIKeyHeader, c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response of %s: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d: %s", path, resp.StatusCode, string(respBody))
+	}
+
+	// The API returns errors as a JSON object with an Error field and a 200 status
+	var apiError struct {
+		Error string `json:"Error"`
+	}
+	if err := json.Unmarshal(respBody, &apiError); err == nil && apiError.Error != "" {
+		return fmt.Errorf("%s failed: %s", path, apiError.Error)
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", path, err)
+	}
+	return nil
+}
+
+// decodeTronMessage decodes the hex encoded messages of the HTTP API
+func decodeTronMessage(message string) string {
+	decoded, err := hex.DecodeString(message)
+	if err != nil {
+		return message
+	}
+	return string(decoded)
+}
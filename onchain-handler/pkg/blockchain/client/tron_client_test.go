@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/contracts/abigen/erc20token"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+)
+
+const (
+	mockEnergyFee = 210     // sun per energy
+	mockEnergy    = 14650   // energy of a contract call
+	mockBalance   = 1000000 // sun, 1 TRX
+	mockFee       = 3420000 // sun burnt by a contract call
+)
+
+// mockTronNode serves the JSON-RPC and the HTTP API of a Tron node
+type mockTronNode struct {
+	t             *testing.T
+	mu            sync.Mutex
+	requests      map[string]map[string]any
+	broadcasted   []map[string]any
+	receiptResult string
+}
+
+func newMockTronNode(t *testing.T) (*mockTronNode, *httptest.Server) {
+	node := &mockTronNode{t: t, requests: make(map[string]map[string]any), receiptResult: "SUCCESS"}
+	server := httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(server.Close)
+	return node, server
+}
+
+func (n *mockTronNode) serve(w http.ResponseWriter, r *http.Request) {
+	var body map[string]any
+	require.NoError(n.t, json.NewDecoder(r.Body).Decode(&body))
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.requests[r.URL.Path] = body
+
+	switch r.URL.Path {
+	case "/jsonrpc":
+		n.serveJSONRPC(w, body)
+	case "/wallet/triggersmartcontract":
+		writeJSON(w, map[string]any{"result": map[string]any{"result": true}, "transaction": mockTransaction("0a0201")})
+	case "/wallet/createtransaction":
+		writeJSON(w, mockTransaction("0a0202"))
+	case "/wallet/broadcasttransaction":
+		n.broadcasted = append(n.broadcasted, body)
+		writeJSON(w, map[string]any{"result": true, "txid": body["txID"]})
+	case "/wallet/gettransactioninfobyid":
+		writeJSON(w, map[string]any{
+			"id":          body["value"],
+			"fee":         mockFee,
+			"blockNumber": 100,
+			"receipt":     map[string]any{"result": n.receiptResult, "energy_usage_total": mockEnergy},
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (n *mockTronNode) serveJSONRPC(w http.ResponseWriter, request map[string]any) {
+	var result any
+	switch request["method"] {
+	case "eth_gasPrice":
+		result = hexutil.EncodeUint64(mockEnergyFee)
+	case "eth_estimateGas":
+		result = hexutil.EncodeUint64(mockEnergy)
+	case "eth_getBalance":
+		result = hexutil.EncodeUint64(mockBalance)
+	case "eth_call":
+		result = hexutil.Encode(common.LeftPadBytes(big.NewInt(25_000_000).Bytes(), 32))
+	default:
+		n.t.Errorf("unexpected JSON-RPC method %v", request["method"])
+	}
+	writeJSON(w, map[string]any{"jsonrpc": "2.0", "id": request["id"], "result": result})
+}
+
+func (n *mockTronNode) request(path string) map[string]any {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.requests[path]
+}
+
+func mockTransaction(rawDataHex string) map[string]any {
+	rawData, _ := hex.DecodeString(rawDataHex)
+	txID := sha256.Sum256(rawData)
+	return map[string]any{
+		"txID":         hex.EncodeToString(txID[:]),
+		"raw_data":     map[string]any{"ref_block_bytes": "0001"},
+		"raw_data_hex": rawDataHex,
+		"visible":      false,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newTestTronClient(t *testing.T, server *httptest.Server) *tronClient {
+	c, err := NewTronClient([]string{server.URL + "/jsonrpc"}, LogRangeLimits{MinRange: 10, MaxRange: 100}, server.URL, "api-key", 0)
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c.(*tronClient)
+}
+
+func TestTronClient(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	privateKeyHex := hex.EncodeToString(crypto.FromECDSA(privateKey))
+	fromAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	tokenAddress := common.HexToAddress("0xa614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	toAddress := common.HexToAddress("0x00000000000000000000000000000000000000ff")
+
+	// verifySignature checks the broadcasted transaction is signed by the sender
+	verifySignature := func(t *testing.T, tx map[string]any) {
+		signatures := tx["signature"].([]any)
+		require.Len(t, signatures, 1)
+		signature, err := hex.DecodeString(signatures[0].(string))
+		require.NoError(t, err)
+		signature[crypto.RecoveryIDOffset] -= 27
+
+		txID, err := hex.DecodeString(tx["txID"].(string))
+		require.NoError(t, err)
+		publicKey, err := crypto.SigToPub(txID, signature)
+		require.NoError(t, err)
+		require.Equal(t, fromAddress, crypto.PubkeyToAddress(*publicKey))
+	}
+
+	t.Run("TransferToken", func(t *testing.T) {
+		node, server := newMockTronNode(t)
+		c := newTestTronClient(t, server)
+
+		hash, gasUsed, gasPrice, status, err := c.TransferToken(
+			context.Background(), constants.TronDefaultChainID, chain.Tron.FormatAddress(tokenAddress), privateKeyHex,
+			chain.Tron.FormatAddress(toAddress), big.NewInt(1_500_000),
+		)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), status)
+
+		// The gas used is the TRX burnt
+		require.Equal(t, uint64(mockFee), gasUsed)
+		require.Equal(t, "3.420000", new(big.Float).Quo(
+			new(big.Float).SetInt(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice)), big.NewFloat(1e18),
+		).Text('f', 6))
+
+		trigger := node.request("/wallet/triggersmartcontract")
+		require.Equal(t, chain.HexAddress(fromAddress), trigger["owner_address"])
+		require.Equal(t, chain.HexAddress(tokenAddress), trigger["contract_address"])
+		require.Equal(t, float64(constants.TronDefaultFeeLimit), trigger["fee_limit"])
+
+		parsedABI, err := erc20token.Erc20tokenMetaData.GetAbi()
+		require.NoError(t, err)
+		expectedData, err := parsedABI.Pack("transfer", toAddress, big.NewInt(1_500_000))
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(expectedData), trigger["data"])
+
+		require.Len(t, node.broadcasted, 1)
+		verifySignature(t, node.broadcasted[0])
+		require.Equal(t, node.broadcasted[0]["txID"], strings.TrimPrefix(hash.Hex(), "0x"))
+	})
+
+	t.Run("FailedReceipt", func(t *testing.T) {
+		node, server := newMockTronNode(t)
+		node.receiptResult = "OUT_OF_ENERGY"
+		c := newTestTronClient(t, server)
+
+		_, _, _, status, err := c.TransferToken(
+			context.Background(), constants.TronDefaultChainID, tokenAddress.Hex(), privateKeyHex, toAddress.Hex(), big.NewInt(1),
+		)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), status)
+	})
+
+	t.Run("TransferNativeToken", func(t *testing.T) {
+		node, server := newMockTronNode(t)
+		c := newTestTronClient(t, server)
+
+		// 1.5 TRX in 18 decimals
+		amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+		_, gas, gasPrice, err := c.TransferNativeToken(context.Background(), constants.TronDefaultChainID, privateKeyHex, chain.Tron.FormatAddress(toAddress), amount)
+		require.NoError(t, err)
+		require.Equal(t, uint64(constants.TronTransferSize*constants.TronBandwidthPrice), gas)
+		require.Equal(t, sunToWei, gasPrice)
+
+		transfer := node.request("/wallet/createtransaction")
+		require.Equal(t, chain.HexAddress(fromAddress), transfer["owner_address"])
+		require.Equal(t, chain.HexAddress(toAddress), transfer["to_address"])
+		require.Equal(t, float64(1_500_000), transfer["amount"])
+
+		require.Len(t, node.broadcasted, 1)
+		verifySignature(t, node.broadcasted[0])
+	})
+
+	t.Run("RejectsAmountBelowOneSun", func(t *testing.T) {
+		_, server := newMockTronNode(t)
+		c := newTestTronClient(t, server)
+
+		_, _, _, err := c.TransferNativeToken(context.Background(), constants.TronDefaultChainID, privateKeyHex, toAddress.Hex(), big.NewInt(1))
+		require.Error(t, err)
+	})
+
+	t.Run("BalancesAndFees", func(t *testing.T) {
+		node, server := newMockTronNode(t)
+		c := newTestTronClient(t, server)
+		ctx := context.Background()
+
+		balance, err := c.GetNativeTokenBalance(ctx, chain.Tron.FormatAddress(toAddress))
+		require.NoError(t, err)
+		require.Equal(t, new(big.Int).Mul(big.NewInt(mockBalance), sunToWei), balance)
+		require.Equal(t, strings.ToLower(toAddress.Hex()), strings.ToLower(node.request("/jsonrpc")["params"].([]any)[0].(string)))
+
+		tokenBalance, err := c.GetTokenBalance(ctx, chain.Tron.FormatAddress(tokenAddress), chain.Tron.FormatAddress(toAddress))
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(25_000_000), tokenBalance)
+
+		gasPrice, err := c.SuggestGasPrice(ctx)
+		require.NoError(t, err)
+		require.Equal(t, new(big.Int).Mul(big.NewInt(mockEnergyFee), sunToWei), gasPrice)
+
+		baseFee, err := c.GetBaseFee(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gasPrice, baseFee)
+
+		tip, err := c.SuggestGasTipCap(ctx)
+		require.NoError(t, err)
+		require.Zero(t, tip.Sign())
+
+		// The bandwidth of the transaction is added as energy, rounded up
+		gas, err := c.EstimateGasGeneric(tokenAddress, fromAddress, erc20token.Erc20tokenMetaData.ABI, "transfer", toAddress, big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, uint64(mockEnergy+(constants.TronTransferSize*constants.TronBandwidthPrice+mockEnergyFee-1)/mockEnergyFee), gas)
+	})
+}
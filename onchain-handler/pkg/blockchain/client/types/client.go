@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -15,7 +16,14 @@ type Client interface {
 		fromBlock uint64, // Block number to start querying from
 		endBlock uint64,
 	) ([]types.Log, error)
+	PollForLogsUpTo(
+		ctx context.Context,
+		contractAddresses []common.Address, // Contract addresses to filter logs
+		fromBlock uint64, // Block number to start querying from
+		maxEndBlock uint64, // Highest block to query, the client may stop earlier
+	) ([]types.Log, uint64, error)
 	GetLatestBlockNumber(ctx context.Context) (*big.Int, error)
+	GetBlockTime(ctx context.Context, blockNumber uint64) (time.Time, error)
 	GetTokenDecimals(ctx context.Context, tokenContractAddress string) (uint8, error)
 	EstimateGasGeneric(
 		contractAddress common.Address,
@@ -24,6 +32,22 @@ type Client interface {
 		method string,
 		args ...any,
 	) (uint64, error)
+	CallContract(
+		ctx context.Context,
+		contractAddress common.Address,
+		abiDef string,
+		method string,
+		args ...any,
+	) ([]any, error)
+	SendContractTransaction(
+		ctx context.Context,
+		chainID uint64,
+		contractAddress common.Address,
+		fromPrivateKeyHex string,
+		abiDef string,
+		method string,
+		args ...any,
+	) (common.Hash, uint64, *big.Int, uint64, error)
 	TransferToken(
 		ctx context.Context,
 		chainID uint64,
@@ -36,6 +60,7 @@ type Client interface {
 		fromPrivateKeyHex, toAddressHex string,
 		amount *big.Int,
 	) (common.Hash, uint64, *big.Int, error)
+	WaitForReceipt(ctx context.Context, txHash common.Hash) (uint64, error)
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 	GetBaseFee(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
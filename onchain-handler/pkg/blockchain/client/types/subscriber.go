@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Subscriber pushes new logs and block headers over a persistent (websocket) connection.
+type Subscriber interface {
+	SubscribeLogs(
+		ctx context.Context,
+		contractAddresses []common.Address, // Contract addresses to filter logs
+		logs chan<- types.Log,
+	) (ethereum.Subscription, error)
+	SubscribeNewHeads(ctx context.Context, heads chan<- *types.Header) (ethereum.Subscription, error)
+	Close()
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+)
+
+// wsSubscriber keeps one websocket connection open and moves to the next endpoint when it fails
+type wsSubscriber struct {
+	endpoints []string
+	client    *ethclient.Client
+	index     int
+	mu        sync.Mutex
+}
+
+// NewWSSubscriber creates a new Subscriber over the given ws:// or wss:// endpoints
+func NewWSSubscriber(wsEndpoints []string) (clienttypes.Subscriber, error) {
+	if len(wsEndpoints) == 0 {
+		return nil, fmt.Errorf("no websocket endpoints provided")
+	}
+
+	return &wsSubscriber{
+		endpoints: wsEndpoints,
+	}, nil
+}
+
+// getClient returns the current connection, dialing the current endpoint if needed
+func (s *wsSubscriber) getClient(ctx context.Context) (*ethclient.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		return s.client, nil
+	}
+
+	client, err := ethclient.DialContext(ctx, s.endpoints[s.index])
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial websocket endpoint %s: %w", s.endpoints[s.index], err)
+	}
+	s.client = client
+	return client, nil
+}
+
+// rotate drops the failed connection and moves to the next endpoint.
+// It is a no-op if another subscription already replaced the failed connection.
+func (s *wsSubscriber) rotate(failed *ethclient.Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if failed != nil && s.client != failed {
+		return
+	}
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+	s.index = (s.index + 1) % len(s.endpoints)
+}
+
+// subscribe tries every endpoint once until one of them accepts the subscription
+func (s *wsSubscriber) subscribe(
+	ctx context.Context,
+	fn func(client *ethclient.Client) (ethereum.Subscription, error),
+) (ethereum.Subscription, error) {
+	var lastErr error
+
+	for range s.endpoints {
+		client, err := s.getClient(ctx)
+		if err == nil {
+			sub, subErr := fn(client)
+			if subErr == nil {
+				return sub, nil
+			}
+			err = subErr
+		}
+
+		lastErr = err
+		logger.GetLogger().Warnf("Websocket subscription failed: %v. Switching endpoint...", err)
+		s.rotate(client)
+	}
+
+	return nil, fmt.Errorf("all websocket endpoints failed: %w", lastErr)
+}
+
+// SubscribeLogs subscribes to the logs emitted by the given contracts
+func (s *wsSubscriber) SubscribeLogs(
+	ctx context.Context,
+	contractAddresses []common.Address,
+	logs chan<- types.Log,
+) (ethereum.Subscription, error) {
+	query := ethereum.FilterQuery{
+		Addresses: contractAddresses,
+	}
+	return s.subscribe(ctx, func(client *ethclient.Client) (ethereum.Subscription, error) {
+		return client.SubscribeFilterLogs(ctx, query, logs)
+	})
+}
+
+// SubscribeNewHeads subscribes to new block headers
+func (s *wsSubscriber) SubscribeNewHeads(ctx context.Context, heads chan<- *types.Header) (ethereum.Subscription, error) {
+	return s.subscribe(ctx, func(client *ethclient.Client) (ethereum.Subscription, error) {
+		return client.SubscribeNewHead(ctx, heads)
+	})
+}
+
+// Close closes the websocket connection
+func (s *wsSubscriber) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testEthService serves the eth_subscribe subscriptions of a websocket endpoint, notifying its block to every subscriber.
+type testEthService struct {
+	blockNumber uint64
+}
+
+func (s *testEthService) Logs(ctx context.Context, _ map[string]any) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		_ = notifier.Notify(sub.ID, types.Log{
+			Address:     common.HexToAddress("0x55d398326f99059fF775485246999027B3197955"),
+			Topics:      []common.Hash{},
+			BlockNumber: s.blockNumber,
+		})
+	}()
+	return sub, nil
+}
+
+func (s *testEthService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		_ = notifier.Notify(sub.ID, &types.Header{
+			Number:     new(big.Int).SetUint64(s.blockNumber),
+			Difficulty: big.NewInt(0),
+		})
+	}()
+	return sub, nil
+}
+
+// newTestWSEndpoint starts a websocket endpoint whose subscriptions notify blockNumber. It returns its URL
+// and a function taking it down, closing its open connections.
+func newTestWSEndpoint(t *testing.T, blockNumber uint64) (string, func()) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &testEthService{blockNumber: blockNumber}))
+	httpServer := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	stop := func() {
+		server.Stop()
+		httpServer.Close()
+	}
+	t.Cleanup(stop)
+	return "ws://" + strings.TrimPrefix(httpServer.URL, "http://"), stop
+}
+
+// newDeadWSEndpoint returns the URL of an endpoint refusing connections.
+func newDeadWSEndpoint(t *testing.T) string {
+	url, stop := newTestWSEndpoint(t, 0)
+	stop()
+	return url
+}
+
+func receiveLog(t *testing.T, logs <-chan types.Log) types.Log {
+	select {
+	case vLog := <-logs:
+		return vLog
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for a log")
+		return types.Log{}
+	}
+}
+
+func TestWSSubscriber(t *testing.T) {
+	contractAddresses := []common.Address{common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")}
+
+	t.Run("NoEndpoints", func(t *testing.T) {
+		_, err := NewWSSubscriber(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("SkipsUnreachableEndpoint", func(t *testing.T) {
+		url, _ := newTestWSEndpoint(t, 200)
+		subscriber, err := NewWSSubscriber([]string{newDeadWSEndpoint(t), url})
+		require.NoError(t, err)
+		defer subscriber.Close()
+
+		logs := make(chan types.Log, 1)
+		sub, err := subscriber.SubscribeLogs(context.Background(), contractAddresses, logs)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+		require.Equal(t, uint64(200), receiveLog(t, logs).BlockNumber)
+	})
+
+	t.Run("ResubscribesOnNextEndpointAfterDrop", func(t *testing.T) {
+		firstURL, stopFirst := newTestWSEndpoint(t, 100)
+		secondURL, _ := newTestWSEndpoint(t, 200)
+		subscriber, err := NewWSSubscriber([]string{firstURL, secondURL})
+		require.NoError(t, err)
+		defer subscriber.Close()
+
+		logs := make(chan types.Log, 1)
+		sub, err := subscriber.SubscribeLogs(context.Background(), contractAddresses, logs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), receiveLog(t, logs).BlockNumber)
+
+		// The first endpoint goes down, the subscription reports it
+		stopFirst()
+		select {
+		case err := <-sub.Err():
+			require.Error(t, err)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "the dropped subscription was not reported")
+		}
+		sub.Unsubscribe()
+
+		// Subscribing again moves to the second endpoint
+		sub, err = subscriber.SubscribeLogs(context.Background(), contractAddresses, logs)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+		require.Equal(t, uint64(200), receiveLog(t, logs).BlockNumber)
+	})
+
+	t.Run("AllEndpointsFail", func(t *testing.T) {
+		subscriber, err := NewWSSubscriber([]string{newDeadWSEndpoint(t), newDeadWSEndpoint(t)})
+		require.NoError(t, err)
+		defer subscriber.Close()
+
+		_, err = subscriber.SubscribeLogs(context.Background(), contractAddresses, make(chan types.Log))
+		require.ErrorContains(t, err, "all websocket endpoints failed")
+	})
+
+	t.Run("SubscribesNewHeads", func(t *testing.T) {
+		url, _ := newTestWSEndpoint(t, 300)
+		subscriber, err := NewWSSubscriber([]string{url})
+		require.NoError(t, err)
+		defer subscriber.Close()
+
+		heads := make(chan *types.Header, 1)
+		sub, err := subscriber.SubscribeNewHeads(context.Background(), heads)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		select {
+		case head := <-heads:
+			require.Equal(t, uint64(300), head.Number.Uint64())
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for a header")
+		}
+	})
+}
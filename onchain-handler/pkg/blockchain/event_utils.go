@@ -19,6 +19,19 @@ type TransferEvent struct {
 	Value *big.Int
 }
 
+// ApprovalEvent represents the structure of an ERC-20 approval event.
+type ApprovalEvent struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+}
+
+// AuthorizationUsedEvent represents the structure of an EIP-3009 authorization used event.
+type AuthorizationUsedEvent struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
 func UnpackTransferEvent(vLog types.Log, parsedABI abi.ABI) (TransferEvent, error) {
 	var transferEvent TransferEvent
 
@@ -27,6 +40,11 @@ func UnpackTransferEvent(vLog types.Log, parsedABI abi.ABI) (TransferEvent, erro
 		return transferEvent, fmt.Errorf("invalid number of topics in log")
 	}
 
+	// Approval logs have the same shape, so the event signature must be checked too
+	if event, ok := parsedABI.Events[constants.TransferEventName]; ok && vLog.Topics[0] != event.ID {
+		return transferEvent, fmt.Errorf("log is not a %s event", constants.TransferEventName)
+	}
+
 	// The first topic is the event signature, so we skip it.
 	// The second topic is the "from" address, and the third is the "to" address.
 	transferEvent.From = common.HexToAddress(vLog.Topics[1].Hex())
@@ -41,3 +59,38 @@ func UnpackTransferEvent(vLog types.Log, parsedABI abi.ABI) (TransferEvent, erro
 
 	return transferEvent, nil
 }
+
+// UnpackEvent decodes the indexed topics and the data of a log into out, a pointer to a struct whose fields
+// are named after the event arguments in camel case.
+func UnpackEvent(vLog types.Log, parsedABI abi.ABI, eventName string, out any) error {
+	event, ok := parsedABI.Events[eventName]
+	if !ok {
+		return fmt.Errorf("event %s not found in ABI", eventName)
+	}
+	if len(vLog.Topics) == 0 || vLog.Topics[0] != event.ID {
+		return fmt.Errorf("log is not a %s event", eventName)
+	}
+
+	// Unpack the non-indexed parameters from the data field
+	if len(vLog.Data) > 0 {
+		if err := parsedABI.UnpackIntoInterface(out, eventName, vLog.Data); err != nil {
+			return fmt.Errorf("failed to unpack %s event data: %w", eventName, err)
+		}
+	}
+
+	// The first topic is the event signature, the following ones are the indexed parameters
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if len(vLog.Topics)-1 != len(indexed) {
+		return fmt.Errorf("invalid number of topics in %s log", eventName)
+	}
+	if err := abi.ParseTopics(out, indexed, vLog.Topics[1:]); err != nil {
+		return fmt.Errorf("failed to unpack %s event topics: %w", eventName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package blockchain
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+func TestUnpackEvent(t *testing.T) {
+	transferABI, err := abi.JSON(strings.NewReader(constants.Erc20TransferEventABI))
+	require.NoError(t, err)
+	approvalABI, err := abi.JSON(strings.NewReader(constants.Erc20ApprovalEventABI))
+	require.NoError(t, err)
+	authorizationABI, err := abi.JSON(strings.NewReader(constants.Eip3009AuthorizationUsedEventABI))
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	spender := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	value := big.NewInt(1500)
+	approvalLog := types.Log{
+		Topics: []common.Hash{
+			approvalABI.Events[constants.ApprovalEventName].ID,
+			common.BytesToHash(owner.Bytes()),
+			common.BytesToHash(spender.Bytes()),
+		},
+		Data: common.LeftPadBytes(value.Bytes(), 32),
+	}
+
+	t.Run("DecodesIndexedAndDataArguments", func(t *testing.T) {
+		var event ApprovalEvent
+		require.NoError(t, UnpackEvent(approvalLog, approvalABI, constants.ApprovalEventName, &event))
+		require.Equal(t, owner, event.Owner)
+		require.Equal(t, spender, event.Spender)
+		require.Equal(t, value, event.Value)
+	})
+
+	t.Run("DecodesEventsWithoutData", func(t *testing.T) {
+		nonce := common.HexToHash("0xabc")
+		authorizationLog := types.Log{
+			Topics: []common.Hash{
+				authorizationABI.Events[constants.AuthorizationUsedEventName].ID,
+				common.BytesToHash(owner.Bytes()),
+				nonce,
+			},
+		}
+
+		var event AuthorizationUsedEvent
+		require.NoError(t, UnpackEvent(authorizationLog, authorizationABI, constants.AuthorizationUsedEventName, &event))
+		require.Equal(t, owner, event.Authorizer)
+		require.Equal(t, [32]byte(nonce), event.Nonce)
+	})
+
+	t.Run("RejectsOtherEvents", func(t *testing.T) {
+		var event TransferEvent
+		require.Error(t, UnpackEvent(approvalLog, transferABI, constants.TransferEventName, &event))
+
+		_, err := UnpackTransferEvent(approvalLog, transferABI)
+		require.Error(t, err)
+	})
+}
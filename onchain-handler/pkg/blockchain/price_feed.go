@@ -0,0 +1,44 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	clienttypes "github.com/genefriendway/onchain-handler/pkg/blockchain/client/types"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// GetPriceFromFeed returns the latest answer of a Chainlink price feed, e.g. the USD price of the native token.
+// It fails when the answer is not positive or older than PriceFeedMaxAge.
+func GetPriceFromFeed(ctx context.Context, ethClient clienttypes.Client, feedAddress string) (string, error) {
+	feed := common.HexToAddress(feedAddress)
+
+	outputs, err := ethClient.CallContract(ctx, feed, constants.PriceFeedABI, constants.PriceFeedDecimalsMethod)
+	if err != nil {
+		return "", fmt.Errorf("failed to get decimals of price feed %s: %w", feedAddress, err)
+	}
+	decimals, ok := outputs[0].(uint8)
+	if !ok {
+		return "", fmt.Errorf("unexpected decimals of price feed %s: %v", feedAddress, outputs[0])
+	}
+
+	outputs, err = ethClient.CallContract(ctx, feed, constants.PriceFeedABI, constants.PriceFeedLatestRoundData)
+	if err != nil {
+		return "", fmt.Errorf("failed to get latest round of price feed %s: %w", feedAddress, err)
+	}
+	answer, ok := outputs[1].(*big.Int)
+	if !ok || answer.Sign() <= 0 {
+		return "", fmt.Errorf("invalid answer of price feed %s: %v", feedAddress, outputs[1])
+	}
+	updatedAt, ok := outputs[3].(*big.Int)
+	if !ok || time.Since(time.Unix(updatedAt.Int64(), 0)) > constants.PriceFeedMaxAge {
+		return "", fmt.Errorf("stale answer of price feed %s, updated at %v", feedAddress, outputs[3])
+	}
+
+	return utils.ConvertSmallestUnitToFloatToken(answer.String(), decimals)
+}
@@ -94,6 +94,8 @@ func GetNativeTokenSymbol(network constants.NetworkType) (string, error) {
 		return "AVAX", nil
 	case constants.Bsc:
 		return "BNB", nil
+	case constants.Tron:
+		return "TRX", nil
 	default:
 		return "", fmt.Errorf("unsupported network type: %s", network)
 	}
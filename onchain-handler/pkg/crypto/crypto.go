@@ -87,6 +87,17 @@ func HashToUint32(input string) uint32 {
 }
 
 func GenerateAccount(mnemonic, passphrase, salt string, walletType constants.WalletType, id uint64) (*accounts.Account, *ecdsa.PrivateKey, error) {
+	return GenerateAccountForCoinType(constants.EVMCoinType, mnemonic, passphrase, salt, walletType, id)
+}
+
+// GenerateAccountForCoinType derives the account of the wallet with the BIP-44 coin type of a chain family,
+// so the same wallet has a distinct key on every family. The address is the 20 bytes account of the key.
+func GenerateAccountForCoinType(
+	coinType uint32,
+	mnemonic, passphrase, salt string,
+	walletType constants.WalletType,
+	id uint64,
+) (*accounts.Account, *ecdsa.PrivateKey, error) {
 	// Generate the seed from the mnemonic and passphrase
 	seed := bip39.NewSeed(mnemonic, passphrase)
 
@@ -99,10 +110,10 @@ func GenerateAccount(mnemonic, passphrase, salt string, walletType constants.Wal
 	// Convert walletType to a unique integer for use in the HD Path
 	walletTypeHash := HashToUint32(string(walletType) + fmt.Sprint(id))
 
-	// Define the HD Path for Ethereum address (e.g., m/44'/60'/id'/walletTypeHash/salt)
+	// Define the HD Path for the address (e.g., m/44'/60'/id'/walletTypeHash/salt on Ethereum)
 	path := []uint32{
 		44 + bip32.FirstHardenedChild,         // BIP44 purpose field
-		60 + bip32.FirstHardenedChild,         // Ethereum coin type
+		coinType + bip32.FirstHardenedChild,   // Coin type of the chain family
 		uint32(id) + bip32.FirstHardenedChild, // User-specific field
 		walletTypeHash,                        // Unique integer based on wallet type and id
 		HashToUint32(salt),                    // Hash of salt for additional security
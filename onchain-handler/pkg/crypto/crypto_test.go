@@ -3,9 +3,14 @@ package crypto
 import (
 	"crypto/ecdsa"
 	"encoding/base64"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/genefriendway/onchain-handler/constants"
@@ -149,6 +154,22 @@ func TestGenerateAccount(t *testing.T) {
 		require.NotNil(t, privateKey)
 		require.Equal(t, account.Address, crypto.PubkeyToAddress(privateKey.PublicKey))
 	})
+
+	t.Run("DistinctPerCoinType", func(t *testing.T) {
+		mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+		walletType := constants.WalletType("test-wallet")
+
+		evmAccount, _, err := GenerateAccount(mnemonic, "", "test-salt", walletType, 1)
+		require.NoError(t, err)
+		sameAccount, _, err := GenerateAccountForCoinType(constants.EVMCoinType, mnemonic, "", "test-salt", walletType, 1)
+		require.NoError(t, err)
+		tronAccount, tronKey, err := GenerateAccountForCoinType(constants.TronCoinType, mnemonic, "", "test-salt", walletType, 1)
+		require.NoError(t, err)
+
+		require.Equal(t, evmAccount.Address, sameAccount.Address)
+		require.NotEqual(t, evmAccount.Address, tronAccount.Address)
+		require.Equal(t, tronAccount.Address, crypto.PubkeyToAddress(tronKey.PublicKey))
+	})
 }
 
 func TestPubkeyToAddress(t *testing.T) {
@@ -206,3 +227,126 @@ func TestPrivateKeyToHex(t *testing.T) {
 		require.EqualError(t, err, "private key is nil")
 	})
 }
+
+func TestRecoverTypedDataSigner(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	domain := apitypes.TypedDataDomain{
+		Name:              "USD Coin",
+		Version:           "2",
+		ChainId:           math.NewHexOrDecimal256(43113),
+		VerifyingContract: "0x5425890298aed601595a70AB815c96711a31Bc65",
+	}
+	domainTypedData := apitypes.TypedData{
+		Types: apitypes.Types{"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		}},
+		Domain: domain,
+	}
+	domainSeparator, err := domainTypedData.HashStruct("EIP712Domain", domain.Map())
+	require.NoError(t, err)
+
+	sign := func(digest common.Hash) string {
+		signature, err := crypto.Sign(digest.Bytes(), privateKey)
+		require.NoError(t, err)
+		signature[64] += 27
+		return hexutil.Encode(signature)
+	}
+
+	t.Run("TransferWithAuthorization", func(t *testing.T) {
+		to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		nonce := common.HexToHash("0x01")
+		typedData := apitypes.TypedData{
+			Types: apitypes.Types{
+				"EIP712Domain": {
+					{Name: "name", Type: "string"},
+					{Name: "version", Type: "string"},
+					{Name: "chainId", Type: "uint256"},
+					{Name: "verifyingContract", Type: "address"},
+				},
+				"TransferWithAuthorization": {
+					{Name: "from", Type: "address"},
+					{Name: "to", Type: "address"},
+					{Name: "value", Type: "uint256"},
+					{Name: "validAfter", Type: "uint256"},
+					{Name: "validBefore", Type: "uint256"},
+					{Name: "nonce", Type: "bytes32"},
+				},
+			},
+			PrimaryType: "TransferWithAuthorization",
+			Domain:      domain,
+			Message: apitypes.TypedDataMessage{
+				"from":        signer.Hex(),
+				"to":          to.Hex(),
+				"value":       "1000000",
+				"validAfter":  "0",
+				"validBefore": "1900000000",
+				"nonce":       nonce.Hex(),
+			},
+		}
+		expected, _, err := apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+
+		digest := TransferWithAuthorizationDigest(
+			common.BytesToHash(domainSeparator), signer, to,
+			big.NewInt(1000000), big.NewInt(0), big.NewInt(1900000000), nonce,
+		)
+		require.Equal(t, common.BytesToHash(expected), digest)
+
+		recovered, err := RecoverTypedDataSigner(digest, sign(digest))
+		require.NoError(t, err)
+		require.Equal(t, signer, recovered)
+	})
+
+	t.Run("Permit", func(t *testing.T) {
+		spender := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+		typedData := apitypes.TypedData{
+			Types: apitypes.Types{
+				"EIP712Domain": {
+					{Name: "name", Type: "string"},
+					{Name: "version", Type: "string"},
+					{Name: "chainId", Type: "uint256"},
+					{Name: "verifyingContract", Type: "address"},
+				},
+				"Permit": {
+					{Name: "owner", Type: "address"},
+					{Name: "spender", Type: "address"},
+					{Name: "value", Type: "uint256"},
+					{Name: "nonce", Type: "uint256"},
+					{Name: "deadline", Type: "uint256"},
+				},
+			},
+			PrimaryType: "Permit",
+			Domain:      domain,
+			Message: apitypes.TypedDataMessage{
+				"owner":    signer.Hex(),
+				"spender":  spender.Hex(),
+				"value":    "5000000",
+				"nonce":    "3",
+				"deadline": "1900000000",
+			},
+		}
+		expected, _, err := apitypes.TypedDataAndHash(typedData)
+		require.NoError(t, err)
+
+		digest := PermitDigest(
+			common.BytesToHash(domainSeparator), signer, spender,
+			big.NewInt(5000000), big.NewInt(3), big.NewInt(1900000000),
+		)
+		require.Equal(t, common.BytesToHash(expected), digest)
+
+		recovered, err := RecoverTypedDataSigner(digest, sign(digest))
+		require.NoError(t, err)
+		require.Equal(t, signer, recovered)
+	})
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		_, err := RecoverTypedDataSigner(common.Hash{}, "0x1234")
+		require.Error(t, err)
+	})
+}
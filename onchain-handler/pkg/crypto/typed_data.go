@@ -0,0 +1,109 @@
+package crypto
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// EIP-3009 TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)
+	transferWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte(
+		"TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)",
+	))
+	// EIP-2612 Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)
+	permitTypeHash = crypto.Keccak256Hash([]byte(
+		"Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)",
+	))
+)
+
+// TransferWithAuthorizationDigest returns the EIP-712 digest signed by the payer of an EIP-3009 transferWithAuthorization.
+func TransferWithAuthorizationDigest(
+	domainSeparator common.Hash,
+	from, to common.Address,
+	value, validAfter, validBefore *big.Int,
+	nonce common.Hash,
+) common.Hash {
+	structHash := crypto.Keccak256Hash(
+		transferWithAuthorizationTypeHash.Bytes(),
+		common.LeftPadBytes(from.Bytes(), 32),
+		common.LeftPadBytes(to.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(value)),
+		math.U256Bytes(new(big.Int).Set(validAfter)),
+		math.U256Bytes(new(big.Int).Set(validBefore)),
+		nonce.Bytes(),
+	)
+	return typedDataDigest(domainSeparator, structHash)
+}
+
+// PermitDigest returns the EIP-712 digest signed by the token owner of an EIP-2612 permit.
+func PermitDigest(
+	domainSeparator common.Hash,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) common.Hash {
+	structHash := crypto.Keccak256Hash(
+		permitTypeHash.Bytes(),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(value)),
+		math.U256Bytes(new(big.Int).Set(nonce)),
+		math.U256Bytes(new(big.Int).Set(deadline)),
+	)
+	return typedDataDigest(domainSeparator, structHash)
+}
+
+func typedDataDigest(domainSeparator, structHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// SplitSignature decodes a 65 bytes hex signature (r || s || v) and returns its components.
+// v is returned as 27 or 28, the form expected by token contracts.
+func SplitSignature(signatureHex string) (v uint8, r, s [32]byte, err error) {
+	signature, err := hexutil.Decode(signatureHex)
+	if err != nil {
+		return 0, r, s, fmt.Errorf("failed to decode hex signature: %w", err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		return 0, r, s, fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+
+	copy(r[:], signature[:32])
+	copy(s[:], signature[32:64])
+	v = signature[64]
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return 0, r, s, fmt.Errorf("invalid signature recovery id: %d", signature[64])
+	}
+	return v, r, s, nil
+}
+
+// RecoverTypedDataSigner returns the address that signed the EIP-712 digest with the given hex signature.
+func RecoverTypedDataSigner(digest common.Hash, signatureHex string) (common.Address, error) {
+	v, r, s, err := SplitSignature(signatureHex)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// Reject malleable signatures, token contracts do the same
+	if !crypto.ValidateSignatureValues(v-27, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, fmt.Errorf("invalid signature values")
+	}
+
+	signature := make([]byte, crypto.SignatureLength)
+	copy(signature[:32], r[:])
+	copy(signature[32:64], s[:])
+	signature[64] = v - 27
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
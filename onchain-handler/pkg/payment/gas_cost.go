@@ -0,0 +1,46 @@
+package payment
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// SplitProportionally splits total into shares proportional to the weights. The shares are rounded down and the
+// remainder goes to the first largest weight so that they add up to total. All shares are 0 when the weights add up to 0.
+func SplitProportionally(total *big.Int, weights []*big.Int) []*big.Int {
+	shares := make([]*big.Int, len(weights))
+	weightSum := big.NewInt(0)
+	largest := -1
+	for i, weight := range weights {
+		shares[i] = big.NewInt(0)
+		weightSum.Add(weightSum, weight)
+		if largest < 0 || weight.Cmp(weights[largest]) > 0 {
+			largest = i
+		}
+	}
+	if weightSum.Sign() <= 0 {
+		return shares
+	}
+
+	remainder := new(big.Int).Set(total)
+	for i, weight := range weights {
+		shares[i].Mul(total, weight).Quo(shares[i], weightSum)
+		remainder.Sub(remainder, shares[i])
+	}
+	shares[largest].Add(shares[largest], remainder)
+	return shares
+}
+
+// ConvertToUSD returns amount * price formatted with the given decimal places, e.g. the USD value of a fee
+// paid in native token units at the native token USD price.
+func ConvertToUSD(amount, price string, decimals int) (string, error) {
+	amountRat, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return "", fmt.Errorf("invalid amount: %s", amount)
+	}
+	priceRat, ok := new(big.Rat).SetString(price)
+	if !ok {
+		return "", fmt.Errorf("invalid price: %s", price)
+	}
+	return new(big.Rat).Mul(amountRat, priceRat).FloatString(decimals), nil
+}
@@ -0,0 +1,40 @@
+package payment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitProportionally(t *testing.T) {
+	weights := func(values ...int64) []*big.Int {
+		result := make([]*big.Int, 0, len(values))
+		for _, value := range values {
+			result = append(result, big.NewInt(value))
+		}
+		return result
+	}
+
+	t.Run("Proportional", func(t *testing.T) {
+		require.Equal(t, weights(25, 75), SplitProportionally(big.NewInt(100), weights(1, 3)))
+	})
+
+	t.Run("RemainderToLargestWeight", func(t *testing.T) {
+		require.Equal(t, weights(25, 51, 25), SplitProportionally(big.NewInt(101), weights(10, 20, 10)))
+		require.Equal(t, weights(4, 3, 3), SplitProportionally(big.NewInt(10), weights(1, 1, 1)))
+	})
+
+	t.Run("ZeroWeights", func(t *testing.T) {
+		require.Equal(t, weights(0, 0), SplitProportionally(big.NewInt(100), weights(0, 0)))
+	})
+}
+
+func TestConvertToUSD(t *testing.T) {
+	usd, err := ConvertToUSD("0.0015", "600.5", 18)
+	require.NoError(t, err)
+	require.Equal(t, "0.900750000000000000", usd)
+
+	_, err = ConvertToUSD("0.0015", "", 18)
+	require.Error(t, err)
+}
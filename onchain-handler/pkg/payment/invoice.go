@@ -0,0 +1,46 @@
+package payment
+
+import (
+	"math/big"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+// InvoiceLine is the payment order of an invoice line item, its amounts are in the same unit as the invoice total.
+type InvoiceLine struct {
+	Amount      *big.Int
+	Transferred *big.Int
+	Status      string
+}
+
+// EvaluateInvoice returns the status and settled amount of an invoice of the given total. A paid order settles
+// at least its amount, so an order accepted within its under tolerance does not hold the invoice back.
+// The invoice is PAID once the settled amount meets the total, FAILED when every order is closed before that,
+// PARTIAL when something is settled and PENDING otherwise.
+func EvaluateInvoice(total *big.Int, lines []InvoiceLine) (string, *big.Int) {
+	settled := big.NewInt(0)
+	allClosed := true
+
+	for _, line := range lines {
+		lineSettled := line.Transferred
+		if constants.IsPaidStatus(line.Status) && lineSettled.Cmp(line.Amount) < 0 {
+			lineSettled = line.Amount
+		}
+		settled.Add(settled, lineSettled)
+
+		if !constants.IsPaidStatus(line.Status) && line.Status != constants.Failed && line.Status != constants.Cancelled {
+			allClosed = false
+		}
+	}
+
+	switch {
+	case settled.Cmp(total) >= 0:
+		return constants.InvoicePaid, settled
+	case allClosed:
+		return constants.InvoiceFailed, settled
+	case settled.Sign() > 0:
+		return constants.InvoicePartial, settled
+	default:
+		return constants.InvoicePending, settled
+	}
+}
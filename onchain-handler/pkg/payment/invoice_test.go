@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+func TestEvaluateInvoice(t *testing.T) {
+	total := big.NewInt(300)
+	line := func(amount, transferred int64, status string) InvoiceLine {
+		return InvoiceLine{Amount: big.NewInt(amount), Transferred: big.NewInt(transferred), Status: status}
+	}
+
+	t.Run("NothingPaid", func(t *testing.T) {
+		status, settled := EvaluateInvoice(total, []InvoiceLine{line(100, 0, constants.Pending), line(200, 0, constants.Pending)})
+		require.Equal(t, constants.InvoicePending, status)
+		require.Zero(t, settled.Sign())
+	})
+
+	t.Run("PartiallyPaid", func(t *testing.T) {
+		status, settled := EvaluateInvoice(total, []InvoiceLine{line(100, 100, constants.Success), line(200, 50, constants.Partial)})
+		require.Equal(t, constants.InvoicePartial, status)
+		require.Equal(t, int64(150), settled.Int64())
+	})
+
+	t.Run("OverpaymentCoversAnotherLine", func(t *testing.T) {
+		status, settled := EvaluateInvoice(total, []InvoiceLine{line(100, 300, constants.Overpaid), line(200, 0, constants.Pending)})
+		require.Equal(t, constants.InvoicePaid, status)
+		require.Equal(t, int64(300), settled.Int64())
+	})
+
+	t.Run("PaidWithinTolerance", func(t *testing.T) {
+		status, settled := EvaluateInvoice(total, []InvoiceLine{line(100, 99, constants.Success), line(200, 199, constants.Success)})
+		require.Equal(t, constants.InvoicePaid, status)
+		require.Equal(t, int64(300), settled.Int64())
+	})
+
+	t.Run("ClosedBeforeTotal", func(t *testing.T) {
+		status, _ := EvaluateInvoice(total, []InvoiceLine{line(100, 100, constants.Success), line(200, 20, constants.Failed)})
+		require.Equal(t, constants.InvoiceFailed, status)
+	})
+
+	t.Run("ExpiredOrdersCanStillBePaid", func(t *testing.T) {
+		status, _ := EvaluateInvoice(total, []InvoiceLine{line(100, 100, constants.Success), line(200, 0, constants.Expired)})
+		require.Equal(t, constants.InvoicePartial, status)
+	})
+}
@@ -0,0 +1,20 @@
+package payment
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BuildEIP681TransferURI returns the EIP-681 URI of an ERC-20 transfer of amount (in the smallest token unit)
+// to the recipient, e.g. ethereum:<token>@56/transfer?address=<recipient>&uint256=<amount>.
+func BuildEIP681TransferURI(tokenAddress string, chainID uint64, recipient string, amount *big.Int) string {
+	return fmt.Sprintf(
+		"ethereum:%s@%d/transfer?address=%s&uint256=%s",
+		common.HexToAddress(tokenAddress).Hex(),
+		chainID,
+		common.HexToAddress(recipient).Hex(),
+		amount.String(),
+	)
+}
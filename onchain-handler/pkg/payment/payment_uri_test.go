@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildEIP681TransferURI(t *testing.T) {
+	maxUint256, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+
+	tests := []struct {
+		name      string
+		token     string
+		chainID   uint64
+		recipient string
+		amount    *big.Int
+		expected  string
+	}{
+		{
+			name:      "BscUSDT",
+			token:     "0x55d398326f99059fF775485246999027B3197955",
+			chainID:   56,
+			recipient: "0x1111111111111111111111111111111111111111",
+			amount:    big.NewInt(10_000_000_000_000_000),
+			expected:  "ethereum:0x55d398326f99059fF775485246999027B3197955@56/transfer?address=0x1111111111111111111111111111111111111111&uint256=10000000000000000",
+		},
+		{
+			name:      "AvaxUSDC",
+			token:     "0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E",
+			chainID:   43114,
+			recipient: "0x2222222222222222222222222222222222222222",
+			amount:    big.NewInt(2_500_000),
+			expected:  "ethereum:0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E@43114/transfer?address=0x2222222222222222222222222222222222222222&uint256=2500000",
+		},
+		{
+			name:      "AddressesChecksummed",
+			token:     "0x55d398326f99059ff775485246999027b3197955",
+			chainID:   97,
+			recipient: "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd",
+			amount:    big.NewInt(1),
+			expected:  "ethereum:0x55d398326f99059fF775485246999027B3197955@97/transfer?address=0xABcdEFABcdEFabcdEfAbCdefabcdeFABcDEFabCD&uint256=1",
+		},
+		{
+			name:      "AmountInPlainDecimal",
+			token:     "0x55d398326f99059fF775485246999027B3197955",
+			chainID:   56,
+			recipient: "0x1111111111111111111111111111111111111111",
+			amount:    maxUint256,
+			expected:  "ethereum:0x55d398326f99059fF775485246999027B3197955@56/transfer?address=0x1111111111111111111111111111111111111111&uint256=115792089237316195423570985008687907853269984665640564039457584007913129639935",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, BuildEIP681TransferURI(test.token, test.chainID, test.recipient, test.amount))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package payment
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// TolerancePolicy sets how far the transferred amount of an order may be from the order amount.
+// Each side has an absolute limit in token units (e.g. 1 for 1 USDT) and a percentage limit of the order amount
+// (e.g. 0.5 for 0.5%). A nil limit is not set, when both limits of a side are set the smaller one applies.
+// Without any under limit the order must be paid in full, without any over limit no payment is flagged as overpaid.
+type TolerancePolicy struct {
+	UnderAbsolute   *float64
+	UnderPercentage *float64
+	OverAbsolute    *float64
+	OverPercentage  *float64
+}
+
+// EvaluatePayment returns the status of an order of the given amount once the transferred amount is paid to it:
+// PENDING when nothing is transferred, PARTIAL below the under tolerance, OVERPAID above the over tolerance
+// and SUCCESS otherwise. Both amounts are in the token smallest unit.
+func EvaluatePayment(amount, transferred *big.Int, policy TolerancePolicy, decimals uint8) string {
+	if transferred.Sign() <= 0 {
+		return constants.Pending
+	}
+
+	// The minimum accepted amount never goes below 0
+	minimumAcceptedAmount := new(big.Int).Set(amount)
+	if underTolerance := toleranceOf(amount, policy.UnderAbsolute, policy.UnderPercentage, decimals); underTolerance != nil {
+		minimumAcceptedAmount.Sub(minimumAcceptedAmount, underTolerance)
+		if minimumAcceptedAmount.Sign() < 0 {
+			minimumAcceptedAmount.SetInt64(0)
+		}
+	}
+	if transferred.Cmp(minimumAcceptedAmount) < 0 {
+		return constants.Partial
+	}
+
+	overTolerance := toleranceOf(amount, policy.OverAbsolute, policy.OverPercentage, decimals)
+	if overTolerance != nil && transferred.Cmp(new(big.Int).Add(amount, overTolerance)) > 0 {
+		return constants.Overpaid
+	}
+	return constants.Success
+}
+
+// toleranceOf returns the allowed difference from amount in the token smallest unit, or nil when no limit is set.
+func toleranceOf(amount *big.Int, absolute, percentage *float64, decimals uint8) *big.Int {
+	var tolerance *big.Int
+
+	if absolute != nil {
+		absoluteTolerance, err := utils.ConvertFloatTokenToSmallestUnit(strconv.FormatFloat(*absolute, 'f', -1, 64), decimals)
+		if err != nil {
+			// Ignore the limit rather than accept any amount
+			logger.GetLogger().Errorf("Failed to convert absolute tolerance %v to smallest unit: %v", *absolute, err)
+		} else {
+			tolerance = absoluteTolerance
+		}
+	}
+
+	if percentage != nil {
+		// amount * percentage / 100, rounded down
+		ratio := new(big.Rat).SetFloat64(*percentage / 100)
+		if ratio == nil {
+			logger.GetLogger().Errorf("Invalid percentage tolerance: %v", *percentage)
+			return tolerance
+		}
+		percentageAmount := new(big.Rat).Mul(new(big.Rat).SetInt(amount), ratio)
+		percentageTolerance := new(big.Int).Quo(percentageAmount.Num(), percentageAmount.Denom())
+		if tolerance == nil || percentageTolerance.Cmp(tolerance) < 0 {
+			tolerance = percentageTolerance
+		}
+	}
+
+	return tolerance
+}
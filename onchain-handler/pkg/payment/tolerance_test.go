@@ -0,0 +1,55 @@
+package payment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+func TestEvaluatePayment(t *testing.T) {
+	const decimals = 6
+	amount := big.NewInt(100_000_000) // 100 tokens
+	float := func(value float64) *float64 { return &value }
+
+	t.Run("NothingTransferred", func(t *testing.T) {
+		require.Equal(t, constants.Pending, EvaluatePayment(amount, big.NewInt(0), TolerancePolicy{}, decimals))
+	})
+
+	t.Run("ExactPaymentWithoutPolicy", func(t *testing.T) {
+		require.Equal(t, constants.Partial, EvaluatePayment(amount, big.NewInt(99_999_999), TolerancePolicy{}, decimals))
+		require.Equal(t, constants.Success, EvaluatePayment(amount, big.NewInt(100_000_000), TolerancePolicy{}, decimals))
+		require.Equal(t, constants.Success, EvaluatePayment(amount, big.NewInt(500_000_000), TolerancePolicy{}, decimals))
+	})
+
+	t.Run("UnderAbsolute", func(t *testing.T) {
+		policy := TolerancePolicy{UnderAbsolute: float(1)}
+		require.Equal(t, constants.Success, EvaluatePayment(amount, big.NewInt(99_000_000), policy, decimals))
+		require.Equal(t, constants.Partial, EvaluatePayment(amount, big.NewInt(98_999_999), policy, decimals))
+	})
+
+	t.Run("UnderPercentage", func(t *testing.T) {
+		policy := TolerancePolicy{UnderPercentage: float(2)}
+		require.Equal(t, constants.Success, EvaluatePayment(amount, big.NewInt(98_000_000), policy, decimals))
+		require.Equal(t, constants.Partial, EvaluatePayment(amount, big.NewInt(97_999_999), policy, decimals))
+	})
+
+	t.Run("UnderBothUsesSmallerLimit", func(t *testing.T) {
+		policy := TolerancePolicy{UnderAbsolute: float(1), UnderPercentage: float(2)}
+		require.Equal(t, constants.Partial, EvaluatePayment(amount, big.NewInt(98_500_000), policy, decimals))
+		require.Equal(t, constants.Success, EvaluatePayment(amount, big.NewInt(99_000_000), policy, decimals))
+	})
+
+	t.Run("UnderAboveAmount", func(t *testing.T) {
+		policy := TolerancePolicy{UnderAbsolute: float(200)}
+		require.Equal(t, constants.Success, EvaluatePayment(amount, big.NewInt(1), policy, decimals))
+	})
+
+	t.Run("Overpaid", func(t *testing.T) {
+		policy := TolerancePolicy{OverAbsolute: float(5), OverPercentage: float(1)}
+		require.Equal(t, constants.Success, EvaluatePayment(amount, big.NewInt(101_000_000), policy, decimals))
+		require.Equal(t, constants.Overpaid, EvaluatePayment(amount, big.NewInt(101_000_001), policy, decimals))
+	})
+}
@@ -0,0 +1,74 @@
+package payment
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/pkg/logger"
+	"github.com/genefriendway/onchain-handler/pkg/utils"
+)
+
+// TransferPolicy guards the outbound transfers of a network and token. Limits are in token units (e.g. 100 for 100 USDT),
+// a nil approval threshold lets every transfer through without approval and a nil daily limit sets no limit.
+type TransferPolicy struct {
+	ApprovalThreshold *float64 // Transfers above it wait for RequiredApprovals approvals, 0 holds every transfer
+	RequiredApprovals uint
+	DailyLimit        *float64 // Total sent per UTC day
+	AllowListOnly     bool     // Only transfers to an allow-listed address are sent
+}
+
+// EvaluateTransfer returns the decision of the policy on a transfer of the amount, in token units, with its reason:
+// BLOCKED when the destination is not allowed, HELD above the approval threshold and AUTO_APPROVED otherwise.
+func EvaluateTransfer(amount string, policy TransferPolicy, allowListed bool) (decision, reason string) {
+	if policy.AllowListOnly && !allowListed {
+		return constants.TransferDecisionBlocked, "destination address is not on the allow-list"
+	}
+	if policy.ApprovalThreshold == nil {
+		return constants.TransferDecisionAutoApproved, ""
+	}
+
+	threshold, err := tokenUnits(*policy.ApprovalThreshold)
+	if err != nil {
+		// Hold rather than let the transfer through
+		logger.GetLogger().Errorf("Failed to convert approval threshold %v: %v", *policy.ApprovalThreshold, err)
+		return constants.TransferDecisionHeld, "invalid approval threshold"
+	}
+	transferAmount, err := utils.ConvertFloatTokenToSmallestUnit(amount, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		logger.GetLogger().Errorf("Failed to convert transfer amount %s: %v", amount, err)
+		return constants.TransferDecisionHeld, "invalid transfer amount"
+	}
+	if transferAmount.Cmp(threshold) > 0 {
+		return constants.TransferDecisionHeld, "amount is above the approval threshold"
+	}
+	return constants.TransferDecisionAutoApproved, ""
+}
+
+// DailyLimitRoom returns how much can still be sent today under the policy, in token units with
+// PaymentAmountDecimalPlaces decimals, given the amount already sent today. It returns nil without a daily limit.
+func DailyLimitRoom(policy TransferPolicy, sentToday string) (*big.Int, error) {
+	if policy.DailyLimit == nil {
+		return nil, nil
+	}
+
+	limit, err := tokenUnits(*policy.DailyLimit)
+	if err != nil {
+		return nil, err
+	}
+	sent, err := utils.ConvertFloatTokenToSmallestUnit(sentToday, constants.PaymentAmountDecimalPlaces)
+	if err != nil {
+		return nil, err
+	}
+
+	room := limit.Sub(limit, sent)
+	if room.Sign() < 0 {
+		room.SetInt64(0)
+	}
+	return room, nil
+}
+
+// tokenUnits converts a policy limit to token units with PaymentAmountDecimalPlaces decimals.
+func tokenUnits(limit float64) (*big.Int, error) {
+	return utils.ConvertFloatTokenToSmallestUnit(strconv.FormatFloat(limit, 'f', -1, 64), constants.PaymentAmountDecimalPlaces)
+}
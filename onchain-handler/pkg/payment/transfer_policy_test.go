@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+func TestEvaluateTransfer(t *testing.T) {
+	float := func(value float64) *float64 { return &value }
+
+	t.Run("NoThreshold", func(t *testing.T) {
+		decision, _ := EvaluateTransfer("1000000", TransferPolicy{}, false)
+		require.Equal(t, constants.TransferDecisionAutoApproved, decision)
+	})
+
+	t.Run("Threshold", func(t *testing.T) {
+		policy := TransferPolicy{ApprovalThreshold: float(100), RequiredApprovals: 2}
+		decision, _ := EvaluateTransfer("100", policy, false)
+		require.Equal(t, constants.TransferDecisionAutoApproved, decision)
+		decision, _ = EvaluateTransfer("100.000001", policy, false)
+		require.Equal(t, constants.TransferDecisionHeld, decision)
+	})
+
+	t.Run("ZeroThresholdHoldsEveryTransfer", func(t *testing.T) {
+		decision, _ := EvaluateTransfer("0.01", TransferPolicy{ApprovalThreshold: float(0)}, false)
+		require.Equal(t, constants.TransferDecisionHeld, decision)
+	})
+
+	t.Run("AllowList", func(t *testing.T) {
+		policy := TransferPolicy{ApprovalThreshold: float(100), AllowListOnly: true}
+		decision, _ := EvaluateTransfer("1", policy, false)
+		require.Equal(t, constants.TransferDecisionBlocked, decision)
+		decision, _ = EvaluateTransfer("1", policy, true)
+		require.Equal(t, constants.TransferDecisionAutoApproved, decision)
+		decision, _ = EvaluateTransfer("101", policy, true)
+		require.Equal(t, constants.TransferDecisionHeld, decision)
+	})
+}
+
+func TestDailyLimitRoom(t *testing.T) {
+	float := func(value float64) *float64 { return &value }
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(constants.PaymentAmountDecimalPlaces), nil)
+
+	room, err := DailyLimitRoom(TransferPolicy{}, "500")
+	require.NoError(t, err)
+	require.Nil(t, room)
+
+	room, err = DailyLimitRoom(TransferPolicy{DailyLimit: float(1000)}, "250.5")
+	require.NoError(t, err)
+	expected := new(big.Int).Mul(big.NewInt(7495), unit)
+	require.Equal(t, 0, room.Cmp(expected.Div(expected, big.NewInt(10))))
+
+	room, err = DailyLimitRoom(TransferPolicy{DailyLimit: float(1000)}, "1500")
+	require.NoError(t, err)
+	require.Equal(t, 0, room.Sign())
+}
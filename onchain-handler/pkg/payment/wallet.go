@@ -12,6 +12,7 @@ import (
 
 	"github.com/genefriendway/onchain-handler/constants"
 	ucasetypes "github.com/genefriendway/onchain-handler/internal/domain/ucases/types"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 	"github.com/genefriendway/onchain-handler/pkg/crypto"
 	"github.com/genefriendway/onchain-handler/pkg/logger"
 )
@@ -58,6 +59,51 @@ func GetReceivingWallet(mnemonic, passphrase, salt string) (*accounts.Account, *
 	return account, privateKey, nil
 }
 
+// GetRelayerWallet returns the wallet sending gasless payments and paying their gas.
+func GetRelayerWallet(mnemonic, passphrase, salt string) (*accounts.Account, *ecdsa.PrivateKey, error) {
+	account, privateKey, err := crypto.GenerateAccount(mnemonic, passphrase, salt, constants.RelayerWallet, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate relayer wallet: %w", err)
+	}
+
+	return account, privateKey, nil
+}
+
+// GetGasSourceWallet returns the wallet refilling the receiving wallet with the native token for gas, it has to be funded with it.
+func GetGasSourceWallet(mnemonic, passphrase, salt string) (*accounts.Account, *ecdsa.PrivateKey, error) {
+	account, privateKey, err := crypto.GenerateAccount(mnemonic, passphrase, salt, constants.GasSourceWallet, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate gas source wallet: %w", err)
+	}
+
+	return account, privateKey, nil
+}
+
+// GetPayoutWallet returns the hot wallet the payouts are sent from, it has to be funded with the tokens and the native token for gas.
+func GetPayoutWallet(mnemonic, passphrase, salt string) (*accounts.Account, *ecdsa.PrivateKey, error) {
+	account, privateKey, err := crypto.GenerateAccount(mnemonic, passphrase, salt, constants.PayoutWallet, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate payout wallet: %w", err)
+	}
+
+	return account, privateKey, nil
+}
+
+// GetNetworkWallet returns the wallet of the type on the network, derived with the coin type of the network family.
+// It is the same wallet as the one of the EVM networks unless the family has its own coin type, as Tron does.
+func GetNetworkWallet(
+	network constants.NetworkType, walletType constants.WalletType, mnemonic, passphrase, salt string,
+) (*accounts.Account, *ecdsa.PrivateKey, error) {
+	account, privateKey, err := crypto.GenerateAccountForCoinType(
+		chain.ForNetwork(network).CoinType(), mnemonic, passphrase, salt, walletType, 0,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate %s wallet on %s: %w", walletType, network, err)
+	}
+
+	return account, privateKey, nil
+}
+
 func GenerateTempAddress() string {
 	uuidPart := uuid.New().String()
 	hash := sha256.Sum256([]byte(uuidPart))           // Hash UUID for uniqueness
@@ -0,0 +1,100 @@
+package screening
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
+)
+
+// Lookup returns the reason the address is denied on the network and whether it is denied at all,
+// e.g. from the denied address table.
+type Lookup func(ctx context.Context, network, address string) (string, bool, error)
+
+type denyListProvider struct {
+	entries map[string]string // Lower case hex address to the reason it is denied
+	lookup  Lookup
+}
+
+// NewDenyListProvider creates a provider flagging the addresses of the entries, on any network, and the addresses
+// denied by lookup. Either of them may be empty. Tron addresses are matched in their hex form.
+func NewDenyListProvider(entries map[string]string, lookup Lookup) Provider {
+	normalized := make(map[string]string, len(entries))
+	for address, reason := range entries {
+		normalized[entryKey(address)] = reason
+	}
+	return &denyListProvider{
+		entries: normalized,
+		lookup:  lookup,
+	}
+}
+
+func (p *denyListProvider) Name() string {
+	return constants.ScreeningProviderDenyList
+}
+
+func (p *denyListProvider) Screen(ctx context.Context, network, address string) (Result, error) {
+	if reason, exists := p.entries[entryKey(address)]; exists {
+		return Result{Flagged: true, Provider: p.Name(), Reason: reason}, nil
+	}
+
+	if p.lookup != nil {
+		reason, denied, err := p.lookup(ctx, network, address)
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to look up address %s in the deny-list: %w", address, err)
+		}
+		if denied {
+			return Result{Flagged: true, Provider: p.Name(), Reason: reason}, nil
+		}
+	}
+
+	return Result{Provider: p.Name()}, nil
+}
+
+func entryKey(address string) string {
+	return strings.ToLower(chain.NormalizeAddress(address))
+}
+
+// LoadDenyListFile reads the deny-list entries of a file in the ParseDenyList format.
+func LoadDenyListFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open deny-list file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return ParseDenyList(file)
+}
+
+// ParseDenyList reads one address per line, optionally followed by a comma and the reason it is denied
+// (e.g. "0x7F36...Bb2a,OFAC SDN"), EVM and Tron addresses are accepted. Blank lines and lines starting with # are skipped.
+func ParseDenyList(reader io.Reader) (map[string]string, error) {
+	entries := make(map[string]string)
+
+	scanner := bufio.NewScanner(reader)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		address, reason, _ := strings.Cut(text, ",")
+		address = strings.TrimSpace(address)
+		if !chain.EVM.IsValidAddress(address) && !chain.Tron.IsValidAddress(address) {
+			return nil, fmt.Errorf("invalid address %q on line %d", address, line)
+		}
+		entries[entryKey(address)] = strings.TrimSpace(reason)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read deny-list: %w", err)
+	}
+
+	return entries, nil
+}
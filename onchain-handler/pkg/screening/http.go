@@ -0,0 +1,77 @@
+package screening
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+type httpRequest struct {
+	Network string `json:"network"`
+	Address string `json:"address"`
+}
+
+type httpResponse struct {
+	Flagged bool   `json:"flagged"`
+	Reason  string `json:"reason"`
+}
+
+type httpProvider struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+// NewHTTPProvider creates a provider posting {"network", "address"} to the screening service at url, which answers
+// {"flagged", "reason"}. The API key, when set, is sent as a bearer token.
+func NewHTTPProvider(url, apiKey string, timeout time.Duration) Provider {
+	return &httpProvider{
+		url:    url,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *httpProvider) Name() string {
+	return constants.ScreeningProviderHTTP
+}
+
+func (p *httpProvider) Screen(ctx context.Context, network, address string) (Result, error) {
+	payload, err := json.Marshal(httpRequest{Network: network, Address: address})
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to marshal screening request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(payload))
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create screening request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to screen address %s: %w", address, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return Result{}, fmt.Errorf("screening service responded with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result httpResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Result{}, fmt.Errorf("failed to decode screening response: %w", err)
+	}
+
+	return Result{Flagged: result.Flagged, Provider: p.Name(), Reason: result.Reason}, nil
+}
@@ -0,0 +1,16 @@
+package screening
+
+import "context"
+
+// Result is the outcome of screening an address. Reason explains why a flagged address is flagged.
+type Result struct {
+	Flagged  bool
+	Provider string
+	Reason   string
+}
+
+// Provider screens the addresses payments are received from, e.g. against sanctions lists.
+type Provider interface {
+	Name() string
+	Screen(ctx context.Context, network, address string) (Result, error)
+}
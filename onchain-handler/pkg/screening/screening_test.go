@@ -0,0 +1,128 @@
+package screening
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	deniedAddress = "0x8589427373D6D84E98730D7795D8f6f8731FDA16"
+	cleanAddress  = "0x1111111111111111111111111111111111111111"
+)
+
+func TestParseDenyList(t *testing.T) {
+	t.Run("Entries", func(t *testing.T) {
+		entries, err := ParseDenyList(strings.NewReader(`
+# OFAC SDN list
+0x8589427373D6D84E98730D7795D8f6f8731FDA16, OFAC SDN
+0x2222222222222222222222222222222222222222
+`))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			strings.ToLower(deniedAddress):               "OFAC SDN",
+			"0x2222222222222222222222222222222222222222": "",
+		}, entries)
+	})
+
+	t.Run("InvalidAddress", func(t *testing.T) {
+		_, err := ParseDenyList(strings.NewReader("0x1234,too short\n"))
+		require.ErrorContains(t, err, "line 1")
+	})
+}
+
+func TestDenyListProvider(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Entries", func(t *testing.T) {
+		provider := NewDenyListProvider(map[string]string{deniedAddress: "OFAC SDN"}, nil)
+
+		result, err := provider.Screen(ctx, "BSC", strings.ToLower(deniedAddress))
+		require.NoError(t, err)
+		require.Equal(t, Result{Flagged: true, Provider: "deny_list", Reason: "OFAC SDN"}, result)
+
+		result, err = provider.Screen(ctx, "BSC", cleanAddress)
+		require.NoError(t, err)
+		require.False(t, result.Flagged)
+	})
+
+	t.Run("TronEntries", func(t *testing.T) {
+		// The same account written in base58 and in hex
+		provider := NewDenyListProvider(map[string]string{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t": "OFAC SDN"}, nil)
+
+		result, err := provider.Screen(ctx, "TRON", "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c")
+		require.NoError(t, err)
+		require.True(t, result.Flagged)
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		provider := NewDenyListProvider(nil, func(_ context.Context, network, address string) (string, bool, error) {
+			return "denied on " + network, network == "BSC" && address == cleanAddress, nil
+		})
+
+		result, err := provider.Screen(ctx, "BSC", cleanAddress)
+		require.NoError(t, err)
+		require.Equal(t, Result{Flagged: true, Provider: "deny_list", Reason: "denied on BSC"}, result)
+
+		result, err = provider.Screen(ctx, "AVAX C-Chain", cleanAddress)
+		require.NoError(t, err)
+		require.False(t, result.Flagged)
+	})
+
+	t.Run("LookupError", func(t *testing.T) {
+		provider := NewDenyListProvider(nil, func(context.Context, string, string) (string, bool, error) {
+			return "", false, errors.New("database unavailable")
+		})
+
+		_, err := provider.Screen(ctx, "BSC", cleanAddress)
+		require.ErrorContains(t, err, "database unavailable")
+	})
+}
+
+func TestHTTPProvider(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req httpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.Address == deniedAddress {
+			_ = json.NewEncoder(w).Encode(httpResponse{Flagged: true, Reason: "sanctioned on " + req.Network})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(httpResponse{})
+	}))
+	defer server.Close()
+
+	provider := NewHTTPProvider(server.URL, "secret", time.Second)
+
+	t.Run("Flagged", func(t *testing.T) {
+		result, err := provider.Screen(ctx, "BSC", deniedAddress)
+		require.NoError(t, err)
+		require.Equal(t, Result{Flagged: true, Provider: "http", Reason: "sanctioned on BSC"}, result)
+	})
+
+	t.Run("Clean", func(t *testing.T) {
+		result, err := provider.Screen(ctx, "BSC", cleanAddress)
+		require.NoError(t, err)
+		require.False(t, result.Flagged)
+	})
+
+	t.Run("ErrorStatus", func(t *testing.T) {
+		_, err := NewHTTPProvider(server.URL, "wrong", time.Second).Screen(ctx, "BSC", deniedAddress)
+		require.ErrorContains(t, err, "status 401")
+	})
+}
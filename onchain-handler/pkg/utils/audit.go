@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+// AuditSource is what changes a payment order, recorded with each change in the payment order audit.
+type AuditSource struct {
+	Actor       string // e.g. "listener:BSC", "worker:order_clean", "vendor:<Vendor-Id>" or "admin:<operator>"
+	BlockNumber uint64 // Block of the transfer behind the change, 0 when none
+	TxHash      string // Transaction of the transfer behind the change, empty when none
+	Note        string
+}
+
+type auditSourceKey struct{}
+
+// AuditActor names an actor of the given kind, e.g. AuditActor(constants.AuditActorListener, "BSC").
+func AuditActor(kind, name string) string {
+	if name == "" {
+		return kind
+	}
+	return kind + ":" + name
+}
+
+// WithAuditSource returns a context whose payment order changes are recorded under the source.
+func WithAuditSource(ctx context.Context, source AuditSource) context.Context {
+	return context.WithValue(ctx, auditSourceKey{}, source)
+}
+
+// WithAuditActor returns a context whose payment order changes are recorded under the actor.
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return WithAuditSource(ctx, AuditSource{Actor: actor})
+}
+
+// AuditSourceOf returns the source of the payment order changes made with the context,
+// a system actor when none was set.
+func AuditSourceOf(ctx context.Context) (AuditSource, bool) {
+	if source, ok := ctx.Value(auditSourceKey{}).(AuditSource); ok && source.Actor != "" {
+		return source, true
+	}
+	return AuditSource{Actor: constants.AuditActorSystem}, false
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+func TestAuditSourceOf(t *testing.T) {
+	t.Run("NoSource", func(t *testing.T) {
+		source, ok := AuditSourceOf(context.Background())
+		require.False(t, ok)
+		require.Equal(t, constants.AuditActorSystem, source.Actor)
+	})
+
+	t.Run("Actor", func(t *testing.T) {
+		ctx := WithAuditActor(context.Background(), AuditActor(constants.AuditActorListener, "BSC"))
+		source, ok := AuditSourceOf(ctx)
+		require.True(t, ok)
+		require.Equal(t, "listener:BSC", source.Actor)
+		require.Zero(t, source.BlockNumber)
+	})
+
+	t.Run("EmptyActor", func(t *testing.T) {
+		ctx := WithAuditSource(context.Background(), AuditSource{BlockNumber: 10})
+		source, ok := AuditSourceOf(ctx)
+		require.False(t, ok)
+		require.Equal(t, constants.AuditActorSystem, source.Actor)
+	})
+
+	t.Run("UnnamedActor", func(t *testing.T) {
+		require.Equal(t, constants.AuditActorAPI, AuditActor(constants.AuditActorAPI, ""))
+	})
+}
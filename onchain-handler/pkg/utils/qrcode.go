@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+// GenerateQRCodeDataURI encodes the content as a QR code and returns it as a PNG or SVG data URI,
+// ready to be used as an image source.
+func GenerateQRCodeDataURI(content, format string) (string, error) {
+	code, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode QR code: %w", err)
+	}
+
+	switch format {
+	case constants.QRCodeFormatPNG:
+		png, err := code.PNG(constants.QRCodeSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to render QR code as PNG: %w", err)
+		}
+		return "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+	case constants.QRCodeFormatSVG:
+		return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(qrCodeSVG(code.Bitmap()))), nil
+	default:
+		return "", fmt.Errorf("unsupported QR code format: %s", format)
+	}
+}
+
+// qrCodeSVG draws one square per dark module of the bitmap, the bitmap already includes the quiet zone.
+func qrCodeSVG(bitmap [][]bool) string {
+	size := len(bitmap)
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size)
+	fmt.Fprintf(&svg, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, size, size)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&svg, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	svg.WriteString(`"/></svg>`)
+	return svg.String()
+}
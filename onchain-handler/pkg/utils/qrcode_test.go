@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/skip2/go-qrcode"
+	"github.com/stretchr/testify/require"
+
+	"github.com/genefriendway/onchain-handler/constants"
+)
+
+const testPaymentURI = "ethereum:0x55d398326f99059fF775485246999027B3197955@56/transfer?address=0x1111111111111111111111111111111111111111&uint256=10000000000000000"
+
+func TestGenerateQRCodeDataURI(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		prefix    string
+		expectErr bool
+	}{
+		{name: "PNG", format: constants.QRCodeFormatPNG, prefix: "data:image/png;base64,"},
+		{name: "SVG", format: constants.QRCodeFormatSVG, prefix: "data:image/svg+xml;base64,"},
+		{name: "UnsupportedFormat", format: "gif", expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dataURI, err := GenerateQRCodeDataURI(testPaymentURI, test.format)
+			if test.expectErr {
+				require.Error(t, err)
+				require.Empty(t, dataURI)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(dataURI, test.prefix))
+
+			content, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(dataURI, test.prefix))
+			require.NoError(t, err)
+			require.NotEmpty(t, content)
+
+			again, err := GenerateQRCodeDataURI(testPaymentURI, test.format)
+			require.NoError(t, err)
+			require.Equal(t, dataURI, again, "the same content renders the same QR code")
+		})
+	}
+
+	t.Run("PNGSize", func(t *testing.T) {
+		dataURI, err := GenerateQRCodeDataURI(testPaymentURI, constants.QRCodeFormatPNG)
+		require.NoError(t, err)
+		content, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(dataURI, "data:image/png;base64,"))
+		require.NoError(t, err)
+
+		image, err := png.Decode(bytes.NewReader(content))
+		require.NoError(t, err)
+		require.Equal(t, constants.QRCodeSize, image.Bounds().Dx())
+		require.Equal(t, constants.QRCodeSize, image.Bounds().Dy())
+	})
+
+	t.Run("SVGMatchesBitmap", func(t *testing.T) {
+		code, err := qrcode.New(testPaymentURI, qrcode.Medium)
+		require.NoError(t, err)
+		bitmap := code.Bitmap()
+
+		dataURI, err := GenerateQRCodeDataURI(testPaymentURI, constants.QRCodeFormatSVG)
+		require.NoError(t, err)
+		content, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(dataURI, "data:image/svg+xml;base64,"))
+		require.NoError(t, err)
+
+		svg := string(content)
+		require.Contains(t, svg, fmt.Sprintf(`viewBox="0 0 %d %d"`, len(bitmap), len(bitmap)))
+		dark := 0
+		for _, row := range bitmap {
+			for _, module := range row {
+				if module {
+					dark++
+				}
+			}
+		}
+		require.Equal(t, dark, strings.Count(svg, "h1v1h-1z"), "one square per dark module")
+	})
+
+	t.Run("DifferentContent", func(t *testing.T) {
+		first, err := GenerateQRCodeDataURI(testPaymentURI, constants.QRCodeFormatSVG)
+		require.NoError(t, err)
+		second, err := GenerateQRCodeDataURI(strings.Replace(testPaymentURI, "uint256=1", "uint256=2", 1), constants.QRCodeFormatSVG)
+		require.NoError(t, err)
+		require.NotEqual(t, first, second)
+	})
+}
+
+func TestQRCodeSVG(t *testing.T) {
+	svg := qrCodeSVG([][]bool{
+		{true, false},
+		{false, true},
+	})
+	require.Equal(t,
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 2 2" shape-rendering="crispEdges">`+
+			`<rect width="2" height="2" fill="#fff"/><path fill="#000" d="M0 0h1v1h-1zM1 1h1v1h-1z"/></svg>`,
+		svg,
+	)
+}
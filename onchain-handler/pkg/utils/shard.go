@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+// AddressPartition maps an address to one of partitionCount hash partitions.
+// The address is lower-cased first so checksummed and plain hex forms land in the same partition.
+func AddressPartition(address string, partitionCount uint32) uint32 {
+	if partitionCount <= 1 {
+		return 0
+	}
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(strings.ToLower(address)))
+	return hasher.Sum32() % partitionCount
+}
+
+// BalanceShardAssignments distributes shards across the given instances.
+// Shards whose current owner is still alive keep that owner as long as the owner does not exceed its fair share,
+// so joining or leaving instances only move the minimum number of shards.
+// It returns the new owner for every shard, or an empty map if there are no instances.
+func BalanceShardAssignments(current map[string]string, shards, instances []string) map[string]string {
+	assignments := make(map[string]string, len(shards))
+	if len(instances) == 0 {
+		return assignments
+	}
+
+	sortedInstances := append([]string(nil), instances...)
+	sort.Strings(sortedInstances)
+	sortedShards := append([]string(nil), shards...)
+	sort.Strings(sortedShards)
+
+	// Each instance owns at most ceil(shards / instances) shards
+	maxPerInstance := (len(sortedShards) + len(sortedInstances) - 1) / len(sortedInstances)
+
+	load := make(map[string]int, len(sortedInstances))
+	for _, instance := range sortedInstances {
+		load[instance] = 0
+	}
+
+	// Step 1: Keep shards on their live owners up to the fair share
+	var orphans []string
+	for _, shard := range sortedShards {
+		owner, ok := current[shard]
+		if count, alive := load[owner]; ok && alive && count < maxPerInstance {
+			assignments[shard] = owner
+			load[owner]++
+			continue
+		}
+		orphans = append(orphans, shard)
+	}
+
+	// Step 2: Hand out the remaining shards to the least loaded instances
+	for _, shard := range orphans {
+		target := sortedInstances[0]
+		for _, instance := range sortedInstances[1:] {
+			if load[instance] < load[target] {
+				target = instance
+			}
+		}
+		assignments[shard] = target
+		load[target]++
+	}
+
+	return assignments
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressPartition(t *testing.T) {
+	t.Run("SinglePartition", func(t *testing.T) {
+		require.Equal(t, uint32(0), AddressPartition("0xAbC0000000000000000000000000000000000001", 1))
+		require.Equal(t, uint32(0), AddressPartition("0xAbC0000000000000000000000000000000000001", 0))
+	})
+
+	t.Run("CaseInsensitive", func(t *testing.T) {
+		checksummed := AddressPartition("0xAbC0000000000000000000000000000000000001", 8)
+		lower := AddressPartition("0xabc0000000000000000000000000000000000001", 8)
+		require.Equal(t, checksummed, lower)
+		require.Less(t, checksummed, uint32(8))
+	})
+}
+
+func TestBalanceShardAssignments(t *testing.T) {
+	shards := []string{"BSC_0", "BSC_1", "AVAX_0", "AVAX_1"}
+
+	t.Run("NoInstances", func(t *testing.T) {
+		require.Empty(t, BalanceShardAssignments(nil, shards, nil))
+	})
+
+	t.Run("EvenDistribution", func(t *testing.T) {
+		assignments := BalanceShardAssignments(nil, shards, []string{"a", "b"})
+		require.Len(t, assignments, len(shards))
+
+		load := map[string]int{}
+		for _, owner := range assignments {
+			load[owner]++
+		}
+		require.Equal(t, 2, load["a"])
+		require.Equal(t, 2, load["b"])
+	})
+
+	t.Run("KeepsLiveOwners", func(t *testing.T) {
+		current := map[string]string{"BSC_0": "a", "BSC_1": "b", "AVAX_0": "a", "AVAX_1": "b"}
+		assignments := BalanceShardAssignments(current, shards, []string{"a", "b", "c"})
+
+		moved := 0
+		for shard, owner := range assignments {
+			if current[shard] != owner {
+				moved++
+				require.Equal(t, "c", owner)
+			}
+		}
+		require.LessOrEqual(t, moved, 1)
+	})
+
+	t.Run("ReassignsShardsOfLeavingInstance", func(t *testing.T) {
+		current := map[string]string{"BSC_0": "a", "BSC_1": "b", "AVAX_0": "a", "AVAX_1": "b"}
+		assignments := BalanceShardAssignments(current, shards, []string{"a"})
+		for _, owner := range assignments {
+			require.Equal(t, "a", owner)
+		}
+	})
+}
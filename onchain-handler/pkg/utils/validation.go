@@ -4,22 +4,32 @@ import (
 	"fmt"
 
 	"github.com/genefriendway/onchain-handler/constants"
+	"github.com/genefriendway/onchain-handler/pkg/blockchain/chain"
 )
 
 func ValidateNetworkType(network string) error {
-	// Validate network type is either BSC or AVAX C-Chain
+	// Validate network type is either BSC, AVAX C-Chain or TRON
 	validNetworks := map[constants.NetworkType]bool{
 		constants.Bsc:        true,
 		constants.AvaxCChain: true,
+		constants.Tron:       true,
 	}
 	networkType := constants.NetworkType(network)
 	if !validNetworks[networkType] {
-		return fmt.Errorf("invalid network type: %s, must be BSC or AVAX C-Chain", network)
+		return fmt.Errorf("invalid network type: %s, must be BSC, AVAX C-Chain or TRON", network)
 	}
 
 	return nil
 }
 
+// IsValidAddress reports whether the address is valid on the network, or on any network when it is empty
+func IsValidAddress(network, address string) bool {
+	if network == "" {
+		return chain.EVM.IsValidAddress(address) || chain.Tron.IsValidAddress(address)
+	}
+	return chain.ForNetwork(constants.NetworkType(network)).IsValidAddress(address)
+}
+
 func ValidateSymbol(symbol string) error {
 	// Validate symbol is either USDT or USDC
 	validSymbols := map[string]bool{
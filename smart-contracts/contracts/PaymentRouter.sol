@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.6;
+
+import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import "@openzeppelin/contracts/token/ERC20/utils/SafeERC20.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/security/ReentrancyGuard.sol";
+
+contract PaymentRouter is Ownable, ReentrancyGuard {
+    using SafeERC20 for IERC20;
+
+    address public treasury;
+
+    event PaymentReceived(bytes32 indexed orderId, address indexed payer, address indexed token, uint256 amount);
+    event TreasuryUpdated(address indexed previousTreasury, address indexed newTreasury);
+
+    /**
+     * @dev Constructor that sets the address receiving the payments.
+     * @param initialTreasury The address receiving the payments.
+     */
+    constructor(address initialTreasury) {
+        require(initialTreasury != address(0), "Invalid address"); // Ensure valid address
+        treasury = initialTreasury;
+    }
+
+    /**
+     * @dev Pays an order by moving tokens from the caller to the treasury, the caller must approve the amount first.
+     * @param orderId The order ID given by the payment service when the order was created.
+     * @param token The ERC-20 token used to pay.
+     * @param amount The amount of tokens, in the smallest unit of the token.
+     */
+    function pay(bytes32 orderId, address token, uint256 amount) external nonReentrant {
+        require(amount > 0, "Invalid amount");
+        IERC20(token).safeTransferFrom(msg.sender, treasury, amount);
+        emit PaymentReceived(orderId, msg.sender, token, amount);
+    }
+
+    /**
+     * @dev Allows the contract owner to change the address receiving the payments.
+     * @param newTreasury The new address receiving the payments.
+     */
+    function setTreasury(address newTreasury) external onlyOwner {
+        require(newTreasury != address(0), "Invalid address"); // Ensure valid address
+        emit TreasuryUpdated(treasury, newTreasury);
+        treasury = newTreasury;
+    }
+}
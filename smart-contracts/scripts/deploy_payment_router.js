@@ -0,0 +1,32 @@
+// scripts/deploy_payment_router.js
+require("dotenv").config();
+
+const { ethers } = require("hardhat");
+
+async function main() {
+    const [deployer] = await ethers.getSigners();
+
+    console.log("Deploying PaymentRouter contract with account:", deployer.address);
+    const balance = await deployer.getBalance();
+    console.log("Account balance:", ethers.utils.formatEther(balance));
+
+    const treasury = process.env.PAYMENT_ROUTER_TREASURY;
+    if (!treasury) {
+        throw new Error("Please set PAYMENT_ROUTER_TREASURY in your .env file");
+    }
+
+    const PaymentRouter = await ethers.getContractFactory("PaymentRouter");
+    const paymentRouter = await PaymentRouter.deploy(treasury);
+
+    await paymentRouter.deployed();
+
+    console.log("PaymentRouter deployed to:", paymentRouter.address);
+    console.log("Payments are sent to:", treasury);
+}
+
+main()
+    .then(() => process.exit(0))
+    .catch((error) => {
+        console.error(error);
+        process.exit(1);
+    });